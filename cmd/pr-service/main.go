@@ -1,13 +1,70 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	_ "time/tzdata"
+
 	"github.com/Tortik3000/PR-service/config"
 	"github.com/Tortik3000/PR-service/internal/app"
 	"github.com/labstack/gommon/log"
 	"go.uber.org/zap"
 )
 
+const usage = `Использование:
+  pr-service [serve] [--no-migrate]    запустить сервис
+  pr-service migrate <команда>         управлять миграциями
+
+Команды migrate:
+  up        применить все новые миграции
+  down      откатить последнюю миграцию
+  redo      откатить и заново применить последнюю миграцию
+  status    показать состояние миграций
+  version   показать текущую и последнюю версии схемы
+`
+
 func main() {
+	command, args := parseCommand(os.Args[1:])
+
+	switch command {
+	case "serve":
+		flags := flag.NewFlagSet("serve", flag.ExitOnError)
+		flags.Usage = printUsage
+		noMigrate := flags.Bool("no-migrate", false, "не применять миграции при старте")
+		_ = flags.Parse(args)
+
+		cfg, logger := setup()
+		app.Run(logger, cfg, app.RunOptions{NoMigrate: *noMigrate})
+	case "migrate":
+		if len(args) != 1 {
+			printUsage()
+			os.Exit(2)
+		}
+
+		cfg, logger := setup()
+		if err := app.Migrate(logger, cfg, args[0], os.Stdout); err != nil {
+			log.Fatalf("migrate %s: %s", args[0], err)
+		}
+	case "help":
+		printUsage()
+	default:
+		printUsage()
+		os.Exit(2)
+	}
+}
+
+// parseCommand отделяет подкоманду от её аргументов: без команды или с одними
+// флагами бинарник запускает сервис, как раньше
+func parseCommand(args []string) (string, []string) {
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		return args[0], args[1:]
+	}
+	return "serve", args
+}
+
+func setup() (*config.Config, *zap.Logger) {
 	cfg, err := config.New()
 
 	if err != nil {
@@ -19,5 +76,9 @@ func main() {
 		log.Fatalf("can not initialize logger: %s", err)
 	}
 
-	app.Run(logger, cfg)
+	return cfg, logger
+}
+
+func printUsage() {
+	_, _ = fmt.Fprint(os.Stderr, usage)
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		args        []string
+		wantCommand string
+		wantArgs    []string
+	}{
+		{
+			name:        "no arguments",
+			args:        []string{},
+			wantCommand: "serve",
+			wantArgs:    []string{},
+		},
+		{
+			name:        "flags only",
+			args:        []string{"--no-migrate"},
+			wantCommand: "serve",
+			wantArgs:    []string{"--no-migrate"},
+		},
+		{
+			name:        "subcommand",
+			args:        []string{"migrate", "up"},
+			wantCommand: "migrate",
+			wantArgs:    []string{"up"},
+		},
+		{
+			name:        "empty argument",
+			args:        []string{"", "up"},
+			wantCommand: "",
+			wantArgs:    []string{"up"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			command, args := parseCommand(tt.args)
+			assert.Equal(t, tt.wantCommand, command)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultServer = "http://localhost:8080"
+	envServer     = "PRCTL_SERVER"
+	envToken      = "PRCTL_TOKEN"
+	envConfig     = "PRCTL_CONFIG"
+)
+
+type cliConfig struct {
+	Server string `yaml:"server"`
+	Token  string `yaml:"token"`
+}
+
+// defaultConfigPath возвращает ~/.config/prctl/config.yaml (или аналог для ОС)
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "prctl", "config.yaml")
+}
+
+// loadConfig читает файл конфигурации и накладывает поверх переменные окружения.
+// Отсутствие файла по умолчанию не ошибка, явно указанный файл должен существовать.
+func loadConfig(path string, explicit bool) (cliConfig, error) {
+	cfg := cliConfig{Server: defaultServer}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err = yaml.Unmarshal(data, &cfg); err != nil {
+				return cliConfig{}, fmt.Errorf("parse config %s: %w", path, err)
+			}
+		case errors.Is(err, fs.ErrNotExist) && !explicit:
+		default:
+			return cliConfig{}, fmt.Errorf("read config: %w", err)
+		}
+	}
+
+	if server := os.Getenv(envServer); server != "" {
+		cfg.Server = server
+	}
+	if token := os.Getenv(envToken); token != "" {
+		cfg.Token = token
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if err := newRootCommand(os.Stdout).ExecuteContext(ctx); err != nil {
+		// cobra уже напечатал ошибку
+		cancel()
+		os.Exit(1)
+	}
+}
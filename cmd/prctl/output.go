@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+var outputFormats = []string{formatTable, formatJSON, formatYAML}
+
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func newTable(header ...string) *table {
+	return &table{header: header}
+}
+
+func (t *table) add(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// render печатает value в выбранном формате. JSON и YAML повторяют ответ API,
+// таблица строится функцией toTable.
+func render(out io.Writer, format string, value any, toTable func() *table) error {
+	switch format {
+	case formatJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case formatYAML:
+		// через JSON, чтобы ключи совпадали с полями API, а не с именами полей Go
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		var node yaml.Node
+		if err = yaml.Unmarshal(data, &node); err != nil {
+			return err
+		}
+		resetStyle(&node)
+
+		encoder := yaml.NewEncoder(out)
+		encoder.SetIndent(2)
+		if err = encoder.Encode(&node); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case formatTable:
+		t := toTable()
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, strings.Join(t.header, "\t"))
+		for _, row := range t.rows {
+			_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown output format %q, expected one of %s", format, strings.Join(outputFormats, ", "))
+	}
+}
+
+// resetStyle убирает flow-стиль, который yaml выставляет для документа, прочитанного из JSON
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+func formatBool(v bool) string {
+	if v {
+		return "yes"
+	}
+	return "no"
+}
+
+func formatString(v *string) string {
+	if v == nil || *v == "" {
+		return "-"
+	}
+	return *v
+}
+
+func formatList(v []string) string {
+	if len(v) == 0 {
+		return "-"
+	}
+	return strings.Join(v, ",")
+}
+
+func formatTime(v *time.Time) string {
+	if v == nil {
+		return "-"
+	}
+	return v.UTC().Format(time.RFC3339)
+}
+
+func formatSeconds(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return time.Duration(*v * float64(time.Second)).Round(time.Second).String()
+}
+
+func formatInt(v *int) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.Itoa(*v)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-client"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	team := &api.Team{
+		TeamName: "backend",
+		Members: []api.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "42", Username: "Bob", IsActive: false},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:   "table",
+			format: formatTable,
+			expected: "TEAM     USER ID  USERNAME  ACTIVE\n" +
+				"backend  u1       Alice     yes\n" +
+				"backend  42       Bob       no\n",
+		},
+		{
+			name:   "json uses API field names",
+			format: formatJSON,
+			expected: `{
+  "members": [
+    {
+      "is_active": true,
+      "user_id": "u1",
+      "username": "Alice"
+    },
+    {
+      "is_active": false,
+      "user_id": "42",
+      "username": "Bob"
+    }
+  ],
+  "team_name": "backend"
+}
+`,
+		},
+		{
+			name:   "yaml keeps string ids quoted",
+			format: formatYAML,
+			expected: `members:
+  - is_active: true
+    user_id: u1
+    username: Alice
+  - is_active: false
+    user_id: "42"
+    username: Bob
+team_name: backend
+`,
+		},
+		{
+			name:    "unknown format",
+			format:  "xml",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			err := render(&out, tt.format, team, func() *table { return teamTable(team) })
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-client"
+)
+
+func newPRsCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prs",
+		Aliases: []string{"pr"},
+		Short:   "Pull request'ы",
+	}
+	cmd.AddCommand(
+		newPRsCreateCommand(c),
+		newPRsGetCommand(c),
+		newPRsMergeCommand(c),
+		newPRsReassignCommand(c),
+	)
+	cmd.PersistentFlags().String("repository", "", "репозиторий PR")
+	return cmd
+}
+
+func newPRsCreateCommand(c *cli) *cobra.Command {
+	var (
+		name         string
+		author       string
+		team         string
+		changedFiles []string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "create <pr_id> --name <name> --author <user_id>",
+		Short:   "Создать PR и назначить ревьюверов",
+		Example: `  prctl prs create pr-1001 --name "Add search" --author u1 --file internal/search.go`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			body := api.PostPullRequestCreateJSONRequestBody{
+				PullRequestId:   args[0],
+				PullRequestName: name,
+				AuthorId:        author,
+				TeamName:        optional(team),
+				Repository:      optional(repositoryFlag(cmd)),
+			}
+			if len(changedFiles) > 0 {
+				body.ChangedFiles = &changedFiles
+			}
+
+			resp, err := client.PostPullRequestCreateWithResponse(cmd.Context(), body)
+			if err != nil {
+				return err
+			}
+			created, err := decoded(resp.JSON201, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			if created.ReviewerShortfall != nil && *created.ReviewerShortfall > 0 {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: %d reviewer(s) could not be assigned\n",
+					*created.ReviewerShortfall)
+			}
+			return c.render(created, func() *table { return prTable(created.Pr, etagVersion(resp.HTTPResponse)) })
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "название PR")
+	cmd.Flags().StringVar(&author, "author", "", "user_id автора")
+	cmd.Flags().StringVar(&team, "team", "", "команда автора, из которой назначаются ревьюверы")
+	cmd.Flags().StringArrayVar(&changedFiles, "file", nil, "изменённый файл для правил CODEOWNERS")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("author")
+	_ = cmd.RegisterFlagCompletionFunc("team", c.completeTeams)
+
+	return cmd
+}
+
+func newPRsGetCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <pr_id>",
+		Short: "Показать PR",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetPullRequestGetWithResponse(cmd.Context(), &api.GetPullRequestGetParams{
+				PullRequestId: args[0],
+				Repository:    optional(repositoryFlag(cmd)),
+			})
+			if err != nil {
+				return err
+			}
+			result, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(result, func() *table { return prTable(result.Pr, etagVersion(resp.HTTPResponse)) })
+		},
+	}
+}
+
+func newPRsMergeCommand(c *cli) *cobra.Command {
+	var version int64
+
+	cmd := &cobra.Command{
+		Use:   "merge <pr_id>",
+		Short: "Пометить PR как MERGED",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.PostPullRequestMergeWithResponse(cmd.Context(),
+				&api.PostPullRequestMergeParams{IfMatch: ifMatch(cmd, version)},
+				api.PostPullRequestMergeJSONRequestBody{
+					PullRequestId: args[0],
+					Repository:    optional(repositoryFlag(cmd)),
+				})
+			if err != nil {
+				return err
+			}
+			result, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(result, func() *table { return prTable(result.Pr, etagVersion(resp.HTTPResponse)) })
+		},
+	}
+	cmd.Flags().Int64Var(&version, "if-version", 0, "выполнить, только если версия PR не менялась")
+
+	return cmd
+}
+
+func newPRsReassignCommand(c *cli) *cobra.Command {
+	var (
+		oldUserID string
+		newUserID string
+		exclude   []string
+		version   int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reassign <pr_id> --old <user_id>",
+		Short: "Переназначить ревьювера",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			body := api.PostPullRequestReassignJSONRequestBody{
+				PullRequestId: args[0],
+				OldUserId:     oldUserID,
+				NewUserId:     optional(newUserID),
+				Repository:    optional(repositoryFlag(cmd)),
+			}
+			if len(exclude) > 0 {
+				body.ExcludeUserIds = &exclude
+			}
+
+			resp, err := client.PostPullRequestReassignWithResponse(cmd.Context(),
+				&api.PostPullRequestReassignParams{IfMatch: ifMatch(cmd, version)}, body)
+			if err != nil {
+				return err
+			}
+			result, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(result, func() *table {
+				t := newTable("PR ID", "OLD REVIEWER", "NEW REVIEWER", "REVIEWERS", "VERSION")
+				t.add(result.Pr.PullRequestId, oldUserID, result.ReplacedBy,
+					formatList(result.Pr.AssignedReviewers), etagVersion(resp.HTTPResponse))
+				return t
+			})
+		},
+	}
+	cmd.Flags().StringVar(&oldUserID, "old", "", "ревьювер, которого нужно заменить")
+	cmd.Flags().StringVar(&newUserID, "new", "", "назначить конкретного пользователя вместо автоматического выбора")
+	cmd.Flags().StringSliceVar(&exclude, "exclude", nil, "пользователи, которых не следует выбирать")
+	cmd.Flags().Int64Var(&version, "if-version", 0, "выполнить, только если версия PR не менялась")
+	_ = cmd.MarkFlagRequired("old")
+
+	return cmd
+}
+
+func repositoryFlag(cmd *cobra.Command) string {
+	repository, _ := cmd.Flags().GetString("repository")
+	return repository
+}
+
+func prTable(pr *api.PullRequest, version string) *table {
+	t := newTable("PR ID", "NAME", "AUTHOR", "REPOSITORY", "STATUS", "REVIEWERS", "CREATED", "MERGED", "VERSION")
+	if pr == nil {
+		return t
+	}
+	t.add(pr.PullRequestId, pr.PullRequestName, pr.AuthorId, formatString(pr.Repository), string(pr.Status),
+		formatList(pr.AssignedReviewers), formatTime(pr.CreatedAt), formatTime(pr.MergedAt), version)
+	return t
+}
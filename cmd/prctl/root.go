@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-client"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type cli struct {
+	out        io.Writer
+	configPath string
+	server     string
+	token      string
+	output     string
+	timeout    time.Duration
+	client     *api.ClientWithResponses
+}
+
+func newRootCommand(out io.Writer) *cobra.Command {
+	c := &cli{out: out}
+
+	root := &cobra.Command{
+		Use:   "prctl",
+		Short: "Клиент REST API PR service для операторов",
+		Long: `prctl управляет командами, пользователями и PR через REST API PR service.
+
+Адрес сервера и токен берутся из флагов, переменных окружения PRCTL_SERVER и PRCTL_TOKEN
+или из файла конфигурации (по умолчанию ` + defaultConfigPath() + `):
+
+  server: http://localhost:8080
+  token: <токен>`,
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			if !slices.Contains(outputFormats, c.output) {
+				return fmt.Errorf("unknown output format %q, expected one of %s",
+					c.output, strings.Join(outputFormats, ", "))
+			}
+			return nil
+		},
+	}
+	root.SetOut(out)
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&c.configPath, "config", "", "файл конфигурации (env "+envConfig+")")
+	flags.StringVarP(&c.server, "server", "s", "", "адрес PR service (env "+envServer+")")
+	flags.StringVar(&c.token, "token", "", "токен, передаётся в заголовке Authorization (env "+envToken+")")
+	flags.StringVarP(&c.output, "output", "o", formatTable, "формат вывода: "+strings.Join(outputFormats, ", "))
+	flags.DurationVar(&c.timeout, "timeout", defaultTimeout, "таймаут запроса")
+	_ = root.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(outputFormats, cobra.ShellCompDirectiveNoFileComp))
+
+	root.AddCommand(
+		newTeamsCommand(c),
+		newUsersCommand(c),
+		newPRsCommand(c),
+		newStatsCommand(c),
+	)
+
+	return root
+}
+
+// apiClient создаёт клиент при первом обращении: автодополнение вызывает команды
+// без PersistentPreRunE, а флаги к этому моменту уже разобраны
+func (c *cli) apiClient() (*api.ClientWithResponses, error) {
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	path, explicit := c.configPath, c.configPath != ""
+	if !explicit {
+		path, explicit = os.Getenv(envConfig), os.Getenv(envConfig) != ""
+	}
+	if !explicit {
+		path = defaultConfigPath()
+	}
+
+	cfg, err := loadConfig(path, explicit)
+	if err != nil {
+		return nil, err
+	}
+	if c.server != "" {
+		cfg.Server = c.server
+	}
+	if c.token != "" {
+		cfg.Token = c.token
+	}
+
+	opts := []api.ClientOption{api.WithHTTPClient(&http.Client{Timeout: c.timeout})}
+	if cfg.Token != "" {
+		opts = append(opts, api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+cfg.Token)
+			return nil
+		}))
+	}
+
+	c.client, err = api.NewClientWithResponses(cfg.Server, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create client for %s: %w", cfg.Server, err)
+	}
+
+	return c.client, nil
+}
+
+func (c *cli) render(value any, toTable func() *table) error {
+	return render(c.out, c.output, value, toTable)
+}
+
+// decoded возвращает разобранный успешный ответ или ошибку из ErrorResponse
+func decoded[T any](value *T, resp *http.Response, body []byte) (*T, error) {
+	if value != nil {
+		return value, nil
+	}
+
+	var errResp api.ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Code != "" {
+		return nil, fmt.Errorf("%s: %s", errResp.Error.Code, errResp.Error.Message)
+	}
+	return nil, fmt.Errorf("unexpected response %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
+
+// ifMatch превращает --if-version в заголовок If-Match, если флаг задан
+func ifMatch(cmd *cobra.Command, version int64) *string {
+	if !cmd.Flags().Changed("if-version") {
+		return nil
+	}
+	etag := strconv.Quote(strconv.FormatInt(version, 10))
+	return &etag
+}
+
+// etagVersion достаёт версию ресурса из заголовка ETag
+func etagVersion(resp *http.Response) string {
+	version, err := strconv.Unquote(resp.Header.Get("ETag"))
+	if err != nil {
+		return "-"
+	}
+	return version
+}
+
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("server: http://pr-service:8080\ntoken: secret\n"), 0o600))
+	brokenPath := filepath.Join(dir, "broken.yaml")
+	require.NoError(t, os.WriteFile(brokenPath, []byte("server: [\n"), 0o600))
+	missingPath := filepath.Join(dir, "missing.yaml")
+
+	tests := []struct {
+		name     string
+		path     string
+		explicit bool
+		env      map[string]string
+		expected cliConfig
+		wantErr  bool
+	}{
+		{
+			name:     "file",
+			path:     configPath,
+			explicit: true,
+			expected: cliConfig{Server: "http://pr-service:8080", Token: "secret"},
+		},
+		{
+			name:     "environment overrides file",
+			path:     configPath,
+			env:      map[string]string{envServer: "http://other:8080", envToken: "other"},
+			expected: cliConfig{Server: "http://other:8080", Token: "other"},
+		},
+		{
+			name:     "missing default file",
+			path:     missingPath,
+			expected: cliConfig{Server: defaultServer},
+		},
+		{
+			name:     "missing explicit file",
+			path:     missingPath,
+			explicit: true,
+			wantErr:  true,
+		},
+		{
+			name:     "malformed file",
+			path:     brokenPath,
+			explicit: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// переменные окружения общие для процесса, поэтому без t.Parallel
+			t.Setenv(envServer, tt.env[envServer])
+			t.Setenv(envToken, tt.env[envToken])
+
+			cfg, err := loadConfig(tt.path, tt.explicit)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestCommands(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		method        string
+		path          string
+		query         string
+		ifMatch       string
+		authorization string
+		body          map[string]any
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		status   int
+		response string
+		etag     string
+		want     request
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "teams add sends members and token",
+			args:     []string{"teams", "add", "backend", "-m", "u1=Alice", "--token", "secret"},
+			status:   http.StatusCreated,
+			response: `{"team":{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}}`,
+			want: request{
+				method:        http.MethodPost,
+				path:          "/team/add",
+				authorization: "Bearer secret",
+				body: map[string]any{
+					"team_name": "backend",
+					"members":   []any{map[string]any{"user_id": "u1", "username": "Alice", "is_active": true}},
+				},
+			},
+			expected: "TEAM     USER ID  USERNAME  ACTIVE\nbackend  u1       Alice     yes\n",
+		},
+		{
+			name:     "prs get passes repository and shows version",
+			args:     []string{"prs", "get", "pr-1", "--repository", "api"},
+			status:   http.StatusOK,
+			etag:     `"3"`,
+			response: `{"pr":{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","repository":"api","status":"OPEN","assigned_reviewers":["u2"]}}`,
+			want: request{
+				method: http.MethodGet,
+				path:   "/pullRequest/get",
+				query:  "pull_request_id=pr-1&repository=api",
+			},
+			expected: "PR ID  NAME        AUTHOR  REPOSITORY  STATUS  REVIEWERS  CREATED  MERGED  VERSION\n" +
+				"pr-1   Add search  u1      api         OPEN    u2         -        -       3\n",
+		},
+		{
+			name:     "prs merge sends If-Match",
+			args:     []string{"prs", "merge", "pr-1", "--if-version", "2", "-o", "json"},
+			status:   http.StatusOK,
+			etag:     `"3"`,
+			response: `{"pr":{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","status":"MERGED","assigned_reviewers":[]}}`,
+			want: request{
+				method:  http.MethodPost,
+				path:    "/pullRequest/merge",
+				ifMatch: `"2"`,
+				body:    map[string]any{"pull_request_id": "pr-1"},
+			},
+			expected: `{
+  "pr": {
+    "assigned_reviewers": [],
+    "author_id": "u1",
+    "createdAt": null,
+    "mergedAt": null,
+    "pull_request_id": "pr-1",
+    "pull_request_name": "Add search",
+    "status": "MERGED"
+  }
+}
+`,
+		},
+		{
+			name:     "users deactivate",
+			args:     []string{"users", "deactivate", "u2"},
+			status:   http.StatusOK,
+			response: `{"user":{"user_id":"u2","username":"Bob","team_name":"backend","team_names":["backend","platform"],"is_active":false}}`,
+			want: request{
+				method: http.MethodPost,
+				path:   "/users/setIsActive",
+				body:   map[string]any{"user_id": "u2", "is_active": false},
+			},
+			expected: "USER ID  USERNAME  TEAMS             ACTIVE\nu2       Bob       backend,platform  no\n",
+		},
+		{
+			name:     "API error is reported",
+			args:     []string{"teams", "get", "unknown"},
+			status:   http.StatusNotFound,
+			response: `{"error":{"code":"NOT_FOUND","message":"team not found"}}`,
+			want: request{
+				method: http.MethodGet,
+				path:   "/team/get",
+				query:  "team_name=unknown",
+			},
+			wantErr: "NOT_FOUND: team not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = request{
+					method:        r.Method,
+					path:          r.URL.Path,
+					query:         r.URL.RawQuery,
+					ifMatch:       r.Header.Get("If-Match"),
+					authorization: r.Header.Get("Authorization"),
+				}
+				if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+					assert.NoError(t, json.Unmarshal(data, &got.body))
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if tt.etag != "" {
+					w.Header().Set("ETag", tt.etag)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			var out, errOut bytes.Buffer
+			root := newRootCommand(&out)
+			root.SetErr(&errOut)
+			root.SetArgs(append(tt.args, "--server", server.URL, "--config", os.DevNull))
+
+			err := root.ExecuteContext(t.Context())
+			assert.Equal(t, tt.want, got)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
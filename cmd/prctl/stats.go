@@ -0,0 +1,112 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-client"
+)
+
+func newStatsCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Статистика",
+	}
+	cmd.AddCommand(newStatsTeamsCommand(c), newStatsPairingsCommand(c))
+	return cmd
+}
+
+func newStatsTeamsCommand(c *cli) *cobra.Command {
+	var page, pageSize, weeks, staleDays int
+
+	cmd := &cobra.Command{
+		Use:   "teams",
+		Short: "Пропускная способность, время до мержа и зависшие PR по командам",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			params := &api.GetStatsTeamsParams{Page: &page, PageSize: &pageSize}
+			if cmd.Flags().Changed("weeks") {
+				params.Weeks = &weeks
+			}
+			if cmd.Flags().Changed("stale-days") {
+				params.StaleDays = &staleDays
+			}
+
+			resp, err := client.GetStatsTeamsWithResponse(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			stats, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(stats, func() *table {
+				t := newTable("TEAM", "ACTIVE MEMBERS", "UNDERSTAFFED", "STALE OPEN PRS", "TTM P50", "TTM P90", "OPENED", "MERGED")
+				for _, team := range stats.Teams {
+					var opened, merged int
+					for _, week := range team.Weekly {
+						opened += week.Opened
+						merged += week.Merged
+					}
+					t.add(team.TeamName, strconv.Itoa(team.ActiveMembers), formatBool(team.Understaffed),
+						strconv.Itoa(team.StaleOpenPrs), formatSeconds(team.TimeToMergeP50Seconds),
+						formatSeconds(team.TimeToMergeP90Seconds), strconv.Itoa(opened), strconv.Itoa(merged))
+				}
+				return t
+			})
+		},
+	}
+	cmd.Flags().IntVar(&page, "page", 1, "номер страницы")
+	cmd.Flags().IntVar(&pageSize, "page-size", 20, "размер страницы")
+	cmd.Flags().IntVar(&weeks, "weeks", 0, "за сколько последних недель считать PR")
+	cmd.Flags().IntVar(&staleDays, "stale-days", 0, "открытый PR старше стольких дней считается зависшим")
+
+	return cmd
+}
+
+func newStatsPairingsCommand(c *cli) *cobra.Command {
+	var days int
+
+	cmd := &cobra.Command{
+		Use:               "pairings <team>",
+		Short:             "Сколько раз ревьюверы назначались на PR авторов команды",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: c.completeTeams,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			params := &api.GetStatsPairingsParams{TeamName: args[0]}
+			if cmd.Flags().Changed("days") {
+				params.Days = &days
+			}
+
+			resp, err := client.GetStatsPairingsWithResponse(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			pairings, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(pairings, func() *table {
+				t := newTable("AUTHOR", "REVIEWER", "REVIEWS")
+				for _, pairing := range pairings.Pairings {
+					t.add(pairing.AuthorId, pairing.ReviewerId, strconv.Itoa(pairing.Reviews))
+				}
+				return t
+			})
+		},
+	}
+	cmd.Flags().IntVar(&days, "days", 0, "за сколько последних дней учитывать назначения")
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-client"
+)
+
+// teamsPageSize — максимальный размер страницы /stats/teams
+const teamsPageSize = 100
+
+type teamSummary struct {
+	TeamName      string `json:"team_name"`
+	ActiveMembers int    `json:"active_members"`
+	ReviewerCount int    `json:"reviewer_count"`
+	Understaffed  bool   `json:"understaffed"`
+}
+
+func newTeamsCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "teams",
+		Aliases: []string{"team"},
+		Short:   "Команды",
+	}
+	cmd.AddCommand(newTeamsAddCommand(c), newTeamsGetCommand(c), newTeamsListCommand(c))
+	return cmd
+}
+
+func newTeamsAddCommand(c *cli) *cobra.Command {
+	var members []string
+
+	cmd := &cobra.Command{
+		Use:     "add <team> --member <user_id>=<username>...",
+		Short:   "Создать команду с участниками",
+		Example: `  prctl teams add backend --member u1=Alice --member u2=Bob`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			team := api.Team{TeamName: args[0], Members: []api.TeamMember{}}
+			for _, member := range members {
+				userID, username, ok := strings.Cut(member, "=")
+				if !ok || userID == "" || username == "" {
+					return fmt.Errorf("invalid member %q, expected <user_id>=<username>", member)
+				}
+				team.Members = append(team.Members, api.TeamMember{UserId: userID, Username: username, IsActive: true})
+			}
+
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.PostTeamAddWithResponse(cmd.Context(), team)
+			if err != nil {
+				return err
+			}
+			created, err := decoded(resp.JSON201, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(created, func() *table { return teamTable(created.Team) })
+		},
+	}
+	cmd.Flags().StringArrayVarP(&members, "member", "m", nil, "участник в виде <user_id>=<username>")
+
+	return cmd
+}
+
+func newTeamsGetCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:               "get <team>",
+		Short:             "Показать состав команды",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: c.completeTeams,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetTeamGetWithResponse(cmd.Context(), &api.GetTeamGetParams{TeamName: args[0]})
+			if err != nil {
+				return err
+			}
+			team, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(team, func() *table { return teamTable(team) })
+		},
+	}
+}
+
+func newTeamsListCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Список команд",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			teams, err := c.listTeams(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			return c.render(teams, func() *table {
+				t := newTable("TEAM", "ACTIVE MEMBERS", "REVIEWERS PER PR", "UNDERSTAFFED")
+				for _, team := range teams {
+					t.add(team.TeamName, strconv.Itoa(team.ActiveMembers),
+						strconv.Itoa(team.ReviewerCount), formatBool(team.Understaffed))
+				}
+				return t
+			})
+		},
+	}
+}
+
+// listTeams обходит все страницы /stats/teams: отдельного списка команд в API нет
+func (c *cli) listTeams(ctx context.Context) ([]teamSummary, error) {
+	client, err := c.apiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	teams := []teamSummary{}
+	pageSize := teamsPageSize
+	for page := 1; ; page++ {
+		resp, err := client.GetStatsTeamsWithResponse(ctx, &api.GetStatsTeamsParams{Page: &page, PageSize: &pageSize})
+		if err != nil {
+			return nil, err
+		}
+		stats, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, team := range stats.Teams {
+			teams = append(teams, teamSummary{
+				TeamName:      team.TeamName,
+				ActiveMembers: team.ActiveMembers,
+				ReviewerCount: team.ReviewerCount,
+				Understaffed:  team.Understaffed,
+			})
+		}
+		if len(stats.Teams) == 0 || len(teams) >= stats.Total {
+			return teams, nil
+		}
+	}
+}
+
+// completeTeams дополняет имя команды списком с сервера
+func (c *cli) completeTeams(cmd *cobra.Command, args []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	teams, err := c.listTeams(cmd.Context())
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	names := make([]cobra.Completion, 0, len(teams))
+	for _, team := range teams {
+		names = append(names, team.TeamName)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func teamTable(team *api.Team) *table {
+	t := newTable("TEAM", "USER ID", "USERNAME", "ACTIVE")
+	if team == nil {
+		return t
+	}
+	for _, member := range team.Members {
+		t.add(team.TeamName, member.UserId, member.Username, formatBool(member.IsActive))
+	}
+	return t
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-client"
+)
+
+func newUsersCommand(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "users",
+		Aliases: []string{"user"},
+		Short:   "Пользователи",
+	}
+	cmd.AddCommand(
+		newUsersSetActiveCommand(c, "activate", "Вернуть пользователя к ревью", true),
+		newUsersSetActiveCommand(c, "deactivate", "Исключить пользователя из назначения на ревью", false),
+		newUsersReviewsCommand(c),
+	)
+	return cmd
+}
+
+func newUsersSetActiveCommand(c *cli, use, short string, isActive bool) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " <user_id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.PostUsersSetIsActiveWithResponse(cmd.Context(), api.PostUsersSetIsActiveJSONRequestBody{
+				UserId:   args[0],
+				IsActive: isActive,
+			})
+			if err != nil {
+				return err
+			}
+			result, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(result, func() *table {
+				t := newTable("USER ID", "USERNAME", "TEAMS", "ACTIVE")
+				if user := result.User; user != nil {
+					teams := []string{user.TeamName}
+					if user.TeamNames != nil {
+						teams = *user.TeamNames
+					}
+					t.add(user.UserId, user.Username, strings.Join(teams, ","), formatBool(user.IsActive))
+				}
+				return t
+			})
+		},
+	}
+}
+
+func newUsersReviewsCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reviews <user_id>",
+		Short: "PR, где пользователь назначен ревьювером",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetUsersGetReviewWithResponse(cmd.Context(), &api.GetUsersGetReviewParams{UserId: args[0]})
+			if err != nil {
+				return err
+			}
+			reviews, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+			if err != nil {
+				return err
+			}
+
+			return c.render(reviews, func() *table {
+				t := newTable("PR ID", "NAME", "AUTHOR", "REPOSITORY", "STATUS")
+				for _, pr := range reviews.PullRequests {
+					t.add(pr.PullRequestId, pr.PullRequestName, pr.AuthorId,
+						formatString(pr.Repository), string(pr.Status))
+				}
+				return t
+			})
+		},
+	}
+}
@@ -5,44 +5,118 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultEscalationInterval  = time.Minute
+	defaultOutOfOfficeInterval = time.Minute
+	defaultIdempotencyTTL      = 24 * time.Hour
+	defaultIdempotencyCleanup  = time.Hour
+	defaultSQLitePath          = "pr-service.db"
+	defaultCacheTTL            = time.Minute
+)
+
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+	StorageSQLite   = "sqlite"
 )
 
 type (
 	Config struct {
 		REST
+		Storage
 		PG
+		SQLite
 		Observability
+		Escalation
+		OutOfOffice
+		Idempotency
+		Cache
+		Auth
 	}
 
 	REST struct {
 		Port string `setEnv:"PORT"`
 	}
 
+	Storage struct {
+		Type string `env:"STORAGE"`
+	}
+
 	PG struct {
-		URL      string
-		Host     string `setEnv:"POSTGRES_HOST"`
-		Port     string `setEnv:"POSTGRES_PORT"`
-		DB       string `setEnv:"POSTGRES_DB"`
-		User     string `setEnv:"POSTGRES_USER"`
-		Password string `setEnv:"POSTGRES_PASSWORD"`
+		URL        string
+		Host       string `setEnv:"POSTGRES_HOST"`
+		Port       string `setEnv:"POSTGRES_PORT"`
+		DB         string `setEnv:"POSTGRES_DB"`
+		User       string `setEnv:"POSTGRES_USER"`
+		Password   string `setEnv:"POSTGRES_PASSWORD"`
+		ReplicaURL string `env:"POSTGRES_REPLICA_URL"`
+	}
+
+	SQLite struct {
+		Path string `env:"SQLITE_PATH"`
 	}
 
 	Observability struct {
 		MetricsPort string `env:"METRICS_PORT"`
 	}
+
+	Escalation struct {
+		Interval time.Duration `env:"ESCALATION_INTERVAL"`
+	}
+
+	OutOfOffice struct {
+		Interval time.Duration `env:"OUT_OF_OFFICE_INTERVAL"`
+	}
+
+	Idempotency struct {
+		TTL             time.Duration `env:"IDEMPOTENCY_TTL"`
+		CleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+	}
+
+	Cache struct {
+		TTL time.Duration `env:"CACHE_TTL"`
+	}
+
+	// Auth сопоставляет токен организации; пустой набор отключает проверку токенов
+	Auth struct {
+		APIKeys map[string]string `env:"API_KEYS"`
+	}
 )
 
 func New() (*Config, error) {
 	cfg := &Config{}
 
+	cfg.Storage.Type = os.Getenv("STORAGE")
+	switch cfg.Storage.Type {
+	case "":
+		cfg.Storage.Type = StoragePostgres
+	case StoragePostgres, StorageMemory, StorageSQLite:
+	default:
+		return nil, fmt.Errorf("environment variable STORAGE: unknown storage %q", cfg.Storage.Type)
+	}
+
 	envVars := map[string]*string{
-		"REST_PORT":         &cfg.REST.Port,
-		"POSTGRES_HOST":     &cfg.PG.Host,
-		"POSTGRES_PORT":     &cfg.PG.Port,
-		"POSTGRES_DB":       &cfg.PG.DB,
-		"POSTGRES_USER":     &cfg.PG.User,
-		"POSTGRES_PASSWORD": &cfg.PG.Password,
-		"METRICS_PORT":      &cfg.Observability.MetricsPort,
+		"REST_PORT":    &cfg.REST.Port,
+		"METRICS_PORT": &cfg.Observability.MetricsPort,
+	}
+	if cfg.Storage.Type == StoragePostgres {
+		envVars["POSTGRES_HOST"] = &cfg.PG.Host
+		envVars["POSTGRES_PORT"] = &cfg.PG.Port
+		envVars["POSTGRES_DB"] = &cfg.PG.DB
+		envVars["POSTGRES_USER"] = &cfg.PG.User
+		envVars["POSTGRES_PASSWORD"] = &cfg.PG.Password
+		cfg.PG.ReplicaURL = os.Getenv("POSTGRES_REPLICA_URL")
+	}
+
+	if cfg.Storage.Type == StorageSQLite {
+		cfg.SQLite.Path = os.Getenv("SQLITE_PATH")
+		if cfg.SQLite.Path == "" {
+			cfg.SQLite.Path = defaultSQLitePath
+		}
 	}
 
 	for name, ptr := range envVars {
@@ -51,6 +125,42 @@ func New() (*Config, error) {
 		}
 	}
 
+	interval, err := optionalIntervalEnv("ESCALATION_INTERVAL", defaultEscalationInterval)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Escalation.Interval = interval
+
+	interval, err = optionalIntervalEnv("OUT_OF_OFFICE_INTERVAL", defaultOutOfOfficeInterval)
+	if err != nil {
+		return nil, err
+	}
+	cfg.OutOfOffice.Interval = interval
+
+	ttl, err := optionalDurationEnv("IDEMPOTENCY_TTL", defaultIdempotencyTTL)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Idempotency.TTL = ttl
+
+	interval, err = optionalIntervalEnv("IDEMPOTENCY_CLEANUP_INTERVAL", defaultIdempotencyCleanup)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Idempotency.CleanupInterval = interval
+
+	ttl, err = optionalDurationEnv("CACHE_TTL", defaultCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Cache.TTL = ttl
+
+	apiKeys, err := apiKeysEnv("API_KEYS")
+	if err != nil {
+		return nil, err
+	}
+	cfg.Auth.APIKeys = apiKeys
+
 	cfg.PG.URL = fmt.Sprintf(
 		"postgres://%s:%s@%s/%s?sslmode=disable",
 		url.QueryEscape(cfg.PG.User),
@@ -74,3 +184,50 @@ func requireEnv(storage *string, envName string) error {
 	*storage = val
 	return nil
 }
+
+// optionalIntervalEnv читает период фоновой задачи: time.NewTicker не принимает
+// нулевые и отрицательные значения, поэтому они отклоняются при старте
+func optionalIntervalEnv(envName string, def time.Duration) (time.Duration, error) {
+	d, err := optionalDurationEnv(envName, def)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("environment variable %s: interval must be positive, got %s", envName, d)
+	}
+	return d, nil
+}
+
+func optionalDurationEnv(envName string, def time.Duration) (time.Duration, error) {
+	val := os.Getenv(envName)
+	if val == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("environment variable %s: %w", envName, err)
+	}
+	return d, nil
+}
+
+// apiKeysEnv читает пары организация:токен через запятую, например "acme:s3cret,globex:t0ken"
+func apiKeysEnv(envName string) (map[string]string, error) {
+	val := os.Getenv(envName)
+	if val == "" {
+		return nil, nil
+	}
+
+	keys := make(map[string]string)
+	for _, pair := range strings.Split(val, ",") {
+		organization, token, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || organization == "" || token == "" {
+			return nil, fmt.Errorf("environment variable %s: expected organization:token, got %q", envName, pair)
+		}
+		if _, ok = keys[token]; ok {
+			return nil, fmt.Errorf("environment variable %s: token of organization %s is already used", envName, organization)
+		}
+		keys[token] = organization
+	}
+	return keys, nil
+}
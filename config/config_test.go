@@ -0,0 +1,116 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_Intervals(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    time.Duration
+		wantErr string
+	}{
+		{
+			name: "default",
+			want: defaultEscalationInterval,
+		},
+		{
+			name: "custom",
+			env:  map[string]string{"ESCALATION_INTERVAL": "30s"},
+			want: 30 * time.Second,
+		},
+		{
+			name:    "zero",
+			env:     map[string]string{"ESCALATION_INTERVAL": "0s"},
+			wantErr: "environment variable ESCALATION_INTERVAL: interval must be positive, got 0s",
+		},
+		{
+			name:    "negative",
+			env:     map[string]string{"OUT_OF_OFFICE_INTERVAL": "-1m"},
+			wantErr: "environment variable OUT_OF_OFFICE_INTERVAL: interval must be positive, got -1m0s",
+		},
+		{
+			name:    "zero cleanup",
+			env:     map[string]string{"IDEMPOTENCY_CLEANUP_INTERVAL": "0"},
+			wantErr: "environment variable IDEMPOTENCY_CLEANUP_INTERVAL: interval must be positive, got 0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("STORAGE", StorageMemory)
+			t.Setenv("REST_PORT", "8080")
+			t.Setenv("METRICS_PORT", "9090")
+			for _, name := range []string{"ESCALATION_INTERVAL", "OUT_OF_OFFICE_INTERVAL", "IDEMPOTENCY_CLEANUP_INTERVAL"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			cfg, err := New()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cfg.Escalation.Interval)
+		})
+	}
+}
+
+func TestNew_APIKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name: "pairs",
+			env:  "acme:t1, globex:t2",
+			want: map[string]string{"t1": "acme", "t2": "globex"},
+		},
+		{
+			name:    "missing token",
+			env:     "acme:t1,globex",
+			wantErr: `environment variable API_KEYS: expected organization:token, got "globex"`,
+		},
+		{
+			name:    "empty organization",
+			env:     ":t1",
+			wantErr: `environment variable API_KEYS: expected organization:token, got ":t1"`,
+		},
+		{
+			name:    "shared token",
+			env:     "acme:t1,globex:t1",
+			wantErr: "environment variable API_KEYS: token of organization globex is already used",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("REST_PORT", "8080")
+			t.Setenv("METRICS_PORT", "9090")
+			t.Setenv("POSTGRES_HOST", "localhost")
+			t.Setenv("POSTGRES_PORT", "5432")
+			t.Setenv("POSTGRES_DB", "pr-service")
+			t.Setenv("POSTGRES_USER", "user")
+			t.Setenv("POSTGRES_PASSWORD", "password")
+			t.Setenv("API_KEYS", tt.env)
+
+			cfg, err := New()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cfg.Auth.APIKeys)
+		})
+	}
+}
@@ -1,28 +1,85 @@
 package db
 
 import (
+	"context"
+	"database/sql"
 	"embed"
-	"os"
+	"fmt"
+	"io/fs"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
-	"go.uber.org/zap"
 )
 
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
-func SetupPostgres(pool *pgxpool.Pool, logger *zap.Logger) {
-	goose.SetBaseFS(embedMigrations)
-	if err := goose.SetDialect("postgres"); err != nil {
-		logger.Error("can not set dialect in goose", zap.Error(err))
-		os.Exit(1)
+//go:embed sqlite/migrations/*.sql
+var embedSQLiteMigrations embed.FS
+
+// Migrator применяет встроенные в бинарник миграции. Провайдер goose не
+// использует глобальное состояние, поэтому мигратор можно создавать для
+// нескольких баз в одном процессе.
+type Migrator struct {
+	provider *goose.Provider
+}
+
+func NewPostgresMigrator(pool *pgxpool.Pool) (*Migrator, error) {
+	return newMigrator(goose.DialectPostgres, stdlib.OpenDBFromPool(pool), embedMigrations, "migrations")
+}
+
+func NewSQLiteMigrator(db *sql.DB) (*Migrator, error) {
+	return newMigrator(goose.DialectSQLite3, db, embedSQLiteMigrations, "sqlite/migrations")
+}
+
+func newMigrator(dialect goose.Dialect, db *sql.DB, fsys fs.FS, dir string) (*Migrator, error) {
+	migrations, err := fs.Sub(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("open migrations: %w", err)
+	}
+
+	provider, err := goose.NewProvider(dialect, db, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("create migration provider: %w", err)
+	}
+
+	return &Migrator{provider: provider}, nil
+}
+
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	return m.provider.Up(ctx)
+}
+
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	return m.provider.Down(ctx)
+}
+
+// Redo откатывает и заново применяет последнюю миграцию
+func (m *Migrator) Redo(ctx context.Context) ([]*goose.MigrationResult, error) {
+	down, err := m.provider.Down(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	db := stdlib.OpenDBFromPool(pool)
-	if err := goose.Up(db, "migrations"); err != nil {
-		logger.Error("can not setup migrations", zap.Error(err))
-		os.Exit(1)
+	up, err := m.provider.UpByOne(ctx)
+	if err != nil {
+		return []*goose.MigrationResult{down}, err
 	}
+
+	return []*goose.MigrationResult{down, up}, nil
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx)
+}
+
+// Version возвращает текущую версию схемы и последнюю версию среди встроенных миграций
+func (m *Migrator) Version(ctx context.Context) (current, latest int64, err error) {
+	return m.provider.GetVersions(ctx)
+}
+
+// Pending сообщает, что в базе применены не все встроенные миграции
+func (m *Migrator) Pending(ctx context.Context) (bool, error) {
+	return m.provider.HasPending(ctx)
 }
@@ -0,0 +1,93 @@
+package db_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/db"
+	"github.com/Tortik3000/PR-service/internal/repository/sqlite"
+)
+
+func TestMigrator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		migrate     func(t *testing.T, migrator *db.Migrator)
+		wantCurrent int64
+		wantPending bool
+	}{
+		{
+			name:        "fresh database is behind",
+			migrate:     func(*testing.T, *db.Migrator) {},
+			wantCurrent: 0,
+			wantPending: true,
+		},
+		{
+			name: "up applies all migrations",
+			migrate: func(t *testing.T, migrator *db.Migrator) {
+				results, err := migrator.Up(t.Context())
+				require.NoError(t, err)
+				require.NotEmpty(t, results)
+
+				results, err = migrator.Up(t.Context())
+				require.NoError(t, err)
+				require.Empty(t, results)
+			},
+			wantCurrent: 1,
+		},
+		{
+			name: "down rolls back last migration",
+			migrate: func(t *testing.T, migrator *db.Migrator) {
+				_, err := migrator.Up(t.Context())
+				require.NoError(t, err)
+
+				result, err := migrator.Down(t.Context())
+				require.NoError(t, err)
+				require.Equal(t, "down", result.Direction)
+			},
+			wantCurrent: 0,
+			wantPending: true,
+		},
+		{
+			name: "redo reapplies last migration",
+			migrate: func(t *testing.T, migrator *db.Migrator) {
+				_, err := migrator.Up(t.Context())
+				require.NoError(t, err)
+
+				results, err := migrator.Redo(t.Context())
+				require.NoError(t, err)
+				require.Len(t, results, 2)
+				require.Equal(t, "down", results[0].Direction)
+				require.Equal(t, "up", results[1].Direction)
+			},
+			wantCurrent: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, err := sqlite.Open(filepath.Join(t.TempDir(), "pr-service.db"))
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = sqlDB.Close() })
+
+			migrator, err := db.NewSQLiteMigrator(sqlDB)
+			require.NoError(t, err)
+
+			tt.migrate(t, migrator)
+
+			current, latest, err := migrator.Version(t.Context())
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCurrent, current)
+			require.Equal(t, int64(1), latest)
+
+			pending, err := migrator.Pending(t.Context())
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPending, pending)
+		})
+	}
+}
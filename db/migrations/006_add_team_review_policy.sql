@@ -0,0 +1,18 @@
+-- +goose Up
+
+ALTER TABLE team
+    ADD COLUMN review_sla_seconds BIGINT,
+    ADD COLUMN escalation_policy  TEXT NOT NULL DEFAULT 'REASSIGN'
+        CHECK (escalation_policy IN ('REASSIGN', 'ADD_REVIEWER'));
+
+ALTER TABLE assigned_reviewer
+    ADD COLUMN assigned_at TIMESTAMP DEFAULT now() NOT NULL;
+
+
+-- +goose Down
+ALTER TABLE assigned_reviewer
+    DROP COLUMN assigned_at;
+
+ALTER TABLE team
+    DROP COLUMN escalation_policy,
+    DROP COLUMN review_sla_seconds;
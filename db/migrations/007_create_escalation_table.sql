@@ -0,0 +1,18 @@
+-- +goose Up
+
+CREATE TABLE escalation
+(
+    id               BIGSERIAL PRIMARY KEY,
+    pr_id            TEXT REFERENCES pull_request (id) NOT NULL,
+    team_id          BIGINT REFERENCES team (id)       NOT NULL,
+    idle_reviewer_id TEXT REFERENCES users (id)        NOT NULL,
+    new_reviewer_id  TEXT REFERENCES users (id),
+    policy           TEXT                              NOT NULL,
+    created_at       TIMESTAMP DEFAULT now()           NOT NULL
+);
+
+CREATE INDEX escalation_pr_reviewer_idx ON escalation (pr_id, idle_reviewer_id);
+
+
+-- +goose Down
+DROP TABLE escalation;
@@ -0,0 +1,13 @@
+-- +goose Up
+
+ALTER TABLE users
+    ADD COLUMN time_zone         TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN work_start_minute INT CHECK (work_start_minute BETWEEN 0 AND 1439),
+    ADD COLUMN work_end_minute   INT CHECK (work_end_minute BETWEEN 0 AND 1439);
+
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN work_end_minute,
+    DROP COLUMN work_start_minute,
+    DROP COLUMN time_zone;
@@ -0,0 +1,18 @@
+-- +goose Up
+
+CREATE TABLE out_of_office
+(
+    id                    BIGSERIAL PRIMARY KEY,
+    user_id               TEXT REFERENCES users (id) NOT NULL,
+    starts_at             TIMESTAMP                  NOT NULL,
+    ends_at               TIMESTAMP                  NOT NULL,
+    reassign_reviews      BOOLEAN DEFAULT FALSE      NOT NULL,
+    reviews_reassigned_at TIMESTAMP,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX out_of_office_user_period_idx ON out_of_office (user_id, starts_at, ends_at);
+
+
+-- +goose Down
+DROP TABLE out_of_office;
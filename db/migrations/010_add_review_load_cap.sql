@@ -0,0 +1,18 @@
+-- +goose Up
+
+ALTER TABLE users
+    ADD COLUMN max_open_reviews INT CHECK (max_open_reviews > 0);
+
+ALTER TABLE team
+    ADD COLUMN default_max_open_reviews INT CHECK (default_max_open_reviews > 0),
+    ADD COLUMN shortfall_policy         TEXT NOT NULL DEFAULT 'ALLOW'
+        CHECK (shortfall_policy IN ('ALLOW', 'REJECT'));
+
+
+-- +goose Down
+ALTER TABLE team
+    DROP COLUMN shortfall_policy,
+    DROP COLUMN default_max_open_reviews;
+
+ALTER TABLE users
+    DROP COLUMN max_open_reviews;
@@ -0,0 +1,20 @@
+-- +goose Up
+
+CREATE TABLE team_fallback
+(
+    team_id          BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    fallback_team_id BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    priority         INT                                           NOT NULL,
+    PRIMARY KEY (team_id, fallback_team_id),
+    CHECK (team_id <> fallback_team_id)
+);
+
+ALTER TABLE assigned_reviewer
+    ADD COLUMN from_fallback BOOLEAN DEFAULT FALSE NOT NULL;
+
+
+-- +goose Down
+ALTER TABLE assigned_reviewer
+    DROP COLUMN from_fallback;
+
+DROP TABLE team_fallback;
@@ -0,0 +1,14 @@
+-- +goose Up
+
+CREATE TABLE codeowners_rule
+(
+    team_id  BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    position INT                                           NOT NULL,
+    pattern  TEXT                                          NOT NULL,
+    owners   JSONB DEFAULT '[]'::JSONB                     NOT NULL,
+    PRIMARY KEY (team_id, position)
+);
+
+
+-- +goose Down
+DROP TABLE codeowners_rule;
@@ -0,0 +1,31 @@
+-- +goose Up
+
+CREATE TABLE review_pairing
+(
+    id          BIGSERIAL PRIMARY KEY,
+    pr_id       TEXT REFERENCES pull_request (id) ON DELETE CASCADE NOT NULL,
+    author_id   TEXT REFERENCES users (id) ON DELETE CASCADE        NOT NULL,
+    reviewer_id TEXT REFERENCES users (id) ON DELETE CASCADE        NOT NULL,
+    assigned_at TIMESTAMP DEFAULT now()                             NOT NULL
+);
+
+CREATE INDEX review_pairing_author_idx ON review_pairing (author_id, assigned_at);
+
+INSERT INTO review_pairing (pr_id, author_id, reviewer_id, assigned_at)
+SELECT ar.pr_id, pr.author_id, ar.user_id, ar.assigned_at
+FROM assigned_reviewer ar
+         JOIN pull_request pr ON pr.id = ar.pr_id;
+
+ALTER TABLE team
+    ADD COLUMN selection_mode          TEXT   NOT NULL DEFAULT 'DEFAULT'
+        CHECK (selection_mode IN ('DEFAULT', 'ROTATION')),
+    ADD COLUMN rotation_window_seconds BIGINT NOT NULL DEFAULT 2592000
+        CHECK (rotation_window_seconds > 0);
+
+
+-- +goose Down
+ALTER TABLE team
+    DROP COLUMN rotation_window_seconds,
+    DROP COLUMN selection_mode;
+
+DROP TABLE review_pairing;
@@ -0,0 +1,50 @@
+-- +goose Up
+
+CREATE TABLE team_audit
+(
+    id             BIGSERIAL PRIMARY KEY,
+    action         TEXT                                            NOT NULL,
+    team_id        BIGINT                                          NOT NULL,
+    team_name      TEXT                                            NOT NULL,
+    user_id        TEXT REFERENCES users (id) ON DELETE CASCADE,
+    from_team_id   BIGINT,
+    from_team_name TEXT,
+    created_at     TIMESTAMP DEFAULT now()                         NOT NULL,
+    CHECK (action IN ('TEAM_CREATED', 'TEAM_RENAMED', 'TEAM_DELETED',
+                      'MEMBER_ADDED', 'MEMBER_REMOVED', 'MEMBER_MOVED'))
+);
+
+CREATE INDEX team_audit_team_idx ON team_audit (team_id);
+CREATE INDEX team_audit_from_team_idx ON team_audit (from_team_id);
+
+-- история эскалаций переживает удаление команды: ссылка обнуляется, имя команды остаётся в записи
+ALTER TABLE escalation
+    ADD COLUMN team_name TEXT,
+    ALTER COLUMN team_id DROP NOT NULL,
+    DROP CONSTRAINT escalation_team_id_fkey,
+    ADD CONSTRAINT escalation_team_id_fkey
+        FOREIGN KEY (team_id) REFERENCES team (id) ON DELETE SET NULL;
+
+UPDATE escalation e
+SET team_name = t.name
+FROM team t
+WHERE t.id = e.team_id;
+
+ALTER TABLE escalation
+    ALTER COLUMN team_name SET NOT NULL;
+
+
+-- +goose Down
+-- до этой миграции эскалация не могла остаться без команды, записи удалённых команд не сохраняются
+DELETE
+FROM escalation
+WHERE team_id IS NULL;
+
+ALTER TABLE escalation
+    DROP CONSTRAINT escalation_team_id_fkey,
+    ADD CONSTRAINT escalation_team_id_fkey
+        FOREIGN KEY (team_id) REFERENCES team (id),
+    ALTER COLUMN team_id SET NOT NULL,
+    DROP COLUMN team_name;
+
+DROP TABLE team_audit;
@@ -0,0 +1,44 @@
+-- +goose Up
+
+CREATE TABLE team_membership
+(
+    team_id   BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    user_id   TEXT REFERENCES users (id) ON DELETE CASCADE  NOT NULL,
+    joined_at TIMESTAMP DEFAULT now()                       NOT NULL,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX team_membership_user_idx ON team_membership (user_id);
+
+INSERT INTO team_membership (team_id, user_id)
+SELECT team_id, id
+FROM users
+WHERE team_id IS NOT NULL;
+
+ALTER TABLE pull_request
+    ADD COLUMN team_id BIGINT REFERENCES team (id) ON DELETE SET NULL;
+
+UPDATE pull_request pr
+SET team_id = u.team_id
+FROM users u
+WHERE u.id = pr.author_id;
+
+ALTER TABLE users
+    DROP COLUMN team_id;
+
+
+-- +goose Down
+ALTER TABLE users
+    ADD COLUMN team_id BIGINT REFERENCES team (id);
+
+UPDATE users u
+SET team_id = (SELECT m.team_id
+               FROM team_membership m
+               WHERE m.user_id = u.id
+               ORDER BY m.joined_at, m.team_id
+               LIMIT 1);
+
+ALTER TABLE pull_request
+    DROP COLUMN team_id;
+
+DROP TABLE team_membership;
@@ -0,0 +1,35 @@
+-- +goose Up
+
+CREATE TABLE repository
+(
+    id               BIGSERIAL PRIMARY KEY,
+    name             TEXT UNIQUE                                  NOT NULL,
+    owner_team_id    BIGINT REFERENCES team (id) ON DELETE SET NULL,
+    reviewer_count   INT CHECK (reviewer_count BETWEEN 1 AND 5),
+    shortfall_policy TEXT CHECK (shortfall_policy IN ('ALLOW', 'REJECT')),
+    selection_mode   TEXT CHECK (selection_mode IN ('DEFAULT', 'ROTATION')),
+    created_at       TIMESTAMP DEFAULT now()                      NOT NULL
+);
+
+ALTER TABLE pull_request
+    ADD COLUMN external_id   TEXT,
+    ADD COLUMN repository_id BIGINT REFERENCES repository (id);
+
+UPDATE pull_request
+SET external_id = id;
+
+ALTER TABLE pull_request
+    ALTER COLUMN external_id SET NOT NULL;
+
+CREATE UNIQUE INDEX pull_request_external_id_repository_idx
+    ON pull_request (external_id, repository_id) NULLS NOT DISTINCT;
+
+
+-- +goose Down
+DROP INDEX pull_request_external_id_repository_idx;
+
+ALTER TABLE pull_request
+    DROP COLUMN repository_id,
+    DROP COLUMN external_id;
+
+DROP TABLE repository;
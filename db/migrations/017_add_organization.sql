@@ -0,0 +1,64 @@
+-- +goose Up
+
+ALTER TABLE team
+    ADD COLUMN organization TEXT DEFAULT 'default' NOT NULL,
+    DROP CONSTRAINT team_name_key,
+    ADD CONSTRAINT team_organization_name_key UNIQUE (organization, name);
+
+ALTER TABLE users
+    ADD COLUMN organization TEXT DEFAULT 'default' NOT NULL;
+
+ALTER TABLE repository
+    ADD COLUMN organization TEXT DEFAULT 'default' NOT NULL,
+    DROP CONSTRAINT repository_name_key,
+    ADD CONSTRAINT repository_organization_name_key UNIQUE (organization, name);
+
+ALTER TABLE pull_request
+    ADD COLUMN organization TEXT DEFAULT 'default' NOT NULL;
+
+DROP INDEX pull_request_external_id_repository_idx;
+CREATE UNIQUE INDEX pull_request_external_id_repository_idx
+    ON pull_request (organization, external_id, repository_id) NULLS NOT DISTINCT;
+
+
+-- +goose Down
+-- После отката все организации снова делят одно пространство имён. Имена команд и репозиториев
+-- и ID PR, которые совпали у разных организаций, получают суффикс @организация
+-- (у организации default имена не меняются), иначе глобальные ограничения уникальности не восстановятся.
+UPDATE pull_request p
+SET external_id = p.external_id || '@' || p.organization
+WHERE p.organization <> 'default'
+  AND EXISTS (SELECT 1
+              FROM pull_request o
+              WHERE o.external_id = p.external_id
+                AND o.repository_id IS NOT DISTINCT FROM p.repository_id
+                AND o.id <> p.id);
+
+DROP INDEX pull_request_external_id_repository_idx;
+ALTER TABLE pull_request
+    DROP COLUMN organization;
+CREATE UNIQUE INDEX pull_request_external_id_repository_idx
+    ON pull_request (external_id, repository_id) NULLS NOT DISTINCT;
+
+UPDATE repository r
+SET name = r.name || '@' || r.organization
+WHERE r.organization <> 'default'
+  AND EXISTS (SELECT 1 FROM repository o WHERE o.name = r.name AND o.id <> r.id);
+
+ALTER TABLE repository
+    DROP CONSTRAINT repository_organization_name_key,
+    DROP COLUMN organization,
+    ADD CONSTRAINT repository_name_key UNIQUE (name);
+
+ALTER TABLE users
+    DROP COLUMN organization;
+
+UPDATE team t
+SET name = t.name || '@' || t.organization
+WHERE t.organization <> 'default'
+  AND EXISTS (SELECT 1 FROM team o WHERE o.name = t.name AND o.id <> t.id);
+
+ALTER TABLE team
+    DROP CONSTRAINT team_organization_name_key,
+    DROP COLUMN organization,
+    ADD CONSTRAINT team_name_key UNIQUE (name);
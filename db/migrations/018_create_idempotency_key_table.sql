@@ -0,0 +1,21 @@
+-- +goose Up
+
+CREATE TABLE idempotency_key
+(
+    organization TEXT      DEFAULT 'default' NOT NULL,
+    key          TEXT                    NOT NULL,
+    path         TEXT                    NOT NULL,
+    fingerprint  TEXT                    NOT NULL,
+    status_code  INT,
+    content_type TEXT,
+    response     BYTEA,
+    created_at   TIMESTAMP DEFAULT now() NOT NULL,
+    expires_at   TIMESTAMP               NOT NULL,
+    PRIMARY KEY (organization, key, path)
+);
+
+CREATE INDEX idempotency_key_expires_at_idx ON idempotency_key (expires_at);
+
+
+-- +goose Down
+DROP TABLE idempotency_key;
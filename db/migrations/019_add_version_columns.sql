@@ -0,0 +1,21 @@
+-- +goose Up
+
+ALTER TABLE pull_request
+    ADD COLUMN version BIGINT DEFAULT 1 NOT NULL;
+
+ALTER TABLE team
+    ADD COLUMN version BIGINT DEFAULT 1 NOT NULL;
+
+ALTER TABLE idempotency_key
+    ADD COLUMN etag TEXT;
+
+
+-- +goose Down
+ALTER TABLE idempotency_key
+    DROP COLUMN etag;
+
+ALTER TABLE team
+    DROP COLUMN version;
+
+ALTER TABLE pull_request
+    DROP COLUMN version;
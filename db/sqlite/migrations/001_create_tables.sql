@@ -0,0 +1,204 @@
+-- +goose Up
+
+-- Схема повторяет db/migrations после 019. Время хранится в INTEGER как микросекунды
+-- Unix-времени UTC, статус PR — числом без enum-типа.
+
+CREATE TABLE team
+(
+    id                       INTEGER PRIMARY KEY AUTOINCREMENT,
+    organization             TEXT        NOT NULL DEFAULT 'default',
+    name                     TEXT        NOT NULL,
+    review_sla_seconds       INTEGER,
+    escalation_policy        TEXT        NOT NULL DEFAULT 'REASSIGN'
+        CHECK (escalation_policy IN ('REASSIGN', 'ADD_REVIEWER')),
+    default_max_open_reviews INTEGER CHECK (default_max_open_reviews > 0),
+    shortfall_policy         TEXT        NOT NULL DEFAULT 'ALLOW'
+        CHECK (shortfall_policy IN ('ALLOW', 'REJECT')),
+    selection_mode           TEXT        NOT NULL DEFAULT 'DEFAULT'
+        CHECK (selection_mode IN ('DEFAULT', 'ROTATION')),
+    rotation_window_seconds  INTEGER     NOT NULL DEFAULT 2592000
+        CHECK (rotation_window_seconds > 0),
+    version                  INTEGER     NOT NULL DEFAULT 1,
+    UNIQUE (organization, name)
+);
+
+CREATE TABLE status_table
+(
+    id     INTEGER PRIMARY KEY,
+    status TEXT NOT NULL CHECK (status IN ('MERGED', 'OPEN'))
+);
+
+INSERT INTO status_table (status, id) VALUES ('MERGED', 1);
+INSERT INTO status_table (status, id) VALUES ('OPEN', 0);
+
+CREATE TABLE users
+(
+    id                TEXT PRIMARY KEY,
+    organization      TEXT    NOT NULL DEFAULT 'default',
+    name              TEXT    NOT NULL,
+    is_active         BOOLEAN NOT NULL DEFAULT TRUE,
+    time_zone         TEXT    NOT NULL DEFAULT 'UTC',
+    work_start_minute INTEGER CHECK (work_start_minute BETWEEN 0 AND 1439),
+    work_end_minute   INTEGER CHECK (work_end_minute BETWEEN 0 AND 1439),
+    max_open_reviews  INTEGER CHECK (max_open_reviews > 0)
+);
+
+CREATE TABLE team_membership
+(
+    team_id   INTEGER REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    user_id   TEXT REFERENCES users (id) ON DELETE CASCADE   NOT NULL,
+    joined_at INTEGER                                        NOT NULL,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX team_membership_user_idx ON team_membership (user_id);
+
+CREATE TABLE repository
+(
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    organization     TEXT                                            NOT NULL DEFAULT 'default',
+    name             TEXT                                            NOT NULL,
+    owner_team_id    INTEGER REFERENCES team (id) ON DELETE SET NULL,
+    reviewer_count   INTEGER CHECK (reviewer_count BETWEEN 1 AND 5),
+    shortfall_policy TEXT CHECK (shortfall_policy IN ('ALLOW', 'REJECT')),
+    selection_mode   TEXT CHECK (selection_mode IN ('DEFAULT', 'ROTATION')),
+    created_at       INTEGER                                         NOT NULL,
+    UNIQUE (organization, name)
+);
+
+CREATE TABLE pull_request
+(
+    id            TEXT PRIMARY KEY,
+    organization  TEXT                                            NOT NULL DEFAULT 'default',
+    external_id   TEXT                                            NOT NULL,
+    repository_id INTEGER REFERENCES repository (id),
+    name          TEXT                                            NOT NULL,
+    author_id     TEXT REFERENCES users (id),
+    team_id       INTEGER REFERENCES team (id) ON DELETE SET NULL,
+    created_at    INTEGER                                         NOT NULL,
+    merged_at     INTEGER,
+    status        INTEGER DEFAULT 0 REFERENCES status_table (id)  NOT NULL,
+    version       INTEGER DEFAULT 1                               NOT NULL
+);
+
+-- в SQLite нет NULLS NOT DISTINCT, поэтому PR без репозитория проверяются отдельным индексом
+CREATE UNIQUE INDEX pull_request_external_id_repository_idx
+    ON pull_request (organization, external_id, repository_id) WHERE repository_id IS NOT NULL;
+CREATE UNIQUE INDEX pull_request_external_id_idx
+    ON pull_request (organization, external_id) WHERE repository_id IS NULL;
+
+CREATE TABLE assigned_reviewer
+(
+    user_id       TEXT REFERENCES users (id),
+    pr_id         TEXT REFERENCES pull_request (id),
+    assigned_at   INTEGER               NOT NULL,
+    from_fallback BOOLEAN DEFAULT FALSE NOT NULL,
+    PRIMARY KEY (user_id, pr_id)
+);
+
+CREATE TABLE escalation
+(
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id            TEXT REFERENCES pull_request (id)            NOT NULL,
+    team_id          INTEGER REFERENCES team (id) ON DELETE SET NULL,
+    team_name        TEXT                                         NOT NULL,
+    idle_reviewer_id TEXT REFERENCES users (id)                   NOT NULL,
+    new_reviewer_id  TEXT REFERENCES users (id),
+    policy           TEXT                                         NOT NULL,
+    created_at       INTEGER                                      NOT NULL
+);
+
+CREATE INDEX escalation_pr_reviewer_idx ON escalation (pr_id, idle_reviewer_id);
+
+CREATE TABLE out_of_office
+(
+    id                    INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id               TEXT REFERENCES users (id) NOT NULL,
+    starts_at             INTEGER                    NOT NULL,
+    ends_at               INTEGER                    NOT NULL,
+    reassign_reviews      BOOLEAN DEFAULT FALSE      NOT NULL,
+    reviews_reassigned_at INTEGER,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX out_of_office_user_period_idx ON out_of_office (user_id, starts_at, ends_at);
+
+CREATE TABLE team_fallback
+(
+    team_id          INTEGER REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    fallback_team_id INTEGER REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    priority         INTEGER                                        NOT NULL,
+    PRIMARY KEY (team_id, fallback_team_id),
+    CHECK (team_id <> fallback_team_id)
+);
+
+CREATE TABLE codeowners_rule
+(
+    team_id  INTEGER REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+    position INTEGER                                        NOT NULL,
+    pattern  TEXT                                           NOT NULL,
+    owners   TEXT DEFAULT '[]'                              NOT NULL CHECK (json_valid(owners)),
+    PRIMARY KEY (team_id, position)
+);
+
+CREATE TABLE review_pairing
+(
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id       TEXT REFERENCES pull_request (id) ON DELETE CASCADE NOT NULL,
+    author_id   TEXT REFERENCES users (id) ON DELETE CASCADE        NOT NULL,
+    reviewer_id TEXT REFERENCES users (id) ON DELETE CASCADE        NOT NULL,
+    assigned_at INTEGER                                             NOT NULL
+);
+
+CREATE INDEX review_pairing_author_idx ON review_pairing (author_id, assigned_at);
+
+CREATE TABLE team_audit
+(
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    action         TEXT                                          NOT NULL,
+    team_id        INTEGER                                       NOT NULL,
+    team_name      TEXT                                          NOT NULL,
+    user_id        TEXT REFERENCES users (id) ON DELETE CASCADE,
+    from_team_id   INTEGER,
+    from_team_name TEXT,
+    created_at     INTEGER                                       NOT NULL,
+    CHECK (action IN ('TEAM_CREATED', 'TEAM_RENAMED', 'TEAM_DELETED',
+                      'MEMBER_ADDED', 'MEMBER_REMOVED', 'MEMBER_MOVED'))
+);
+
+CREATE INDEX team_audit_team_idx ON team_audit (team_id);
+CREATE INDEX team_audit_from_team_idx ON team_audit (from_team_id);
+
+CREATE TABLE idempotency_key
+(
+    organization TEXT    NOT NULL DEFAULT 'default',
+    key          TEXT    NOT NULL,
+    path         TEXT    NOT NULL,
+    fingerprint  TEXT    NOT NULL,
+    status_code  INTEGER,
+    content_type TEXT,
+    etag         TEXT,
+    response     BLOB,
+    created_at   INTEGER NOT NULL,
+    expires_at   INTEGER NOT NULL,
+    PRIMARY KEY (organization, key, path)
+);
+
+CREATE INDEX idempotency_key_expires_at_idx ON idempotency_key (expires_at);
+
+
+-- +goose Down
+DROP TABLE idempotency_key;
+DROP TABLE team_audit;
+DROP TABLE review_pairing;
+DROP TABLE codeowners_rule;
+DROP TABLE team_fallback;
+DROP TABLE out_of_office;
+DROP TABLE escalation;
+DROP TABLE assigned_reviewer;
+DROP TABLE pull_request;
+DROP TABLE repository;
+DROP TABLE team_membership;
+DROP TABLE users;
+DROP TABLE status_table;
+DROP TABLE team;
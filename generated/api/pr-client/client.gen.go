@@ -17,16 +17,46 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for CodeOwnerKind.
+const (
+	TEAM CodeOwnerKind = "TEAM"
+	USER CodeOwnerKind = "USER"
 )
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYASSIGNED          ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	AUTHORNOTALLOWED         ErrorResponseErrorCode = "AUTHOR_NOT_ALLOWED"
+	EMPTYTEAM                ErrorResponseErrorCode = "EMPTY_TEAM"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INVALIDCODEOWNERS        ErrorResponseErrorCode = "INVALID_CODEOWNERS"
+	INVALIDFALLBACK          ErrorResponseErrorCode = "INVALID_FALLBACK"
+	INVALIDMEMBERS           ErrorResponseErrorCode = "INVALID_MEMBERS"
+	INVALIDPERIOD            ErrorResponseErrorCode = "INVALID_PERIOD"
+	INVALIDSCHEDULE          ErrorResponseErrorCode = "INVALID_SCHEDULE"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	NOTTEAMMEMBER            ErrorResponseErrorCode = "NOT_TEAM_MEMBER"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	REPOSITORYEXISTS         ErrorResponseErrorCode = "REPOSITORY_EXISTS"
+	REPOSITORYHASPRS         ErrorResponseErrorCode = "REPOSITORY_HAS_PRS"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMHASOPENPRS           ErrorResponseErrorCode = "TEAM_HAS_OPEN_PRS"
+	TEAMREQUIRED             ErrorResponseErrorCode = "TEAM_REQUIRED"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	USERIDTAKEN              ErrorResponseErrorCode = "USER_ID_TAKEN"
+	USERINACTIVE             ErrorResponseErrorCode = "USER_INACTIVE"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
 )
 
 // Defines values for PullRequestStatus.
@@ -41,6 +71,84 @@ const (
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for RepositorySelectionMode.
+const (
+	RepositorySelectionDefault  RepositorySelectionMode = "DEFAULT"
+	RepositorySelectionRotation RepositorySelectionMode = "ROTATION"
+)
+
+// Defines values for RepositoryShortfallPolicy.
+const (
+	RepositoryShortfallAllow  RepositoryShortfallPolicy = "ALLOW"
+	RepositoryShortfallReject RepositoryShortfallPolicy = "REJECT"
+)
+
+// Defines values for TeamAuditEntryAction.
+const (
+	MEMBERADDED   TeamAuditEntryAction = "MEMBER_ADDED"
+	MEMBERMOVED   TeamAuditEntryAction = "MEMBER_MOVED"
+	MEMBERREMOVED TeamAuditEntryAction = "MEMBER_REMOVED"
+	TEAMCREATED   TeamAuditEntryAction = "TEAM_CREATED"
+	TEAMDELETED   TeamAuditEntryAction = "TEAM_DELETED"
+	TEAMRENAMED   TeamAuditEntryAction = "TEAM_RENAMED"
+)
+
+// Defines values for TeamPolicyEscalationPolicy.
+const (
+	ADDREVIEWER TeamPolicyEscalationPolicy = "ADD_REVIEWER"
+	REASSIGN    TeamPolicyEscalationPolicy = "REASSIGN"
+)
+
+// Defines values for TeamPolicySelectionMode.
+const (
+	DEFAULT  TeamPolicySelectionMode = "DEFAULT"
+	ROTATION TeamPolicySelectionMode = "ROTATION"
+)
+
+// Defines values for TeamPolicyShortfallPolicy.
+const (
+	ALLOW  TeamPolicyShortfallPolicy = "ALLOW"
+	REJECT TeamPolicyShortfallPolicy = "REJECT"
+)
+
+// Defines values for TransferPullRequestStatus.
+const (
+	MERGED TransferPullRequestStatus = "MERGED"
+	OPEN   TransferPullRequestStatus = "OPEN"
+)
+
+// Defines values for TransferRecordKind.
+const (
+	TransferRecordKindPullRequest TransferRecordKind = "pull_request"
+	TransferRecordKindTeam        TransferRecordKind = "team"
+	TransferRecordKindUser        TransferRecordKind = "user"
+)
+
+// Defines values for DeleteTeamParamsOpenPrs.
+const (
+	OpenPRsReject   DeleteTeamParamsOpenPrs = "REJECT"
+	OpenPRsUnassign DeleteTeamParamsOpenPrs = "UNASSIGN"
+)
+
+// CodeOwner defines model for CodeOwner.
+type CodeOwner struct {
+	Kind CodeOwnerKind `json:"kind"`
+
+	// Name Идентификатор пользователя или имя команды
+	Name string `json:"name"`
+}
+
+// CodeOwnerKind defines model for CodeOwner.Kind.
+type CodeOwnerKind string
+
+// CodeOwnersRule defines model for CodeOwnersRule.
+type CodeOwnersRule struct {
+	Owners []CodeOwner `json:"owners"`
+
+	// Pattern Glob-шаблон пути в синтаксисе CODEOWNERS
+	Pattern string `json:"pattern"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -52,16 +160,61 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ImportCounts defines model for ImportCounts.
+type ImportCounts struct {
+	Created   int `json:"created"`
+	Unchanged int `json:"unchanged"`
+	Updated   int `json:"updated"`
+}
+
+// ImportError defines model for ImportError.
+type ImportError struct {
+	// Line Номер строки NDJSON или элемента JSON-массива (с 1)
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Applied Изменения сохранены (нет ошибок и это не dry-run)
+	Applied      bool          `json:"applied"`
+	DryRun       bool          `json:"dry_run"`
+	Errors       []ImportError `json:"errors"`
+	PullRequests ImportCounts  `json:"pull_requests"`
+	Teams        ImportCounts  `json:"teams"`
+	Users        ImportCounts  `json:"users"`
+}
+
+// LocalTime Локальное время HH:MM в тайм-зоне пользователя
+type LocalTime = string
+
+// OutOfOffice defines model for OutOfOffice.
+type OutOfOffice struct {
+	From time.Time `json:"from"`
+	Id   int64     `json:"id"`
+
+	// ReassignReviews Переназначить открытые ревью пользователя при начале отсутствия
+	ReassignReviews bool      `json:"reassign_reviews"`
+	To              time.Time `json:"to"`
+	UserId          string    `json:"user_id"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// FallbackReviewers Ревьюверы из assigned_reviewers, взятые из резервных команд
+	FallbackReviewers *[]string  `json:"fallback_reviewers,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Repository Репозиторий PR (не задан для PR вне репозиториев)
+	Repository *string           `json:"repository,omitempty"`
+	Status     PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -72,18 +225,110 @@ type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
+	Repository      *string                `json:"repository,omitempty"`
 	Status          PullRequestShortStatus `json:"status"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Repository defines model for Repository.
+type Repository struct {
+	// OwnerTeam Команда-владелец, из которой назначаются ревьюверы PR репозитория (null — команда автора)
+	OwnerTeam      *string `json:"owner_team"`
+	RepositoryName string  `json:"repository_name"`
+
+	// ReviewerCount Сколько ревьюверов назначать на PR (null — 2)
+	ReviewerCount *int `json:"reviewer_count"`
+
+	// SelectionMode Перекрывает selection_mode команды (null — как у команды)
+	SelectionMode *RepositorySelectionMode `json:"selection_mode"`
+
+	// ShortfallPolicy Перекрывает shortfall_policy команды (null — как у команды)
+	ShortfallPolicy *RepositoryShortfallPolicy `json:"shortfall_policy"`
+}
+
+// RepositorySelectionMode Перекрывает selection_mode команды (null — как у команды)
+type RepositorySelectionMode string
+
+// RepositoryShortfallPolicy Перекрывает shortfall_policy команды (null — как у команды)
+type RepositoryShortfallPolicy string
+
+// RepositoryList defines model for RepositoryList.
+type RepositoryList struct {
+	Repositories []Repository `json:"repositories"`
+}
+
+// ReviewPairing defines model for ReviewPairing.
+type ReviewPairing struct {
+	AuthorId   string `json:"author_id"`
+	ReviewerId string `json:"reviewer_id"`
+
+	// Reviews Сколько раз ревьювер назначался на PR автора за окно
+	Reviews int `json:"reviews"`
+}
+
+// ReviewerChange defines model for ReviewerChange.
+type ReviewerChange struct {
+	PullRequestId string  `json:"pull_request_id"`
+	Repository    *string `json:"repository,omitempty"`
+	UserId        string  `json:"user_id"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
 	TeamName string       `json:"team_name"`
 }
 
+// TeamAudit defines model for TeamAudit.
+type TeamAudit struct {
+	Entries  []TeamAuditEntry `json:"entries"`
+	TeamName string           `json:"team_name"`
+}
+
+// TeamAuditEntry defines model for TeamAuditEntry.
+type TeamAuditEntry struct {
+	Action    TeamAuditEntryAction `json:"action"`
+	CreatedAt time.Time            `json:"created_at"`
+
+	// PreviousTeamName Прежнее имя команды или команда, из которой переведён участник
+	PreviousTeamName *string `json:"previous_team_name,omitempty"`
+
+	// TeamName Имя команды на момент события
+	TeamName string `json:"team_name"`
+
+	// UserId Участник, которого касается событие
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// TeamAuditEntryAction defines model for TeamAuditEntry.Action.
+type TeamAuditEntryAction string
+
+// TeamCodeOwners defines model for TeamCodeOwners.
+type TeamCodeOwners struct {
+	// Rules Правила в порядке файла, при совпадении нескольких действует последнее
+	Rules    []CodeOwnersRule `json:"rules"`
+	TeamName string           `json:"team_name"`
+}
+
+// TeamDeletion defines model for TeamDeletion.
+type TeamDeletion struct {
+	// ReleasedMembers Участники удалённой команды
+	ReleasedMembers []string `json:"released_members"`
+	TeamName        string   `json:"team_name"`
+
+	// UnassignedReviews Сколько назначений на открытые PR было снято
+	UnassignedReviews int `json:"unassigned_reviews"`
+}
+
+// TeamFallbacks defines model for TeamFallbacks.
+type TeamFallbacks struct {
+	// FallbackTeams Резервные команды в порядке приоритета
+	FallbackTeams []string `json:"fallback_teams"`
+	TeamName      string   `json:"team_name"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -91,36 +336,341 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamMembersChange defines model for TeamMembersChange.
+type TeamMembersChange struct {
+	// Add Добавить участников или обновить данные уже состоящих в команде
+	Add *[]TeamMember `json:"add,omitempty"`
+
+	// Move Исключить добавляемых пользователей из остальных их команд
+	Move *bool `json:"move,omitempty"`
+
+	// Remove Идентификаторы участников, которых нужно исключить из команды
+	Remove   *[]string `json:"remove,omitempty"`
+	TeamName string    `json:"team_name"`
+}
+
+// TeamPairings defines model for TeamPairings.
+type TeamPairings struct {
+	// Pairings Ненулевые ячейки матрицы автор × ревьювер для авторов из команды
+	Pairings []ReviewPairing `json:"pairings"`
+	Since    time.Time       `json:"since"`
+	TeamName string          `json:"team_name"`
+}
+
+// TeamPolicy defines model for TeamPolicy.
+type TeamPolicy struct {
+	// DefaultMaxOpenReviews Лимит открытых ревью на участника по умолчанию (null — без лимита)
+	DefaultMaxOpenReviews *int `json:"default_max_open_reviews"`
+
+	// EscalationPolicy Переназначить зависшего ревьювера или добавить ещё одного
+	EscalationPolicy TeamPolicyEscalationPolicy `json:"escalation_policy"`
+
+	// ReviewSlaMinutes Через сколько минут бездействия ревьювера PR эскалируется (null — эскалация выключена)
+	ReviewSlaMinutes *int `json:"review_sla_minutes"`
+
+	// RotationWindowDays Окно, за которое учитываются прошлые пары автор–ревьювер
+	RotationWindowDays *int `json:"rotation_window_days,omitempty"`
+
+	// SelectionMode ROTATION — в первую очередь выбирать тех, кто реже ревьюил автора за окно rotation_window_days
+	SelectionMode *TeamPolicySelectionMode `json:"selection_mode,omitempty"`
+
+	// ShortfallPolicy Создавать PR с неполным набором ревьюверов или отклонять создание
+	ShortfallPolicy *TeamPolicyShortfallPolicy `json:"shortfall_policy,omitempty"`
+	TeamName        string                     `json:"team_name"`
+}
+
+// TeamPolicyEscalationPolicy Переназначить зависшего ревьювера или добавить ещё одного
+type TeamPolicyEscalationPolicy string
+
+// TeamPolicySelectionMode ROTATION — в первую очередь выбирать тех, кто реже ревьюил автора за окно rotation_window_days
+type TeamPolicySelectionMode string
+
+// TeamPolicyShortfallPolicy Создавать PR с неполным набором ревьюверов или отклонять создание
+type TeamPolicyShortfallPolicy string
+
+// TeamRename defines model for TeamRename.
+type TeamRename struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	ActiveMembers int `json:"active_members"`
+
+	// ReviewerCount Наибольшее число ревьюверов, которое назначается на PR команды: 2 по умолчанию
+	// или больше, если так настроен репозиторий команды либо репозиторий без владельца
+	ReviewerCount int `json:"reviewer_count"`
+
+	// StaleOpenPrs Открытые PR старше stale_days дней
+	StaleOpenPrs int    `json:"stale_open_prs"`
+	TeamName     string `json:"team_name"`
+
+	// TimeToMergeP50Seconds Медиана времени от создания до мержа
+	TimeToMergeP50Seconds *float64 `json:"time_to_merge_p50_seconds"`
+
+	// TimeToMergeP90Seconds 90-й перцентиль времени от создания до мержа
+	TimeToMergeP90Seconds *float64 `json:"time_to_merge_p90_seconds"`
+
+	// Understaffed Активных участников меньше, чем ревьюверов на PR
+	Understaffed bool               `json:"understaffed"`
+	Weekly       []WeeklyThroughput `json:"weekly"`
+}
+
+// TeamStatsPage defines model for TeamStatsPage.
+type TeamStatsPage struct {
+	Page     int         `json:"page"`
+	PageSize int         `json:"page_size"`
+	Teams    []TeamStats `json:"teams"`
+	Total    int         `json:"total"`
+}
+
+// TransferPullRequest defines model for TransferPullRequest.
+type TransferPullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// FallbackReviewers Ревьюверы из assigned_reviewers, взятые из резервных команд
+	FallbackReviewers *[]string  `json:"fallback_reviewers,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Repository Репозиторий PR (не задан для PR вне репозиториев)
+	Repository *string                   `json:"repository,omitempty"`
+	Status     TransferPullRequestStatus `json:"status"`
+
+	// TeamName Команда, из которой назначались ревьюверы (не задана — PR без команды)
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// TransferPullRequestStatus defines model for TransferPullRequest.Status.
+type TransferPullRequestStatus string
+
+// TransferRecord Одна сущность импорта или экспорта, заполняется поле, соответствующее kind
+type TransferRecord struct {
+	Kind        TransferRecordKind   `json:"kind"`
+	PullRequest *TransferPullRequest `json:"pull_request,omitempty"`
+	Team        *Team                `json:"team,omitempty"`
+	User        *TransferUser        `json:"user,omitempty"`
+}
+
+// TransferRecordKind defines model for TransferRecord.Kind.
+type TransferRecordKind string
+
+// TransferUser defines model for TransferUser.
+type TransferUser struct {
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Персональный лимит открытых ревью (null — действует лимит команды)
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// TimeZone IANA тайм-зона пользователя (по умолчанию UTC)
+	TimeZone *string `json:"time_zone,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+
+	// WorkEnd Локальное время HH:MM в тайм-зоне пользователя
+	WorkEnd *LocalTime `json:"work_end"`
+
+	// WorkStart Локальное время HH:MM в тайм-зоне пользователя
+	WorkStart *LocalTime `json:"work_start"`
+}
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Персональный лимит открытых ревью (null — действует лимит команды)
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// TeamName Первая по времени вступления команда пользователя
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+
+	// TeamNames Все команды пользователя в порядке вступления
+	TeamNames *[]string `json:"team_names,omitempty"`
+
+	// TimeZone IANA тайм-зона пользователя
+	TimeZone *string `json:"time_zone,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+
+	// WorkEnd Локальное время HH:MM в тайм-зоне пользователя
+	WorkEnd *LocalTime `json:"work_end"`
+
+	// WorkStart Локальное время HH:MM в тайм-зоне пользователя
+	WorkStart *LocalTime `json:"work_start"`
+}
+
+// WeeklyThroughput defines model for WeeklyThroughput.
+type WeeklyThroughput struct {
+	Merged int `json:"merged"`
+	Opened int `json:"opened"`
+
+	// WeekStart Понедельник недели (UTC)
+	WeekStart openapi_types.Date `json:"week_start"`
 }
 
+// IfMatchHeader defines model for IfMatchHeader.
+type IfMatchHeader = string
+
+// PageQuery defines model for PageQuery.
+type PageQuery = int
+
+// PageSizeQuery defines model for PageSizeQuery.
+type PageSizeQuery = int
+
+// RepositoryNameQuery defines model for RepositoryNameQuery.
+type RepositoryNameQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostAdminImportJSONBody defines parameters for PostAdminImport.
+type PostAdminImportJSONBody = []TransferRecord
+
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	// DryRun Только проверить файл, не сохраняя изменения
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostPullRequestAddReviewerParams defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы, владельцы по CODEOWNERS команды автора назначаются первыми
+	ChangedFiles    *[]string `json:"changed_files,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+
+	// Repository Репозиторий PR
+	Repository *string `json:"repository,omitempty"`
+
+	// TeamName Команда автора, из которой назначаются ревьюверы
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+
+	// Repository Репозиторий PR (не задаётся для PR вне репозиториев)
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+	PullRequestId string  `json:"pull_request_id"`
+	Repository    *string `json:"repository,omitempty"`
+}
+
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
 }
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+	// ExcludeUserIds Пользователи, которых не следует выбирать (например, отказавшиеся от ревью)
+	ExcludeUserIds *[]string `json:"exclude_user_ids,omitempty"`
+
+	// NewUserId Назначить конкретного пользователя вместо автоматического выбора.
+	// Проверяются те же правила, что и в /pullRequest/addReviewer.
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+	Repository    *string `json:"repository,omitempty"`
+}
+
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestRemoveReviewerParams defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// DeleteRepositoryParams defines parameters for DeleteRepository.
+type DeleteRepositoryParams struct {
+	// RepositoryName Уникальное имя репозитория
+	RepositoryName RepositoryNameQuery `form:"repository_name" json:"repository_name"`
+}
+
+// GetRepositoryGetParams defines parameters for GetRepositoryGet.
+type GetRepositoryGetParams struct {
+	// RepositoryName Уникальное имя репозитория
+	RepositoryName RepositoryNameQuery `form:"repository_name" json:"repository_name"`
+}
+
+// GetStatsPairingsParams defines parameters for GetStatsPairings.
+type GetStatsPairingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Days За сколько последних дней учитывать назначения
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// GetStatsTeamsParams defines parameters for GetStatsTeams.
+type GetStatsTeamsParams struct {
+	// Page Номер страницы (с 1)
+	Page *PageQuery `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Размер страницы
+	PageSize *PageSizeQuery `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Weeks За сколько последних недель считать открытые/смерженные PR
+	Weeks *int `form:"weeks,omitempty" json:"weeks,omitempty"`
+
+	// StaleDays Открытый PR старше стольких дней считается зависшим
+	StaleDays *int `form:"stale_days,omitempty" json:"stale_days,omitempty"`
+}
+
+// DeleteTeamParams defines parameters for DeleteTeam.
+type DeleteTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// OpenPrs Что делать, если у команды есть открытые PR:
+	// REJECT — отказать в удалении, UNASSIGN — снять с этих PR всех ревьюверов
+	// (сами PR остаются открытыми).
+	OpenPrs *DeleteTeamParamsOpenPrs `form:"open_prs,omitempty" json:"open_prs,omitempty"`
+
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// DeleteTeamParamsOpenPrs defines parameters for DeleteTeam.
+type DeleteTeamParamsOpenPrs string
+
+// GetTeamAuditParams defines parameters for GetTeamAudit.
+type GetTeamAuditParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamCodeownersParams defines parameters for GetTeamCodeowners.
+type GetTeamCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
 // GetTeamGetParams defines parameters for GetTeamGet.
@@ -129,18 +679,68 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PutTeamMembersParams defines parameters for PutTeamMembers.
+type PutTeamMembersParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// PostTeamRenameParams defines parameters for PostTeamRename.
+type PostTeamRenameParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// PostTeamSetCodeownersJSONBody defines parameters for PostTeamSetCodeowners.
+type PostTeamSetCodeownersJSONBody struct {
+	Content  string `json:"content"`
+	TeamName string `json:"team_name"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// PostUsersOutOfOfficeJSONBody defines parameters for PostUsersOutOfOffice.
+type PostUsersOutOfOfficeJSONBody struct {
+	From            time.Time `json:"from"`
+	ReassignReviews *bool     `json:"reassign_reviews,omitempty"`
+	To              time.Time `json:"to"`
+	UserId          string    `json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetScheduleJSONBody defines parameters for PostUsersSetSchedule.
+type PostUsersSetScheduleJSONBody struct {
+	TimeZone string `json:"time_zone"`
+	UserId   string `json:"user_id"`
+
+	// WorkEnd Локальное время HH:MM в тайм-зоне пользователя
+	WorkEnd *LocalTime `json:"work_end"`
+
+	// WorkStart Локальное время HH:MM в тайм-зоне пользователя
+	WorkStart *LocalTime `json:"work_start"`
+}
+
+// PostAdminImportJSONRequestBody defines body for PostAdminImport for application/json ContentType.
+type PostAdminImportJSONRequestBody = PostAdminImportJSONBody
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody = ReviewerChange
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -150,12 +750,45 @@ type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody = ReviewerChange
+
+// PostRepositoryAddJSONRequestBody defines body for PostRepositoryAdd for application/json ContentType.
+type PostRepositoryAddJSONRequestBody = Repository
+
+// PutRepositoryUpdateJSONRequestBody defines body for PutRepositoryUpdate for application/json ContentType.
+type PutRepositoryUpdateJSONRequestBody = Repository
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PutTeamMembersJSONRequestBody defines body for PutTeamMembers for application/json ContentType.
+type PutTeamMembersJSONRequestBody = TeamMembersChange
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody = TeamRename
+
+// PostTeamSetCodeownersJSONRequestBody defines body for PostTeamSetCodeowners for application/json ContentType.
+type PostTeamSetCodeownersJSONRequestBody PostTeamSetCodeownersJSONBody
+
+// PostTeamSetFallbacksJSONRequestBody defines body for PostTeamSetFallbacks for application/json ContentType.
+type PostTeamSetFallbacksJSONRequestBody = TeamFallbacks
+
+// PostTeamSetPolicyJSONRequestBody defines body for PostTeamSetPolicy for application/json ContentType.
+type PostTeamSetPolicyJSONRequestBody = TeamPolicy
+
+// PostUsersOutOfOfficeJSONRequestBody defines body for PostUsersOutOfOffice for application/json ContentType.
+type PostUsersOutOfOfficeJSONRequestBody PostUsersOutOfOfficeJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetScheduleJSONRequestBody defines body for PostUsersSetSchedule for application/json ContentType.
+type PostUsersSetScheduleJSONRequestBody PostUsersSetScheduleJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -229,40 +862,135 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAdminExport request
+	GetAdminExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminImportWithBody request with any body
+	PostAdminImportWithBody(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminImport(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestAddReviewerWithBody request with any body
+	PostPullRequestAddReviewerWithBody(ctx context.Context, params *PostPullRequestAddReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestAddReviewer(ctx context.Context, params *PostPullRequestAddReviewerParams, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestCreateWithBody request with any body
 	PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPullRequestGet request
+	GetPullRequestGet(ctx context.Context, params *GetPullRequestGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestMergeWithBody request with any body
-	PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostPullRequestMergeWithBody(ctx context.Context, params *PostPullRequestMergeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostPullRequestMerge(ctx context.Context, params *PostPullRequestMergeParams, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostPullRequestReassignWithBody request with any body
-	PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostPullRequestReassignWithBody(ctx context.Context, params *PostPullRequestReassignParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestReassign(ctx context.Context, params *PostPullRequestReassignParams, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostPullRequestRemoveReviewerWithBody request with any body
+	PostPullRequestRemoveReviewerWithBody(ctx context.Context, params *PostPullRequestRemoveReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestRemoveReviewer(ctx context.Context, params *PostPullRequestRemoveReviewerParams, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRepository request
+	DeleteRepository(ctx context.Context, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRepositoryAddWithBody request with any body
+	PostRepositoryAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRepositoryAdd(ctx context.Context, body PostRepositoryAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRepositoryGet request
+	GetRepositoryGet(ctx context.Context, params *GetRepositoryGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRepositoryList request
+	GetRepositoryList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutRepositoryUpdateWithBody request with any body
+	PutRepositoryUpdateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutRepositoryUpdate(ctx context.Context, body PutRepositoryUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsPairings request
+	GetStatsPairings(ctx context.Context, params *GetStatsPairingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsTeams request
+	GetStatsTeams(ctx context.Context, params *GetStatsTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTeam request
+	DeleteTeam(ctx context.Context, params *DeleteTeamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostTeamAddWithBody request with any body
 	PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTeamAudit request
+	GetTeamAudit(ctx context.Context, params *GetTeamAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamCodeowners request
+	GetTeamCodeowners(ctx context.Context, params *GetTeamCodeownersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTeamGet request
 	GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PutTeamMembersWithBody request with any body
+	PutTeamMembersWithBody(ctx context.Context, params *PutTeamMembersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutTeamMembers(ctx context.Context, params *PutTeamMembersParams, body PutTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamRenameWithBody request with any body
+	PostTeamRenameWithBody(ctx context.Context, params *PostTeamRenameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamRename(ctx context.Context, params *PostTeamRenameParams, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetCodeownersWithBody request with any body
+	PostTeamSetCodeownersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetCodeowners(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetFallbacksWithBody request with any body
+	PostTeamSetFallbacksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetFallbacks(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetPolicyWithBody request with any body
+	PostTeamSetPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetPolicy(ctx context.Context, body PostTeamSetPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUsersGetReview request
 	GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostUsersOutOfOfficeWithBody request with any body
+	PostUsersOutOfOfficeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersOutOfOffice(ctx context.Context, body PostUsersOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostUsersSetIsActiveWithBody request with any body
 	PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetMaxOpenReviewsWithBody request with any body
+	PostUsersSetMaxOpenReviewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetMaxOpenReviews(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetScheduleWithBody request with any body
+	PostUsersSetScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetSchedule(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAdminExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminExportRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -273,8 +1001,8 @@ func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestCreateRequest(c.Server, body)
+func (c *Client) PostAdminImportWithBody(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminImportRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -285,8 +1013,8 @@ func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequest
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAdminImport(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminImportRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -297,8 +1025,8 @@ func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestMergeRequest(c.Server, body)
+func (c *Client) PostPullRequestAddReviewerWithBody(ctx context.Context, params *PostPullRequestAddReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAddReviewerRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +1037,8 @@ func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestM
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostPullRequestAddReviewer(ctx context.Context, params *PostPullRequestAddReviewerParams, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAddReviewerRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -321,8 +1049,8 @@ func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPullRequestReassignRequest(c.Server, body)
+func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -333,8 +1061,8 @@ func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullReque
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -345,8 +1073,8 @@ func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTeamAddRequest(c.Server, body)
+func (c *Client) GetPullRequestGet(ctx context.Context, params *GetPullRequestGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -357,8 +1085,8 @@ func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTeamGetRequest(c.Server, params)
+func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, params *PostPullRequestMergeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -369,8 +1097,8 @@ func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+func (c *Client) PostPullRequestMerge(ctx context.Context, params *PostPullRequestMergeParams, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -381,8 +1109,8 @@ func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetRevie
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, params *PostPullRequestReassignParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -393,8 +1121,8 @@ func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+func (c *Client) PostPullRequestReassign(ctx context.Context, params *PostPullRequestReassignParams, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -405,1528 +1133,7721 @@ func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsAc
 	return c.Client.Do(req)
 }
 
-// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
-func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPullRequestRemoveReviewerWithBody(ctx context.Context, params *PostPullRequestRemoveReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestRemoveReviewerRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
-func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPullRequestRemoveReviewer(ctx context.Context, params *PostPullRequestRemoveReviewerParams, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestRemoveReviewerRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/create")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteRepository(ctx context.Context, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRepositoryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
-func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostRepositoryAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRepositoryAddRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestMergeRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
-func NewPostPullRequestMergeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostRepositoryAdd(ctx context.Context, body PostRepositoryAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRepositoryAddRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/merge")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetRepositoryGet(ctx context.Context, params *GetRepositoryGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRepositoryGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
-func NewPostPullRequestReassignRequest(server string, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetRepositoryList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRepositoryListRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPullRequestReassignRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
-func NewPostPullRequestReassignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutRepositoryUpdateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutRepositoryUpdateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pullRequest/reassign")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutRepositoryUpdate(ctx context.Context, body PutRepositoryUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutRepositoryUpdateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
-func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetStatsPairings(ctx context.Context, params *GetStatsPairingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsPairingsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
-func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetStatsTeams(ctx context.Context, params *GetStatsTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsTeamsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/team/add")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteTeam(ctx context.Context, params *DeleteTeamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTeamRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetTeamGetRequest generates requests for GetTeamGet
-func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/team/get")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetTeamAudit(ctx context.Context, params *GetTeamAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamAuditRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
-func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTeamCodeowners(ctx context.Context, params *GetTeamCodeownersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamCodeownersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/getReview")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PutTeamMembersWithBody(ctx context.Context, params *PutTeamMembersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutTeamMembersRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
-func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutTeamMembers(ctx context.Context, params *PutTeamMembersParams, body PutTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutTeamMembersRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
-func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostTeamRenameWithBody(ctx context.Context, params *PostTeamRenameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRenameRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/setIsActive")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostTeamRename(ctx context.Context, params *PostTeamRenameParams, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamRenameRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostTeamSetCodeownersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetCodeownersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) PostTeamSetCodeowners(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetCodeownersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostTeamSetFallbacksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetFallbacksRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PostTeamSetFallbacks(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetFallbacksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostPullRequestCreateWithBodyWithResponse request with any body
-	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
-
-	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
-
-	// PostPullRequestMergeWithBodyWithResponse request with any body
-	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
-
-	PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
-
-	// PostPullRequestReassignWithBodyWithResponse request with any body
-	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
-
-	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
-
-	// PostTeamAddWithBodyWithResponse request with any body
-	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
-
-	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
-
-	// GetTeamGetWithResponse request
-	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
-
-	// GetUsersGetReviewWithResponse request
-	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
-
-	// PostUsersSetIsActiveWithBodyWithResponse request with any body
-	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
-
-	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+func (c *Client) PostTeamSetPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetPolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostPullRequestCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Pr *PullRequest `json:"pr,omitempty"`
+func (c *Client) PostTeamSetPolicy(ctx context.Context, body PostTeamSetPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *ErrorResponse
-	JSON409 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersOutOfOfficeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersOutOfOfficeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostPullRequestMergeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Pr *PullRequest `json:"pr,omitempty"`
+func (c *Client) PostUsersOutOfOffice(ctx context.Context, body PostUsersOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersOutOfOfficeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestMergeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestMergeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostPullRequestReassignResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Pr PullRequest `json:"pr"`
-
-		// ReplacedBy user_id нового ревьювера
-		ReplacedBy string `json:"replaced_by"`
+func (c *Client) PostUsersSetMaxOpenReviewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetMaxOpenReviewsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *ErrorResponse
-	JSON409 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPullRequestReassignResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostUsersSetMaxOpenReviews(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetMaxOpenReviewsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPullRequestReassignResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostUsersSetScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetScheduleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostTeamAddResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Team *Team `json:"team,omitempty"`
+func (c *Client) PostUsersSetSchedule(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetScheduleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *ErrorResponse
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostTeamAddResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetAdminExportRequest generates requests for GetAdminExport
+func NewGetAdminExportRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTeamAddResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTeamGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Team
-	JSON404      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r GetTeamGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/admin/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTeamGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUsersGetReviewResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		PullRequests []PullRequestShort `json:"pull_requests"`
-		UserId       string             `json:"user_id"`
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetUsersGetReviewResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersGetReviewResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAdminImportRequest calls the generic PostAdminImport builder with application/json body
+func NewPostAdminImportRequest(server string, params *PostAdminImportParams, body PostAdminImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminImportRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-type PostUsersSetIsActiveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		User *User `json:"user,omitempty"`
-	}
-	JSON404 *ErrorResponse
-}
+// NewPostAdminImportRequestWithBody generates requests for PostAdminImport with any type of body
+func NewPostAdminImportRequestWithBody(server string, params *PostAdminImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostUsersSetIsActiveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostUsersSetIsActiveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/admin/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
-func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
-	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
-	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return ParsePostPullRequestCreateResponse(rsp)
-}
 
-// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
-func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
-	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestMergeResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
-	rsp, err := c.PostPullRequestMerge(ctx, body, reqEditors...)
+// NewPostPullRequestAddReviewerRequest calls the generic PostPullRequestAddReviewer builder with application/json body
+func NewPostPullRequestAddReviewerRequest(server string, params *PostPullRequestAddReviewerParams, body PostPullRequestAddReviewerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestMergeResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestAddReviewerRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
-func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
-	rsp, err := c.PostPullRequestReassignWithBody(ctx, contentType, body, reqEditors...)
+// NewPostPullRequestAddReviewerRequestWithBody generates requests for PostPullRequestAddReviewer with any type of body
+func NewPostPullRequestAddReviewerRequestWithBody(server string, params *PostPullRequestAddReviewerParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestReassignResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
-	rsp, err := c.PostPullRequestReassign(ctx, body, reqEditors...)
+	operationPath := fmt.Sprintf("/pullRequest/addReviewer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostPullRequestReassignResponse(rsp)
-}
 
-// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
-func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
-	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostTeamAddResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
-	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
+// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
+func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostTeamAddResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// GetTeamGetWithResponse request returning *GetTeamGetResponse
-func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
-	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
+// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
+func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTeamGetResponse(rsp)
-}
 
-// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
-func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
-	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
+	operationPath := fmt.Sprintf("/pullRequest/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUsersGetReviewResponse(rsp)
-}
 
-// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
-func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
-	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostUsersSetIsActiveResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
-	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
+// NewGetPullRequestGetRequest generates requests for GetPullRequestGet
+func NewGetPullRequestGetRequest(server string, params *GetPullRequestGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostUsersSetIsActiveResponse(rsp)
-}
 
-// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
-func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/pullRequest/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestCreateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Pr *PullRequest `json:"pr,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pull_request_id", runtime.ParamLocationQuery, params.PullRequestId); err != nil {
 			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Repository != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON409 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
-func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestMergeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	return req, nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Pr *PullRequest `json:"pr,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
+func NewPostPullRequestMergeRequest(server string, params *PostPullRequestMergeParams, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestMergeRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
+func NewPostPullRequestMergeRequestWithBody(server string, params *PostPullRequestMergeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/pullRequest/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
-func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostPullRequestReassignResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Pr PullRequest `json:"pr"`
+	req.Header.Add("Content-Type", contentType)
 
-			// ReplacedBy user_id нового ревьювера
-			ReplacedBy string `json:"replaced_by"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	if params != nil {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+		if params.IfMatch != nil {
+			var headerParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
 		}
-		response.JSON409 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
-func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
+func NewPostPullRequestReassignRequest(server string, params *PostPullRequestReassignParams, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReassignRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-	response := &PostTeamAddResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Team *Team `json:"team,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
+func NewPostPullRequestReassignRequestWithBody(server string, params *PostPullRequestReassignParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/pullRequest/reassign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
-func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTeamGetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Team
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
 		}
-		response.JSON404 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
-func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostPullRequestRemoveReviewerRequest calls the generic PostPullRequestRemoveReviewer builder with application/json body
+func NewPostPullRequestRemoveReviewerRequest(server string, params *PostPullRequestRemoveReviewerParams, body PostPullRequestRemoveReviewerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestRemoveReviewerRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-	response := &GetUsersGetReviewResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// NewPostPullRequestRemoveReviewerRequestWithBody generates requests for PostPullRequestRemoveReviewer with any type of body
+func NewPostPullRequestRemoveReviewerRequestWithBody(server string, params *PostPullRequestRemoveReviewerParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			PullRequests []PullRequestShort `json:"pull_requests"`
-			UserId       string             `json:"user_id"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	operationPath := fmt.Sprintf("/pullRequest/removeReviewer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
 		}
-		response.JSON200 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
-func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteRepositoryRequest generates requests for DeleteRepository
+func NewDeleteRepositoryRequest(server string, params *DeleteRepositoryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostUsersSetIsActiveResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/repository")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			User *User `json:"user,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository_name", runtime.ParamLocationQuery, params.RepositoryName); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON404 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
-}
-
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
-
-type Unimplemented struct{}
-
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-// (POST /pullRequest/create)
-func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// NewPostRepositoryAddRequest calls the generic PostRepositoryAdd builder with application/json body
+func NewPostRepositoryAddRequest(server string, body PostRepositoryAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRepositoryAddRequestWithBody(server, "application/json", bodyReader)
 }
 
-// Пометить PR как MERGED (идемпотентная операция)
-// (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// NewPostRepositoryAddRequestWithBody generates requests for PostRepositoryAdd with any type of body
+func NewPostRepositoryAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Переназначить конкретного ревьювера на другого из его команды
-// (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	operationPath := fmt.Sprintf("/repository/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	return req, nil
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// NewGetRepositoryGetRequest generates requests for GetRepositoryGet
+func NewGetRepositoryGetRequest(server string, params *GetRepositoryGetParams) (*http.Request, error) {
+	var err error
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
-	}))
+	operationPath := fmt.Sprintf("/repository/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository_name", runtime.ParamLocationQuery, params.RepositoryName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
-	}))
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
-	}))
+// NewGetRepositoryListRequest generates requests for GetRepositoryList
+func NewGetRepositoryListRequest(server string) (*http.Request, error) {
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	operationPath := fmt.Sprintf("/repository/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
-	}))
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// NewPutRepositoryUpdateRequest calls the generic PutRepositoryUpdate builder with application/json body
+func NewPutRepositoryUpdateRequest(server string, body PutRepositoryUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutRepositoryUpdateRequestWithBody(server, "application/json", bodyReader)
+}
 
+// NewPutRepositoryUpdateRequestWithBody generates requests for PutRepositoryUpdate with any type of body
+func NewPutRepositoryUpdateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
-
-	// ------------- Required query parameter "team_name" -------------
-
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
-		return
+	operationPath := fmt.Sprintf("/repository/update")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
-		return
+		return nil, err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+	return req, nil
+}
 
+// NewGetStatsPairingsRequest generates requests for GetStatsPairings
+func NewGetStatsPairingsRequest(server string, params *GetStatsPairingsParams) (*http.Request, error) {
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
-
-	// ------------- Required query parameter "user_id" -------------
-
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
+	operationPath := fmt.Sprintf("/stats/pairings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
+		return nil, err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
-	}))
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	handler.ServeHTTP(w, r)
-}
+		if params.Days != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+		}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
-	}))
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-type UnescapedCookieParamError struct {
+// NewGetStatsTeamsRequest generates requests for GetStatsTeams
+func NewGetStatsTeamsRequest(server string, params *GetStatsTeamsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/teams")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Weeks != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "weeks", runtime.ParamLocationQuery, *params.Weeks); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StaleDays != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stale_days", runtime.ParamLocationQuery, *params.StaleDays); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTeamRequest generates requests for DeleteTeam
+func NewDeleteTeamRequest(server string, params *DeleteTeamParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.OpenPrs != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "open_prs", runtime.ParamLocationQuery, *params.OpenPrs); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
+func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
+func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamAuditRequest generates requests for GetTeamAudit
+func NewGetTeamAuditRequest(server string, params *GetTeamAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamCodeownersRequest generates requests for GetTeamCodeowners
+func NewGetTeamCodeownersRequest(server string, params *GetTeamCodeownersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/codeowners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamGetRequest generates requests for GetTeamGet
+func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutTeamMembersRequest calls the generic PutTeamMembers builder with application/json body
+func NewPutTeamMembersRequest(server string, params *PutTeamMembersParams, body PutTeamMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutTeamMembersRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutTeamMembersRequestWithBody generates requests for PutTeamMembers with any type of body
+func NewPutTeamMembersRequestWithBody(server string, params *PutTeamMembersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostTeamRenameRequest calls the generic PostTeamRename builder with application/json body
+func NewPostTeamRenameRequest(server string, params *PostTeamRenameParams, body PostTeamRenameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamRenameRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostTeamRenameRequestWithBody generates requests for PostTeamRename with any type of body
+func NewPostTeamRenameRequestWithBody(server string, params *PostTeamRenameParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/rename")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostTeamSetCodeownersRequest calls the generic PostTeamSetCodeowners builder with application/json body
+func NewPostTeamSetCodeownersRequest(server string, body PostTeamSetCodeownersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetCodeownersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetCodeownersRequestWithBody generates requests for PostTeamSetCodeowners with any type of body
+func NewPostTeamSetCodeownersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setCodeowners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetFallbacksRequest calls the generic PostTeamSetFallbacks builder with application/json body
+func NewPostTeamSetFallbacksRequest(server string, body PostTeamSetFallbacksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetFallbacksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetFallbacksRequestWithBody generates requests for PostTeamSetFallbacks with any type of body
+func NewPostTeamSetFallbacksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setFallbacks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamSetPolicyRequest calls the generic PostTeamSetPolicy builder with application/json body
+func NewPostTeamSetPolicyRequest(server string, body PostTeamSetPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetPolicyRequestWithBody generates requests for PostTeamSetPolicy with any type of body
+func NewPostTeamSetPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setPolicy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
+func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getReview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersOutOfOfficeRequest calls the generic PostUsersOutOfOffice builder with application/json body
+func NewPostUsersOutOfOfficeRequest(server string, body PostUsersOutOfOfficeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersOutOfOfficeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersOutOfOfficeRequestWithBody generates requests for PostUsersOutOfOffice with any type of body
+func NewPostUsersOutOfOfficeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/outOfOffice")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
+func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
+func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setIsActive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetMaxOpenReviewsRequest calls the generic PostUsersSetMaxOpenReviews builder with application/json body
+func NewPostUsersSetMaxOpenReviewsRequest(server string, body PostUsersSetMaxOpenReviewsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetMaxOpenReviewsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetMaxOpenReviewsRequestWithBody generates requests for PostUsersSetMaxOpenReviews with any type of body
+func NewPostUsersSetMaxOpenReviewsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setMaxOpenReviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetScheduleRequest calls the generic PostUsersSetSchedule builder with application/json body
+func NewPostUsersSetScheduleRequest(server string, body PostUsersSetScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetScheduleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetScheduleRequestWithBody generates requests for PostUsersSetSchedule with any type of body
+func NewPostUsersSetScheduleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setSchedule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAdminExportWithResponse request
+	GetAdminExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminExportResponse, error)
+
+	// PostAdminImportWithBodyWithResponse request with any body
+	PostAdminImportWithBodyWithResponse(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error)
+
+	PostAdminImportWithResponse(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error)
+
+	// PostPullRequestAddReviewerWithBodyWithResponse request with any body
+	PostPullRequestAddReviewerWithBodyWithResponse(ctx context.Context, params *PostPullRequestAddReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error)
+
+	PostPullRequestAddReviewerWithResponse(ctx context.Context, params *PostPullRequestAddReviewerParams, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error)
+
+	// PostPullRequestCreateWithBodyWithResponse request with any body
+	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	// GetPullRequestGetWithResponse request
+	GetPullRequestGetWithResponse(ctx context.Context, params *GetPullRequestGetParams, reqEditors ...RequestEditorFn) (*GetPullRequestGetResponse, error)
+
+	// PostPullRequestMergeWithBodyWithResponse request with any body
+	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, params *PostPullRequestMergeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	PostPullRequestMergeWithResponse(ctx context.Context, params *PostPullRequestMergeParams, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	// PostPullRequestReassignWithBodyWithResponse request with any body
+	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, params *PostPullRequestReassignParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	PostPullRequestReassignWithResponse(ctx context.Context, params *PostPullRequestReassignParams, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	// PostPullRequestRemoveReviewerWithBodyWithResponse request with any body
+	PostPullRequestRemoveReviewerWithBodyWithResponse(ctx context.Context, params *PostPullRequestRemoveReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error)
+
+	PostPullRequestRemoveReviewerWithResponse(ctx context.Context, params *PostPullRequestRemoveReviewerParams, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error)
+
+	// DeleteRepositoryWithResponse request
+	DeleteRepositoryWithResponse(ctx context.Context, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*DeleteRepositoryResponse, error)
+
+	// PostRepositoryAddWithBodyWithResponse request with any body
+	PostRepositoryAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRepositoryAddResponse, error)
+
+	PostRepositoryAddWithResponse(ctx context.Context, body PostRepositoryAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRepositoryAddResponse, error)
+
+	// GetRepositoryGetWithResponse request
+	GetRepositoryGetWithResponse(ctx context.Context, params *GetRepositoryGetParams, reqEditors ...RequestEditorFn) (*GetRepositoryGetResponse, error)
+
+	// GetRepositoryListWithResponse request
+	GetRepositoryListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRepositoryListResponse, error)
+
+	// PutRepositoryUpdateWithBodyWithResponse request with any body
+	PutRepositoryUpdateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutRepositoryUpdateResponse, error)
+
+	PutRepositoryUpdateWithResponse(ctx context.Context, body PutRepositoryUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutRepositoryUpdateResponse, error)
+
+	// GetStatsPairingsWithResponse request
+	GetStatsPairingsWithResponse(ctx context.Context, params *GetStatsPairingsParams, reqEditors ...RequestEditorFn) (*GetStatsPairingsResponse, error)
+
+	// GetStatsTeamsWithResponse request
+	GetStatsTeamsWithResponse(ctx context.Context, params *GetStatsTeamsParams, reqEditors ...RequestEditorFn) (*GetStatsTeamsResponse, error)
+
+	// DeleteTeamWithResponse request
+	DeleteTeamWithResponse(ctx context.Context, params *DeleteTeamParams, reqEditors ...RequestEditorFn) (*DeleteTeamResponse, error)
+
+	// PostTeamAddWithBodyWithResponse request with any body
+	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	// GetTeamAuditWithResponse request
+	GetTeamAuditWithResponse(ctx context.Context, params *GetTeamAuditParams, reqEditors ...RequestEditorFn) (*GetTeamAuditResponse, error)
+
+	// GetTeamCodeownersWithResponse request
+	GetTeamCodeownersWithResponse(ctx context.Context, params *GetTeamCodeownersParams, reqEditors ...RequestEditorFn) (*GetTeamCodeownersResponse, error)
+
+	// GetTeamGetWithResponse request
+	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
+
+	// PutTeamMembersWithBodyWithResponse request with any body
+	PutTeamMembersWithBodyWithResponse(ctx context.Context, params *PutTeamMembersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTeamMembersResponse, error)
+
+	PutTeamMembersWithResponse(ctx context.Context, params *PutTeamMembersParams, body PutTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTeamMembersResponse, error)
+
+	// PostTeamRenameWithBodyWithResponse request with any body
+	PostTeamRenameWithBodyWithResponse(ctx context.Context, params *PostTeamRenameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error)
+
+	PostTeamRenameWithResponse(ctx context.Context, params *PostTeamRenameParams, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error)
+
+	// PostTeamSetCodeownersWithBodyWithResponse request with any body
+	PostTeamSetCodeownersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error)
+
+	PostTeamSetCodeownersWithResponse(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error)
+
+	// PostTeamSetFallbacksWithBodyWithResponse request with any body
+	PostTeamSetFallbacksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error)
+
+	PostTeamSetFallbacksWithResponse(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error)
+
+	// PostTeamSetPolicyWithBodyWithResponse request with any body
+	PostTeamSetPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetPolicyResponse, error)
+
+	PostTeamSetPolicyWithResponse(ctx context.Context, body PostTeamSetPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetPolicyResponse, error)
+
+	// GetUsersGetReviewWithResponse request
+	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
+
+	// PostUsersOutOfOfficeWithBodyWithResponse request with any body
+	PostUsersOutOfOfficeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersOutOfOfficeResponse, error)
+
+	PostUsersOutOfOfficeWithResponse(ctx context.Context, body PostUsersOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersOutOfOfficeResponse, error)
+
+	// PostUsersSetIsActiveWithBodyWithResponse request with any body
+	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	// PostUsersSetMaxOpenReviewsWithBodyWithResponse request with any body
+	PostUsersSetMaxOpenReviewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error)
+
+	PostUsersSetMaxOpenReviewsWithResponse(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error)
+
+	// PostUsersSetScheduleWithBodyWithResponse request with any body
+	PostUsersSetScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error)
+
+	PostUsersSetScheduleWithResponse(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error)
+}
+
+type GetAdminExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TransferRecord
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+	JSON422      *ImportReport
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestAddReviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+	JSON412 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestAddReviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestAddReviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+
+		// ReviewerShortfall Сколько ревьюверов не удалось назначить из-за нехватки кандидатов
+		ReviewerShortfall *int `json:"reviewer_shortfall,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPullRequestGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON412 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReassignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+	JSON412 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReassignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReassignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestRemoveReviewerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+	JSON412 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestRemoveReviewerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestRemoveReviewerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRepositoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		RepositoryName string `json:"repository_name"`
+	}
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRepositoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRepositoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRepositoryAddResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Repository Repository `json:"repository"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRepositoryAddResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRepositoryAddResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoryGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Repository
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoryGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoryGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoryListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepositoryList
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoryListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoryListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutRepositoryUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Repository Repository `json:"repository"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutRepositoryUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutRepositoryUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsPairingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamPairings
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsPairingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsPairingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamStatsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamDeletion
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON412      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamAddResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamAddResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamAddResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamAuditResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamAudit
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamCodeownersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamCodeOwners
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamCodeownersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamCodeownersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutTeamMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Team Team `json:"team"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+	JSON412 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutTeamMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutTeamMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamRenameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Team Team `json:"team"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON412 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamRenameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamRenameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetCodeownersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamCodeOwners
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetCodeownersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetCodeownersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetFallbacksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamFallbacks
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetFallbacksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetFallbacksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Policy TeamPolicy `json:"policy"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersGetReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PullRequests []PullRequestShort `json:"pull_requests"`
+		UserId       string             `json:"user_id"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersGetReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersGetReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersOutOfOfficeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		OutOfOffice OutOfOffice `json:"out_of_office"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersOutOfOfficeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersOutOfOfficeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetIsActiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetIsActiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetIsActiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetMaxOpenReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetMaxOpenReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetMaxOpenReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAdminExportWithResponse request returning *GetAdminExportResponse
+func (c *ClientWithResponses) GetAdminExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminExportResponse, error) {
+	rsp, err := c.GetAdminExport(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminExportResponse(rsp)
+}
+
+// PostAdminImportWithBodyWithResponse request with arbitrary body returning *PostAdminImportResponse
+func (c *ClientWithResponses) PostAdminImportWithBodyWithResponse(ctx context.Context, params *PostAdminImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error) {
+	rsp, err := c.PostAdminImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminImportWithResponse(ctx context.Context, params *PostAdminImportParams, body PostAdminImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminImportResponse, error) {
+	rsp, err := c.PostAdminImport(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminImportResponse(rsp)
+}
+
+// PostPullRequestAddReviewerWithBodyWithResponse request with arbitrary body returning *PostPullRequestAddReviewerResponse
+func (c *ClientWithResponses) PostPullRequestAddReviewerWithBodyWithResponse(ctx context.Context, params *PostPullRequestAddReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error) {
+	rsp, err := c.PostPullRequestAddReviewerWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAddReviewerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestAddReviewerWithResponse(ctx context.Context, params *PostPullRequestAddReviewerParams, body PostPullRequestAddReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAddReviewerResponse, error) {
+	rsp, err := c.PostPullRequestAddReviewer(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAddReviewerResponse(rsp)
+}
+
+// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
+func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+// GetPullRequestGetWithResponse request returning *GetPullRequestGetResponse
+func (c *ClientWithResponses) GetPullRequestGetWithResponse(ctx context.Context, params *GetPullRequestGetParams, reqEditors ...RequestEditorFn) (*GetPullRequestGetResponse, error) {
+	rsp, err := c.GetPullRequestGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestGetResponse(rsp)
+}
+
+// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
+func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, params *PostPullRequestMergeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMergeWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestMergeWithResponse(ctx context.Context, params *PostPullRequestMergeParams, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMerge(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
+func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, params *PostPullRequestReassignParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassignWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, params *PostPullRequestReassignParams, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassign(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+// PostPullRequestRemoveReviewerWithBodyWithResponse request with arbitrary body returning *PostPullRequestRemoveReviewerResponse
+func (c *ClientWithResponses) PostPullRequestRemoveReviewerWithBodyWithResponse(ctx context.Context, params *PostPullRequestRemoveReviewerParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error) {
+	rsp, err := c.PostPullRequestRemoveReviewerWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestRemoveReviewerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestRemoveReviewerWithResponse(ctx context.Context, params *PostPullRequestRemoveReviewerParams, body PostPullRequestRemoveReviewerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestRemoveReviewerResponse, error) {
+	rsp, err := c.PostPullRequestRemoveReviewer(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestRemoveReviewerResponse(rsp)
+}
+
+// DeleteRepositoryWithResponse request returning *DeleteRepositoryResponse
+func (c *ClientWithResponses) DeleteRepositoryWithResponse(ctx context.Context, params *DeleteRepositoryParams, reqEditors ...RequestEditorFn) (*DeleteRepositoryResponse, error) {
+	rsp, err := c.DeleteRepository(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRepositoryResponse(rsp)
+}
+
+// PostRepositoryAddWithBodyWithResponse request with arbitrary body returning *PostRepositoryAddResponse
+func (c *ClientWithResponses) PostRepositoryAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRepositoryAddResponse, error) {
+	rsp, err := c.PostRepositoryAddWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRepositoryAddResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRepositoryAddWithResponse(ctx context.Context, body PostRepositoryAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRepositoryAddResponse, error) {
+	rsp, err := c.PostRepositoryAdd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRepositoryAddResponse(rsp)
+}
+
+// GetRepositoryGetWithResponse request returning *GetRepositoryGetResponse
+func (c *ClientWithResponses) GetRepositoryGetWithResponse(ctx context.Context, params *GetRepositoryGetParams, reqEditors ...RequestEditorFn) (*GetRepositoryGetResponse, error) {
+	rsp, err := c.GetRepositoryGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRepositoryGetResponse(rsp)
+}
+
+// GetRepositoryListWithResponse request returning *GetRepositoryListResponse
+func (c *ClientWithResponses) GetRepositoryListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRepositoryListResponse, error) {
+	rsp, err := c.GetRepositoryList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRepositoryListResponse(rsp)
+}
+
+// PutRepositoryUpdateWithBodyWithResponse request with arbitrary body returning *PutRepositoryUpdateResponse
+func (c *ClientWithResponses) PutRepositoryUpdateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutRepositoryUpdateResponse, error) {
+	rsp, err := c.PutRepositoryUpdateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutRepositoryUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutRepositoryUpdateWithResponse(ctx context.Context, body PutRepositoryUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutRepositoryUpdateResponse, error) {
+	rsp, err := c.PutRepositoryUpdate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutRepositoryUpdateResponse(rsp)
+}
+
+// GetStatsPairingsWithResponse request returning *GetStatsPairingsResponse
+func (c *ClientWithResponses) GetStatsPairingsWithResponse(ctx context.Context, params *GetStatsPairingsParams, reqEditors ...RequestEditorFn) (*GetStatsPairingsResponse, error) {
+	rsp, err := c.GetStatsPairings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsPairingsResponse(rsp)
+}
+
+// GetStatsTeamsWithResponse request returning *GetStatsTeamsResponse
+func (c *ClientWithResponses) GetStatsTeamsWithResponse(ctx context.Context, params *GetStatsTeamsParams, reqEditors ...RequestEditorFn) (*GetStatsTeamsResponse, error) {
+	rsp, err := c.GetStatsTeams(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsTeamsResponse(rsp)
+}
+
+// DeleteTeamWithResponse request returning *DeleteTeamResponse
+func (c *ClientWithResponses) DeleteTeamWithResponse(ctx context.Context, params *DeleteTeamParams, reqEditors ...RequestEditorFn) (*DeleteTeamResponse, error) {
+	rsp, err := c.DeleteTeam(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTeamResponse(rsp)
+}
+
+// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
+func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+// GetTeamAuditWithResponse request returning *GetTeamAuditResponse
+func (c *ClientWithResponses) GetTeamAuditWithResponse(ctx context.Context, params *GetTeamAuditParams, reqEditors ...RequestEditorFn) (*GetTeamAuditResponse, error) {
+	rsp, err := c.GetTeamAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamAuditResponse(rsp)
+}
+
+// GetTeamCodeownersWithResponse request returning *GetTeamCodeownersResponse
+func (c *ClientWithResponses) GetTeamCodeownersWithResponse(ctx context.Context, params *GetTeamCodeownersParams, reqEditors ...RequestEditorFn) (*GetTeamCodeownersResponse, error) {
+	rsp, err := c.GetTeamCodeowners(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamCodeownersResponse(rsp)
+}
+
+// GetTeamGetWithResponse request returning *GetTeamGetResponse
+func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
+	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetResponse(rsp)
+}
+
+// PutTeamMembersWithBodyWithResponse request with arbitrary body returning *PutTeamMembersResponse
+func (c *ClientWithResponses) PutTeamMembersWithBodyWithResponse(ctx context.Context, params *PutTeamMembersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTeamMembersResponse, error) {
+	rsp, err := c.PutTeamMembersWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutTeamMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutTeamMembersWithResponse(ctx context.Context, params *PutTeamMembersParams, body PutTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTeamMembersResponse, error) {
+	rsp, err := c.PutTeamMembers(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutTeamMembersResponse(rsp)
+}
+
+// PostTeamRenameWithBodyWithResponse request with arbitrary body returning *PostTeamRenameResponse
+func (c *ClientWithResponses) PostTeamRenameWithBodyWithResponse(ctx context.Context, params *PostTeamRenameParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error) {
+	rsp, err := c.PostTeamRenameWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRenameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamRenameWithResponse(ctx context.Context, params *PostTeamRenameParams, body PostTeamRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamRenameResponse, error) {
+	rsp, err := c.PostTeamRename(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamRenameResponse(rsp)
+}
+
+// PostTeamSetCodeownersWithBodyWithResponse request with arbitrary body returning *PostTeamSetCodeownersResponse
+func (c *ClientWithResponses) PostTeamSetCodeownersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error) {
+	rsp, err := c.PostTeamSetCodeownersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetCodeownersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetCodeownersWithResponse(ctx context.Context, body PostTeamSetCodeownersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetCodeownersResponse, error) {
+	rsp, err := c.PostTeamSetCodeowners(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetCodeownersResponse(rsp)
+}
+
+// PostTeamSetFallbacksWithBodyWithResponse request with arbitrary body returning *PostTeamSetFallbacksResponse
+func (c *ClientWithResponses) PostTeamSetFallbacksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error) {
+	rsp, err := c.PostTeamSetFallbacksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetFallbacksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetFallbacksWithResponse(ctx context.Context, body PostTeamSetFallbacksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetFallbacksResponse, error) {
+	rsp, err := c.PostTeamSetFallbacks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetFallbacksResponse(rsp)
+}
+
+// PostTeamSetPolicyWithBodyWithResponse request with arbitrary body returning *PostTeamSetPolicyResponse
+func (c *ClientWithResponses) PostTeamSetPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetPolicyResponse, error) {
+	rsp, err := c.PostTeamSetPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetPolicyWithResponse(ctx context.Context, body PostTeamSetPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetPolicyResponse, error) {
+	rsp, err := c.PostTeamSetPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetPolicyResponse(rsp)
+}
+
+// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
+func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
+	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersGetReviewResponse(rsp)
+}
+
+// PostUsersOutOfOfficeWithBodyWithResponse request with arbitrary body returning *PostUsersOutOfOfficeResponse
+func (c *ClientWithResponses) PostUsersOutOfOfficeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersOutOfOfficeResponse, error) {
+	rsp, err := c.PostUsersOutOfOfficeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersOutOfOfficeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersOutOfOfficeWithResponse(ctx context.Context, body PostUsersOutOfOfficeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersOutOfOfficeResponse, error) {
+	rsp, err := c.PostUsersOutOfOffice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersOutOfOfficeResponse(rsp)
+}
+
+// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
+func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+// PostUsersSetMaxOpenReviewsWithBodyWithResponse request with arbitrary body returning *PostUsersSetMaxOpenReviewsResponse
+func (c *ClientWithResponses) PostUsersSetMaxOpenReviewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	rsp, err := c.PostUsersSetMaxOpenReviewsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetMaxOpenReviewsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetMaxOpenReviewsWithResponse(ctx context.Context, body PostUsersSetMaxOpenReviewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	rsp, err := c.PostUsersSetMaxOpenReviews(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetMaxOpenReviewsResponse(rsp)
+}
+
+// PostUsersSetScheduleWithBodyWithResponse request with arbitrary body returning *PostUsersSetScheduleResponse
+func (c *ClientWithResponses) PostUsersSetScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error) {
+	rsp, err := c.PostUsersSetScheduleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetScheduleWithResponse(ctx context.Context, body PostUsersSetScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetScheduleResponse, error) {
+	rsp, err := c.PostUsersSetSchedule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetScheduleResponse(rsp)
+}
+
+// ParseGetAdminExportResponse parses an HTTP response from a GetAdminExportWithResponse call
+func ParseGetAdminExportResponse(rsp *http.Response) (*GetAdminExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TransferRecord
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminImportResponse parses an HTTP response from a PostAdminImportWithResponse call
+func ParsePostAdminImportResponse(rsp *http.Response) (*PostAdminImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestAddReviewerResponse parses an HTTP response from a PostPullRequestAddReviewerWithResponse call
+func ParsePostPullRequestAddReviewerResponse(rsp *http.Response) (*PostPullRequestAddReviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestAddReviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
+func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+
+			// ReviewerShortfall Сколько ревьюверов не удалось назначить из-за нехватки кандидатов
+			ReviewerShortfall *int `json:"reviewer_shortfall,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestGetResponse parses an HTTP response from a GetPullRequestGetWithResponse call
+func ParseGetPullRequestGetResponse(rsp *http.Response) (*GetPullRequestGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
+func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
+func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReassignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestRemoveReviewerResponse parses an HTTP response from a PostPullRequestRemoveReviewerWithResponse call
+func ParsePostPullRequestRemoveReviewerResponse(rsp *http.Response) (*PostPullRequestRemoveReviewerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestRemoveReviewerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRepositoryResponse parses an HTTP response from a DeleteRepositoryWithResponse call
+func ParseDeleteRepositoryResponse(rsp *http.Response) (*DeleteRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			RepositoryName string `json:"repository_name"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRepositoryAddResponse parses an HTTP response from a PostRepositoryAddWithResponse call
+func ParsePostRepositoryAddResponse(rsp *http.Response) (*PostRepositoryAddResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRepositoryAddResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Repository Repository `json:"repository"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRepositoryGetResponse parses an HTTP response from a GetRepositoryGetWithResponse call
+func ParseGetRepositoryGetResponse(rsp *http.Response) (*GetRepositoryGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRepositoryGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Repository
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRepositoryListResponse parses an HTTP response from a GetRepositoryListWithResponse call
+func ParseGetRepositoryListResponse(rsp *http.Response) (*GetRepositoryListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRepositoryListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepositoryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutRepositoryUpdateResponse parses an HTTP response from a PutRepositoryUpdateWithResponse call
+func ParsePutRepositoryUpdateResponse(rsp *http.Response) (*PutRepositoryUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutRepositoryUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Repository Repository `json:"repository"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsPairingsResponse parses an HTTP response from a GetStatsPairingsWithResponse call
+func ParseGetStatsPairingsResponse(rsp *http.Response) (*GetStatsPairingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsPairingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamPairings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsTeamsResponse parses an HTTP response from a GetStatsTeamsWithResponse call
+func ParseGetStatsTeamsResponse(rsp *http.Response) (*GetStatsTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamStatsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTeamResponse parses an HTTP response from a DeleteTeamWithResponse call
+func ParseDeleteTeamResponse(rsp *http.Response) (*DeleteTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamDeletion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
+func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamAddResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Team *Team `json:"team,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamAuditResponse parses an HTTP response from a GetTeamAuditWithResponse call
+func ParseGetTeamAuditResponse(rsp *http.Response) (*GetTeamAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamAudit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamCodeownersResponse parses an HTTP response from a GetTeamCodeownersWithResponse call
+func ParseGetTeamCodeownersResponse(rsp *http.Response) (*GetTeamCodeownersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamCodeownersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamCodeOwners
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
+func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutTeamMembersResponse parses an HTTP response from a PutTeamMembersWithResponse call
+func ParsePutTeamMembersResponse(rsp *http.Response) (*PutTeamMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutTeamMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Team Team `json:"team"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamRenameResponse parses an HTTP response from a PostTeamRenameWithResponse call
+func ParsePostTeamRenameResponse(rsp *http.Response) (*PostTeamRenameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamRenameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Team Team `json:"team"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetCodeownersResponse parses an HTTP response from a PostTeamSetCodeownersWithResponse call
+func ParsePostTeamSetCodeownersResponse(rsp *http.Response) (*PostTeamSetCodeownersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetCodeownersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamCodeOwners
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetFallbacksResponse parses an HTTP response from a PostTeamSetFallbacksWithResponse call
+func ParsePostTeamSetFallbacksResponse(rsp *http.Response) (*PostTeamSetFallbacksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetFallbacksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamFallbacks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetPolicyResponse parses an HTTP response from a PostTeamSetPolicyWithResponse call
+func ParsePostTeamSetPolicyResponse(rsp *http.Response) (*PostTeamSetPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Policy TeamPolicy `json:"policy"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
+func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersGetReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PullRequests []PullRequestShort `json:"pull_requests"`
+			UserId       string             `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersOutOfOfficeResponse parses an HTTP response from a PostUsersOutOfOfficeWithResponse call
+func ParsePostUsersOutOfOfficeResponse(rsp *http.Response) (*PostUsersOutOfOfficeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersOutOfOfficeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			OutOfOffice OutOfOffice `json:"out_of_office"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
+func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetIsActiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetMaxOpenReviewsResponse parses an HTTP response from a PostUsersSetMaxOpenReviewsWithResponse call
+func ParsePostUsersSetMaxOpenReviewsResponse(rsp *http.Response) (*PostUsersSetMaxOpenReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetMaxOpenReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetScheduleResponse parses an HTTP response from a PostUsersSetScheduleWithResponse call
+func ParsePostUsersSetScheduleResponse(rsp *http.Response) (*PostUsersSetScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Выгрузка команд, пользователей и PR
+	// (GET /admin/export)
+	GetAdminExport(w http.ResponseWriter, r *http.Request)
+	// Массовый импорт команд, пользователей и PR
+	// (POST /admin/import)
+	PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams)
+	// Вручную добавить ревьювера в PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestAddReviewerParams)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Получить PR
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
+	// Вручную снять ревьювера с PR
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestRemoveReviewerParams)
+	// Удалить репозиторий без PR
+	// (DELETE /repository)
+	DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams)
+	// Зарегистрировать репозиторий
+	// (POST /repository/add)
+	PostRepositoryAdd(w http.ResponseWriter, r *http.Request)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams)
+	// Список репозиториев
+	// (GET /repository/list)
+	GetRepositoryList(w http.ResponseWriter, r *http.Request)
+	// Изменить команду-владельца и настройки ревью репозитория
+	// (PUT /repository/update)
+	PutRepositoryUpdate(w http.ResponseWriter, r *http.Request)
+	// Матрица пар автор–ревьювер по команде
+	// (GET /stats/pairings)
+	GetStatsPairings(w http.ResponseWriter, r *http.Request, params GetStatsPairingsParams)
+	// Статистика по командам (пропускная способность, время до мержа, зависшие PR)
+	// (GET /stats/teams)
+	GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams)
+	// Удалить команду
+	// (DELETE /team)
+	DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams)
+	// Создать команду с участниками (пользователи из других команд остаются и в них)
+	// (POST /team/add)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Журнал изменений состава команды
+	// (GET /team/audit)
+	GetTeamAudit(w http.ResponseWriter, r *http.Request, params GetTeamAuditParams)
+	// Получить правила CODEOWNERS команды
+	// (GET /team/codeowners)
+	GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Добавить или исключить участников команды
+	// (PUT /team/members)
+	PutTeamMembers(w http.ResponseWriter, r *http.Request, params PutTeamMembersParams)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams)
+	// Загрузить CODEOWNERS команды
+	// (POST /team/setCodeowners)
+	PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request)
+	// Задать резервные команды для выбора ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request)
+	// Настроить SLA ревью и политику эскалации команды
+	// (POST /team/setPolicy)
+	PostTeamSetPolicy(w http.ResponseWriter, r *http.Request)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Запланировать период отсутствия пользователя
+	// (POST /users/outOfOffice)
+	PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Установить персональный лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request)
+	// Установить тайм-зону и рабочие часы пользователя
+	// (POST /users/setSchedule)
+	PostUsersSetSchedule(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Выгрузка команд, пользователей и PR
+// (GET /admin/export)
+func (_ Unimplemented) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массовый импорт команд, пользователей и PR
+// (POST /admin/import)
+func (_ Unimplemented) PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вручную добавить ревьювера в PR
+// (POST /pullRequest/addReviewer)
+func (_ Unimplemented) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestAddReviewerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// (POST /pullRequest/create)
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вручную снять ревьювера с PR
+// (POST /pullRequest/removeReviewer)
+func (_ Unimplemented) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestRemoveReviewerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить репозиторий без PR
+// (DELETE /repository)
+func (_ Unimplemented) DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Зарегистрировать репозиторий
+// (POST /repository/add)
+func (_ Unimplemented) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить репозиторий
+// (GET /repository/get)
+func (_ Unimplemented) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список репозиториев
+// (GET /repository/list)
+func (_ Unimplemented) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить команду-владельца и настройки ревью репозитория
+// (PUT /repository/update)
+func (_ Unimplemented) PutRepositoryUpdate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Матрица пар автор–ревьювер по команде
+// (GET /stats/pairings)
+func (_ Unimplemented) GetStatsPairings(w http.ResponseWriter, r *http.Request, params GetStatsPairingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика по командам (пропускная способность, время до мержа, зависшие PR)
+// (GET /stats/teams)
+func (_ Unimplemented) GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить команду
+// (DELETE /team)
+func (_ Unimplemented) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (пользователи из других команд остаются и в них)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал изменений состава команды
+// (GET /team/audit)
+func (_ Unimplemented) GetTeamAudit(w http.ResponseWriter, r *http.Request, params GetTeamAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить правила CODEOWNERS команды
+// (GET /team/codeowners)
+func (_ Unimplemented) GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить или исключить участников команды
+// (PUT /team/members)
+func (_ Unimplemented) PutTeamMembers(w http.ResponseWriter, r *http.Request, params PutTeamMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (_ Unimplemented) PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить CODEOWNERS команды
+// (POST /team/setCodeowners)
+func (_ Unimplemented) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать резервные команды для выбора ревьюверов
+// (POST /team/setFallbacks)
+func (_ Unimplemented) PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настроить SLA ревью и политику эскалации команды
+// (POST /team/setPolicy)
+func (_ Unimplemented) PostTeamSetPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запланировать период отсутствия пользователя
+// (POST /users/outOfOffice)
+func (_ Unimplemented) PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить персональный лимит открытых ревью
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить тайм-зону и рабочие часы пользователя
+// (POST /users/setSchedule)
+func (_ Unimplemented) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAdminExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminExport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestAddReviewerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestAddReviewer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestRemoveReviewerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestRemoveReviewer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteRepositoryParams
+
+	// ------------- Required query parameter "repository_name" -------------
+
+	if paramValue := r.URL.Query().Get("repository_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_name", r.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepository(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRepositoryAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRepositoryAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepositoryGet operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryGetParams
+
+	// ------------- Required query parameter "repository_name" -------------
+
+	if paramValue := r.URL.Query().Get("repository_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_name", r.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepositoryList operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutRepositoryUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PutRepositoryUpdate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutRepositoryUpdate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsPairings operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsPairings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsPairingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", r.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsPairings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "weeks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weeks", r.URL.Query(), &params.Weeks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "weeks", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stale_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stale_days", r.URL.Query(), &params.StaleDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stale_days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "open_prs" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open_prs", r.URL.Query(), &params.OpenPrs)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "open_prs", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamAuditParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCodeownersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutTeamMembers operation middleware
+func (siw *ServerInterfaceWrapper) PutTeamMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTeamMembersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTeamMembers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamRenameParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetCodeowners(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetFallbacks operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetFallbacks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetPolicy(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersOutOfOffice operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersOutOfOffice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
 	ParamName string
 	Err       error
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export", wrapper.GetAdminExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/import", wrapper.PostAdminImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/repository", wrapper.DeleteRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repository/add", wrapper.PostRepositoryAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repository/get", wrapper.GetRepositoryGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repository/list", wrapper.GetRepositoryList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/repository/update", wrapper.PutRepositoryUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/pairings", wrapper.GetStatsPairings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/teams", wrapper.GetStatsTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/team", wrapper.DeleteTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/audit", wrapper.GetTeamAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/codeowners", wrapper.GetTeamCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/team/members", wrapper.PutTeamMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setCodeowners", wrapper.PostTeamSetCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setFallbacks", wrapper.PostTeamSetFallbacks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setPolicy", wrapper.PostTeamSetPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/outOfOffice", wrapper.PostUsersOutOfOffice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSchedule", wrapper.PostUsersSetSchedule)
+	})
+
+	return r
+}
+
+type GetAdminExportRequestObject struct {
+}
+
+type GetAdminExportResponseObject interface {
+	VisitGetAdminExportResponse(w http.ResponseWriter) error
+}
+
+type GetAdminExport200JSONResponse []TransferRecord
+
+func (response GetAdminExport200JSONResponse) VisitGetAdminExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAdminExport200ApplicationxNdjsonResponse) VisitGetAdminExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostAdminImportRequestObject struct {
+	Params   PostAdminImportParams
+	JSONBody *PostAdminImportJSONRequestBody
+	Body     io.Reader
+}
+
+type PostAdminImportResponseObject interface {
+	VisitPostAdminImportResponse(w http.ResponseWriter) error
+}
+
+type PostAdminImport200JSONResponse ImportReport
+
+func (response PostAdminImport200JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport422JSONResponse ImportReport
+
+func (response PostAdminImport422JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewerRequestObject struct {
+	Params PostPullRequestAddReviewerParams
+	Body   *PostPullRequestAddReviewerJSONRequestBody
+}
+
+type PostPullRequestAddReviewerResponseObject interface {
+	VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAddReviewer200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestAddReviewer200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestAddReviewer200ResponseHeaders
+}
+
+func (response PostPullRequestAddReviewer200JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestAddReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer404JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer409JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer412JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer412JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+
+		// ReviewerShortfall Сколько ревьюверов не удалось назначить из-за нехватки кандидатов
+		ReviewerShortfall *int `json:"reviewer_shortfall,omitempty"`
+	}
+	Headers PostPullRequestCreate201ResponseHeaders
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestCreate400JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGetRequestObject struct {
+	Params GetPullRequestGetParams
+}
+
+type GetPullRequestGetResponseObject interface {
+	VisitGetPullRequestGetResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetPullRequestGet200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers GetPullRequestGet200ResponseHeaders
+}
+
+func (response GetPullRequestGet200JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequestGet404JSONResponse ErrorResponse
+
+func (response GetPullRequestGet404JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Params PostPullRequestMergeParams
+	Body   *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers PostPullRequestMerge200ResponseHeaders
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge412JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge412JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Params PostPullRequestReassignParams
+	Body   *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	Headers PostPullRequestReassign200ResponseHeaders
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign412JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign412JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewerRequestObject struct {
+	Params PostPullRequestRemoveReviewerParams
+	Body   *PostPullRequestRemoveReviewerJSONRequestBody
+}
+
+type PostPullRequestRemoveReviewerResponseObject interface {
+	VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRemoveReviewer200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestRemoveReviewer200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestRemoveReviewer200ResponseHeaders
+}
+
+func (response PostPullRequestRemoveReviewer200JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestRemoveReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer404JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer409JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer412JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer412JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepositoryRequestObject struct {
+	Params DeleteRepositoryParams
+}
+
+type DeleteRepositoryResponseObject interface {
+	VisitDeleteRepositoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteRepository200JSONResponse struct {
+	RepositoryName string `json:"repository_name"`
+}
+
+func (response DeleteRepository200JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository404JSONResponse ErrorResponse
+
+func (response DeleteRepository404JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository409JSONResponse ErrorResponse
+
+func (response DeleteRepository409JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAddRequestObject struct {
+	Body *PostRepositoryAddJSONRequestBody
+}
+
+type PostRepositoryAddResponseObject interface {
+	VisitPostRepositoryAddResponse(w http.ResponseWriter) error
+}
+
+type PostRepositoryAdd201JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response PostRepositoryAdd201JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAdd400JSONResponse ErrorResponse
+
+func (response PostRepositoryAdd400JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAdd404JSONResponse ErrorResponse
+
+func (response PostRepositoryAdd404JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGetRequestObject struct {
+	Params GetRepositoryGetParams
+}
+
+type GetRepositoryGetResponseObject interface {
+	VisitGetRepositoryGetResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryGet200JSONResponse Repository
+
+func (response GetRepositoryGet200JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGet404JSONResponse ErrorResponse
+
+func (response GetRepositoryGet404JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryListRequestObject struct {
+}
+
+type GetRepositoryListResponseObject interface {
+	VisitGetRepositoryListResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryList200JSONResponse RepositoryList
+
+func (response GetRepositoryList200JSONResponse) VisitGetRepositoryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRepositoryUpdateRequestObject struct {
+	Body *PutRepositoryUpdateJSONRequestBody
+}
+
+type PutRepositoryUpdateResponseObject interface {
+	VisitPutRepositoryUpdateResponse(w http.ResponseWriter) error
+}
+
+type PutRepositoryUpdate200JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response PutRepositoryUpdate200JSONResponse) VisitPutRepositoryUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRepositoryUpdate404JSONResponse ErrorResponse
+
+func (response PutRepositoryUpdate404JSONResponse) VisitPutRepositoryUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsPairingsRequestObject struct {
+	Params GetStatsPairingsParams
+}
+
+type GetStatsPairingsResponseObject interface {
+	VisitGetStatsPairingsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsPairings200JSONResponse TeamPairings
+
+func (response GetStatsPairings200JSONResponse) VisitGetStatsPairingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsPairings404JSONResponse ErrorResponse
+
+func (response GetStatsPairings404JSONResponse) VisitGetStatsPairingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeamsRequestObject struct {
+	Params GetStatsTeamsParams
+}
+
+type GetStatsTeamsResponseObject interface {
+	VisitGetStatsTeamsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsTeams200JSONResponse TeamStatsPage
+
+func (response GetStatsTeams200JSONResponse) VisitGetStatsTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeams200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsTeams200TextcsvResponse) VisitGetStatsTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DeleteTeamRequestObject struct {
+	Params DeleteTeamParams
+}
+
+type DeleteTeamResponseObject interface {
+	VisitDeleteTeamResponse(w http.ResponseWriter) error
+}
+
+type DeleteTeam200JSONResponse TeamDeletion
+
+func (response DeleteTeam200JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam404JSONResponse ErrorResponse
+
+func (response DeleteTeam404JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam409JSONResponse ErrorResponse
+
+func (response DeleteTeam409JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam412JSONResponse ErrorResponse
+
+func (response DeleteTeam412JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamAuditRequestObject struct {
+	Params GetTeamAuditParams
+}
+
+type GetTeamAuditResponseObject interface {
+	VisitGetTeamAuditResponse(w http.ResponseWriter) error
+}
+
+type GetTeamAudit200JSONResponse TeamAudit
+
+func (response GetTeamAudit200JSONResponse) VisitGetTeamAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamAudit404JSONResponse ErrorResponse
+
+func (response GetTeamAudit404JSONResponse) VisitGetTeamAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeownersRequestObject struct {
+	Params GetTeamCodeownersParams
+}
+
+type GetTeamCodeownersResponseObject interface {
+	VisitGetTeamCodeownersResponse(w http.ResponseWriter) error
+}
+
+type GetTeamCodeowners200JSONResponse TeamCodeOwners
+
+func (response GetTeamCodeowners200JSONResponse) VisitGetTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeowners404JSONResponse ErrorResponse
+
+func (response GetTeamCodeowners404JSONResponse) VisitGetTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTeamGet200JSONResponse struct {
+	Body    Team
+	Headers GetTeamGet200ResponseHeaders
+}
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembersRequestObject struct {
+	Params PutTeamMembersParams
+	Body   *PutTeamMembersJSONRequestBody
+}
+
+type PutTeamMembersResponseObject interface {
+	VisitPutTeamMembersResponse(w http.ResponseWriter) error
+}
+
+type PutTeamMembers200ResponseHeaders struct {
+	ETag string
+}
+
+type PutTeamMembers200JSONResponse struct {
+	Body struct {
+		Team Team `json:"team"`
+	}
+	Headers PutTeamMembers200ResponseHeaders
+}
+
+func (response PutTeamMembers200JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutTeamMembers400JSONResponse ErrorResponse
+
+func (response PutTeamMembers400JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembers404JSONResponse ErrorResponse
+
+func (response PutTeamMembers404JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembers409JSONResponse ErrorResponse
+
+func (response PutTeamMembers409JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembers412JSONResponse ErrorResponse
+
+func (response PutTeamMembers412JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRenameRequestObject struct {
+	Params PostTeamRenameParams
+	Body   *PostTeamRenameJSONRequestBody
+}
+
+type PostTeamRenameResponseObject interface {
+	VisitPostTeamRenameResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRename200ResponseHeaders struct {
+	ETag string
+}
+
+type PostTeamRename200JSONResponse struct {
+	Body struct {
+		Team Team `json:"team"`
+	}
+	Headers PostTeamRename200ResponseHeaders
+}
+
+func (response PostTeamRename200JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamRename400JSONResponse ErrorResponse
+
+func (response PostTeamRename400JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename404JSONResponse ErrorResponse
+
+func (response PostTeamRename404JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename412JSONResponse ErrorResponse
+
+func (response PostTeamRename412JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeownersRequestObject struct {
+	Body *PostTeamSetCodeownersJSONRequestBody
+}
+
+type PostTeamSetCodeownersResponseObject interface {
+	VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetCodeowners200JSONResponse TeamCodeOwners
+
+func (response PostTeamSetCodeowners200JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeowners400JSONResponse ErrorResponse
+
+func (response PostTeamSetCodeowners400JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeowners404JSONResponse ErrorResponse
+
+func (response PostTeamSetCodeowners404JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacksRequestObject struct {
+	Body *PostTeamSetFallbacksJSONRequestBody
+}
+
+type PostTeamSetFallbacksResponseObject interface {
+	VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetFallbacks200JSONResponse TeamFallbacks
+
+func (response PostTeamSetFallbacks200JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks400JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks400JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks404JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks404JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetPolicyRequestObject struct {
+	Body *PostTeamSetPolicyJSONRequestBody
+}
+
+type PostTeamSetPolicyResponseObject interface {
+	VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetPolicy200JSONResponse struct {
+	Policy TeamPolicy `json:"policy"`
+}
+
+func (response PostTeamSetPolicy200JSONResponse) VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetPolicy404JSONResponse ErrorResponse
+
+func (response PostTeamSetPolicy404JSONResponse) VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersOutOfOfficeRequestObject struct {
+	Body *PostUsersOutOfOfficeJSONRequestBody
+}
+
+type PostUsersOutOfOfficeResponseObject interface {
+	VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error
+}
+
+type PostUsersOutOfOffice201JSONResponse struct {
+	OutOfOffice OutOfOffice `json:"out_of_office"`
+}
+
+func (response PostUsersOutOfOffice201JSONResponse) VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersOutOfOffice400JSONResponse ErrorResponse
+
+func (response PostUsersOutOfOffice400JSONResponse) VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersOutOfOffice404JSONResponse ErrorResponse
+
+func (response PostUsersOutOfOffice404JSONResponse) VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviewsRequestObject struct {
+	Body *PostUsersSetMaxOpenReviewsJSONRequestBody
+}
+
+type PostUsersSetMaxOpenReviewsResponseObject interface {
+	VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetMaxOpenReviews200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetMaxOpenReviews200JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews404JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetScheduleRequestObject struct {
+	Body *PostUsersSetScheduleJSONRequestBody
+}
+
+type PostUsersSetScheduleResponseObject interface {
+	VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetSchedule200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetSchedule200JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSchedule400JSONResponse ErrorResponse
+
+func (response PostUsersSetSchedule400JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSchedule404JSONResponse ErrorResponse
+
+func (response PostUsersSetSchedule404JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Выгрузка команд, пользователей и PR
+	// (GET /admin/export)
+	GetAdminExport(ctx context.Context, request GetAdminExportRequestObject) (GetAdminExportResponseObject, error)
+	// Массовый импорт команд, пользователей и PR
+	// (POST /admin/import)
+	PostAdminImport(ctx context.Context, request PostAdminImportRequestObject) (PostAdminImportResponseObject, error)
+	// Вручную добавить ревьювера в PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx context.Context, request PostPullRequestAddReviewerRequestObject) (PostPullRequestAddReviewerResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Получить PR
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx context.Context, request GetPullRequestGetRequestObject) (GetPullRequestGetResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Вручную снять ревьювера с PR
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx context.Context, request PostPullRequestRemoveReviewerRequestObject) (PostPullRequestRemoveReviewerResponseObject, error)
+	// Удалить репозиторий без PR
+	// (DELETE /repository)
+	DeleteRepository(ctx context.Context, request DeleteRepositoryRequestObject) (DeleteRepositoryResponseObject, error)
+	// Зарегистрировать репозиторий
+	// (POST /repository/add)
+	PostRepositoryAdd(ctx context.Context, request PostRepositoryAddRequestObject) (PostRepositoryAddResponseObject, error)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(ctx context.Context, request GetRepositoryGetRequestObject) (GetRepositoryGetResponseObject, error)
+	// Список репозиториев
+	// (GET /repository/list)
+	GetRepositoryList(ctx context.Context, request GetRepositoryListRequestObject) (GetRepositoryListResponseObject, error)
+	// Изменить команду-владельца и настройки ревью репозитория
+	// (PUT /repository/update)
+	PutRepositoryUpdate(ctx context.Context, request PutRepositoryUpdateRequestObject) (PutRepositoryUpdateResponseObject, error)
+	// Матрица пар автор–ревьювер по команде
+	// (GET /stats/pairings)
+	GetStatsPairings(ctx context.Context, request GetStatsPairingsRequestObject) (GetStatsPairingsResponseObject, error)
+	// Статистика по командам (пропускная способность, время до мержа, зависшие PR)
+	// (GET /stats/teams)
+	GetStatsTeams(ctx context.Context, request GetStatsTeamsRequestObject) (GetStatsTeamsResponseObject, error)
+	// Удалить команду
+	// (DELETE /team)
+	DeleteTeam(ctx context.Context, request DeleteTeamRequestObject) (DeleteTeamResponseObject, error)
+	// Создать команду с участниками (пользователи из других команд остаются и в них)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Журнал изменений состава команды
+	// (GET /team/audit)
+	GetTeamAudit(ctx context.Context, request GetTeamAuditRequestObject) (GetTeamAuditResponseObject, error)
+	// Получить правила CODEOWNERS команды
+	// (GET /team/codeowners)
+	GetTeamCodeowners(ctx context.Context, request GetTeamCodeownersRequestObject) (GetTeamCodeownersResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Добавить или исключить участников команды
+	// (PUT /team/members)
+	PutTeamMembers(ctx context.Context, request PutTeamMembersRequestObject) (PutTeamMembersResponseObject, error)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(ctx context.Context, request PostTeamRenameRequestObject) (PostTeamRenameResponseObject, error)
+	// Загрузить CODEOWNERS команды
+	// (POST /team/setCodeowners)
+	PostTeamSetCodeowners(ctx context.Context, request PostTeamSetCodeownersRequestObject) (PostTeamSetCodeownersResponseObject, error)
+	// Задать резервные команды для выбора ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(ctx context.Context, request PostTeamSetFallbacksRequestObject) (PostTeamSetFallbacksResponseObject, error)
+	// Настроить SLA ревью и политику эскалации команды
+	// (POST /team/setPolicy)
+	PostTeamSetPolicy(ctx context.Context, request PostTeamSetPolicyRequestObject) (PostTeamSetPolicyResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Запланировать период отсутствия пользователя
+	// (POST /users/outOfOffice)
+	PostUsersOutOfOffice(ctx context.Context, request PostUsersOutOfOfficeRequestObject) (PostUsersOutOfOfficeResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Установить персональный лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx context.Context, request PostUsersSetMaxOpenReviewsRequestObject) (PostUsersSetMaxOpenReviewsResponseObject, error)
+	// Установить тайм-зону и рабочие часы пользователя
+	// (POST /users/setSchedule)
+	PostUsersSetSchedule(ctx context.Context, request PostUsersSetScheduleRequestObject) (PostUsersSetScheduleResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-type RequiredParamError struct {
-	ParamName string
-}
+// GetAdminExport operation middleware
+func (sh *strictHandler) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+	var request GetAdminExportRequestObject
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminExport(ctx, request.(GetAdminExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminExport")
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminExportResponseObject); ok {
+		if err := validResponse.VisitGetAdminExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// PostAdminImport operation middleware
+func (sh *strictHandler) PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams) {
+	var request PostAdminImportRequestObject
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+		var body PostAdminImportJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-ndjson") {
+		request.Body = r.Body
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminImport(ctx, request.(PostAdminImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminImport")
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminImportResponseObject); ok {
+		if err := validResponse.VisitPostAdminImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+// PostPullRequestAddReviewer operation middleware
+func (sh *strictHandler) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestAddReviewerParams) {
+	var request PostPullRequestAddReviewerRequestObject
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+	var body PostPullRequestAddReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAddReviewer(ctx, request.(PostPullRequestAddReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAddReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestAddReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestAddReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetPullRequestGet operation middleware
+func (sh *strictHandler) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	var request GetPullRequestGetRequestObject
 
-	if r == nil {
-		r = chi.NewRouter()
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestGet(ctx, request.(GetPullRequestGetRequestObject))
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestGetResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	var request PostPullRequestMergeRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	var request PostPullRequestReassignRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return r
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+// PostPullRequestRemoveReviewer operation middleware
+func (sh *strictHandler) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestRemoveReviewerParams) {
+	var request PostPullRequestRemoveReviewerRequestObject
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostPullRequestRemoveReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRemoveReviewer(ctx, request.(PostPullRequestRemoveReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRemoveReviewer")
+	}
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestRemoveReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestRemoveReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+// DeleteRepository operation middleware
+func (sh *strictHandler) DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams) {
+	var request DeleteRepositoryRequestObject
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRepository(ctx, request.(DeleteRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRepository")
+	}
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRepositoryResponseObject); ok {
+		if err := validResponse.VisitDeleteRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+// PostRepositoryAdd operation middleware
+func (sh *strictHandler) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostRepositoryAddRequestObject
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body PostRepositoryAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRepositoryAdd(ctx, request.(PostRepositoryAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRepositoryAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostRepositoryAddResponseObject); ok {
+		if err := validResponse.VisitPostRepositoryAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+// GetRepositoryGet operation middleware
+func (sh *strictHandler) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams) {
+	var request GetRepositoryGetRequestObject
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryGet(ctx, request.(GetRepositoryGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryGet")
+	}
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryGetResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+// GetRepositoryList operation middleware
+func (sh *strictHandler) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+	var request GetRepositoryListRequestObject
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryList(ctx, request.(GetRepositoryListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryList")
+	}
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryListResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
-
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// PutRepositoryUpdate operation middleware
+func (sh *strictHandler) PutRepositoryUpdate(w http.ResponseWriter, r *http.Request) {
+	var request PutRepositoryUpdateRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PutRepositoryUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutRepositoryUpdate(ctx, request.(PutRepositoryUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutRepositoryUpdate")
+	}
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutRepositoryUpdateResponseObject); ok {
+		if err := validResponse.VisitPutRepositoryUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
-}
+// GetStatsPairings operation middleware
+func (sh *strictHandler) GetStatsPairings(w http.ResponseWriter, r *http.Request, params GetStatsPairingsParams) {
+	var request GetStatsPairingsRequestObject
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsPairings(ctx, request.(GetStatsPairingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsPairings")
+	}
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsPairingsResponseObject); ok {
+		if err := validResponse.VisitGetStatsPairingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// GetStatsTeams operation middleware
+func (sh *strictHandler) GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams) {
+	var request GetStatsTeamsRequestObject
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsTeams(ctx, request.(GetStatsTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsTeams")
+	}
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsTeamsResponseObject); ok {
+		if err := validResponse.VisitGetStatsTeamsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGet200JSONResponse Team
-
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteTeam operation middleware
+func (sh *strictHandler) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	var request DeleteTeamRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetTeamGet404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTeam(ctx, request.(DeleteTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTeam")
+	}
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTeamResponseObject); ok {
+		if err := validResponse.VisitDeleteTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamAudit operation middleware
+func (sh *strictHandler) GetTeamAudit(w http.ResponseWriter, r *http.Request, params GetTeamAuditParams) {
+	var request GetTeamAuditRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamAudit(ctx, request.(GetTeamAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamAudit")
+	}
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamAuditResponseObject); ok {
+		if err := validResponse.VisitGetTeamAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamCodeowners operation middleware
+func (sh *strictHandler) GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams) {
+	var request GetTeamCodeownersRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamCodeowners(ctx, request.(GetTeamCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamCodeowners")
+	}
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamCodeownersResponseObject); ok {
+		if err := validResponse.VisitGetTeamCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
-}
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// PutTeamMembers operation middleware
+func (sh *strictHandler) PutTeamMembers(w http.ResponseWriter, r *http.Request, params PutTeamMembersParams) {
+	var request PutTeamMembersRequestObject
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+	request.Params = params
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PutTeamMembersJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1934,18 +8855,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PutTeamMembers(ctx, request.(PutTeamMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PutTeamMembers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutTeamMembersResponseObject); ok {
+		if err := validResponse.VisitPutTeamMembersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1953,11 +8874,13 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostTeamRename operation middleware
+func (sh *strictHandler) PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams) {
+	var request PostTeamRenameRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	request.Params = params
+
+	var body PostTeamRenameJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1965,18 +8888,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostTeamRename(ctx, request.(PostTeamRenameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostTeamRename")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamRenameResponseObject); ok {
+		if err := validResponse.VisitPostTeamRenameResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1984,11 +8907,11 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// PostTeamSetCodeowners operation middleware
+func (sh *strictHandler) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetCodeownersRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostTeamSetCodeownersJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1996,18 +8919,18 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostTeamSetCodeowners(ctx, request.(PostTeamSetCodeownersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostTeamSetCodeowners")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetCodeownersResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetCodeownersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2015,11 +8938,11 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostTeamSetFallbacks operation middleware
+func (sh *strictHandler) PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetFallbacksRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostTeamSetFallbacksJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2027,18 +8950,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostTeamSetFallbacks(ctx, request.(PostTeamSetFallbacksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostTeamSetFallbacks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetFallbacksResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetFallbacksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2046,25 +8969,30 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// PostTeamSetPolicy operation middleware
+func (sh *strictHandler) PostTeamSetPolicy(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetPolicyRequestObject
 
-	request.Params = params
+	var body PostTeamSetPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.PostTeamSetPolicy(ctx, request.(PostTeamSetPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "PostTeamSetPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetPolicyResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2098,6 +9026,37 @@ func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// PostUsersOutOfOffice operation middleware
+func (sh *strictHandler) PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersOutOfOfficeRequestObject
+
+	var body PostUsersOutOfOfficeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersOutOfOffice(ctx, request.(PostUsersOutOfOfficeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersOutOfOffice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersOutOfOfficeResponseObject); ok {
+		if err := validResponse.VisitPostUsersOutOfOfficeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostUsersSetIsActive operation middleware
 func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	var request PostUsersSetIsActiveRequestObject
@@ -2128,3 +9087,65 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (sh *strictHandler) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetMaxOpenReviewsRequestObject
+
+	var body PostUsersSetMaxOpenReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetMaxOpenReviews(ctx, request.(PostUsersSetMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetMaxOpenReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetMaxOpenReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetSchedule operation middleware
+func (sh *strictHandler) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetScheduleRequestObject
+
+	var body PostUsersSetScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetSchedule(ctx, request.(PostUsersSetScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetScheduleResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -7,22 +7,54 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for CodeOwnerKind.
+const (
+	TEAM CodeOwnerKind = "TEAM"
+	USER CodeOwnerKind = "USER"
 )
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYASSIGNED          ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	AUTHORNOTALLOWED         ErrorResponseErrorCode = "AUTHOR_NOT_ALLOWED"
+	EMPTYTEAM                ErrorResponseErrorCode = "EMPTY_TEAM"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INVALIDCODEOWNERS        ErrorResponseErrorCode = "INVALID_CODEOWNERS"
+	INVALIDFALLBACK          ErrorResponseErrorCode = "INVALID_FALLBACK"
+	INVALIDMEMBERS           ErrorResponseErrorCode = "INVALID_MEMBERS"
+	INVALIDPERIOD            ErrorResponseErrorCode = "INVALID_PERIOD"
+	INVALIDSCHEDULE          ErrorResponseErrorCode = "INVALID_SCHEDULE"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	NOTTEAMMEMBER            ErrorResponseErrorCode = "NOT_TEAM_MEMBER"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	REPOSITORYEXISTS         ErrorResponseErrorCode = "REPOSITORY_EXISTS"
+	REPOSITORYHASPRS         ErrorResponseErrorCode = "REPOSITORY_HAS_PRS"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMHASOPENPRS           ErrorResponseErrorCode = "TEAM_HAS_OPEN_PRS"
+	TEAMREQUIRED             ErrorResponseErrorCode = "TEAM_REQUIRED"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	USERIDTAKEN              ErrorResponseErrorCode = "USER_ID_TAKEN"
+	USERINACTIVE             ErrorResponseErrorCode = "USER_INACTIVE"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
 )
 
 // Defines values for PullRequestStatus.
@@ -37,6 +69,84 @@ const (
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for RepositorySelectionMode.
+const (
+	RepositorySelectionDefault  RepositorySelectionMode = "DEFAULT"
+	RepositorySelectionRotation RepositorySelectionMode = "ROTATION"
+)
+
+// Defines values for RepositoryShortfallPolicy.
+const (
+	RepositoryShortfallAllow  RepositoryShortfallPolicy = "ALLOW"
+	RepositoryShortfallReject RepositoryShortfallPolicy = "REJECT"
+)
+
+// Defines values for TeamAuditEntryAction.
+const (
+	MEMBERADDED   TeamAuditEntryAction = "MEMBER_ADDED"
+	MEMBERMOVED   TeamAuditEntryAction = "MEMBER_MOVED"
+	MEMBERREMOVED TeamAuditEntryAction = "MEMBER_REMOVED"
+	TEAMCREATED   TeamAuditEntryAction = "TEAM_CREATED"
+	TEAMDELETED   TeamAuditEntryAction = "TEAM_DELETED"
+	TEAMRENAMED   TeamAuditEntryAction = "TEAM_RENAMED"
+)
+
+// Defines values for TeamPolicyEscalationPolicy.
+const (
+	ADDREVIEWER TeamPolicyEscalationPolicy = "ADD_REVIEWER"
+	REASSIGN    TeamPolicyEscalationPolicy = "REASSIGN"
+)
+
+// Defines values for TeamPolicySelectionMode.
+const (
+	DEFAULT  TeamPolicySelectionMode = "DEFAULT"
+	ROTATION TeamPolicySelectionMode = "ROTATION"
+)
+
+// Defines values for TeamPolicyShortfallPolicy.
+const (
+	ALLOW  TeamPolicyShortfallPolicy = "ALLOW"
+	REJECT TeamPolicyShortfallPolicy = "REJECT"
+)
+
+// Defines values for TransferPullRequestStatus.
+const (
+	MERGED TransferPullRequestStatus = "MERGED"
+	OPEN   TransferPullRequestStatus = "OPEN"
+)
+
+// Defines values for TransferRecordKind.
+const (
+	TransferRecordKindPullRequest TransferRecordKind = "pull_request"
+	TransferRecordKindTeam        TransferRecordKind = "team"
+	TransferRecordKindUser        TransferRecordKind = "user"
+)
+
+// Defines values for DeleteTeamParamsOpenPrs.
+const (
+	OpenPRsReject   DeleteTeamParamsOpenPrs = "REJECT"
+	OpenPRsUnassign DeleteTeamParamsOpenPrs = "UNASSIGN"
+)
+
+// CodeOwner defines model for CodeOwner.
+type CodeOwner struct {
+	Kind CodeOwnerKind `json:"kind"`
+
+	// Name Идентификатор пользователя или имя команды
+	Name string `json:"name"`
+}
+
+// CodeOwnerKind defines model for CodeOwner.Kind.
+type CodeOwnerKind string
+
+// CodeOwnersRule defines model for CodeOwnersRule.
+type CodeOwnersRule struct {
+	Owners []CodeOwner `json:"owners"`
+
+	// Pattern Glob-шаблон пути в синтаксисе CODEOWNERS
+	Pattern string `json:"pattern"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -48,16 +158,61 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ImportCounts defines model for ImportCounts.
+type ImportCounts struct {
+	Created   int `json:"created"`
+	Unchanged int `json:"unchanged"`
+	Updated   int `json:"updated"`
+}
+
+// ImportError defines model for ImportError.
+type ImportError struct {
+	// Line Номер строки NDJSON или элемента JSON-массива (с 1)
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Applied Изменения сохранены (нет ошибок и это не dry-run)
+	Applied      bool          `json:"applied"`
+	DryRun       bool          `json:"dry_run"`
+	Errors       []ImportError `json:"errors"`
+	PullRequests ImportCounts  `json:"pull_requests"`
+	Teams        ImportCounts  `json:"teams"`
+	Users        ImportCounts  `json:"users"`
+}
+
+// LocalTime Локальное время HH:MM в тайм-зоне пользователя
+type LocalTime = string
+
+// OutOfOffice defines model for OutOfOffice.
+type OutOfOffice struct {
+	From time.Time `json:"from"`
+	Id   int64     `json:"id"`
+
+	// ReassignReviews Переназначить открытые ревью пользователя при начале отсутствия
+	ReassignReviews bool      `json:"reassign_reviews"`
+	To              time.Time `json:"to"`
+	UserId          string    `json:"user_id"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// FallbackReviewers Ревьюверы из assigned_reviewers, взятые из резервных команд
+	FallbackReviewers *[]string  `json:"fallback_reviewers,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Repository Репозиторий PR (не задан для PR вне репозиториев)
+	Repository *string           `json:"repository,omitempty"`
+	Status     PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -68,18 +223,110 @@ type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
+	Repository      *string                `json:"repository,omitempty"`
 	Status          PullRequestShortStatus `json:"status"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Repository defines model for Repository.
+type Repository struct {
+	// OwnerTeam Команда-владелец, из которой назначаются ревьюверы PR репозитория (null — команда автора)
+	OwnerTeam      *string `json:"owner_team"`
+	RepositoryName string  `json:"repository_name"`
+
+	// ReviewerCount Сколько ревьюверов назначать на PR (null — 2)
+	ReviewerCount *int `json:"reviewer_count"`
+
+	// SelectionMode Перекрывает selection_mode команды (null — как у команды)
+	SelectionMode *RepositorySelectionMode `json:"selection_mode"`
+
+	// ShortfallPolicy Перекрывает shortfall_policy команды (null — как у команды)
+	ShortfallPolicy *RepositoryShortfallPolicy `json:"shortfall_policy"`
+}
+
+// RepositorySelectionMode Перекрывает selection_mode команды (null — как у команды)
+type RepositorySelectionMode string
+
+// RepositoryShortfallPolicy Перекрывает shortfall_policy команды (null — как у команды)
+type RepositoryShortfallPolicy string
+
+// RepositoryList defines model for RepositoryList.
+type RepositoryList struct {
+	Repositories []Repository `json:"repositories"`
+}
+
+// ReviewPairing defines model for ReviewPairing.
+type ReviewPairing struct {
+	AuthorId   string `json:"author_id"`
+	ReviewerId string `json:"reviewer_id"`
+
+	// Reviews Сколько раз ревьювер назначался на PR автора за окно
+	Reviews int `json:"reviews"`
+}
+
+// ReviewerChange defines model for ReviewerChange.
+type ReviewerChange struct {
+	PullRequestId string  `json:"pull_request_id"`
+	Repository    *string `json:"repository,omitempty"`
+	UserId        string  `json:"user_id"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
 	TeamName string       `json:"team_name"`
 }
 
+// TeamAudit defines model for TeamAudit.
+type TeamAudit struct {
+	Entries  []TeamAuditEntry `json:"entries"`
+	TeamName string           `json:"team_name"`
+}
+
+// TeamAuditEntry defines model for TeamAuditEntry.
+type TeamAuditEntry struct {
+	Action    TeamAuditEntryAction `json:"action"`
+	CreatedAt time.Time            `json:"created_at"`
+
+	// PreviousTeamName Прежнее имя команды или команда, из которой переведён участник
+	PreviousTeamName *string `json:"previous_team_name,omitempty"`
+
+	// TeamName Имя команды на момент события
+	TeamName string `json:"team_name"`
+
+	// UserId Участник, которого касается событие
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// TeamAuditEntryAction defines model for TeamAuditEntry.Action.
+type TeamAuditEntryAction string
+
+// TeamCodeOwners defines model for TeamCodeOwners.
+type TeamCodeOwners struct {
+	// Rules Правила в порядке файла, при совпадении нескольких действует последнее
+	Rules    []CodeOwnersRule `json:"rules"`
+	TeamName string           `json:"team_name"`
+}
+
+// TeamDeletion defines model for TeamDeletion.
+type TeamDeletion struct {
+	// ReleasedMembers Участники удалённой команды
+	ReleasedMembers []string `json:"released_members"`
+	TeamName        string   `json:"team_name"`
+
+	// UnassignedReviews Сколько назначений на открытые PR было снято
+	UnassignedReviews int `json:"unassigned_reviews"`
+}
+
+// TeamFallbacks defines model for TeamFallbacks.
+type TeamFallbacks struct {
+	// FallbackTeams Резервные команды в порядке приоритета
+	FallbackTeams []string `json:"fallback_teams"`
+	TeamName      string   `json:"team_name"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -87,36 +334,341 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamMembersChange defines model for TeamMembersChange.
+type TeamMembersChange struct {
+	// Add Добавить участников или обновить данные уже состоящих в команде
+	Add *[]TeamMember `json:"add,omitempty"`
+
+	// Move Исключить добавляемых пользователей из остальных их команд
+	Move *bool `json:"move,omitempty"`
+
+	// Remove Идентификаторы участников, которых нужно исключить из команды
+	Remove   *[]string `json:"remove,omitempty"`
+	TeamName string    `json:"team_name"`
+}
+
+// TeamPairings defines model for TeamPairings.
+type TeamPairings struct {
+	// Pairings Ненулевые ячейки матрицы автор × ревьювер для авторов из команды
+	Pairings []ReviewPairing `json:"pairings"`
+	Since    time.Time       `json:"since"`
+	TeamName string          `json:"team_name"`
+}
+
+// TeamPolicy defines model for TeamPolicy.
+type TeamPolicy struct {
+	// DefaultMaxOpenReviews Лимит открытых ревью на участника по умолчанию (null — без лимита)
+	DefaultMaxOpenReviews *int `json:"default_max_open_reviews"`
+
+	// EscalationPolicy Переназначить зависшего ревьювера или добавить ещё одного
+	EscalationPolicy TeamPolicyEscalationPolicy `json:"escalation_policy"`
+
+	// ReviewSlaMinutes Через сколько минут бездействия ревьювера PR эскалируется (null — эскалация выключена)
+	ReviewSlaMinutes *int `json:"review_sla_minutes"`
+
+	// RotationWindowDays Окно, за которое учитываются прошлые пары автор–ревьювер
+	RotationWindowDays *int `json:"rotation_window_days,omitempty"`
+
+	// SelectionMode ROTATION — в первую очередь выбирать тех, кто реже ревьюил автора за окно rotation_window_days
+	SelectionMode *TeamPolicySelectionMode `json:"selection_mode,omitempty"`
+
+	// ShortfallPolicy Создавать PR с неполным набором ревьюверов или отклонять создание
+	ShortfallPolicy *TeamPolicyShortfallPolicy `json:"shortfall_policy,omitempty"`
+	TeamName        string                     `json:"team_name"`
+}
+
+// TeamPolicyEscalationPolicy Переназначить зависшего ревьювера или добавить ещё одного
+type TeamPolicyEscalationPolicy string
+
+// TeamPolicySelectionMode ROTATION — в первую очередь выбирать тех, кто реже ревьюил автора за окно rotation_window_days
+type TeamPolicySelectionMode string
+
+// TeamPolicyShortfallPolicy Создавать PR с неполным набором ревьюверов или отклонять создание
+type TeamPolicyShortfallPolicy string
+
+// TeamRename defines model for TeamRename.
+type TeamRename struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	ActiveMembers int `json:"active_members"`
+
+	// ReviewerCount Наибольшее число ревьюверов, которое назначается на PR команды: 2 по умолчанию
+	// или больше, если так настроен репозиторий команды либо репозиторий без владельца
+	ReviewerCount int `json:"reviewer_count"`
+
+	// StaleOpenPrs Открытые PR старше stale_days дней
+	StaleOpenPrs int    `json:"stale_open_prs"`
+	TeamName     string `json:"team_name"`
+
+	// TimeToMergeP50Seconds Медиана времени от создания до мержа
+	TimeToMergeP50Seconds *float64 `json:"time_to_merge_p50_seconds"`
+
+	// TimeToMergeP90Seconds 90-й перцентиль времени от создания до мержа
+	TimeToMergeP90Seconds *float64 `json:"time_to_merge_p90_seconds"`
+
+	// Understaffed Активных участников меньше, чем ревьюверов на PR
+	Understaffed bool               `json:"understaffed"`
+	Weekly       []WeeklyThroughput `json:"weekly"`
+}
+
+// TeamStatsPage defines model for TeamStatsPage.
+type TeamStatsPage struct {
+	Page     int         `json:"page"`
+	PageSize int         `json:"page_size"`
+	Teams    []TeamStats `json:"teams"`
+	Total    int         `json:"total"`
+}
+
+// TransferPullRequest defines model for TransferPullRequest.
+type TransferPullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// FallbackReviewers Ревьюверы из assigned_reviewers, взятые из резервных команд
+	FallbackReviewers *[]string  `json:"fallback_reviewers,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Repository Репозиторий PR (не задан для PR вне репозиториев)
+	Repository *string                   `json:"repository,omitempty"`
+	Status     TransferPullRequestStatus `json:"status"`
+
+	// TeamName Команда, из которой назначались ревьюверы (не задана — PR без команды)
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// TransferPullRequestStatus defines model for TransferPullRequest.Status.
+type TransferPullRequestStatus string
+
+// TransferRecord Одна сущность импорта или экспорта, заполняется поле, соответствующее kind
+type TransferRecord struct {
+	Kind        TransferRecordKind   `json:"kind"`
+	PullRequest *TransferPullRequest `json:"pull_request,omitempty"`
+	Team        *Team                `json:"team,omitempty"`
+	User        *TransferUser        `json:"user,omitempty"`
+}
+
+// TransferRecordKind defines model for TransferRecord.Kind.
+type TransferRecordKind string
+
+// TransferUser defines model for TransferUser.
+type TransferUser struct {
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Персональный лимит открытых ревью (null — действует лимит команды)
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// TimeZone IANA тайм-зона пользователя (по умолчанию UTC)
+	TimeZone *string `json:"time_zone,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+
+	// WorkEnd Локальное время HH:MM в тайм-зоне пользователя
+	WorkEnd *LocalTime `json:"work_end"`
+
+	// WorkStart Локальное время HH:MM в тайм-зоне пользователя
+	WorkStart *LocalTime `json:"work_start"`
+}
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Персональный лимит открытых ревью (null — действует лимит команды)
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// TeamName Первая по времени вступления команда пользователя
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+
+	// TeamNames Все команды пользователя в порядке вступления
+	TeamNames *[]string `json:"team_names,omitempty"`
+
+	// TimeZone IANA тайм-зона пользователя
+	TimeZone *string `json:"time_zone,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+
+	// WorkEnd Локальное время HH:MM в тайм-зоне пользователя
+	WorkEnd *LocalTime `json:"work_end"`
+
+	// WorkStart Локальное время HH:MM в тайм-зоне пользователя
+	WorkStart *LocalTime `json:"work_start"`
+}
+
+// WeeklyThroughput defines model for WeeklyThroughput.
+type WeeklyThroughput struct {
+	Merged int `json:"merged"`
+	Opened int `json:"opened"`
+
+	// WeekStart Понедельник недели (UTC)
+	WeekStart openapi_types.Date `json:"week_start"`
 }
 
+// IfMatchHeader defines model for IfMatchHeader.
+type IfMatchHeader = string
+
+// PageQuery defines model for PageQuery.
+type PageQuery = int
+
+// PageSizeQuery defines model for PageSizeQuery.
+type PageSizeQuery = int
+
+// RepositoryNameQuery defines model for RepositoryNameQuery.
+type RepositoryNameQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostAdminImportJSONBody defines parameters for PostAdminImport.
+type PostAdminImportJSONBody = []TransferRecord
+
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	// DryRun Только проверить файл, не сохраняя изменения
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostPullRequestAddReviewerParams defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы, владельцы по CODEOWNERS команды автора назначаются первыми
+	ChangedFiles    *[]string `json:"changed_files,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+
+	// Repository Репозиторий PR
+	Repository *string `json:"repository,omitempty"`
+
+	// TeamName Команда автора, из которой назначаются ревьюверы
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+
+	// Repository Репозиторий PR (не задаётся для PR вне репозиториев)
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+	PullRequestId string  `json:"pull_request_id"`
+	Repository    *string `json:"repository,omitempty"`
+}
+
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
 }
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+	// ExcludeUserIds Пользователи, которых не следует выбирать (например, отказавшиеся от ревью)
+	ExcludeUserIds *[]string `json:"exclude_user_ids,omitempty"`
+
+	// NewUserId Назначить конкретного пользователя вместо автоматического выбора.
+	// Проверяются те же правила, что и в /pullRequest/addReviewer.
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+	Repository    *string `json:"repository,omitempty"`
+}
+
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestRemoveReviewerParams defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// DeleteRepositoryParams defines parameters for DeleteRepository.
+type DeleteRepositoryParams struct {
+	// RepositoryName Уникальное имя репозитория
+	RepositoryName RepositoryNameQuery `form:"repository_name" json:"repository_name"`
+}
+
+// GetRepositoryGetParams defines parameters for GetRepositoryGet.
+type GetRepositoryGetParams struct {
+	// RepositoryName Уникальное имя репозитория
+	RepositoryName RepositoryNameQuery `form:"repository_name" json:"repository_name"`
+}
+
+// GetStatsPairingsParams defines parameters for GetStatsPairings.
+type GetStatsPairingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Days За сколько последних дней учитывать назначения
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// GetStatsTeamsParams defines parameters for GetStatsTeams.
+type GetStatsTeamsParams struct {
+	// Page Номер страницы (с 1)
+	Page *PageQuery `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Размер страницы
+	PageSize *PageSizeQuery `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Weeks За сколько последних недель считать открытые/смерженные PR
+	Weeks *int `form:"weeks,omitempty" json:"weeks,omitempty"`
+
+	// StaleDays Открытый PR старше стольких дней считается зависшим
+	StaleDays *int `form:"stale_days,omitempty" json:"stale_days,omitempty"`
+}
+
+// DeleteTeamParams defines parameters for DeleteTeam.
+type DeleteTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// OpenPrs Что делать, если у команды есть открытые PR:
+	// REJECT — отказать в удалении, UNASSIGN — снять с этих PR всех ревьюверов
+	// (сами PR остаются открытыми).
+	OpenPrs *DeleteTeamParamsOpenPrs `form:"open_prs,omitempty" json:"open_prs,omitempty"`
+
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// DeleteTeamParamsOpenPrs defines parameters for DeleteTeam.
+type DeleteTeamParamsOpenPrs string
+
+// GetTeamAuditParams defines parameters for GetTeamAudit.
+type GetTeamAuditParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamCodeownersParams defines parameters for GetTeamCodeowners.
+type GetTeamCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
 // GetTeamGetParams defines parameters for GetTeamGet.
@@ -125,18 +677,68 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PutTeamMembersParams defines parameters for PutTeamMembers.
+type PutTeamMembersParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// PostTeamRenameParams defines parameters for PostTeamRename.
+type PostTeamRenameParams struct {
+	// IfMatch ETag, полученный при чтении; изменение выполнится, только если версия не менялась
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// PostTeamSetCodeownersJSONBody defines parameters for PostTeamSetCodeowners.
+type PostTeamSetCodeownersJSONBody struct {
+	Content  string `json:"content"`
+	TeamName string `json:"team_name"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// PostUsersOutOfOfficeJSONBody defines parameters for PostUsersOutOfOffice.
+type PostUsersOutOfOfficeJSONBody struct {
+	From            time.Time `json:"from"`
+	ReassignReviews *bool     `json:"reassign_reviews,omitempty"`
+	To              time.Time `json:"to"`
+	UserId          string    `json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetScheduleJSONBody defines parameters for PostUsersSetSchedule.
+type PostUsersSetScheduleJSONBody struct {
+	TimeZone string `json:"time_zone"`
+	UserId   string `json:"user_id"`
+
+	// WorkEnd Локальное время HH:MM в тайм-зоне пользователя
+	WorkEnd *LocalTime `json:"work_end"`
+
+	// WorkStart Локальное время HH:MM в тайм-зоне пользователя
+	WorkStart *LocalTime `json:"work_start"`
+}
+
+// PostAdminImportJSONRequestBody defines body for PostAdminImport for application/json ContentType.
+type PostAdminImportJSONRequestBody = PostAdminImportJSONBody
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody = ReviewerChange
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -146,111 +748,343 @@ type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody = ReviewerChange
+
+// PostRepositoryAddJSONRequestBody defines body for PostRepositoryAdd for application/json ContentType.
+type PostRepositoryAddJSONRequestBody = Repository
+
+// PutRepositoryUpdateJSONRequestBody defines body for PutRepositoryUpdate for application/json ContentType.
+type PutRepositoryUpdateJSONRequestBody = Repository
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PutTeamMembersJSONRequestBody defines body for PutTeamMembers for application/json ContentType.
+type PutTeamMembersJSONRequestBody = TeamMembersChange
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody = TeamRename
+
+// PostTeamSetCodeownersJSONRequestBody defines body for PostTeamSetCodeowners for application/json ContentType.
+type PostTeamSetCodeownersJSONRequestBody PostTeamSetCodeownersJSONBody
+
+// PostTeamSetFallbacksJSONRequestBody defines body for PostTeamSetFallbacks for application/json ContentType.
+type PostTeamSetFallbacksJSONRequestBody = TeamFallbacks
+
+// PostTeamSetPolicyJSONRequestBody defines body for PostTeamSetPolicy for application/json ContentType.
+type PostTeamSetPolicyJSONRequestBody = TeamPolicy
+
+// PostUsersOutOfOfficeJSONRequestBody defines body for PostUsersOutOfOffice for application/json ContentType.
+type PostUsersOutOfOfficeJSONRequestBody PostUsersOutOfOfficeJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetScheduleJSONRequestBody defines body for PostUsersSetSchedule for application/json ContentType.
+type PostUsersSetScheduleJSONRequestBody PostUsersSetScheduleJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Выгрузка команд, пользователей и PR
+	// (GET /admin/export)
+	GetAdminExport(w http.ResponseWriter, r *http.Request)
+	// Массовый импорт команд, пользователей и PR
+	// (POST /admin/import)
+	PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams)
+	// Вручную добавить ревьювера в PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestAddReviewerParams)
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Получить PR
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
+	// Вручную снять ревьювера с PR
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestRemoveReviewerParams)
+	// Удалить репозиторий без PR
+	// (DELETE /repository)
+	DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams)
+	// Зарегистрировать репозиторий
+	// (POST /repository/add)
+	PostRepositoryAdd(w http.ResponseWriter, r *http.Request)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams)
+	// Список репозиториев
+	// (GET /repository/list)
+	GetRepositoryList(w http.ResponseWriter, r *http.Request)
+	// Изменить команду-владельца и настройки ревью репозитория
+	// (PUT /repository/update)
+	PutRepositoryUpdate(w http.ResponseWriter, r *http.Request)
+	// Матрица пар автор–ревьювер по команде
+	// (GET /stats/pairings)
+	GetStatsPairings(w http.ResponseWriter, r *http.Request, params GetStatsPairingsParams)
+	// Статистика по командам (пропускная способность, время до мержа, зависшие PR)
+	// (GET /stats/teams)
+	GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams)
+	// Удалить команду
+	// (DELETE /team)
+	DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams)
+	// Создать команду с участниками (пользователи из других команд остаются и в них)
 	// (POST /team/add)
 	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Журнал изменений состава команды
+	// (GET /team/audit)
+	GetTeamAudit(w http.ResponseWriter, r *http.Request, params GetTeamAuditParams)
+	// Получить правила CODEOWNERS команды
+	// (GET /team/codeowners)
+	GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Добавить или исключить участников команды
+	// (PUT /team/members)
+	PutTeamMembers(w http.ResponseWriter, r *http.Request, params PutTeamMembersParams)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams)
+	// Загрузить CODEOWNERS команды
+	// (POST /team/setCodeowners)
+	PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request)
+	// Задать резервные команды для выбора ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request)
+	// Настроить SLA ревью и политику эскалации команды
+	// (POST /team/setPolicy)
+	PostTeamSetPolicy(w http.ResponseWriter, r *http.Request)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Запланировать период отсутствия пользователя
+	// (POST /users/outOfOffice)
+	PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Установить персональный лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request)
+	// Установить тайм-зону и рабочие часы пользователя
+	// (POST /users/setSchedule)
+	PostUsersSetSchedule(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Выгрузка команд, пользователей и PR
+// (GET /admin/export)
+func (_ Unimplemented) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массовый импорт команд, пользователей и PR
+// (POST /admin/import)
+func (_ Unimplemented) PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вручную добавить ревьювера в PR
+// (POST /pullRequest/addReviewer)
+func (_ Unimplemented) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestAddReviewerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 // (POST /pullRequest/create)
 func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить PR
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Переназначить конкретного ревьювера на другого из его команды
 // (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// Вручную снять ревьювера с PR
+// (POST /pullRequest/removeReviewer)
+func (_ Unimplemented) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestRemoveReviewerParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+// Удалить репозиторий без PR
+// (DELETE /repository)
+func (_ Unimplemented) DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+// Зарегистрировать репозиторий
+// (POST /repository/add)
+func (_ Unimplemented) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// Получить репозиторий
+// (GET /repository/get)
+func (_ Unimplemented) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Список репозиториев
+// (GET /repository/list)
+func (_ Unimplemented) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Изменить команду-владельца и настройки ревью репозитория
+// (PUT /repository/update)
+func (_ Unimplemented) PutRepositoryUpdate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Матрица пар автор–ревьювер по команде
+// (GET /stats/pairings)
+func (_ Unimplemented) GetStatsPairings(w http.ResponseWriter, r *http.Request, params GetStatsPairingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// Статистика по командам (пропускная способность, время до мержа, зависшие PR)
+// (GET /stats/teams)
+func (_ Unimplemented) GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить команду
+// (DELETE /team)
+func (_ Unimplemented) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (пользователи из других команд остаются и в них)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал изменений состава команды
+// (GET /team/audit)
+func (_ Unimplemented) GetTeamAudit(w http.ResponseWriter, r *http.Request, params GetTeamAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить правила CODEOWNERS команды
+// (GET /team/codeowners)
+func (_ Unimplemented) GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить или исключить участников команды
+// (PUT /team/members)
+func (_ Unimplemented) PutTeamMembers(w http.ResponseWriter, r *http.Request, params PutTeamMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (_ Unimplemented) PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить CODEOWNERS команды
+// (POST /team/setCodeowners)
+func (_ Unimplemented) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать резервные команды для выбора ревьюверов
+// (POST /team/setFallbacks)
+func (_ Unimplemented) PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настроить SLA ревью и политику эскалации команды
+// (POST /team/setPolicy)
+func (_ Unimplemented) PostTeamSetPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запланировать период отсутствия пользователя
+// (POST /users/outOfOffice)
+func (_ Unimplemented) PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить персональный лимит открытых ревью
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить тайм-зону и рабочие часы пользователя
+// (POST /users/setSchedule)
+func (_ Unimplemented) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAdminExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.GetAdminExport(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -260,11 +1094,30 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostAdminImport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -274,11 +1127,43 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestAddReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestAddReviewerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostPullRequestAddReviewer(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -288,31 +1173,65 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetPullRequestGetParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "pull_request_id" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetPullRequestGet(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -322,31 +1241,43 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	var params PostPullRequestMergeParams
 
-	// ------------- Required query parameter "user_id" -------------
+	headers := r.Header
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.PostPullRequestMerge(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -356,11 +1287,43 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostPullRequestReassign(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -370,410 +1333,2792 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostPullRequestRemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestRemoveReviewerParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	headers := r.Header
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+		params.IfMatch = &IfMatch
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestRemoveReviewer(w, r, params)
+	}))
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// DeleteRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var err error
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	ctx := r.Context()
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	r = r.WithContext(ctx)
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteRepositoryParams
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Required query parameter "repository_name" -------------
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	if paramValue := r.URL.Query().Get("repository_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_name"})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_name", r.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_name", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepository(w, r, params)
+	}))
 
-	return r
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+// PostRepositoryAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	ctx := r.Context()
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRepositoryAdd(w, r)
+	}))
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+// GetRepositoryGet operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryGet(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryGetParams
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Required query parameter "repository_name" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("repository_name"); paramValue != "" {
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_name"})
+		return
+	}
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, true, "repository_name", r.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_name", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryGet(w, r, params)
+	}))
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+// GetRepositoryList operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
-}
+	ctx := r.Context()
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryList(w, r)
+	}))
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+// PutRepositoryUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PutRepositoryUpdate(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutRepositoryUpdate(w, r)
+	}))
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetStatsPairings operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsPairings(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostTeamAdd400JSONResponse ErrorResponse
+	ctx := r.Context()
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsPairingsParams
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
-}
+	// ------------- Required query parameter "team_name" -------------
 
-type GetTeamGet200JSONResponse Team
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", r.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsPairings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetTeamGet404JSONResponse ErrorResponse
+// GetStatsTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTeams(w http.ResponseWriter, r *http.Request) {
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "weeks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weeks", r.URL.Query(), &params.Weeks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "weeks", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stale_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stale_days", r.URL.Query(), &params.StaleDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stale_days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+// DeleteTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "open_prs" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open_prs", r.URL.Query(), &params.OpenPrs)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "open_prs", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamAuditParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCodeownersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutTeamMembers operation middleware
+func (siw *ServerInterfaceWrapper) PutTeamMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTeamMembersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTeamMembers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamRenameParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetCodeowners(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetFallbacks operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetFallbacks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetPolicy(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersOutOfOffice operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersOutOfOffice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export", wrapper.GetAdminExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/import", wrapper.PostAdminImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/repository", wrapper.DeleteRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repository/add", wrapper.PostRepositoryAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repository/get", wrapper.GetRepositoryGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repository/list", wrapper.GetRepositoryList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/repository/update", wrapper.PutRepositoryUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/pairings", wrapper.GetStatsPairings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/teams", wrapper.GetStatsTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/team", wrapper.DeleteTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/audit", wrapper.GetTeamAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/codeowners", wrapper.GetTeamCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/team/members", wrapper.PutTeamMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setCodeowners", wrapper.PostTeamSetCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setFallbacks", wrapper.PostTeamSetFallbacks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setPolicy", wrapper.PostTeamSetPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/outOfOffice", wrapper.PostUsersOutOfOffice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSchedule", wrapper.PostUsersSetSchedule)
+	})
+
+	return r
+}
+
+type GetAdminExportRequestObject struct {
+}
+
+type GetAdminExportResponseObject interface {
+	VisitGetAdminExportResponse(w http.ResponseWriter) error
+}
+
+type GetAdminExport200JSONResponse []TransferRecord
+
+func (response GetAdminExport200JSONResponse) VisitGetAdminExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAdminExport200ApplicationxNdjsonResponse) VisitGetAdminExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostAdminImportRequestObject struct {
+	Params   PostAdminImportParams
+	JSONBody *PostAdminImportJSONRequestBody
+	Body     io.Reader
+}
+
+type PostAdminImportResponseObject interface {
+	VisitPostAdminImportResponse(w http.ResponseWriter) error
+}
+
+type PostAdminImport200JSONResponse ImportReport
+
+func (response PostAdminImport200JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport422JSONResponse ImportReport
+
+func (response PostAdminImport422JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewerRequestObject struct {
+	Params PostPullRequestAddReviewerParams
+	Body   *PostPullRequestAddReviewerJSONRequestBody
+}
+
+type PostPullRequestAddReviewerResponseObject interface {
+	VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAddReviewer200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestAddReviewer200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestAddReviewer200ResponseHeaders
+}
+
+func (response PostPullRequestAddReviewer200JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestAddReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer404JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer409JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer412JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer412JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+
+		// ReviewerShortfall Сколько ревьюверов не удалось назначить из-за нехватки кандидатов
+		ReviewerShortfall *int `json:"reviewer_shortfall,omitempty"`
+	}
+	Headers PostPullRequestCreate201ResponseHeaders
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestCreate400JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGetRequestObject struct {
+	Params GetPullRequestGetParams
+}
+
+type GetPullRequestGetResponseObject interface {
+	VisitGetPullRequestGetResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetPullRequestGet200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers GetPullRequestGet200ResponseHeaders
+}
+
+func (response GetPullRequestGet200JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequestGet404JSONResponse ErrorResponse
+
+func (response GetPullRequestGet404JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Params PostPullRequestMergeParams
+	Body   *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers PostPullRequestMerge200ResponseHeaders
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge412JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge412JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Params PostPullRequestReassignParams
+	Body   *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	Headers PostPullRequestReassign200ResponseHeaders
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign412JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign412JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewerRequestObject struct {
+	Params PostPullRequestRemoveReviewerParams
+	Body   *PostPullRequestRemoveReviewerJSONRequestBody
+}
+
+type PostPullRequestRemoveReviewerResponseObject interface {
+	VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRemoveReviewer200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestRemoveReviewer200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestRemoveReviewer200ResponseHeaders
+}
+
+func (response PostPullRequestRemoveReviewer200JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestRemoveReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer404JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer409JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer412JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer412JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepositoryRequestObject struct {
+	Params DeleteRepositoryParams
+}
+
+type DeleteRepositoryResponseObject interface {
+	VisitDeleteRepositoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteRepository200JSONResponse struct {
+	RepositoryName string `json:"repository_name"`
+}
+
+func (response DeleteRepository200JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository404JSONResponse ErrorResponse
+
+func (response DeleteRepository404JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository409JSONResponse ErrorResponse
+
+func (response DeleteRepository409JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAddRequestObject struct {
+	Body *PostRepositoryAddJSONRequestBody
+}
+
+type PostRepositoryAddResponseObject interface {
+	VisitPostRepositoryAddResponse(w http.ResponseWriter) error
+}
+
+type PostRepositoryAdd201JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response PostRepositoryAdd201JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAdd400JSONResponse ErrorResponse
+
+func (response PostRepositoryAdd400JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAdd404JSONResponse ErrorResponse
+
+func (response PostRepositoryAdd404JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGetRequestObject struct {
+	Params GetRepositoryGetParams
+}
+
+type GetRepositoryGetResponseObject interface {
+	VisitGetRepositoryGetResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryGet200JSONResponse Repository
+
+func (response GetRepositoryGet200JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGet404JSONResponse ErrorResponse
+
+func (response GetRepositoryGet404JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryListRequestObject struct {
+}
+
+type GetRepositoryListResponseObject interface {
+	VisitGetRepositoryListResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryList200JSONResponse RepositoryList
+
+func (response GetRepositoryList200JSONResponse) VisitGetRepositoryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRepositoryUpdateRequestObject struct {
+	Body *PutRepositoryUpdateJSONRequestBody
+}
+
+type PutRepositoryUpdateResponseObject interface {
+	VisitPutRepositoryUpdateResponse(w http.ResponseWriter) error
+}
+
+type PutRepositoryUpdate200JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response PutRepositoryUpdate200JSONResponse) VisitPutRepositoryUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRepositoryUpdate404JSONResponse ErrorResponse
+
+func (response PutRepositoryUpdate404JSONResponse) VisitPutRepositoryUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsPairingsRequestObject struct {
+	Params GetStatsPairingsParams
+}
+
+type GetStatsPairingsResponseObject interface {
+	VisitGetStatsPairingsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsPairings200JSONResponse TeamPairings
+
+func (response GetStatsPairings200JSONResponse) VisitGetStatsPairingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsPairings404JSONResponse ErrorResponse
+
+func (response GetStatsPairings404JSONResponse) VisitGetStatsPairingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeamsRequestObject struct {
+	Params GetStatsTeamsParams
+}
+
+type GetStatsTeamsResponseObject interface {
+	VisitGetStatsTeamsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsTeams200JSONResponse TeamStatsPage
+
+func (response GetStatsTeams200JSONResponse) VisitGetStatsTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeams200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsTeams200TextcsvResponse) VisitGetStatsTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DeleteTeamRequestObject struct {
+	Params DeleteTeamParams
+}
+
+type DeleteTeamResponseObject interface {
+	VisitDeleteTeamResponse(w http.ResponseWriter) error
+}
+
+type DeleteTeam200JSONResponse TeamDeletion
+
+func (response DeleteTeam200JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam404JSONResponse ErrorResponse
+
+func (response DeleteTeam404JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam409JSONResponse ErrorResponse
+
+func (response DeleteTeam409JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam412JSONResponse ErrorResponse
+
+func (response DeleteTeam412JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamAuditRequestObject struct {
+	Params GetTeamAuditParams
+}
+
+type GetTeamAuditResponseObject interface {
+	VisitGetTeamAuditResponse(w http.ResponseWriter) error
+}
+
+type GetTeamAudit200JSONResponse TeamAudit
+
+func (response GetTeamAudit200JSONResponse) VisitGetTeamAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamAudit404JSONResponse ErrorResponse
+
+func (response GetTeamAudit404JSONResponse) VisitGetTeamAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeownersRequestObject struct {
+	Params GetTeamCodeownersParams
+}
+
+type GetTeamCodeownersResponseObject interface {
+	VisitGetTeamCodeownersResponse(w http.ResponseWriter) error
+}
+
+type GetTeamCodeowners200JSONResponse TeamCodeOwners
+
+func (response GetTeamCodeowners200JSONResponse) VisitGetTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeowners404JSONResponse ErrorResponse
+
+func (response GetTeamCodeowners404JSONResponse) VisitGetTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTeamGet200JSONResponse struct {
+	Body    Team
+	Headers GetTeamGet200ResponseHeaders
+}
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembersRequestObject struct {
+	Params PutTeamMembersParams
+	Body   *PutTeamMembersJSONRequestBody
+}
+
+type PutTeamMembersResponseObject interface {
+	VisitPutTeamMembersResponse(w http.ResponseWriter) error
+}
+
+type PutTeamMembers200ResponseHeaders struct {
+	ETag string
+}
+
+type PutTeamMembers200JSONResponse struct {
+	Body struct {
+		Team Team `json:"team"`
+	}
+	Headers PutTeamMembers200ResponseHeaders
+}
+
+func (response PutTeamMembers200JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutTeamMembers400JSONResponse ErrorResponse
+
+func (response PutTeamMembers400JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembers404JSONResponse ErrorResponse
+
+func (response PutTeamMembers404JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembers409JSONResponse ErrorResponse
+
+func (response PutTeamMembers409JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTeamMembers412JSONResponse ErrorResponse
+
+func (response PutTeamMembers412JSONResponse) VisitPutTeamMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRenameRequestObject struct {
+	Params PostTeamRenameParams
+	Body   *PostTeamRenameJSONRequestBody
+}
+
+type PostTeamRenameResponseObject interface {
+	VisitPostTeamRenameResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRename200ResponseHeaders struct {
+	ETag string
+}
+
+type PostTeamRename200JSONResponse struct {
+	Body struct {
+		Team Team `json:"team"`
+	}
+	Headers PostTeamRename200ResponseHeaders
+}
+
+func (response PostTeamRename200JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamRename400JSONResponse ErrorResponse
+
+func (response PostTeamRename400JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename404JSONResponse ErrorResponse
+
+func (response PostTeamRename404JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename412JSONResponse ErrorResponse
+
+func (response PostTeamRename412JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeownersRequestObject struct {
+	Body *PostTeamSetCodeownersJSONRequestBody
+}
+
+type PostTeamSetCodeownersResponseObject interface {
+	VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetCodeowners200JSONResponse TeamCodeOwners
+
+func (response PostTeamSetCodeowners200JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeowners400JSONResponse ErrorResponse
+
+func (response PostTeamSetCodeowners400JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetCodeowners404JSONResponse ErrorResponse
+
+func (response PostTeamSetCodeowners404JSONResponse) VisitPostTeamSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacksRequestObject struct {
+	Body *PostTeamSetFallbacksJSONRequestBody
+}
+
+type PostTeamSetFallbacksResponseObject interface {
+	VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetFallbacks200JSONResponse TeamFallbacks
+
+func (response PostTeamSetFallbacks200JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks400JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks400JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks404JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks404JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetPolicyRequestObject struct {
+	Body *PostTeamSetPolicyJSONRequestBody
+}
+
+type PostTeamSetPolicyResponseObject interface {
+	VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetPolicy200JSONResponse struct {
+	Policy TeamPolicy `json:"policy"`
+}
+
+func (response PostTeamSetPolicy200JSONResponse) VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetPolicy404JSONResponse ErrorResponse
+
+func (response PostTeamSetPolicy404JSONResponse) VisitPostTeamSetPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersOutOfOfficeRequestObject struct {
+	Body *PostUsersOutOfOfficeJSONRequestBody
+}
+
+type PostUsersOutOfOfficeResponseObject interface {
+	VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error
+}
+
+type PostUsersOutOfOffice201JSONResponse struct {
+	OutOfOffice OutOfOffice `json:"out_of_office"`
+}
+
+func (response PostUsersOutOfOffice201JSONResponse) VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersOutOfOffice400JSONResponse ErrorResponse
+
+func (response PostUsersOutOfOffice400JSONResponse) VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersOutOfOffice404JSONResponse ErrorResponse
+
+func (response PostUsersOutOfOffice404JSONResponse) VisitPostUsersOutOfOfficeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviewsRequestObject struct {
+	Body *PostUsersSetMaxOpenReviewsJSONRequestBody
+}
+
+type PostUsersSetMaxOpenReviewsResponseObject interface {
+	VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetMaxOpenReviews200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetMaxOpenReviews200JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews404JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetScheduleRequestObject struct {
+	Body *PostUsersSetScheduleJSONRequestBody
+}
+
+type PostUsersSetScheduleResponseObject interface {
+	VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetSchedule200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetSchedule200JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSchedule400JSONResponse ErrorResponse
+
+func (response PostUsersSetSchedule400JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSchedule404JSONResponse ErrorResponse
+
+func (response PostUsersSetSchedule404JSONResponse) VisitPostUsersSetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Выгрузка команд, пользователей и PR
+	// (GET /admin/export)
+	GetAdminExport(ctx context.Context, request GetAdminExportRequestObject) (GetAdminExportResponseObject, error)
+	// Массовый импорт команд, пользователей и PR
+	// (POST /admin/import)
+	PostAdminImport(ctx context.Context, request PostAdminImportRequestObject) (PostAdminImportResponseObject, error)
+	// Вручную добавить ревьювера в PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx context.Context, request PostPullRequestAddReviewerRequestObject) (PostPullRequestAddReviewerResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Получить PR
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx context.Context, request GetPullRequestGetRequestObject) (GetPullRequestGetResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Вручную снять ревьювера с PR
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx context.Context, request PostPullRequestRemoveReviewerRequestObject) (PostPullRequestRemoveReviewerResponseObject, error)
+	// Удалить репозиторий без PR
+	// (DELETE /repository)
+	DeleteRepository(ctx context.Context, request DeleteRepositoryRequestObject) (DeleteRepositoryResponseObject, error)
+	// Зарегистрировать репозиторий
+	// (POST /repository/add)
+	PostRepositoryAdd(ctx context.Context, request PostRepositoryAddRequestObject) (PostRepositoryAddResponseObject, error)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(ctx context.Context, request GetRepositoryGetRequestObject) (GetRepositoryGetResponseObject, error)
+	// Список репозиториев
+	// (GET /repository/list)
+	GetRepositoryList(ctx context.Context, request GetRepositoryListRequestObject) (GetRepositoryListResponseObject, error)
+	// Изменить команду-владельца и настройки ревью репозитория
+	// (PUT /repository/update)
+	PutRepositoryUpdate(ctx context.Context, request PutRepositoryUpdateRequestObject) (PutRepositoryUpdateResponseObject, error)
+	// Матрица пар автор–ревьювер по команде
+	// (GET /stats/pairings)
+	GetStatsPairings(ctx context.Context, request GetStatsPairingsRequestObject) (GetStatsPairingsResponseObject, error)
+	// Статистика по командам (пропускная способность, время до мержа, зависшие PR)
+	// (GET /stats/teams)
+	GetStatsTeams(ctx context.Context, request GetStatsTeamsRequestObject) (GetStatsTeamsResponseObject, error)
+	// Удалить команду
+	// (DELETE /team)
+	DeleteTeam(ctx context.Context, request DeleteTeamRequestObject) (DeleteTeamResponseObject, error)
+	// Создать команду с участниками (пользователи из других команд остаются и в них)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Журнал изменений состава команды
+	// (GET /team/audit)
+	GetTeamAudit(ctx context.Context, request GetTeamAuditRequestObject) (GetTeamAuditResponseObject, error)
+	// Получить правила CODEOWNERS команды
+	// (GET /team/codeowners)
+	GetTeamCodeowners(ctx context.Context, request GetTeamCodeownersRequestObject) (GetTeamCodeownersResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Добавить или исключить участников команды
+	// (PUT /team/members)
+	PutTeamMembers(ctx context.Context, request PutTeamMembersRequestObject) (PutTeamMembersResponseObject, error)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(ctx context.Context, request PostTeamRenameRequestObject) (PostTeamRenameResponseObject, error)
+	// Загрузить CODEOWNERS команды
+	// (POST /team/setCodeowners)
+	PostTeamSetCodeowners(ctx context.Context, request PostTeamSetCodeownersRequestObject) (PostTeamSetCodeownersResponseObject, error)
+	// Задать резервные команды для выбора ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(ctx context.Context, request PostTeamSetFallbacksRequestObject) (PostTeamSetFallbacksResponseObject, error)
+	// Настроить SLA ревью и политику эскалации команды
+	// (POST /team/setPolicy)
+	PostTeamSetPolicy(ctx context.Context, request PostTeamSetPolicyRequestObject) (PostTeamSetPolicyResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Запланировать период отсутствия пользователя
+	// (POST /users/outOfOffice)
+	PostUsersOutOfOffice(ctx context.Context, request PostUsersOutOfOfficeRequestObject) (PostUsersOutOfOfficeResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Установить персональный лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx context.Context, request PostUsersSetMaxOpenReviewsRequestObject) (PostUsersSetMaxOpenReviewsResponseObject, error)
+	// Установить тайм-зону и рабочие часы пользователя
+	// (POST /users/setSchedule)
+	PostUsersSetSchedule(ctx context.Context, request PostUsersSetScheduleRequestObject) (PostUsersSetScheduleResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAdminExport operation middleware
+func (sh *strictHandler) GetAdminExport(w http.ResponseWriter, r *http.Request) {
+	var request GetAdminExportRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminExport(ctx, request.(GetAdminExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminExportResponseObject); ok {
+		if err := validResponse.VisitGetAdminExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminImport operation middleware
+func (sh *strictHandler) PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams) {
+	var request PostAdminImportRequestObject
+
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body PostAdminImportJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-ndjson") {
+		request.Body = r.Body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminImport(ctx, request.(PostAdminImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminImportResponseObject); ok {
+		if err := validResponse.VisitPostAdminImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (sh *strictHandler) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestAddReviewerParams) {
+	var request PostPullRequestAddReviewerRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestAddReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAddReviewer(ctx, request.(PostPullRequestAddReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAddReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestAddReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestAddReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestGet operation middleware
+func (sh *strictHandler) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	var request GetPullRequestGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestGet(ctx, request.(GetPullRequestGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestGetResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	var request PostPullRequestMergeRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	var request PostPullRequestReassignRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (sh *strictHandler) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request, params PostPullRequestRemoveReviewerParams) {
+	var request PostPullRequestRemoveReviewerRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestRemoveReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRemoveReviewer(ctx, request.(PostPullRequestRemoveReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRemoveReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestRemoveReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestRemoveReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRepository operation middleware
+func (sh *strictHandler) DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams) {
+	var request DeleteRepositoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRepository(ctx, request.(DeleteRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRepositoryResponseObject); ok {
+		if err := validResponse.VisitDeleteRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostRepositoryAdd operation middleware
+func (sh *strictHandler) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostRepositoryAddRequestObject
+
+	var body PostRepositoryAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRepositoryAdd(ctx, request.(PostRepositoryAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRepositoryAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostRepositoryAddResponseObject); ok {
+		if err := validResponse.VisitPostRepositoryAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepositoryGet operation middleware
+func (sh *strictHandler) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams) {
+	var request GetRepositoryGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryGet(ctx, request.(GetRepositoryGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryGetResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepositoryList operation middleware
+func (sh *strictHandler) GetRepositoryList(w http.ResponseWriter, r *http.Request) {
+	var request GetRepositoryListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryList(ctx, request.(GetRepositoryListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryListResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutRepositoryUpdate operation middleware
+func (sh *strictHandler) PutRepositoryUpdate(w http.ResponseWriter, r *http.Request) {
+	var request PutRepositoryUpdateRequestObject
+
+	var body PutRepositoryUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutRepositoryUpdate(ctx, request.(PutRepositoryUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutRepositoryUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutRepositoryUpdateResponseObject); ok {
+		if err := validResponse.VisitPutRepositoryUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsPairings operation middleware
+func (sh *strictHandler) GetStatsPairings(w http.ResponseWriter, r *http.Request, params GetStatsPairingsParams) {
+	var request GetStatsPairingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsPairings(ctx, request.(GetStatsPairingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsPairings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsPairingsResponseObject); ok {
+		if err := validResponse.VisitGetStatsPairingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsTeams operation middleware
+func (sh *strictHandler) GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams) {
+	var request GetStatsTeamsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsTeams(ctx, request.(GetStatsTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsTeams")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsTeamsResponseObject); ok {
+		if err := validResponse.VisitGetStatsTeamsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTeam operation middleware
+func (sh *strictHandler) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	var request DeleteTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTeam(ctx, request.(DeleteTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTeamResponseObject); ok {
+		if err := validResponse.VisitDeleteTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamAudit operation middleware
+func (sh *strictHandler) GetTeamAudit(w http.ResponseWriter, r *http.Request, params GetTeamAuditParams) {
+	var request GetTeamAuditRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamAudit(ctx, request.(GetTeamAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamAudit")
+	}
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamAuditResponseObject); ok {
+		if err := validResponse.VisitGetTeamAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamCodeowners operation middleware
+func (sh *strictHandler) GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams) {
+	var request GetTeamCodeownersRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamCodeowners(ctx, request.(GetTeamCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamCodeowners")
+	}
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamCodeownersResponseObject); ok {
+		if err := validResponse.VisitGetTeamCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
-}
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// PutTeamMembers operation middleware
+func (sh *strictHandler) PutTeamMembers(w http.ResponseWriter, r *http.Request, params PutTeamMembersParams) {
+	var request PutTeamMembersRequestObject
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+	request.Params = params
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PutTeamMembersJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -781,18 +4126,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PutTeamMembers(ctx, request.(PutTeamMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PutTeamMembers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutTeamMembersResponseObject); ok {
+		if err := validResponse.VisitPutTeamMembersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -800,11 +4145,13 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostTeamRename operation middleware
+func (sh *strictHandler) PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams) {
+	var request PostTeamRenameRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	request.Params = params
+
+	var body PostTeamRenameJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -812,18 +4159,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostTeamRename(ctx, request.(PostTeamRenameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostTeamRename")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamRenameResponseObject); ok {
+		if err := validResponse.VisitPostTeamRenameResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -831,11 +4178,11 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// PostTeamSetCodeowners operation middleware
+func (sh *strictHandler) PostTeamSetCodeowners(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetCodeownersRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostTeamSetCodeownersJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -843,18 +4190,18 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostTeamSetCodeowners(ctx, request.(PostTeamSetCodeownersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostTeamSetCodeowners")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetCodeownersResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetCodeownersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -862,11 +4209,11 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostTeamSetFallbacks operation middleware
+func (sh *strictHandler) PostTeamSetFallbacks(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetFallbacksRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostTeamSetFallbacksJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -874,18 +4221,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostTeamSetFallbacks(ctx, request.(PostTeamSetFallbacksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostTeamSetFallbacks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetFallbacksResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetFallbacksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -893,25 +4240,30 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// PostTeamSetPolicy operation middleware
+func (sh *strictHandler) PostTeamSetPolicy(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSetPolicyRequestObject
 
-	request.Params = params
+	var body PostTeamSetPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.PostTeamSetPolicy(ctx, request.(PostTeamSetPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "PostTeamSetPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSetPolicyResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -945,6 +4297,37 @@ func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// PostUsersOutOfOffice operation middleware
+func (sh *strictHandler) PostUsersOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersOutOfOfficeRequestObject
+
+	var body PostUsersOutOfOfficeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersOutOfOffice(ctx, request.(PostUsersOutOfOfficeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersOutOfOffice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersOutOfOfficeResponseObject); ok {
+		if err := validResponse.VisitPostUsersOutOfOfficeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostUsersSetIsActive operation middleware
 func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	var request PostUsersSetIsActiveRequestObject
@@ -975,3 +4358,65 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (sh *strictHandler) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetMaxOpenReviewsRequestObject
+
+	var body PostUsersSetMaxOpenReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetMaxOpenReviews(ctx, request.(PostUsersSetMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetMaxOpenReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetMaxOpenReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetSchedule operation middleware
+func (sh *strictHandler) PostUsersSetSchedule(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetScheduleRequestObject
+
+	var body PostUsersSetScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetSchedule(ctx, request.(PostUsersSetScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetScheduleResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -1,9 +1,12 @@
 package pr_service_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/fs"
 	"log"
 	"math/rand/v2"
@@ -16,6 +19,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"testing"
 	"time"
@@ -34,9 +38,14 @@ const (
 	prTableName               = "pull_request"
 	teamTableName             = "team"
 	assignedReviewerTableName = "assigned_reviewer"
+	idempotencyKeyTableName   = "idempotency_key"
 )
 
 func TestMain(m *testing.M) {
+	if memoryStorage() {
+		os.Exit(m.Run())
+	}
+
 	host := os.Getenv("POSTGRES_HOST")
 	port := os.Getenv("POSTGRES_PORT")
 	dbName := os.Getenv("POSTGRES_DB")
@@ -66,6 +75,15 @@ func TestMain(m *testing.M) {
 func cleanUp(t *testing.T) {
 	t.Helper()
 
+	// в памяти нечего очищать, состояние сбрасывается перезапуском сервиса
+	if memoryStorage() {
+		if memoryService != nil {
+			stopProcess(t, memoryService.cmd)
+			memoryService.cmd = startPRService(t, memoryService.executable, memoryService.restPort, memoryService.metricsPort, memoryService.env...)
+		}
+		return
+	}
+
 	_, err := db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", userTableName))
 	require.NoError(t, err)
 
@@ -77,6 +95,9 @@ func cleanUp(t *testing.T) {
 
 	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", assignedReviewerTableName))
 	require.NoError(t, err)
+
+	_, err = db.Exec(fmt.Sprintf("TRUNCATE TABLE %s", idempotencyKeyTableName))
+	require.NoError(t, err)
 }
 
 //func TestPullRequestReassignConsistency(t *testing.T) {
@@ -263,6 +284,177 @@ func TestTeam(t *testing.T) {
 
 }
 
+func TestTeamManagement(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "tmBackend",
+		Members: []api.TeamMember{
+			{UserId: "tmAlice", Username: "alice", IsActive: true},
+			{UserId: "tmBob", Username: "bob", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "tmFrontend",
+		Members: []api.TeamMember{
+			{UserId: "tmCarol", Username: "carol", IsActive: true},
+			{UserId: "tmDave", Username: "dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	sharedResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "tmShared",
+		Members:  []api.TeamMember{{UserId: "tmAlice", Username: "alice", IsActive: true}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, sharedResp.StatusCode())
+
+	backendResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "tmBackend"})
+	require.NoError(t, err)
+	require.Len(t, backendResp.JSON200.Members, 2)
+
+	ambiguousResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "tmAlice",
+		PullRequestId:   "tmAmbiguousPR",
+		PullRequestName: "tmAmbiguousPR",
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.TEAMREQUIRED, ambiguousResp.JSON400.Error.Code)
+
+	frontend := "tmFrontend"
+	strangerResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "tmAlice",
+		PullRequestId:   "tmAmbiguousPR",
+		PullRequestName: "tmAmbiguousPR",
+		TeamName:        &frontend,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.NOTTEAMMEMBER, strangerResp.JSON409.Error.Code)
+
+	carol := []api.TeamMember{{UserId: "tmCarol", Username: "carol", IsActive: true}}
+	joinResp, err := client.PutTeamMembersWithResponse(ctx, nil, api.TeamMembersChange{
+		TeamName: "tmBackend",
+		Add:      &carol,
+	})
+	require.NoError(t, err)
+	require.Len(t, joinResp.JSON200.Team.Members, 3)
+
+	frontendResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "tmFrontend"})
+	require.NoError(t, err)
+	require.Len(t, frontendResp.JSON200.Members, 2)
+
+	move := true
+	removeBob := []string{"tmBob"}
+	moveResp, err := client.PutTeamMembersWithResponse(ctx, nil, api.TeamMembersChange{
+		TeamName: "tmBackend",
+		Add:      &carol,
+		Remove:   &removeBob,
+		Move:     &move,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, moveResp.StatusCode())
+	require.ElementsMatch(t, []api.TeamMember{
+		{UserId: "tmAlice", Username: "alice", IsActive: true},
+		{UserId: "tmCarol", Username: "carol", IsActive: true},
+	}, moveResp.JSON200.Team.Members)
+
+	removeDave := []string{"tmDave"}
+	emptyResp, err := client.PutTeamMembersWithResponse(ctx, nil, api.TeamMembersChange{
+		TeamName: "tmFrontend",
+		Remove:   &removeDave,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.EMPTYTEAM, emptyResp.JSON400.Error.Code)
+
+	renameResp, err := client.PostTeamRenameWithResponse(ctx, nil, api.TeamRename{
+		TeamName:    "tmBackend",
+		NewTeamName: "tmPlatform",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "tmPlatform", renameResp.JSON200.Team.TeamName)
+
+	takenResp, err := client.PostTeamRenameWithResponse(ctx, nil, api.TeamRename{
+		TeamName:    "tmPlatform",
+		NewTeamName: "tmFrontend",
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.TEAMEXISTS, takenResp.JSON400.Error.Code)
+
+	auditResp, err := client.GetTeamAuditWithResponse(ctx, &api.GetTeamAuditParams{TeamName: "tmPlatform"})
+	require.NoError(t, err)
+	actions := make([]api.TeamAuditEntryAction, 0, len(auditResp.JSON200.Entries))
+	for _, entry := range auditResp.JSON200.Entries {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []api.TeamAuditEntryAction{
+		api.TEAMCREATED, api.MEMBERADDED, api.MEMBERADDED, api.MEMBERADDED,
+		api.MEMBERMOVED, api.MEMBERREMOVED, api.TEAMRENAMED,
+	}, actions)
+
+	_, err = client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "tmDave",
+		PullRequestId:   "tmPR",
+		PullRequestName: "tmPR",
+	})
+	require.NoError(t, err)
+	_, err = client.PutTeamMembersWithResponse(ctx, nil, api.TeamMembersChange{
+		TeamName: "tmFrontend",
+		Add:      &[]api.TeamMember{{UserId: "tmBob", Username: "bob", IsActive: true}},
+	})
+	require.NoError(t, err)
+	platform := "tmPlatform"
+	reviewedResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "tmAlice",
+		PullRequestId:   "tmReviewedPR",
+		PullRequestName: "tmReviewedPR",
+		TeamName:        &platform,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tmCarol"}, reviewedResp.JSON201.Pr.AssignedReviewers)
+
+	rejectResp, err := client.DeleteTeamWithResponse(ctx, &api.DeleteTeamParams{TeamName: "tmFrontend"})
+	require.NoError(t, err)
+	require.Equal(t, api.TEAMHASOPENPRS, rejectResp.JSON409.Error.Code)
+
+	unassign := api.OpenPRsUnassign
+	deleteResp, err := client.DeleteTeamWithResponse(ctx, &api.DeleteTeamParams{
+		TeamName: "tmPlatform",
+		OpenPrs:  &unassign,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleteResp.StatusCode())
+	require.ElementsMatch(t, []string{"tmAlice", "tmCarol"}, deleteResp.JSON200.ReleasedMembers)
+	require.Equal(t, 1, deleteResp.JSON200.UnassignedReviews)
+
+	getResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "tmPlatform"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, getResp.StatusCode())
+
+	sharedTeamResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "tmShared"})
+	require.NoError(t, err)
+	require.Equal(t, []api.TeamMember{{UserId: "tmAlice", Username: "alice", IsActive: true}}, sharedTeamResp.JSON200.Members)
+
+	readdResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "tmReborn",
+		Members:  []api.TeamMember{{UserId: "tmAlice", Username: "alice", IsActive: true}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, readdResp.StatusCode())
+}
+
 func TestUser(t *testing.T) {
 	executable := getPRServiceExecutable(t)
 	restPort := findFreePort(t)
@@ -313,6 +505,157 @@ func TestUser(t *testing.T) {
 		require.Equal(t, invalidResp.JSON404.Error.Code, api.NOTFOUND)
 	})
 
+	t.Run("set user schedule", func(t *testing.T) {
+		t.Cleanup(func() {
+			cleanUp(t)
+		})
+
+		ctx := context.Background()
+
+		reqUser := api.TeamMember{
+			UserId:   "scheduleUser1",
+			Username: "user1",
+			IsActive: true,
+		}
+
+		_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: "teamSchedule",
+			Members:  []api.TeamMember{reqUser},
+		})
+		require.NoError(t, err)
+
+		start, end := "22:00", "06:00"
+		resp, err := client.PostUsersSetScheduleWithResponse(ctx, api.PostUsersSetScheduleJSONRequestBody{
+			UserId:    reqUser.UserId,
+			TimeZone:  "Asia/Tokyo",
+			WorkStart: &start,
+			WorkEnd:   &end,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		require.Equal(t, "Asia/Tokyo", *resp.JSON200.User.TimeZone)
+		require.Equal(t, start, *resp.JSON200.User.WorkStart)
+		require.Equal(t, end, *resp.JSON200.User.WorkEnd)
+
+		invalidZoneResp, err := client.PostUsersSetScheduleWithResponse(ctx, api.PostUsersSetScheduleJSONRequestBody{
+			UserId:   reqUser.UserId,
+			TimeZone: "Mars/Olympus",
+		})
+		require.NoError(t, err)
+		require.Equal(t, api.INVALIDSCHEDULE, invalidZoneResp.JSON400.Error.Code)
+
+		notFoundResp, err := client.PostUsersSetScheduleWithResponse(ctx, api.PostUsersSetScheduleJSONRequestBody{
+			UserId:   "not_exist",
+			TimeZone: "UTC",
+		})
+		require.NoError(t, err)
+		require.Equal(t, api.NOTFOUND, notFoundResp.JSON404.Error.Code)
+	})
+
+	t.Run("out of office user is not assigned", func(t *testing.T) {
+		t.Cleanup(func() {
+			cleanUp(t)
+		})
+
+		ctx := context.Background()
+
+		author := api.TeamMember{UserId: "oooAuthor", Username: "author", IsActive: true}
+		absent := api.TeamMember{UserId: "oooAbsent", Username: "absent", IsActive: true}
+		present := api.TeamMember{UserId: "oooPresent", Username: "present", IsActive: true}
+
+		_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: "teamOutOfOffice",
+			Members:  []api.TeamMember{author, absent, present},
+		})
+		require.NoError(t, err)
+
+		from := time.Now().Add(-time.Hour)
+		oooResp, err := client.PostUsersOutOfOfficeWithResponse(ctx, api.PostUsersOutOfOfficeJSONRequestBody{
+			UserId: absent.UserId,
+			From:   from,
+			To:     from.Add(24 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, oooResp.JSON201)
+		require.Equal(t, absent.UserId, oooResp.JSON201.OutOfOffice.UserId)
+
+		prResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        author.UserId,
+			PullRequestId:   "oooPR",
+			PullRequestName: "oooPR",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{present.UserId}, prResp.JSON201.Pr.AssignedReviewers)
+
+		invalidResp, err := client.PostUsersOutOfOfficeWithResponse(ctx, api.PostUsersOutOfOfficeJSONRequestBody{
+			UserId: absent.UserId,
+			From:   from,
+			To:     from,
+		})
+		require.NoError(t, err)
+		require.Equal(t, api.INVALIDPERIOD, invalidResp.JSON400.Error.Code)
+	})
+
+	t.Run("user at review limit is not assigned", func(t *testing.T) {
+		t.Cleanup(func() {
+			cleanUp(t)
+		})
+
+		ctx := context.Background()
+
+		author := api.TeamMember{UserId: "capAuthor", Username: "author", IsActive: true}
+		busy := api.TeamMember{UserId: "capBusy", Username: "busy", IsActive: true}
+		free := api.TeamMember{UserId: "capFree", Username: "free", IsActive: true}
+
+		_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: "teamReviewCap",
+			Members:  []api.TeamMember{author, busy, free},
+		})
+		require.NoError(t, err)
+
+		limit := 1
+		limitResp, err := client.PostUsersSetMaxOpenReviewsWithResponse(ctx, api.PostUsersSetMaxOpenReviewsJSONRequestBody{
+			UserId:         busy.UserId,
+			MaxOpenReviews: &limit,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &limit, limitResp.JSON200.User.MaxOpenReviews)
+
+		first, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        author.UserId,
+			PullRequestId:   "capPR1",
+			PullRequestName: "capPR1",
+		})
+		require.NoError(t, err)
+		require.Len(t, first.JSON201.Pr.AssignedReviewers, 2)
+		require.Equal(t, 0, *first.JSON201.ReviewerShortfall)
+
+		second, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        author.UserId,
+			PullRequestId:   "capPR2",
+			PullRequestName: "capPR2",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{free.UserId}, second.JSON201.Pr.AssignedReviewers)
+		require.Equal(t, 1, *second.JSON201.ReviewerShortfall)
+
+		reject := api.REJECT
+		_, err = client.PostTeamSetPolicyWithResponse(ctx, api.TeamPolicy{
+			TeamName:         "teamReviewCap",
+			EscalationPolicy: api.REASSIGN,
+			ShortfallPolicy:  &reject,
+		})
+		require.NoError(t, err)
+
+		rejected, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        author.UserId,
+			PullRequestId:   "capPR3",
+			PullRequestName: "capPR3",
+		})
+		require.NoError(t, err)
+		require.Equal(t, api.NOCANDIDATE, rejected.JSON409.Error.Code)
+	})
+
 	t.Run("get user review", func(t *testing.T) {
 		t.Cleanup(func() {
 			cleanUp(t)
@@ -421,6 +764,7 @@ func TestPR(t *testing.T) {
 
 		response, err := client.PostPullRequestMergeWithResponse(
 			ctx,
+			nil,
 			api.PostPullRequestMergeJSONRequestBody{
 				PullRequestId: pr.PullRequestId,
 			})
@@ -430,12 +774,30 @@ func TestPR(t *testing.T) {
 
 		response, err = client.PostPullRequestMergeWithResponse(
 			ctx,
+			nil,
 			api.PostPullRequestMergeJSONRequestBody{
 				PullRequestId: "not exist",
 			})
 
 		require.NoError(t, err)
 		require.Equal(t, response.JSON404.Error.Code, api.NOTFOUND)
+
+		getResponse, err := client.GetPullRequestGetWithResponse(
+			ctx,
+			&api.GetPullRequestGetParams{PullRequestId: pr.PullRequestId},
+		)
+		require.NoError(t, err)
+		require.Equal(t, pr.Status, getResponse.JSON200.Pr.Status)
+		require.Equal(t, []string{user2.UserId}, getResponse.JSON200.Pr.AssignedReviewers)
+		require.NotNil(t, getResponse.JSON200.Pr.MergedAt)
+		require.Equal(t, `"2"`, getResponse.HTTPResponse.Header.Get("ETag"))
+
+		getResponse, err = client.GetPullRequestGetWithResponse(
+			ctx,
+			&api.GetPullRequestGetParams{PullRequestId: "not exist"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, api.NOTFOUND, getResponse.JSON404.Error.Code)
 	})
 
 	t.Run("reassign pull request", func(t *testing.T) {
@@ -496,6 +858,7 @@ func TestPR(t *testing.T) {
 
 		reassignResp, err := client.PostPullRequestReassignWithResponse(
 			ctx,
+			nil,
 			api.PostPullRequestReassignJSONRequestBody{
 				PullRequestId: pr.PullRequestId,
 				OldUserId:     user2.UserId,
@@ -515,6 +878,7 @@ func TestPR(t *testing.T) {
 
 		reassignResp, err = client.PostPullRequestReassignWithResponse(
 			ctx,
+			nil,
 			api.PostPullRequestReassignJSONRequestBody{
 				PullRequestId: pr.PullRequestId,
 				OldUserId:     user2.UserId,
@@ -531,6 +895,7 @@ func TestPR(t *testing.T) {
 
 		_, err = client.PostPullRequestMergeWithResponse(
 			ctx,
+			nil,
 			api.PostPullRequestMergeJSONRequestBody{
 				PullRequestId: pr.PullRequestId,
 			})
@@ -539,6 +904,7 @@ func TestPR(t *testing.T) {
 
 		reassignResp, err = client.PostPullRequestReassignWithResponse(
 			ctx,
+			nil,
 			api.PostPullRequestReassignJSONRequestBody{
 				PullRequestId: pr.PullRequestId,
 				OldUserId:     user3.UserId,
@@ -551,75 +917,1104 @@ func TestPR(t *testing.T) {
 
 var requiredEnv = []string{"POSTGRES_HOST", "POSTGRES_PORT", "POSTGRES_DB", "POSTGRES_USER", "POSTGRES_PASSWORD"}
 
-func setupPRService(
-	t *testing.T,
-	executable string,
-	restPort string,
-	metricsPort string,
-) *exec.Cmd {
-	t.Helper()
+func TestFallbackTeams(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
 
-	cmd := exec.Command(executable)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
 
-	for _, p := range requiredEnv {
-		cur := os.Getenv(p)
-		require.NotEmpty(t, cur, "you need to pass env variable to tests: "+p)
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "fallbackSmall",
+		Members: []api.TeamMember{
+			{UserId: "fbAuthor", Username: "author", IsActive: true},
+			{UserId: "fbMate", Username: "mate", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "fallbackBig",
+		Members: []api.TeamMember{
+			{UserId: "fbHelper", Username: "helper", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
 
-		cmd.Env = append(cmd.Env, p+"="+cur)
-	}
+	selfResp, err := client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+		TeamName:      "fallbackSmall",
+		FallbackTeams: []string{"fallbackSmall"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.INVALIDFALLBACK, selfResp.JSON400.Error.Code)
 
-	cmd.Env = append(cmd.Env, "REST_PORT="+restPort)
-	cmd.Env = append(cmd.Env, "METRICS_PORT="+metricsPort)
+	setResp, err := client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+		TeamName:      "fallbackSmall",
+		FallbackTeams: []string{"fallbackBig"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fallbackBig"}, setResp.JSON200.FallbackTeams)
 
-	require.NoError(t, cmd.Start())
-	restClient := newRESTClient(t, restPort)
+	prResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "fbAuthor",
+		PullRequestId:   "fbPR",
+		PullRequestName: "fbPR",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fbMate", "fbHelper"}, prResp.JSON201.Pr.AssignedReviewers)
+	require.Equal(t, &[]string{"fbHelper"}, prResp.JSON201.Pr.FallbackReviewers)
+	require.Equal(t, 0, *prResp.JSON201.ReviewerShortfall)
+}
 
-	// rest health check
-	for i := range 50 {
+func TestCodeOwners(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
 
-		_, err := restClient.PostTeamAddWithResponse(context.Background(), api.Team{
-			TeamName: "healthcheck",
-			Members: []api.TeamMember{
-				{
-					IsActive: true,
-					UserId:   "u1",
-					Username: "name",
-				},
-				{
-					IsActive: false,
-					UserId:   "u2",
-					Username: "name",
-				},
-			},
-		})
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
 
-		_, ok := status.FromError(err)
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "coTeam",
+		Members: []api.TeamMember{
+			{UserId: "coAuthor", Username: "author", IsActive: true},
+			{UserId: "coMate1", Username: "mate1", IsActive: true},
+			{UserId: "coMate2", Username: "mate2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "coDBA",
+		Members: []api.TeamMember{
+			{UserId: "coDba", Username: "dba", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
 
-		if ok {
-			break
-		}
+	invalidResp, err := client.PostTeamSetCodeownersWithResponse(ctx, api.PostTeamSetCodeownersJSONRequestBody{
+		TeamName: "coTeam",
+		Content:  "* dba@example.com\n",
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.INVALIDCODEOWNERS, invalidResp.JSON400.Error.Code)
 
-		if i == 49 {
-			require.NoError(t, err)
-			log.Println("rest health check error")
-			t.Fail()
-		}
+	setResp, err := client.PostTeamSetCodeownersWithResponse(ctx, api.PostTeamSetCodeownersJSONRequestBody{
+		TeamName: "coTeam",
+		Content:  "*     @coMate2\n/db/  @acme/coDBA\n",
+	})
+	require.NoError(t, err)
+	require.Len(t, setResp.JSON200.Rules, 2)
 
-		time.Sleep(time.Millisecond * 100)
-	}
+	getResp, err := client.GetTeamCodeownersWithResponse(ctx, &api.GetTeamCodeownersParams{TeamName: "coTeam"})
+	require.NoError(t, err)
+	require.Equal(t, setResp.JSON200.Rules, getResp.JSON200.Rules)
 
-	return cmd
+	prResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "coAuthor",
+		PullRequestId:   "coPR",
+		PullRequestName: "coPR",
+		ChangedFiles:    &[]string{"db/migrations/012.sql"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"coDba", "coMate1"}, prResp.JSON201.Pr.AssignedReviewers)
+	require.Nil(t, prResp.JSON201.Pr.FallbackReviewers)
 }
 
-func stopPRService(t *testing.T, cmd *exec.Cmd) {
-	t.Helper()
+func TestManualReviewers(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "mrTeam",
+		Members: []api.TeamMember{
+			{UserId: "mrAuthor", Username: "author", IsActive: true},
+			{UserId: "mrMate", Username: "mate", IsActive: true},
+			{UserId: "mrIdle", Username: "idle", IsActive: false},
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "mrOther",
+		Members: []api.TeamMember{
+			{UserId: "mrStranger", Username: "stranger", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "mrAuthor",
+		PullRequestId:   "mrPR",
+		PullRequestName: "mrPR",
+	})
+	require.NoError(t, err)
 
-	for i := 0; i < 5; i++ {
-		require.NoError(t, cmd.Process.Signal(syscall.SIGTERM))
+	for userID, code := range map[string]api.ErrorResponseErrorCode{
+		"mrAuthor":   api.AUTHORNOTALLOWED,
+		"mrMate":     api.ALREADYASSIGNED,
+		"mrIdle":     api.USERINACTIVE,
+		"mrStranger": api.NOTTEAMMEMBER,
+	} {
+		addResp, err := client.PostPullRequestAddReviewerWithResponse(ctx, nil, api.ReviewerChange{
+			PullRequestId: "mrPR",
+			UserId:        userID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, code, addResp.JSON409.Error.Code, userID)
 	}
 
+	removeResp, err := client.PostPullRequestRemoveReviewerWithResponse(ctx, nil, api.ReviewerChange{
+		PullRequestId: "mrPR",
+		UserId:        "mrMate",
+	})
+	require.NoError(t, err)
+	require.Empty(t, removeResp.JSON200.Pr.AssignedReviewers)
+
+	_, err = client.PostTeamSetFallbacksWithResponse(ctx, api.TeamFallbacks{
+		TeamName:      "mrTeam",
+		FallbackTeams: []string{"mrOther"},
+	})
+	require.NoError(t, err)
+
+	addResp, err := client.PostPullRequestAddReviewerWithResponse(ctx, nil, api.ReviewerChange{
+		PullRequestId: "mrPR",
+		UserId:        "mrStranger",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"mrStranger"}, addResp.JSON200.Pr.AssignedReviewers)
+	require.Equal(t, &[]string{"mrStranger"}, addResp.JSON200.Pr.FallbackReviewers)
+
+	pinned := "mrMate"
+	pinnedResp, err := client.PostPullRequestReassignWithResponse(ctx, nil, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: "mrPR",
+		OldUserId:     "mrStranger",
+		NewUserId:     &pinned,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "mrMate", pinnedResp.JSON200.ReplacedBy)
+	require.Nil(t, pinnedResp.JSON200.Pr.FallbackReviewers)
+
+	excludedResp, err := client.PostPullRequestReassignWithResponse(ctx, nil, api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId:  "mrPR",
+		OldUserId:      "mrMate",
+		ExcludeUserIds: &[]string{"mrStranger"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.NOCANDIDATE, excludedResp.JSON409.Error.Code)
+
+	_, err = client.PostPullRequestMergeWithResponse(ctx, nil, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: "mrPR",
+	})
+	require.NoError(t, err)
+
+	mergedResp, err := client.PostPullRequestRemoveReviewerWithResponse(ctx, nil, api.ReviewerChange{
+		PullRequestId: "mrPR",
+		UserId:        "mrMate",
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.PRMERGED, mergedResp.JSON409.Error.Code)
+}
+
+func TestRepositories(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "rpAuthors",
+		Members: []api.TeamMember{
+			{UserId: "rpAuthor", Username: "author", IsActive: true},
+			{UserId: "rpMate", Username: "mate", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "rpOwners",
+		Members: []api.TeamMember{
+			{UserId: "rpOwner1", Username: "owner1", IsActive: true},
+			{UserId: "rpOwner2", Username: "owner2", IsActive: true},
+			{UserId: "rpOwner3", Username: "owner3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	ownerTeam := "rpOwners"
+	reviewerCount := 3
+	addResp, err := client.PostRepositoryAddWithResponse(ctx, api.Repository{
+		RepositoryName: "rpService",
+		OwnerTeam:      &ownerTeam,
+		ReviewerCount:  &reviewerCount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &ownerTeam, addResp.JSON201.Repository.OwnerTeam)
+
+	existsResp, err := client.PostRepositoryAddWithResponse(ctx, api.Repository{RepositoryName: "rpService"})
+	require.NoError(t, err)
+	require.Equal(t, api.REPOSITORYEXISTS, existsResp.JSON400.Error.Code)
+
+	unknownTeam := "rpUnknown"
+	noTeamResp, err := client.PostRepositoryAddWithResponse(ctx, api.Repository{
+		RepositoryName: "rpOrphan",
+		OwnerTeam:      &unknownTeam,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.NOTFOUND, noTeamResp.JSON404.Error.Code)
+
+	repository := "rpService"
+	repoPRResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "rpAuthor",
+		PullRequestId:   "rpPR",
+		PullRequestName: "rpPR",
+		Repository:      &repository,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &repository, repoPRResp.JSON201.Pr.Repository)
+	require.ElementsMatch(t, []string{"rpOwner1", "rpOwner2", "rpOwner3"}, repoPRResp.JSON201.Pr.AssignedReviewers)
+	require.Equal(t, 0, *repoPRResp.JSON201.ReviewerShortfall)
+
+	plainPRResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "rpAuthor",
+		PullRequestId:   "rpPR",
+		PullRequestName: "rpPR",
+	})
+	require.NoError(t, err)
+	require.Nil(t, plainPRResp.JSON201.Pr.Repository)
+	require.Equal(t, []string{"rpMate"}, plainPRResp.JSON201.Pr.AssignedReviewers)
+
+	duplicateResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "rpAuthor",
+		PullRequestId:   "rpPR",
+		PullRequestName: "rpPR",
+		Repository:      &repository,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.PREXISTS, duplicateResp.JSON409.Error.Code)
+
+	unknownRepository := "rpUnknown"
+	unknownResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "rpAuthor",
+		PullRequestId:   "rpPR",
+		PullRequestName: "rpPR",
+		Repository:      &unknownRepository,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.NOTFOUND, unknownResp.JSON404.Error.Code)
+
+	mergeResp, err := client.PostPullRequestMergeWithResponse(ctx, nil, api.PostPullRequestMergeJSONRequestBody{
+		PullRequestId: "rpPR",
+		Repository:    &repository,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.PullRequestStatusMERGED, mergeResp.JSON200.Pr.Status)
+	require.Equal(t, &repository, mergeResp.JSON200.Pr.Repository)
+
+	removeResp, err := client.PostPullRequestRemoveReviewerWithResponse(ctx, nil, api.ReviewerChange{
+		PullRequestId: "rpPR",
+		UserId:        "rpMate",
+	})
+	require.NoError(t, err)
+	require.Empty(t, removeResp.JSON200.Pr.AssignedReviewers)
+
+	reviewResp, err := client.GetUsersGetReviewWithResponse(ctx, &api.GetUsersGetReviewParams{UserId: "rpOwner1"})
+	require.NoError(t, err)
+	require.Len(t, reviewResp.JSON200.PullRequests, 1)
+	require.Equal(t, &repository, reviewResp.JSON200.PullRequests[0].Repository)
+
+	updateResp, err := client.PutRepositoryUpdateWithResponse(ctx, api.Repository{RepositoryName: "rpService"})
+	require.NoError(t, err)
+	require.Nil(t, updateResp.JSON200.Repository.OwnerTeam)
+	require.Nil(t, updateResp.JSON200.Repository.ReviewerCount)
+
+	_, err = client.PostRepositoryAddWithResponse(ctx, api.Repository{RepositoryName: "rpEmpty"})
+	require.NoError(t, err)
+
+	listResp, err := client.GetRepositoryListWithResponse(ctx)
+	require.NoError(t, err)
+	require.Len(t, listResp.JSON200.Repositories, 2)
+	require.Equal(t, "rpEmpty", listResp.JSON200.Repositories[0].RepositoryName)
+
+	hasPRsResp, err := client.DeleteRepositoryWithResponse(ctx, &api.DeleteRepositoryParams{RepositoryName: "rpService"})
+	require.NoError(t, err)
+	require.Equal(t, api.REPOSITORYHASPRS, hasPRsResp.JSON409.Error.Code)
+
+	deleteResp, err := client.DeleteRepositoryWithResponse(ctx, &api.DeleteRepositoryParams{RepositoryName: "rpEmpty"})
+	require.NoError(t, err)
+	require.Equal(t, "rpEmpty", deleteResp.JSON200.RepositoryName)
+
+	getResp, err := client.GetRepositoryGetWithResponse(ctx, &api.GetRepositoryGetParams{RepositoryName: "rpEmpty"})
+	require.NoError(t, err)
+	require.Equal(t, api.NOTFOUND, getResp.JSON404.Error.Code)
+}
+
+func TestIdempotency(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "idTeam",
+		Members: []api.TeamMember{
+			{UserId: "idAuthor", Username: "author", IsActive: true},
+			{UserId: "idMate1", Username: "mate1", IsActive: true},
+			{UserId: "idMate2", Username: "mate2", IsActive: true},
+			{UserId: "idMate3", Username: "mate3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	withKey := func(key string) api.RequestEditorFn {
+		return func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Idempotency-Key", key)
+			return nil
+		}
+	}
+
+	createBody := api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "idAuthor",
+		PullRequestId:   "idPR",
+		PullRequestName: "idPR",
+	}
+	firstResp, err := client.PostPullRequestCreateWithResponse(ctx, createBody, withKey("create-1"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, firstResp.StatusCode())
+	require.Empty(t, firstResp.HTTPResponse.Header.Get("Idempotent-Replayed"))
+
+	retryResp, err := client.PostPullRequestCreateWithResponse(ctx, createBody, withKey("create-1"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, retryResp.StatusCode())
+	require.Equal(t, "true", retryResp.HTTPResponse.Header.Get("Idempotent-Replayed"))
+	require.Equal(t, firstResp.JSON201.Pr.AssignedReviewers, retryResp.JSON201.Pr.AssignedReviewers)
+
+	noKeyResp, err := client.PostPullRequestCreateWithResponse(ctx, createBody)
+	require.NoError(t, err)
+	require.Equal(t, api.PREXISTS, noKeyResp.JSON409.Error.Code)
+
+	createBody.PullRequestName = "idPR renamed"
+	reusedResp, err := client.PostPullRequestCreateWithResponse(ctx, createBody, withKey("create-1"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnprocessableEntity, reusedResp.StatusCode())
+	require.Contains(t, string(reusedResp.Body), "IDEMPOTENCY_KEY_REUSED")
+
+	oldReviewer := firstResp.JSON201.Pr.AssignedReviewers[0]
+	reassignBody := api.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: "idPR",
+		OldUserId:     oldReviewer,
+	}
+	reassignResp, err := client.PostPullRequestReassignWithResponse(ctx, nil, reassignBody, withKey("reassign-1"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, reassignResp.StatusCode())
+
+	reassignRetryResp, err := client.PostPullRequestReassignWithResponse(ctx, nil, reassignBody, withKey("reassign-1"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, reassignRetryResp.StatusCode())
+	require.Equal(t, reassignResp.JSON200.ReplacedBy, reassignRetryResp.JSON200.ReplacedBy)
+
+	noKeyReassignResp, err := client.PostPullRequestReassignWithResponse(ctx, nil, reassignBody)
+	require.NoError(t, err)
+	require.Equal(t, api.NOTASSIGNED, noKeyReassignResp.JSON409.Error.Code)
+}
+
+func TestOptimisticConcurrency(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "ocTeam",
+		Members: []api.TeamMember{
+			{UserId: "ocAuthor", Username: "author", IsActive: true},
+			{UserId: "ocMate1", Username: "mate1", IsActive: true},
+			{UserId: "ocMate2", Username: "mate2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "ocAuthor",
+		PullRequestId:   "ocPR",
+		PullRequestName: "ocPR",
+	})
+	require.NoError(t, err)
+	createdETag := createResp.HTTPResponse.Header.Get("ETag")
+	require.Equal(t, `"1"`, createdETag)
+
+	removeResp, err := client.PostPullRequestRemoveReviewerWithResponse(ctx,
+		&api.PostPullRequestRemoveReviewerParams{IfMatch: &createdETag},
+		api.ReviewerChange{PullRequestId: "ocPR", UserId: createResp.JSON201.Pr.AssignedReviewers[0]},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, removeResp.StatusCode())
+	require.Equal(t, `"2"`, removeResp.HTTPResponse.Header.Get("ETag"))
+
+	staleResp, err := client.PostPullRequestMergeWithResponse(ctx,
+		&api.PostPullRequestMergeParams{IfMatch: &createdETag},
+		api.PostPullRequestMergeJSONRequestBody{PullRequestId: "ocPR"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusPreconditionFailed, staleResp.StatusCode())
+	require.Equal(t, api.VERSIONMISMATCH, staleResp.JSON412.Error.Code)
+
+	teamResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "ocTeam"})
+	require.NoError(t, err)
+	teamETag := teamResp.HTTPResponse.Header.Get("ETag")
+	require.Equal(t, `"1"`, teamETag)
+
+	renameResp, err := client.PostTeamRenameWithResponse(ctx,
+		&api.PostTeamRenameParams{IfMatch: &teamETag},
+		api.TeamRename{TeamName: "ocTeam", NewTeamName: "ocRenamed"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, renameResp.StatusCode())
+	require.Equal(t, `"2"`, renameResp.HTTPResponse.Header.Get("ETag"))
+
+	deleteResp, err := client.DeleteTeamWithResponse(ctx, &api.DeleteTeamParams{
+		TeamName: "ocRenamed",
+		IfMatch:  &teamETag,
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.VERSIONMISMATCH, deleteResp.JSON412.Error.Code)
+}
+
+func TestConcurrentReassign(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	const prCount = 8
+	members := make([]api.TeamMember, 0, prCount+2)
+	for i := range prCount + 2 {
+		userID := fmt.Sprintf("crUser%d", i)
+		members = append(members, api.TeamMember{UserId: userID, Username: userID, IsActive: true})
+	}
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{TeamName: "crTeam", Members: members})
+	require.NoError(t, err)
+
+	reassignBodies := make([]api.PostPullRequestReassignJSONRequestBody, 0, prCount)
+	for i := range prCount {
+		prID := fmt.Sprintf("crPR%d", i)
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        members[i].UserId,
+			PullRequestId:   prID,
+			PullRequestName: prID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+		reassignBodies = append(reassignBodies, api.PostPullRequestReassignJSONRequestBody{
+			PullRequestId: prID,
+			OldUserId:     createResp.JSON201.Pr.AssignedReviewers[0],
+		})
+	}
+
+	statusCodes := make([]int, prCount)
+	var wg sync.WaitGroup
+	for i, body := range reassignBodies {
+		wg.Go(func() {
+			resp, err := client.PostPullRequestReassignWithResponse(ctx, nil, body)
+			if err == nil {
+				statusCodes[i] = resp.StatusCode()
+			}
+		})
+	}
+	wg.Wait()
+
+	for _, code := range statusCodes {
+		require.Equal(t, http.StatusOK, code)
+	}
+}
+
+func TestStats(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+
+	t.Run("team stats", func(t *testing.T) {
+		t.Cleanup(func() {
+			cleanUp(t)
+		})
+
+		ctx := context.Background()
+
+		reqTeam := api.Team{
+			TeamName: "statsTeam",
+			Members: []api.TeamMember{
+				{IsActive: true, UserId: "statsUser1", Username: "name"},
+				{IsActive: false, UserId: "statsUser2", Username: "name"},
+			},
+		}
+		_, err := client.PostTeamAddWithResponse(ctx, reqTeam)
+		require.NoError(t, err)
+
+		for _, prID := range []string{"statsPR1", "statsPR2"} {
+			_, err = client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+				AuthorId:        "statsUser1",
+				PullRequestId:   prID,
+				PullRequestName: prID,
+			})
+			require.NoError(t, err)
+		}
+		_, err = client.PostPullRequestMergeWithResponse(ctx, nil, api.PostPullRequestMergeJSONRequestBody{
+			PullRequestId: "statsPR1",
+		})
+		require.NoError(t, err)
+
+		weeks := 2
+		statsResp, err := client.GetStatsTeamsWithResponse(ctx, &api.GetStatsTeamsParams{Weeks: &weeks})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statsResp.StatusCode())
+
+		var teamStats *api.TeamStats
+		for i := range statsResp.JSON200.Teams {
+			if statsResp.JSON200.Teams[i].TeamName == reqTeam.TeamName {
+				teamStats = &statsResp.JSON200.Teams[i]
+			}
+		}
+		require.NotNil(t, teamStats)
+		require.Len(t, teamStats.Weekly, weeks)
+		require.Equal(t, 2, teamStats.Weekly[weeks-1].Opened)
+		require.Equal(t, 1, teamStats.Weekly[weeks-1].Merged)
+		require.NotNil(t, teamStats.TimeToMergeP50Seconds)
+		require.Equal(t, 1, teamStats.ActiveMembers)
+		require.True(t, teamStats.Understaffed)
+
+		csvResp, err := client.GetStatsTeamsWithResponse(ctx, &api.GetStatsTeamsParams{},
+			func(_ context.Context, req *http.Request) error {
+				req.Header.Set("Accept", "text/csv")
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, csvResp.StatusCode())
+		require.Equal(t, "text/csv", csvResp.HTTPResponse.Header.Get("Content-Type"))
+		require.Contains(t, string(csvResp.Body), reqTeam.TeamName)
+
+		invalidPageSize := 1000
+		invalidResp, err := client.GetStatsTeamsWithResponse(ctx, &api.GetStatsTeamsParams{PageSize: &invalidPageSize})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, invalidResp.StatusCode())
+	})
+
+	t.Run("rotation and pairings", func(t *testing.T) {
+		t.Cleanup(func() {
+			cleanUp(t)
+		})
+
+		ctx := context.Background()
+
+		_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: "rotationTeam",
+			Members: []api.TeamMember{
+				{IsActive: true, UserId: "rotAuthor", Username: "author"},
+				{IsActive: true, UserId: "rotMate1", Username: "mate1"},
+				{IsActive: true, UserId: "rotMate2", Username: "mate2"},
+				{IsActive: true, UserId: "rotMate3", Username: "mate3"},
+			},
+		})
+		require.NoError(t, err)
+
+		rotation := api.ROTATION
+		windowDays := 7
+		policyResp, err := client.PostTeamSetPolicyWithResponse(ctx, api.TeamPolicy{
+			TeamName:           "rotationTeam",
+			EscalationPolicy:   api.REASSIGN,
+			SelectionMode:      &rotation,
+			RotationWindowDays: &windowDays,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &rotation, policyResp.JSON200.Policy.SelectionMode)
+		require.Equal(t, &windowDays, policyResp.JSON200.Policy.RotationWindowDays)
+
+		first, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        "rotAuthor",
+			PullRequestId:   "rotPR1",
+			PullRequestName: "rotPR1",
+		})
+		require.NoError(t, err)
+		require.Len(t, first.JSON201.Pr.AssignedReviewers, 2)
+
+		second, err := client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        "rotAuthor",
+			PullRequestId:   "rotPR2",
+			PullRequestName: "rotPR2",
+		})
+		require.NoError(t, err)
+		require.Len(t, second.JSON201.Pr.AssignedReviewers, 2)
+
+		var skipped string
+		for _, mate := range []string{"rotMate1", "rotMate2", "rotMate3"} {
+			if !slices.Contains(first.JSON201.Pr.AssignedReviewers, mate) {
+				skipped = mate
+			}
+		}
+		require.Contains(t, second.JSON201.Pr.AssignedReviewers, skipped)
+
+		pairingsResp, err := client.GetStatsPairingsWithResponse(ctx, &api.GetStatsPairingsParams{TeamName: "rotationTeam"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, pairingsResp.StatusCode())
+
+		reviews := 0
+		for _, pairing := range pairingsResp.JSON200.Pairings {
+			require.Equal(t, "rotAuthor", pairing.AuthorId)
+			reviews += pairing.Reviews
+		}
+		require.Equal(t, 4, reviews)
+
+		missingResp, err := client.GetStatsPairingsWithResponse(ctx, &api.GetStatsPairingsParams{TeamName: "missing"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, missingResp.StatusCode())
+	})
+}
+
+type prService struct {
+	cmd         *exec.Cmd
+	executable  string
+	restPort    string
+	metricsPort string
+	env         []string
+}
+
+var memoryService *prService
+
+func TestTransfer(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort)
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	_, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "tfTeam",
+		Members: []api.TeamMember{
+			{UserId: "tfAuthor", Username: "author", IsActive: true},
+			{UserId: "tfReviewer", Username: "reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.PostPullRequestCreateWithResponse(ctx, api.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "tfAuthor",
+		PullRequestId:   "tfPR",
+		PullRequestName: "tfPR",
+	})
+	require.NoError(t, err)
+
+	t.Run("export ndjson and import it back as dry run", func(t *testing.T) {
+		// сгенерированный клиент пытается разобрать NDJSON как JSON, поэтому выгрузка читается напрямую
+		exportResp, err := http.Get("http://localhost:" + restPort + "/admin/export")
+		require.NoError(t, err)
+		defer exportResp.Body.Close()
+		require.Equal(t, http.StatusOK, exportResp.StatusCode)
+		require.Equal(t, "application/x-ndjson", exportResp.Header.Get("Content-Type"))
+		exported, err := io.ReadAll(exportResp.Body)
+		require.NoError(t, err)
+
+		kinds := make(map[api.TransferRecordKind]int)
+		for _, line := range strings.Split(strings.TrimSpace(string(exported)), "\n") {
+			var record api.TransferRecord
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			kinds[record.Kind]++
+		}
+		// команда healthcheck создаётся при проверке готовности сервиса
+		require.Equal(t, map[api.TransferRecordKind]int{
+			api.TransferRecordKindTeam:        2,
+			api.TransferRecordKindUser:        4,
+			api.TransferRecordKindPullRequest: 1,
+		}, kinds)
+
+		dryRun := true
+		importResp, err := client.PostAdminImportWithBodyWithResponse(ctx,
+			&api.PostAdminImportParams{DryRun: &dryRun},
+			"application/x-ndjson",
+			bytes.NewReader(exported),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, importResp.StatusCode())
+		require.Equal(t, api.ImportReport{
+			DryRun:       true,
+			Teams:        api.ImportCounts{Unchanged: 2},
+			Users:        api.ImportCounts{Unchanged: 4},
+			PullRequests: api.ImportCounts{Unchanged: 1},
+			Errors:       []api.ImportError{},
+		}, *importResp.JSON200)
+	})
+
+	t.Run("invalid lines reject the whole import", func(t *testing.T) {
+		body := `{"kind":"user","user":{"user_id":"tfNew","username":"new","is_active":true}}
+not json
+{"kind":"pull_request","pull_request":{"pull_request_id":"tfOrphan","pull_request_name":"orphan","author_id":"tfMissing","status":"OPEN","assigned_reviewers":[]}}
+`
+		importResp, err := client.PostAdminImportWithBodyWithResponse(ctx, nil, "application/x-ndjson", strings.NewReader(body))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnprocessableEntity, importResp.StatusCode())
+		require.False(t, importResp.JSON422.Applied)
+		require.Equal(t, 1, importResp.JSON422.Users.Created)
+		require.Len(t, importResp.JSON422.Errors, 2)
+		require.Equal(t, 2, importResp.JSON422.Errors[0].Line)
+		require.Equal(t, 3, importResp.JSON422.Errors[1].Line)
+
+		exportResp, err := client.GetAdminExportWithResponse(ctx, func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Accept", "application/json")
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, *exportResp.JSON200, 7)
+		for _, record := range *exportResp.JSON200 {
+			if record.User != nil {
+				require.NotEqual(t, "tfNew", record.User.UserId)
+			}
+		}
+	})
+
+	t.Run("import merged pull request from json array", func(t *testing.T) {
+		createdAt := time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Second)
+		mergedAt := createdAt.Add(time.Hour)
+		teamName := "tfTeam"
+		importResp, err := client.PostAdminImportWithResponse(ctx, nil, []api.TransferRecord{
+			{
+				Kind: api.TransferRecordKindTeam,
+				Team: &api.Team{TeamName: "tfTeam", Members: []api.TeamMember{
+					{UserId: "tfNew", Username: "new", IsActive: true},
+				}},
+			},
+			{
+				Kind: api.TransferRecordKindPullRequest,
+				PullRequest: &api.TransferPullRequest{
+					PullRequestId:     "tfOld",
+					PullRequestName:   "old",
+					AuthorId:          "tfNew",
+					Status:            api.MERGED,
+					AssignedReviewers: []string{"tfReviewer"},
+					CreatedAt:         &createdAt,
+					MergedAt:          &mergedAt,
+					TeamName:          &teamName,
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, importResp.StatusCode())
+		require.True(t, importResp.JSON200.Applied)
+		require.Equal(t, api.ImportCounts{Updated: 1}, importResp.JSON200.Teams)
+		require.Equal(t, api.ImportCounts{Created: 1}, importResp.JSON200.PullRequests)
+
+		getResp, err := client.GetPullRequestGetWithResponse(ctx, &api.GetPullRequestGetParams{PullRequestId: "tfOld"})
+		require.NoError(t, err)
+		require.Equal(t, api.PullRequestStatusMERGED, getResp.JSON200.Pr.Status)
+		require.Equal(t, []string{"tfReviewer"}, getResp.JSON200.Pr.AssignedReviewers)
+		require.True(t, mergedAt.Equal(*getResp.JSON200.Pr.MergedAt))
+	})
+}
+
+func TestOrganizations(t *testing.T) {
+	executable := getPRServiceExecutable(t)
+	restPort := findFreePort(t)
+	metricsPort := findFreePort(t)
+
+	cmd := setupPRService(t, executable, restPort, metricsPort, "API_KEYS=orgA:tokenA,orgB:tokenB")
+	t.Cleanup(func() {
+		stopPRService(t, cmd)
+		cleanUp(t)
+	})
+
+	client := newRESTClient(t, restPort)
+	ctx := context.Background()
+
+	withToken := func(token string) api.RequestEditorFn {
+		return func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+token)
+			return nil
+		}
+	}
+	asA, asB := withToken("tokenA"), withToken("tokenB")
+
+	t.Run("token is required", func(t *testing.T) {
+		resp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "orgTeam"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+
+		resp, err = client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "orgTeam"}, withToken("unknown"))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+
+	// обе организации заводят команду с одним именем
+	addResp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "orgTeam",
+		Members: []api.TeamMember{
+			{UserId: "orgAAuthor", Username: "author", IsActive: true},
+			{UserId: "orgAMate", Username: "mate", IsActive: true},
+		},
+	}, asA)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, addResp.StatusCode())
+
+	addResp, err = client.PostTeamAddWithResponse(ctx, api.Team{
+		TeamName: "orgTeam",
+		Members: []api.TeamMember{
+			{UserId: "orgBAuthor", Username: "author", IsActive: true},
+			{UserId: "orgBMate", Username: "mate", IsActive: true},
+		},
+	}, asB)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, addResp.StatusCode())
+
+	t.Run("teams and users are not visible to another organization", func(t *testing.T) {
+		teamResp, err := client.GetTeamGetWithResponse(ctx, &api.GetTeamGetParams{TeamName: "orgTeam"}, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, teamResp.StatusCode())
+		memberIDs := make([]string, 0, len(teamResp.JSON200.Members))
+		for _, member := range teamResp.JSON200.Members {
+			memberIDs = append(memberIDs, member.UserId)
+		}
+		require.ElementsMatch(t, []string{"orgBAuthor", "orgBMate"}, memberIDs)
+
+		userResp, err := client.PostUsersSetIsActiveWithResponse(ctx, api.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   "orgAMate",
+			IsActive: false,
+		}, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, userResp.StatusCode())
+	})
+
+	t.Run("user id of another organization is taken", func(t *testing.T) {
+		resp, err := client.PostTeamAddWithResponse(ctx, api.Team{
+			TeamName: "orgOther",
+			Members: []api.TeamMember{
+				{UserId: "orgAMate", Username: "mate", IsActive: true},
+			},
+		}, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		require.Equal(t, api.USERIDTAKEN, resp.JSON400.Error.Code)
+	})
+
+	t.Run("pull requests are assigned within the organization", func(t *testing.T) {
+		body := api.PostPullRequestCreateJSONRequestBody{
+			AuthorId:        "orgAAuthor",
+			PullRequestId:   "orgPR",
+			PullRequestName: "orgPR",
+		}
+		foreignResp, err := client.PostPullRequestCreateWithResponse(ctx, body, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, foreignResp.StatusCode())
+
+		createResp, err := client.PostPullRequestCreateWithResponse(ctx, body, asA)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+		require.Equal(t, []string{"orgAMate"}, createResp.JSON201.Pr.AssignedReviewers)
+
+		// идентификаторы PR не пересекаются между организациями
+		body.AuthorId = "orgBAuthor"
+		createResp, err = client.PostPullRequestCreateWithResponse(ctx, body, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+		require.Equal(t, []string{"orgBMate"}, createResp.JSON201.Pr.AssignedReviewers)
+
+		addResp, err := client.PostPullRequestAddReviewerWithResponse(ctx, nil, api.ReviewerChange{
+			PullRequestId: "orgPR",
+			UserId:        "orgAMate",
+		}, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, addResp.StatusCode())
+
+		newUserID := "orgAMate"
+		reassignResp, err := client.PostPullRequestReassignWithResponse(ctx, nil, api.PostPullRequestReassignJSONRequestBody{
+			PullRequestId: "orgPR",
+			OldUserId:     "orgBMate",
+			NewUserId:     &newUserID,
+		}, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, reassignResp.StatusCode())
+
+		reviewResp, err := client.GetUsersGetReviewWithResponse(ctx, &api.GetUsersGetReviewParams{UserId: "orgAMate"}, asB)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, reviewResp.StatusCode())
+		require.Empty(t, reviewResp.JSON200.PullRequests)
+	})
+}
+
+func setupPRService(
+	t *testing.T,
+	executable string,
+	restPort string,
+	metricsPort string,
+	env ...string,
+) *prService {
+	t.Helper()
+
+	service := &prService{
+		cmd:         startPRService(t, executable, restPort, metricsPort, env...),
+		executable:  executable,
+		restPort:    restPort,
+		metricsPort: metricsPort,
+		env:         env,
+	}
+	if memoryStorage() {
+		memoryService = service
+	}
+
+	return service
+}
+
+func startPRService(
+	t *testing.T,
+	executable string,
+	restPort string,
+	metricsPort string,
+	env ...string,
+) *exec.Cmd {
+	t.Helper()
+
+	cmd := exec.Command(executable)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if memoryStorage() {
+		cmd.Env = append(cmd.Env, "STORAGE=memory")
+	} else {
+		for _, p := range requiredEnv {
+			cur := os.Getenv(p)
+			require.NotEmpty(t, cur, "you need to pass env variable to tests: "+p)
+
+			cmd.Env = append(cmd.Env, p+"="+cur)
+		}
+	}
+
+	cmd.Env = append(cmd.Env, "REST_PORT="+restPort)
+	cmd.Env = append(cmd.Env, "METRICS_PORT="+metricsPort)
+	// тесты очищают бд в обход сервиса, кэш команд пережил бы очистку
+	cmd.Env = append(cmd.Env, "CACHE_TTL=0")
+	cmd.Env = append(cmd.Env, env...)
+
+	require.NoError(t, cmd.Start())
+	restClient := newRESTClient(t, restPort)
+
+	// rest health check
+	for i := range 50 {
+
+		_, err := restClient.PostTeamAddWithResponse(context.Background(), api.Team{
+			TeamName: "healthcheck",
+			Members: []api.TeamMember{
+				{
+					IsActive: true,
+					UserId:   "u1",
+					Username: "name",
+				},
+				{
+					IsActive: false,
+					UserId:   "u2",
+					Username: "name",
+				},
+			},
+		})
+
+		_, ok := status.FromError(err)
+
+		if ok {
+			break
+		}
+
+		if i == 49 {
+			require.NoError(t, err)
+			log.Println("rest health check error")
+			t.Fail()
+		}
+
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	return cmd
+}
+
+func memoryStorage() bool {
+	return os.Getenv("STORAGE") == "memory"
+}
+
+func stopPRService(t *testing.T, service *prService) {
+	t.Helper()
+
+	if memoryService == service {
+		memoryService = nil
+	}
+	stopProcess(t, service.cmd)
+}
+
+func stopProcess(t *testing.T, cmd *exec.Cmd) {
+	t.Helper()
+
+	// повторный SIGTERM может прийти после того, как сервис снял обработчик сигналов, и убить его
+	require.NoError(t, cmd.Process.Signal(syscall.SIGTERM))
+
 	require.NoError(t, cmd.Wait())
 	require.Equal(t, 0, cmd.ProcessState.ExitCode())
 }
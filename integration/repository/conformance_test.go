@@ -0,0 +1,66 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/db"
+	"github.com/Tortik3000/PR-service/internal/metrics"
+	repository "github.com/Tortik3000/PR-service/internal/repository/pr-service"
+	"github.com/Tortik3000/PR-service/internal/repository/repositorytest"
+)
+
+const truncateTables = `TRUNCATE TABLE users, team, pull_request, assigned_reviewer, escalation, out_of_office,
+	team_fallback, codeowners_rule, review_pairing, team_audit, team_membership, repository, idempotency_key
+	RESTART IDENTITY CASCADE`
+
+func postgresSource(t *testing.T) string {
+	host := os.Getenv("POSTGRES_HOST")
+	if host == "" {
+		t.Skip("POSTGRES_HOST is not set")
+	}
+
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		url.QueryEscape(os.Getenv("POSTGRES_USER")),
+		url.QueryEscape(os.Getenv("POSTGRES_PASSWORD")),
+		host,
+		os.Getenv("POSTGRES_PORT"),
+		os.Getenv("POSTGRES_DB"),
+	)
+}
+
+func migratePostgres(t *testing.T, pool *pgxpool.Pool) {
+	migrator, err := db.NewPostgresMigrator(pool)
+	require.NoError(t, err)
+
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+}
+
+func TestPostgresConformance(t *testing.T) {
+	source := postgresSource(t)
+
+	pool, err := pgxpool.New(context.Background(), source)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	logger := zap.NewNop()
+	migratePostgres(t, pool)
+
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Backend {
+		_, err := pool.Exec(context.Background(), truncateTables)
+		require.NoError(t, err)
+
+		return repositorytest.Backend{
+			Repository: repository.NewPostgresRepo(logger, pool, nil),
+			Transactor: repository.NewTransactor(pool, logger, metrics.DBTxRetriesTotal),
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/metrics"
+	"github.com/Tortik3000/PR-service/internal/models"
+	repository "github.com/Tortik3000/PR-service/internal/repository/pr-service"
+)
+
+const replicaCheckInterval = 10 * time.Millisecond
+
+func TestPostgresReplicaRouting(t *testing.T) {
+	source := postgresSource(t)
+
+	primary, err := pgxpool.New(context.Background(), source)
+	require.NoError(t, err)
+	t.Cleanup(primary.Close)
+
+	logger := zap.NewNop()
+	migratePostgres(t, primary)
+
+	tests := []struct {
+		name        string
+		replicaURL  string
+		wantHealthy float64
+		wantPool    string
+	}{
+		{
+			name:        "healthy replica serves reads",
+			replicaURL:  source,
+			wantHealthy: 1,
+			wantPool:    "replica",
+		},
+		{
+			name:        "unavailable replica falls back to primary",
+			replicaURL:  "postgres://nobody@127.0.0.1:1/none?sslmode=disable&connect_timeout=1",
+			wantHealthy: 0,
+			wantPool:    "primary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replicaPool, err := pgxpool.New(context.Background(), tt.replicaURL)
+			require.NoError(t, err)
+			t.Cleanup(replicaPool.Close)
+
+			reads := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "reads"}, []string{"pool"})
+			healthy := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "healthy"}, []string{"pool"})
+			replica := repository.NewReplica(replicaPool, logger, reads, healthy)
+
+			ctx, cancel := context.WithCancel(t.Context())
+			t.Cleanup(cancel)
+			go replica.Run(ctx, replicaCheckInterval)
+
+			require.Eventually(t, func() bool {
+				return testutil.CollectAndCount(healthy) == 1 &&
+					testutil.ToFloat64(healthy.WithLabelValues("replica")) == tt.wantHealthy
+			}, 5*time.Second, replicaCheckInterval)
+
+			repo := repository.NewPostgresRepo(logger, primary, replica)
+			_, err = repo.TeamGet(t.Context(), "missing")
+			require.NoError(t, err)
+			require.InDelta(t, 1, testutil.ToFloat64(reads.WithLabelValues(tt.wantPool)), 0)
+
+			// в транзакции чтение идёт через неё и не попадает в метрику пулов
+			transactor := repository.NewTransactor(primary, logger, metrics.DBTxRetriesTotal)
+			err = transactor.WithTx(t.Context(), func(ctx context.Context) error {
+				_, err := repo.TeamGet(ctx, "missing")
+				return err
+			}, models.WithReadOnly())
+			require.NoError(t, err)
+			require.Equal(t, 1, testutil.CollectAndCount(reads))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -16,14 +17,16 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/Tortik3000/PR-service/config"
-	"github.com/Tortik3000/PR-service/db"
 	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/clock"
 	controller "github.com/Tortik3000/PR-service/internal/controller/pr-service"
+	"github.com/Tortik3000/PR-service/internal/events"
 	"github.com/Tortik3000/PR-service/internal/metrics"
 	repoMiddlerware "github.com/Tortik3000/PR-service/internal/middleware/repo_middleware"
 	restMiddlerware "github.com/Tortik3000/PR-service/internal/middleware/rest_middleware"
-	repository "github.com/Tortik3000/PR-service/internal/repository/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
 	usecase "github.com/Tortik3000/PR-service/internal/usecase/pr-service"
+	"github.com/Tortik3000/PR-service/internal/worker"
 )
 
 const (
@@ -37,32 +40,91 @@ const (
 	DBOperationTimeLimit    = 5 * time.Second
 	DBMaxAttemptsForPing    = 5
 	DBDelayForPing          = 2 * time.Second
+	DBReplicaCheckInterval  = 5 * time.Second
 )
 
+type storageTransactor interface {
+	WithTx(ctx context.Context, function func(ctx context.Context) error, opts ...models.TxOption) error
+}
+
+// RunOptions задаются флагами команды serve
+type RunOptions struct {
+	// NoMigrate запускает сервис без применения миграций, они применяются отдельной командой migrate
+	NoMigrate bool
+}
+
 func Run(
 	logger *zap.Logger,
 	cfg *config.Config,
+	opts RunOptions,
 ) {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
-	dbPool := initDBPool(cfg, logger)
-	defer dbPool.Close()
-
-	db.SetupPostgres(dbPool, logger)
+	st, err := openStorage(ctx, cfg, logger)
+	if err != nil {
+		logger.Fatal("Failed to open storage", zap.Error(err))
+	}
+	defer st.close()
 
-	repo := repository.NewPostgresRepo(logger, dbPool)
-	metricsRepo := repoMiddlerware.NewMiddlewareMetricsRepo(repo, metrics.DBQueryLatency)
+	if st.migrator != nil && !opts.NoMigrate {
+		results, err := st.migrator.Up(ctx)
+		if err != nil {
+			logger.Fatal("can not apply migrations", zap.Error(err))
+		}
+		logger.Info("migrations applied", zap.Int("count", len(results)))
+	}
 
-	transactor := repository.NewTransactor(dbPool, logger)
-	useCases := usecase.NewUseCase(logger, metricsRepo, metricsRepo, metricsRepo, transactor)
-	ctrl := controller.NewPRService(logger, useCases, useCases, useCases)
+	repo, transactor := st.repo, st.transactor
+	repo = repoMiddlerware.NewMiddlewareMetricsRepo(repo, metrics.DBQueryLatency)
+	// нулевой CACHE_TTL отключает кэш составов команд
+	if cfg.Cache.TTL > 0 {
+		cacheRepo := repoMiddlerware.NewMiddlewareCacheRepo(
+			repo, transactor, clock.New(), cfg.Cache.TTL, metrics.CacheRequestsTotal)
+		repo, transactor = cacheRepo, cacheRepo
+	}
 
-	go runMetricsServer(ctx, logger, cfg.Observability.MetricsPort)
-	runPRServer(ctx, logger, ctrl, cfg)
+	publisher := events.NewLogPublisher(logger, metrics.EscalationsTotal)
+	useCases := usecase.NewUseCase(
+		logger,
+		repo,
+		repo,
+		repo,
+		repo,
+		repo,
+		repo,
+		repo,
+		repo,
+		repo,
+		transactor,
+		clock.New(),
+		publisher,
+	)
+	ctrl := controller.NewPRService(logger, useCases, useCases, useCases, useCases, useCases, useCases)
+
+	escalationWorker := worker.NewEscalationWorker(logger, useCases, cfg.Escalation.Interval)
+	outOfOfficeWorker := worker.NewOutOfOfficeWorker(logger, useCases, cfg.OutOfOffice.Interval)
+	idempotencyWorker := worker.NewIdempotencyWorker(logger, useCases, cfg.Idempotency.CleanupInterval)
+	var workers sync.WaitGroup
+	workers.Go(func() { escalationWorker.Run(ctx) })
+	workers.Go(func() { outOfOfficeWorker.Run(ctx) })
+	workers.Go(func() { idempotencyWorker.Run(ctx) })
+
+	idempotency := restMiddlerware.IdempotencyMiddleware(logger, useCases, cfg.Idempotency.TTL)
+
+	go runMetricsServer(ctx, logger, cfg.Observability.MetricsPort, st.ready)
+	runPRServer(ctx, logger, ctrl, idempotency, cfg)
+
+	workers.Wait()
 }
 
-func runPRServer(ctx context.Context, logger *zap.Logger, ctrl api.StrictServerInterface, cfg *config.Config) {
+func runPRServer(
+	ctx context.Context,
+	logger *zap.Logger,
+	ctrl api.StrictServerInterface,
+	idempotency func(http.Handler) http.Handler,
+	cfg *config.Config,
+) {
 	loader := openapi3.NewLoader()
 	doc, err := loader.LoadFromFile("api/pr-service/pr-service.yml")
 	if err != nil {
@@ -81,9 +143,11 @@ func runPRServer(ctx context.Context, logger *zap.Logger, ctrl api.StrictServerI
 	r := chi.NewMux()
 
 	r.Use(restMiddlerware.MetricsMiddleware("pr-service"))
+	r.Use(restMiddlerware.AuthMiddleware(cfg.Auth.APIKeys))
 	r.Use(restMiddlerware.OpenAPIValidatorMiddleware(router))
+	r.Use(idempotency)
 
-	serverInterface := api.NewStrictHandler(ctrl, nil)
+	serverInterface := api.NewStrictHandler(ctrl, []api.StrictMiddlewareFunc{controller.AcceptHeaderMiddleware})
 	h := api.HandlerFromMux(serverInterface, r)
 
 	srv := &http.Server{
@@ -99,11 +163,17 @@ func runPRServer(ctx context.Context, logger *zap.Logger, ctrl api.StrictServerI
 	}
 }
 
-func runMetricsServer(ctx context.Context, logger *zap.Logger, port string) {
+func runMetricsServer(
+	ctx context.Context,
+	logger *zap.Logger,
+	port string,
+	ready func(ctx context.Context) error,
+) {
 	r := chi.NewRouter()
 	r.Get("/metrics", func(w http.ResponseWriter, r *http.Request) {
 		promhttp.Handler().ServeHTTP(w, r)
 	})
+	r.Get("/readyz", readinessHandler(logger, ready))
 
 	srv := &http.Server{
 		Addr:    ":" + port,
@@ -118,6 +188,21 @@ func runMetricsServer(ctx context.Context, logger *zap.Logger, port string) {
 	}
 }
 
+func readinessHandler(logger *zap.Logger, ready func(ctx context.Context) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), DBOperationTimeLimit)
+		defer cancel()
+
+		if err := ready(ctx); err != nil {
+			logger.Warn("service is not ready", zap.Error(err))
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		_, _ = w.Write([]byte("ok\n"))
+	}
+}
+
 func gracefulShutdown(ctx context.Context, srv *http.Server, logger *zap.Logger) {
 	<-ctx.Done()
 	logger.Info("Server is shutting down...")
@@ -132,26 +217,9 @@ func gracefulShutdown(ctx context.Context, srv *http.Server, logger *zap.Logger)
 }
 
 func initDBPool(cfg *config.Config, logger *zap.Logger) *pgxpool.Pool {
-	var dbPool *pgxpool.Pool
-
-	pgxCfg, err := pgxpool.ParseConfig(cfg.PG.URL)
-	if err != nil {
-		logger.Fatal("Unable to parse connection string", zap.Error(err))
-	}
-
-	pgxCfg.MaxConns = DBMaxConnections
-	pgxCfg.MinConns = DBMinConnections
-	pgxCfg.MaxConnLifetime = DBMaxConnLifetime
-	pgxCfg.MaxConnIdleTime = DBMaxConnIdleTime
-
-	ctx, cancel := context.WithTimeout(context.Background(), DBOperationTimeLimit)
-	defer cancel()
-
-	dbPool, err = pgxpool.NewWithConfig(ctx, pgxCfg)
-	if err != nil {
-		logger.Fatal("Unable to create connection pool", zap.Error(err))
-	}
+	dbPool := newDBPool(cfg.PG.URL, logger)
 
+	var err error
 	for attempt := range DBMaxAttemptsForPing {
 		ctxPing, cancel := context.WithTimeout(context.Background(), DBOperationTimeLimit)
 		err = dbPool.Ping(ctxPing)
@@ -175,3 +243,33 @@ func initDBPool(cfg *config.Config, logger *zap.Logger) *pgxpool.Pool {
 	logger.Info("Database connection pool established")
 	return dbPool
 }
+
+// newDBPool не проверяет соединение: реплика может быть недоступна при старте,
+// тогда чтение идёт в основной пул до её первой успешной проверки
+func newDBPool(url string, logger *zap.Logger) *pgxpool.Pool {
+	pgxCfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		logger.Fatal("Unable to parse connection string", zap.Error(err))
+	}
+
+	pgxCfg.MaxConns = DBMaxConnections
+	pgxCfg.MinConns = DBMinConnections
+	pgxCfg.MaxConnLifetime = DBMaxConnLifetime
+	pgxCfg.MaxConnIdleTime = DBMaxConnIdleTime
+
+	ctx, cancel := context.WithTimeout(context.Background(), DBOperationTimeLimit)
+	defer cancel()
+
+	dbPool, err := pgxpool.NewWithConfig(ctx, pgxCfg)
+	if err != nil {
+		logger.Fatal("Unable to create connection pool", zap.Error(err))
+	}
+
+	return dbPool
+}
+
+func registerDBPoolMetrics(logger *zap.Logger, name string, pool *pgxpool.Pool) {
+	if err := metrics.RegisterDBPool(name, pool); err != nil {
+		logger.Warn("DB pool metrics already registered", zap.String("pool", name), zap.Error(err))
+	}
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/config"
+)
+
+const (
+	MigrateUp      = "up"
+	MigrateDown    = "down"
+	MigrateStatus  = "status"
+	MigrateRedo    = "redo"
+	MigrateVersion = "version"
+)
+
+// Migrate выполняет команду миграций и печатает результат в out
+func Migrate(
+	logger *zap.Logger,
+	cfg *config.Config,
+	command string,
+	out io.Writer,
+) error {
+	switch command {
+	case MigrateUp, MigrateDown, MigrateRedo, MigrateStatus, MigrateVersion:
+	default:
+		return fmt.Errorf("unknown migrate command %q", command)
+	}
+	if cfg.Storage.Type == config.StorageMemory {
+		return fmt.Errorf("%w: %s", errNoMigrations, cfg.Storage.Type)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	st, err := openStorage(ctx, cfg, logger)
+	if err != nil {
+		return err
+	}
+	defer st.close()
+
+	switch command {
+	case MigrateUp:
+		results, err := st.migrator.Up(ctx)
+		printResults(out, results...)
+		if err == nil && len(results) == 0 {
+			_, err = fmt.Fprintln(out, "no migrations to apply")
+		}
+		return err
+	case MigrateDown:
+		result, err := st.migrator.Down(ctx)
+		if result != nil {
+			printResults(out, result)
+		}
+		return err
+	case MigrateRedo:
+		results, err := st.migrator.Redo(ctx)
+		printResults(out, results...)
+		return err
+	case MigrateStatus:
+		statuses, err := st.migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		return printStatus(out, statuses)
+	default: // MigrateVersion
+		current, latest, err := st.migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "current: %d\nlatest:  %d\n", current, latest)
+		return err
+	}
+}
+
+func printResults(out io.Writer, results ...*goose.MigrationResult) {
+	for _, result := range results {
+		_, _ = fmt.Fprintln(out, result.String())
+	}
+}
+
+func printStatus(out io.Writer, statuses []*goose.MigrationStatus) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED AT\tMIGRATION")
+	for _, status := range statuses {
+		appliedAt := "-"
+		if !status.AppliedAt.IsZero() {
+			appliedAt = status.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			status.Source.Version, status.State, appliedAt, status.Source.Path)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/config"
+	"github.com/Tortik3000/PR-service/db"
+	"github.com/Tortik3000/PR-service/internal/metrics"
+	repoMiddlerware "github.com/Tortik3000/PR-service/internal/middleware/repo_middleware"
+	"github.com/Tortik3000/PR-service/internal/repository/memory"
+	repository "github.com/Tortik3000/PR-service/internal/repository/pr-service"
+	"github.com/Tortik3000/PR-service/internal/repository/sqlite"
+)
+
+var errNoMigrations = errors.New("storage has no migrations")
+
+type storage struct {
+	repo       repoMiddlerware.Repository
+	transactor storageTransactor
+	// nil для хранилища в памяти
+	migrator *db.Migrator
+	ping     func(ctx context.Context) error
+	closers  []func()
+}
+
+func openStorage(
+	ctx context.Context,
+	cfg *config.Config,
+	logger *zap.Logger,
+) (*storage, error) {
+	st := &storage{}
+
+	switch cfg.Storage.Type {
+	case config.StorageMemory:
+		memoryRepo := memory.NewRepo(logger)
+		st.repo, st.transactor = memoryRepo, memoryRepo
+		st.ping = func(context.Context) error { return nil }
+		logger.Warn("using in-memory storage, data will be lost on restart")
+	case config.StorageSQLite:
+		sqliteDB, err := sqlite.Open(cfg.SQLite.Path)
+		if err != nil {
+			return nil, fmt.Errorf("open SQLite database %s: %w", cfg.SQLite.Path, err)
+		}
+		st.closers = append(st.closers, func() { _ = sqliteDB.Close() })
+
+		st.migrator, err = db.NewSQLiteMigrator(sqliteDB)
+		if err != nil {
+			st.close()
+			return nil, err
+		}
+
+		st.repo = sqlite.NewRepo(logger, sqliteDB)
+		st.transactor = sqlite.NewTransactor(sqliteDB, logger)
+		st.ping = sqliteDB.PingContext
+	default:
+		dbPool := initDBPool(cfg, logger)
+		st.closers = append(st.closers, dbPool.Close)
+		registerDBPoolMetrics(logger, "primary", dbPool)
+
+		var err error
+		st.migrator, err = db.NewPostgresMigrator(dbPool)
+		if err != nil {
+			st.close()
+			return nil, err
+		}
+
+		var replica *repository.Replica
+		if cfg.PG.ReplicaURL != "" {
+			replicaPool := newDBPool(cfg.PG.ReplicaURL, logger)
+			st.closers = append(st.closers, replicaPool.Close)
+			registerDBPoolMetrics(logger, "replica", replicaPool)
+
+			replica = repository.NewReplica(replicaPool, logger, metrics.DBReadQueriesTotal, metrics.DBPoolHealthy)
+			go replica.Run(ctx, DBReplicaCheckInterval)
+		}
+
+		st.repo = repository.NewPostgresRepo(logger, dbPool, replica)
+		st.transactor = repository.NewTransactor(dbPool, logger, metrics.DBTxRetriesTotal)
+		st.ping = dbPool.Ping
+	}
+
+	return st, nil
+}
+
+// ready проверяет доступность хранилища и то, что схема не отстаёт от кода
+func (st *storage) ready(ctx context.Context) error {
+	if err := st.ping(ctx); err != nil {
+		return fmt.Errorf("storage is unavailable: %w", err)
+	}
+	if st.migrator == nil {
+		return nil
+	}
+
+	pending, err := st.migrator.Pending(ctx)
+	if err != nil {
+		return fmt.Errorf("check migrations: %w", err)
+	}
+	if pending {
+		return errors.New("database schema is behind, run migrations")
+	}
+
+	return nil
+}
+
+func (st *storage) close() {
+	for i := len(st.closers) - 1; i >= 0; i-- {
+		st.closers[i]()
+	}
+}
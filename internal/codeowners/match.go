@@ -0,0 +1,76 @@
+package codeowners
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func Match(rules []models.CodeOwnersRule, paths []string) []models.CodeOwner {
+	patterns := make([]*regexp.Regexp, len(rules))
+	for i, rule := range rules {
+		patterns[i] = compile(rule.Pattern)
+	}
+
+	var owners []models.CodeOwner
+	for _, path := range paths {
+		path = strings.TrimPrefix(path, "/")
+		for i := len(rules) - 1; i >= 0; i-- {
+			if !patterns[i].MatchString(path) {
+				continue
+			}
+
+			for _, owner := range rules[i].Owners {
+				if !slices.Contains(owners, owner) {
+					owners = append(owners, owner)
+				}
+			}
+			break
+		}
+	}
+
+	return owners
+}
+
+func compile(pattern string) *regexp.Regexp {
+	trimmed, directory := strings.CutSuffix(pattern, "/")
+	anchored := strings.Contains(trimmed, "/")
+	trimmed = strings.TrimPrefix(trimmed, "/")
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(trimmed); i++ {
+		switch {
+		case strings.HasPrefix(trimmed[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(trimmed[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case trimmed[i] == '*':
+			expr.WriteString("[^/]*")
+		case trimmed[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(trimmed[i : i+1]))
+		}
+	}
+
+	// "dir/*" в CODEOWNERS не захватывает вложенные каталоги, остальные
+	// шаблоны распространяются на всё содержимое совпавшего каталога.
+	switch {
+	case directory:
+		expr.WriteString("/.*")
+	case !strings.HasSuffix(trimmed, "/*"):
+		expr.WriteString("(?:/.*)?")
+	}
+	expr.WriteString("$")
+
+	return regexp.MustCompile(expr.String())
+}
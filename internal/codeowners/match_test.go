@@ -0,0 +1,98 @@
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	user := func(name string) models.CodeOwner {
+		return models.CodeOwner{Kind: models.CodeOwnerUser, Name: name}
+	}
+	team := func(name string) models.CodeOwner {
+		return models.CodeOwner{Kind: models.CodeOwnerTeam, Name: name}
+	}
+
+	tests := []struct {
+		name     string
+		rules    []models.CodeOwnersRule
+		paths    []string
+		expected []models.CodeOwner
+	}{
+		{
+			name: "last matching rule wins",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "*", Owners: []models.CodeOwner{user("u1")}},
+				{Pattern: "*.go", Owners: []models.CodeOwner{user("u2")}},
+			},
+			paths:    []string{"cmd/main.go"},
+			expected: []models.CodeOwner{user("u2")},
+		},
+		{
+			name: "rule without owners unsets ownership",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "*", Owners: []models.CodeOwner{user("u1")}},
+				{Pattern: "/generated/"},
+			},
+			paths: []string{"generated/api/server.go"},
+		},
+		{
+			name: "owners are collected across paths without duplicates",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "/api/", Owners: []models.CodeOwner{team("backend"), user("u1")}},
+				{Pattern: "docs", Owners: []models.CodeOwner{user("u1"), user("u3")}},
+			},
+			paths:    []string{"/api/pr-service/pr-service.yml", "internal/docs/README.md", "go.mod"},
+			expected: []models.CodeOwner{team("backend"), user("u1"), user("u3")},
+		},
+		{
+			name: "anchored pattern does not match nested directory",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "/api/", Owners: []models.CodeOwner{user("u1")}},
+			},
+			paths: []string{"integration/api/client.go"},
+		},
+		{
+			name: "single star stays within directory",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "docs/*", Owners: []models.CodeOwner{user("u1")}},
+			},
+			paths: []string{"docs/build/guide.md"},
+		},
+		{
+			name: "double star spans directories",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "internal/**/dto/*_test.go", Owners: []models.CodeOwner{user("u1")}},
+			},
+			paths:    []string{"internal/controller/pr-service/dto/pr_test.go"},
+			expected: []models.CodeOwner{user("u1")},
+		},
+		{
+			name: "directory pattern does not match file with same name",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "build/", Owners: []models.CodeOwner{user("u1")}},
+			},
+			paths: []string{"scripts/build"},
+		},
+		{
+			name: "question mark matches single character",
+			rules: []models.CodeOwnersRule{
+				{Pattern: "v?.sql", Owners: []models.CodeOwner{user("u1")}},
+			},
+			paths:    []string{"db/v1.sql", "db/v10.sql"},
+			expected: []models.CodeOwner{user("u1")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Match(tt.rules, tt.paths))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func Parse(content string) ([]models.CodeOwnersRule, error) {
+	var rules []models.CodeOwnersRule
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		pattern, err := parsePattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", modelsErr.ErrInvalidCodeOwners, lineNumber, err)
+		}
+
+		rule := models.CodeOwnersRule{Pattern: pattern}
+		for _, field := range fields[1:] {
+			if strings.HasPrefix(field, "#") {
+				break
+			}
+
+			owner, err := parseOwner(field)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", modelsErr.ErrInvalidCodeOwners, lineNumber, err)
+			}
+			rule.Owners = append(rule.Owners, owner)
+		}
+
+		rules = append(rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", modelsErr.ErrInvalidCodeOwners, err)
+	}
+
+	return rules, nil
+}
+
+func parsePattern(field string) (string, error) {
+	if strings.HasPrefix(field, `\#`) {
+		field = field[1:]
+	}
+
+	switch {
+	case strings.HasPrefix(field, "!"):
+		return "", fmt.Errorf("negated pattern %q is not supported", field)
+	case strings.ContainsAny(field, "[]"):
+		return "", fmt.Errorf("character range in pattern %q is not supported", field)
+	}
+
+	return field, nil
+}
+
+func parseOwner(field string) (models.CodeOwner, error) {
+	name, ok := strings.CutPrefix(field, "@")
+	if !ok || name == "" {
+		return models.CodeOwner{}, fmt.Errorf("unsupported owner %q", field)
+	}
+
+	if _, team, isTeam := strings.Cut(name, "/"); isTeam {
+		if team == "" {
+			return models.CodeOwner{}, fmt.Errorf("unsupported owner %q", field)
+		}
+		return models.CodeOwner{Kind: models.CodeOwnerTeam, Name: team}, nil
+	}
+
+	return models.CodeOwner{Kind: models.CodeOwnerUser, Name: name}, nil
+}
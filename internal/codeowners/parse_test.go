@@ -0,0 +1,81 @@
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected []models.CodeOwnersRule
+		err      error
+	}{
+		{
+			name: "users, teams and comments",
+			content: `# default owners
+*           @u1
+
+/api/       @acme/backend @u2 # api owners
+\#notes.md  @u3
+docs/
+`,
+			expected: []models.CodeOwnersRule{
+				{Pattern: "*", Owners: []models.CodeOwner{{Kind: models.CodeOwnerUser, Name: "u1"}}},
+				{Pattern: "/api/", Owners: []models.CodeOwner{
+					{Kind: models.CodeOwnerTeam, Name: "backend"},
+					{Kind: models.CodeOwnerUser, Name: "u2"},
+				}},
+				{Pattern: "#notes.md", Owners: []models.CodeOwner{{Kind: models.CodeOwnerUser, Name: "u3"}}},
+				{Pattern: "docs/"},
+			},
+		},
+		{
+			name:    "empty file",
+			content: "\n# nothing here\n",
+		},
+		{
+			name:    "email owner",
+			content: "*.go dev@example.com\n",
+			err:     modelsErr.ErrInvalidCodeOwners,
+		},
+		{
+			name:    "team without name",
+			content: "*.go @acme/\n",
+			err:     modelsErr.ErrInvalidCodeOwners,
+		},
+		{
+			name:    "negated pattern",
+			content: "!vendor/ @u1\n",
+			err:     modelsErr.ErrInvalidCodeOwners,
+		},
+		{
+			name:    "character range",
+			content: "*.[ch] @u1\n",
+			err:     modelsErr.ErrInvalidCodeOwners,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := Parse(tt.content)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rules)
+		})
+	}
+}
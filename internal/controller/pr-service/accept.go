@@ -0,0 +1,30 @@
+package pr_service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+)
+
+const mimeTextCSV = "text/csv"
+
+type acceptHeaderKey struct{}
+
+func AcceptHeaderMiddleware(f api.StrictHandlerFunc, _ string) api.StrictHandlerFunc {
+	return func(
+		ctx context.Context,
+		w http.ResponseWriter,
+		r *http.Request,
+		request interface{},
+	) (interface{}, error) {
+		ctx = context.WithValue(ctx, acceptHeaderKey{}, r.Header.Get("Accept"))
+		return f(ctx, w, r, request)
+	}
+}
+
+func acceptsCSV(ctx context.Context) bool {
+	accept, _ := ctx.Value(acceptHeaderKey{}).(string)
+	return strings.Contains(accept, mimeTextCSV)
+}
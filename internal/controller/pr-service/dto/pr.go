@@ -18,7 +18,7 @@ func ToAPIPullRequest(pr *models.PR) *api.PullRequest {
 	if pr == nil {
 		return nil
 	}
-	return &api.PullRequest{
+	apiPR := &api.PullRequest{
 		PullRequestId:     pr.ID,
 		PullRequestName:   pr.Name,
 		AuthorId:          pr.AuthorID,
@@ -27,18 +27,29 @@ func ToAPIPullRequest(pr *models.PR) *api.PullRequest {
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 	}
+	if len(pr.FallbackReviewers) > 0 {
+		apiPR.FallbackReviewers = &pr.FallbackReviewers
+	}
+	if pr.Repository != "" {
+		apiPR.Repository = &pr.Repository
+	}
+	return apiPR
 }
 
 func ToAPIPullRequestShort(pr *models.PRShort) *api.PullRequestShort {
 	if pr == nil {
 		return nil
 	}
-	return &api.PullRequestShort{
+	apiPR := &api.PullRequestShort{
 		PullRequestId:   pr.ID,
 		PullRequestName: pr.Name,
 		AuthorId:        pr.AuthorID,
 		Status:          api.PullRequestShortStatus(statusToAPIStatus(pr.Status)),
 	}
+	if pr.Repository != "" {
+		apiPR.Repository = &pr.Repository
+	}
+	return apiPR
 }
 
 func statusToAPIStatus(status models.PRStatus) api.PullRequestStatus {
@@ -14,6 +14,7 @@ func TestToAPIPullRequest(t *testing.T) {
 	t.Parallel()
 
 	now := time.Now()
+	repository := "api"
 	tests := []struct {
 		name     string
 		input    *models.PR
@@ -53,6 +54,32 @@ func TestToAPIPullRequest(t *testing.T) {
 				MergedAt:  nil,
 			},
 		},
+		{
+			name: "PR with fallback reviewer",
+			input: &models.PR{
+				Status:            models.PRStatusOPEN,
+				AssignedReviewers: []string{"rev1", "other-team"},
+				FallbackReviewers: []string{"other-team"},
+			},
+			expected: &api.PullRequest{
+				Status:            api.PullRequestStatusOPEN,
+				AssignedReviewers: []string{"rev1", "other-team"},
+				FallbackReviewers: &[]string{"other-team"},
+			},
+		},
+		{
+			name: "PR in repository",
+			input: &models.PR{
+				ID:         "pr1",
+				Status:     models.PRStatusOPEN,
+				Repository: "api",
+			},
+			expected: &api.PullRequest{
+				PullRequestId: "pr1",
+				Status:        api.PullRequestStatusOPEN,
+				Repository:    &repository,
+			},
+		},
 		{
 			name:     "nil pr",
 			input:    nil,
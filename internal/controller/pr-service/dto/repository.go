@@ -0,0 +1,55 @@
+package dto
+
+import (
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func FromAPIRepository(repository api.Repository) models.Repository {
+	ret := models.Repository{
+		Name:          repository.RepositoryName,
+		ReviewerCount: repository.ReviewerCount,
+	}
+	if repository.OwnerTeam != nil {
+		ret.OwnerTeam = *repository.OwnerTeam
+	}
+	if repository.ShortfallPolicy != nil {
+		shortfallPolicy := models.ShortfallPolicy(*repository.ShortfallPolicy)
+		ret.ShortfallPolicy = &shortfallPolicy
+	}
+	if repository.SelectionMode != nil {
+		selectionMode := models.SelectionMode(*repository.SelectionMode)
+		ret.SelectionMode = &selectionMode
+	}
+	return ret
+}
+
+func ToAPIRepository(repository *models.Repository) *api.Repository {
+	if repository == nil {
+		return nil
+	}
+	ret := &api.Repository{
+		RepositoryName: repository.Name,
+		ReviewerCount:  repository.ReviewerCount,
+	}
+	if repository.OwnerTeam != "" {
+		ret.OwnerTeam = &repository.OwnerTeam
+	}
+	if repository.ShortfallPolicy != nil {
+		shortfallPolicy := api.RepositoryShortfallPolicy(*repository.ShortfallPolicy)
+		ret.ShortfallPolicy = &shortfallPolicy
+	}
+	if repository.SelectionMode != nil {
+		selectionMode := api.RepositorySelectionMode(*repository.SelectionMode)
+		ret.SelectionMode = &selectionMode
+	}
+	return ret
+}
+
+func ToAPIRepositories(repositories []models.Repository) []api.Repository {
+	ret := make([]api.Repository, len(repositories))
+	for i, repository := range repositories {
+		ret[i] = *ToAPIRepository(&repository)
+	}
+	return ret
+}
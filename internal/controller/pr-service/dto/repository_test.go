@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func TestFromAPIRepository(t *testing.T) {
+	t.Parallel()
+
+	three := 3
+	ownerTeam := "backend"
+	reject := api.RepositoryShortfallReject
+	rotation := api.RepositorySelectionRotation
+	rejectPolicy := models.ShortfallPolicyReject
+	rotationMode := models.SelectionModeRotation
+
+	tests := []struct {
+		name     string
+		input    api.Repository
+		expected models.Repository
+	}{
+		{
+			name: "all overrides",
+			input: api.Repository{
+				RepositoryName:  "api",
+				OwnerTeam:       &ownerTeam,
+				ReviewerCount:   &three,
+				ShortfallPolicy: &reject,
+				SelectionMode:   &rotation,
+			},
+			expected: models.Repository{
+				Name:            "api",
+				OwnerTeam:       "backend",
+				ReviewerCount:   &three,
+				ShortfallPolicy: &rejectPolicy,
+				SelectionMode:   &rotationMode,
+			},
+		},
+		{
+			name:     "without owner and overrides",
+			input:    api.Repository{RepositoryName: "api"},
+			expected: models.Repository{Name: "api"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, FromAPIRepository(tt.input))
+		})
+	}
+}
+
+func TestToAPIRepositories(t *testing.T) {
+	t.Parallel()
+
+	ownerTeam := "backend"
+	allow := api.RepositoryShortfallAllow
+	allowPolicy := models.ShortfallPolicyAllow
+
+	result := ToAPIRepositories([]models.Repository{
+		{Name: "api", OwnerTeam: "backend", OwnerTeamID: "1", ShortfallPolicy: &allowPolicy},
+		{Name: "web"},
+	})
+
+	assert.Equal(t, []api.Repository{
+		{RepositoryName: "api", OwnerTeam: &ownerTeam, ShortfallPolicy: &allow},
+		{RepositoryName: "web"},
+	}, result)
+}
@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+var teamStatsCSVHeader = []string{
+	"team_name",
+	"week_start",
+	"opened",
+	"merged",
+	"time_to_merge_p50_seconds",
+	"time_to_merge_p90_seconds",
+	"stale_open_prs",
+	"active_members",
+	"reviewer_count",
+	"understaffed",
+}
+
+func ToAPITeamStats(stats []models.TeamStats) []api.TeamStats {
+	ret := make([]api.TeamStats, len(stats))
+	for i, s := range stats {
+		weekly := make([]api.WeeklyThroughput, len(s.Weekly))
+		for j, w := range s.Weekly {
+			weekly[j] = api.WeeklyThroughput{
+				WeekStart: openapi_types.Date{Time: w.WeekStart},
+				Opened:    w.Opened,
+				Merged:    w.Merged,
+			}
+		}
+
+		ret[i] = api.TeamStats{
+			TeamName:              s.TeamName,
+			Weekly:                weekly,
+			TimeToMergeP50Seconds: durationToSeconds(s.TimeToMergeP50),
+			TimeToMergeP90Seconds: durationToSeconds(s.TimeToMergeP90),
+			StaleOpenPrs:          s.StaleOpenPRs,
+			ActiveMembers:         s.ActiveMembers,
+			ReviewerCount:         s.ReviewerCount,
+			Understaffed:          s.Understaffed,
+		}
+	}
+	return ret
+}
+
+func TeamStatsToCSV(stats []models.TeamStats) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(teamStatsCSVHeader); err != nil {
+		return nil, err
+	}
+
+	for _, s := range stats {
+		for _, week := range s.Weekly {
+			record := []string{
+				s.TeamName,
+				week.WeekStart.Format(time.DateOnly),
+				strconv.Itoa(week.Opened),
+				strconv.Itoa(week.Merged),
+				formatSeconds(s.TimeToMergeP50),
+				formatSeconds(s.TimeToMergeP90),
+				strconv.Itoa(s.StaleOpenPRs),
+				strconv.Itoa(s.ActiveMembers),
+				strconv.Itoa(s.ReviewerCount),
+				strconv.FormatBool(s.Understaffed),
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func durationToSeconds(d *time.Duration) *float64 {
+	if d == nil {
+		return nil
+	}
+	seconds := d.Seconds()
+	return &seconds
+}
+
+func formatSeconds(d *time.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+}
+
+func ToAPIReviewPairings(pairings []models.ReviewPairing) []api.ReviewPairing {
+	ret := make([]api.ReviewPairing, len(pairings))
+	for i, pairing := range pairings {
+		ret[i] = api.ReviewPairing{
+			AuthorId:   pairing.AuthorID,
+			ReviewerId: pairing.ReviewerID,
+			Reviews:    pairing.Reviews,
+		}
+	}
+	return ret
+}
@@ -0,0 +1,107 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func TestToAPITeamStats(t *testing.T) {
+	t.Parallel()
+
+	week := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	p50 := 90 * time.Minute
+	p90 := 2 * time.Hour
+	p50Seconds := p50.Seconds()
+	p90Seconds := p90.Seconds()
+
+	tests := []struct {
+		name     string
+		input    []models.TeamStats
+		expected []api.TeamStats
+	}{
+		{
+			name: "with merged PRs",
+			input: []models.TeamStats{
+				{
+					TeamName:       "backend",
+					Weekly:         []models.WeeklyThroughput{{WeekStart: week, Opened: 4, Merged: 2}},
+					TimeToMergeP50: &p50,
+					TimeToMergeP90: &p90,
+					StaleOpenPRs:   1,
+					ActiveMembers:  3,
+					ReviewerCount:  2,
+				},
+			},
+			expected: []api.TeamStats{
+				{
+					TeamName:              "backend",
+					Weekly:                []api.WeeklyThroughput{{WeekStart: openapi_types.Date{Time: week}, Opened: 4, Merged: 2}},
+					TimeToMergeP50Seconds: &p50Seconds,
+					TimeToMergeP90Seconds: &p90Seconds,
+					StaleOpenPrs:          1,
+					ActiveMembers:         3,
+					ReviewerCount:         2,
+				},
+			},
+		},
+		{
+			name: "without merged PRs",
+			input: []models.TeamStats{
+				{TeamName: "empty", ReviewerCount: 2, Understaffed: true},
+			},
+			expected: []api.TeamStats{
+				{TeamName: "empty", Weekly: []api.WeeklyThroughput{}, ReviewerCount: 2, Understaffed: true},
+			},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: []api.TeamStats{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, ToAPITeamStats(tt.input))
+		})
+	}
+}
+
+func TestTeamStatsToCSV(t *testing.T) {
+	t.Parallel()
+
+	p90 := 30 * time.Second
+	stats := []models.TeamStats{
+		{
+			TeamName: "a,b",
+			Weekly: []models.WeeklyThroughput{
+				{WeekStart: time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC), Opened: 1},
+				{WeekStart: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), Merged: 1},
+			},
+			TimeToMergeP90: &p90,
+			StaleOpenPRs:   2,
+			ActiveMembers:  4,
+			ReviewerCount:  2,
+		},
+	}
+
+	body, err := TeamStatsToCSV(stats)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"team_name,week_start,opened,merged,time_to_merge_p50_seconds,time_to_merge_p90_seconds,"+
+			"stale_open_prs,active_members,reviewer_count,understaffed\n"+
+			"\"a,b\",2025-10-13,1,0,,30,2,4,2,false\n"+
+			"\"a,b\",2025-10-20,0,1,,30,2,4,2,false\n",
+		string(body),
+	)
+}
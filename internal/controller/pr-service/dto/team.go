@@ -1,10 +1,17 @@
 package dto
 
 import (
+	"time"
+
 	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
 	"github.com/Tortik3000/PR-service/internal/models"
 )
 
+const (
+	day                       = 24 * time.Hour
+	defaultRotationWindowDays = 30
+)
+
 func FromAPIMembers(members []api.TeamMember) []models.Member {
 	ret := make([]models.Member, len(members))
 	for i, member := range members {
@@ -46,3 +53,113 @@ func ToAPITeam(team *models.Team) *api.Team {
 		Members:  members,
 	}
 }
+
+func FromAPITeamPolicy(policy api.TeamPolicy) models.TeamPolicy {
+	var reviewSLA *time.Duration
+	if policy.ReviewSlaMinutes != nil {
+		d := time.Duration(*policy.ReviewSlaMinutes) * time.Minute
+		reviewSLA = &d
+	}
+	shortfallPolicy := models.ShortfallPolicyAllow
+	if policy.ShortfallPolicy != nil {
+		shortfallPolicy = models.ShortfallPolicy(*policy.ShortfallPolicy)
+	}
+	selectionMode := models.SelectionModeDefault
+	if policy.SelectionMode != nil {
+		selectionMode = models.SelectionMode(*policy.SelectionMode)
+	}
+	rotationWindowDays := defaultRotationWindowDays
+	if policy.RotationWindowDays != nil {
+		rotationWindowDays = *policy.RotationWindowDays
+	}
+	return models.TeamPolicy{
+		TeamName:              policy.TeamName,
+		ReviewSLA:             reviewSLA,
+		EscalationPolicy:      models.EscalationPolicy(policy.EscalationPolicy),
+		DefaultMaxOpenReviews: policy.DefaultMaxOpenReviews,
+		ShortfallPolicy:       shortfallPolicy,
+		SelectionMode:         selectionMode,
+		RotationWindow:        time.Duration(rotationWindowDays) * day,
+	}
+}
+
+func ToAPITeamPolicy(policy *models.TeamPolicy) *api.TeamPolicy {
+	if policy == nil {
+		return nil
+	}
+	var reviewSLAMinutes *int
+	if policy.ReviewSLA != nil {
+		minutes := int(policy.ReviewSLA.Minutes())
+		reviewSLAMinutes = &minutes
+	}
+	shortfallPolicy := api.TeamPolicyShortfallPolicy(policy.ShortfallPolicy)
+	selectionMode := api.TeamPolicySelectionMode(policy.SelectionMode)
+	rotationWindowDays := int(policy.RotationWindow / day)
+	return &api.TeamPolicy{
+		TeamName:              policy.TeamName,
+		ReviewSlaMinutes:      reviewSLAMinutes,
+		EscalationPolicy:      api.TeamPolicyEscalationPolicy(policy.EscalationPolicy),
+		DefaultMaxOpenReviews: policy.DefaultMaxOpenReviews,
+		ShortfallPolicy:       &shortfallPolicy,
+		SelectionMode:         &selectionMode,
+		RotationWindowDays:    &rotationWindowDays,
+	}
+}
+
+func ToAPICodeOwnersRules(rules []models.CodeOwnersRule) []api.CodeOwnersRule {
+	ret := make([]api.CodeOwnersRule, len(rules))
+	for i, rule := range rules {
+		owners := make([]api.CodeOwner, len(rule.Owners))
+		for j, owner := range rule.Owners {
+			owners[j] = api.CodeOwner{
+				Kind: api.CodeOwnerKind(owner.Kind),
+				Name: owner.Name,
+			}
+		}
+		ret[i] = api.CodeOwnersRule{
+			Pattern: rule.Pattern,
+			Owners:  owners,
+		}
+	}
+	return ret
+}
+
+func FromAPITeamMembersChange(change api.TeamMembersChange) models.TeamMembersChange {
+	ret := models.TeamMembersChange{TeamName: change.TeamName}
+	if change.Add != nil {
+		ret.Add = FromAPIMembers(*change.Add)
+	}
+	if change.Remove != nil {
+		ret.Remove = *change.Remove
+	}
+	if change.Move != nil {
+		ret.Move = *change.Move
+	}
+	return ret
+}
+
+func ToAPITeamDeletion(deletion *models.TeamDeletion) api.TeamDeletion {
+	releasedMembers := deletion.ReleasedMembers
+	if releasedMembers == nil {
+		releasedMembers = []string{}
+	}
+	return api.TeamDeletion{
+		TeamName:          deletion.TeamName,
+		ReleasedMembers:   releasedMembers,
+		UnassignedReviews: deletion.UnassignedReviews,
+	}
+}
+
+func ToAPITeamAuditEntries(entries []models.TeamAuditEntry) []api.TeamAuditEntry {
+	ret := make([]api.TeamAuditEntry, len(entries))
+	for i, entry := range entries {
+		ret[i] = api.TeamAuditEntry{
+			Action:           api.TeamAuditEntryAction(entry.Action),
+			TeamName:         entry.TeamName,
+			UserId:           entry.UserID,
+			PreviousTeamName: entry.PreviousTeamName,
+			CreatedAt:        entry.CreatedAt,
+		}
+	}
+	return ret
+}
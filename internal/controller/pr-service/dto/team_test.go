@@ -2,6 +2,7 @@ package dto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -131,3 +132,136 @@ func TestToAPITeam(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamPolicyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	sla := 90 * time.Minute
+	slaMinutes := 90
+	maxOpenReviews := 3
+	allow := api.ALLOW
+	reject := api.REJECT
+	defaultMode := api.DEFAULT
+	rotation := api.ROTATION
+	windowDays := 30
+	shortWindowDays := 7
+
+	tests := []struct {
+		name   string
+		api    api.TeamPolicy
+		models models.TeamPolicy
+	}{
+		{
+			name: "with sla",
+			api: api.TeamPolicy{
+				TeamName:           "core",
+				ReviewSlaMinutes:   &slaMinutes,
+				EscalationPolicy:   api.REASSIGN,
+				ShortfallPolicy:    &allow,
+				SelectionMode:      &defaultMode,
+				RotationWindowDays: &windowDays,
+			},
+			models: models.TeamPolicy{
+				TeamName:         "core",
+				ReviewSLA:        &sla,
+				EscalationPolicy: models.EscalationPolicyReassign,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+				RotationWindow:   30 * 24 * time.Hour,
+			},
+		},
+		{
+			name: "escalation disabled",
+			api: api.TeamPolicy{
+				TeamName:           "core",
+				EscalationPolicy:   api.ADDREVIEWER,
+				ShortfallPolicy:    &allow,
+				SelectionMode:      &defaultMode,
+				RotationWindowDays: &windowDays,
+			},
+			models: models.TeamPolicy{
+				TeamName:         "core",
+				EscalationPolicy: models.EscalationPolicyAddReviewer,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+				RotationWindow:   30 * 24 * time.Hour,
+			},
+		},
+		{
+			name: "load cap with reject",
+			api: api.TeamPolicy{
+				TeamName:              "core",
+				EscalationPolicy:      api.REASSIGN,
+				DefaultMaxOpenReviews: &maxOpenReviews,
+				ShortfallPolicy:       &reject,
+				SelectionMode:         &defaultMode,
+				RotationWindowDays:    &windowDays,
+			},
+			models: models.TeamPolicy{
+				TeamName:              "core",
+				EscalationPolicy:      models.EscalationPolicyReassign,
+				DefaultMaxOpenReviews: &maxOpenReviews,
+				ShortfallPolicy:       models.ShortfallPolicyReject,
+				SelectionMode:         models.SelectionModeDefault,
+				RotationWindow:        30 * 24 * time.Hour,
+			},
+		},
+		{
+			name: "rotation",
+			api: api.TeamPolicy{
+				TeamName:           "core",
+				EscalationPolicy:   api.REASSIGN,
+				ShortfallPolicy:    &allow,
+				SelectionMode:      &rotation,
+				RotationWindowDays: &shortWindowDays,
+			},
+			models: models.TeamPolicy{
+				TeamName:         "core",
+				EscalationPolicy: models.EscalationPolicyReassign,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeRotation,
+				RotationWindow:   7 * 24 * time.Hour,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.models, FromAPITeamPolicy(tt.api))
+			assert.Equal(t, &tt.api, ToAPITeamPolicy(&tt.models))
+		})
+	}
+}
+
+func TestFromAPITeamPolicyDefaultsShortfall(t *testing.T) {
+	t.Parallel()
+
+	policy := FromAPITeamPolicy(api.TeamPolicy{TeamName: "core", EscalationPolicy: api.REASSIGN})
+	assert.Equal(t, models.ShortfallPolicyAllow, policy.ShortfallPolicy)
+	assert.Equal(t, models.SelectionModeDefault, policy.SelectionMode)
+	assert.Equal(t, 30*24*time.Hour, policy.RotationWindow)
+}
+
+func TestFromAPITeamMembersChange(t *testing.T) {
+	t.Parallel()
+
+	move := true
+	add := []api.TeamMember{{UserId: "u3", Username: "Carol", IsActive: true}}
+	remove := []string{"u2"}
+
+	assert.Equal(t,
+		models.TeamMembersChange{TeamName: "core"},
+		FromAPITeamMembersChange(api.TeamMembersChange{TeamName: "core"}),
+	)
+	assert.Equal(t,
+		models.TeamMembersChange{
+			TeamName: "core",
+			Add:      []models.Member{{UserID: "u3", Username: "Carol", IsActive: true}},
+			Remove:   []string{"u2"},
+			Move:     true,
+		},
+		FromAPITeamMembersChange(api.TeamMembersChange{TeamName: "core", Add: &add, Remove: &remove, Move: &move}),
+	)
+}
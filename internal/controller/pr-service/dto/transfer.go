@@ -0,0 +1,203 @@
+package dto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+// строка NDJSON длиннее этого считается ошибкой: в ней не может быть одной сущности
+const maxNDJSONLine = 1 << 20
+
+// ParseNDJSON разбирает тело импорта построчно. Пустые строки пропускаются,
+// строки с некорректным JSON попадают в ошибки пакета, не прерывая разбор.
+func ParseNDJSON(body io.Reader) models.ImportBatch {
+	var batch models.ImportBatch
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLine)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		var record api.TransferRecord
+		if err := json.Unmarshal(raw, &record); err != nil {
+			batch.Errors = append(batch.Errors, models.ImportError{Line: line, Message: "invalid JSON: " + err.Error()})
+			continue
+		}
+		addTransferRecord(&batch, record, line)
+	}
+	if err := scanner.Err(); err != nil {
+		batch.Errors = append(batch.Errors, models.ImportError{Line: line + 1, Message: "read body: " + err.Error()})
+	}
+
+	return batch
+}
+
+// FromAPITransferRecords нумерует элементы JSON-массива с единицы, как строки NDJSON
+func FromAPITransferRecords(records []api.TransferRecord) models.ImportBatch {
+	var batch models.ImportBatch
+	for i, record := range records {
+		addTransferRecord(&batch, record, i+1)
+	}
+	return batch
+}
+
+func addTransferRecord(batch *models.ImportBatch, record api.TransferRecord, line int) {
+	converted, err := FromAPITransferRecord(record, line)
+	if err != nil {
+		batch.Errors = append(batch.Errors, models.ImportError{Line: line, Message: err.Error()})
+		return
+	}
+	batch.Records = append(batch.Records, converted)
+}
+
+func FromAPITransferRecord(record api.TransferRecord, line int) (models.TransferRecord, error) {
+	ret := models.TransferRecord{
+		Kind: models.TransferKind(record.Kind),
+		Line: line,
+	}
+
+	// поле, не соответствующее kind, игнорируется; отсутствие нужного проверит usecase
+	switch ret.Kind {
+	case models.TransferKindTeam:
+		if record.Team != nil {
+			ret.Team = &models.Team{
+				Name:    record.Team.TeamName,
+				Members: FromAPIMembers(record.Team.Members),
+			}
+		}
+
+	case models.TransferKindUser:
+		if record.User != nil {
+			workingHours, err := FromAPIWorkingHours(record.User.WorkStart, record.User.WorkEnd)
+			if err != nil {
+				return ret, err
+			}
+			ret.User = &models.User{
+				ID:             record.User.UserId,
+				Name:           record.User.Username,
+				IsActive:       record.User.IsActive,
+				TimeZone:       valueOrEmpty(record.User.TimeZone),
+				WorkingHours:   workingHours,
+				MaxOpenReviews: record.User.MaxOpenReviews,
+			}
+		}
+
+	case models.TransferKindPullRequest:
+		if record.PullRequest != nil {
+			pr := record.PullRequest
+			status, err := transferStatusFromAPI(pr.Status)
+			if err != nil {
+				return ret, err
+			}
+			ret.PR = &models.PR{
+				ID:                pr.PullRequestId,
+				Name:              pr.PullRequestName,
+				AuthorID:          pr.AuthorId,
+				Status:            status,
+				AssignedReviewers: pr.AssignedReviewers,
+				CreatedAt:         pr.CreatedAt,
+				MergedAt:          pr.MergedAt,
+				Repository:        valueOrEmpty(pr.Repository),
+				TeamName:          valueOrEmpty(pr.TeamName),
+			}
+			if pr.FallbackReviewers != nil {
+				ret.PR.FallbackReviewers = *pr.FallbackReviewers
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+func ToAPITransferRecord(record models.TransferRecord) api.TransferRecord {
+	ret := api.TransferRecord{Kind: api.TransferRecordKind(record.Kind)}
+
+	switch {
+	case record.Team != nil:
+		ret.Team = ToAPITeam(record.Team)
+
+	case record.User != nil:
+		user := ToAPIUser(record.User)
+		ret.User = &api.TransferUser{
+			UserId:         user.UserId,
+			Username:       user.Username,
+			IsActive:       user.IsActive,
+			TimeZone:       user.TimeZone,
+			WorkStart:      user.WorkStart,
+			WorkEnd:        user.WorkEnd,
+			MaxOpenReviews: user.MaxOpenReviews,
+		}
+
+	case record.PR != nil:
+		pr := ToAPIPullRequest(record.PR)
+		ret.PullRequest = &api.TransferPullRequest{
+			PullRequestId:     pr.PullRequestId,
+			PullRequestName:   pr.PullRequestName,
+			AuthorId:          pr.AuthorId,
+			Status:            api.TransferPullRequestStatus(pr.Status),
+			AssignedReviewers: pr.AssignedReviewers,
+			FallbackReviewers: pr.FallbackReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+			Repository:        pr.Repository,
+		}
+		if record.PR.TeamName != "" {
+			ret.PullRequest.TeamName = &record.PR.TeamName
+		}
+	}
+
+	return ret
+}
+
+func ToAPIImportReport(report *models.ImportReport) api.ImportReport {
+	errs := make([]api.ImportError, len(report.Errors))
+	for i, e := range report.Errors {
+		errs[i] = api.ImportError{Line: e.Line, Message: e.Message}
+	}
+	return api.ImportReport{
+		DryRun:       report.DryRun,
+		Applied:      report.Applied,
+		Teams:        toAPIImportCounts(report.Teams),
+		Users:        toAPIImportCounts(report.Users),
+		PullRequests: toAPIImportCounts(report.PullRequests),
+		Errors:       errs,
+	}
+}
+
+func toAPIImportCounts(counts models.ImportCounts) api.ImportCounts {
+	return api.ImportCounts{
+		Created:   counts.Created,
+		Updated:   counts.Updated,
+		Unchanged: counts.Unchanged,
+	}
+}
+
+func transferStatusFromAPI(status api.TransferPullRequestStatus) (models.PRStatus, error) {
+	switch status {
+	case api.OPEN:
+		return models.PRStatusOPEN, nil
+	case api.MERGED:
+		return models.PRStatusMERGED, nil
+	default:
+		return 0, fmt.Errorf("unknown status %q", status)
+	}
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
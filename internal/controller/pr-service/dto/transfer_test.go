@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func TestParseNDJSON(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 12, 22, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		body     string
+		expected models.ImportBatch
+	}{
+		{
+			name: "records of every kind",
+			body: `{"kind":"team","team":{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}}
+{"kind":"user","user":{"user_id":"u1","username":"Alice","is_active":true,"time_zone":"Europe/Moscow","work_start":"09:00","work_end":"18:00"}}
+
+{"kind":"pull_request","pull_request":{"pull_request_id":"pr-1","pull_request_name":"feature","author_id":"u1","status":"OPEN","assigned_reviewers":[],"createdAt":"2025-12-22T10:00:00Z","team_name":"backend"}}
+`,
+			expected: models.ImportBatch{Records: []models.TransferRecord{
+				{Kind: models.TransferKindTeam, Line: 1, Team: &models.Team{
+					Name:    "backend",
+					Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+				}},
+				{Kind: models.TransferKindUser, Line: 2, User: &models.User{
+					ID:           "u1",
+					Name:         "Alice",
+					IsActive:     true,
+					TimeZone:     "Europe/Moscow",
+					WorkingHours: &models.WorkingHours{Start: 9 * time.Hour, End: 18 * time.Hour},
+				}},
+				{Kind: models.TransferKindPullRequest, Line: 4, PR: &models.PR{
+					ID:                "pr-1",
+					Name:              "feature",
+					AuthorID:          "u1",
+					Status:            models.PRStatusOPEN,
+					AssignedReviewers: []string{},
+					CreatedAt:         &createdAt,
+					TeamName:          "backend",
+				}},
+			}},
+		},
+		{
+			name: "invalid lines",
+			body: `{"kind":"user",
+{"kind":"user","user":{"user_id":"u1","username":"Alice","is_active":true,"work_start":"9am","work_end":"18:00"}}
+{"kind":"pull_request","pull_request":{"pull_request_id":"pr-1","pull_request_name":"feature","author_id":"u1","status":"CLOSED","assigned_reviewers":[]}}`,
+			expected: models.ImportBatch{Errors: []models.ImportError{
+				{Line: 1, Message: "invalid JSON: unexpected end of JSON input"},
+				{Line: 2, Message: modelsErr.ErrInvalidSchedule.Error()},
+				{Line: 3, Message: `unknown status "CLOSED"`},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, ParseNDJSON(strings.NewReader(tt.body)))
+		})
+	}
+}
+
+func TestToAPITransferRecord(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 12, 22, 10, 0, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(time.Hour)
+
+	record := ToAPITransferRecord(models.TransferRecord{
+		Kind: models.TransferKindPullRequest,
+		PR: &models.PR{
+			ID:                "pr-1",
+			Name:              "feature",
+			AuthorID:          "u1",
+			Status:            models.PRStatusMERGED,
+			AssignedReviewers: []string{"u2"},
+			FallbackReviewers: []string{"u2"},
+			CreatedAt:         &createdAt,
+			MergedAt:          &mergedAt,
+			Repository:        "api",
+			TeamName:          "backend",
+		},
+	})
+
+	repository, teamName := "api", "backend"
+	require.Equal(t, api.TransferRecord{
+		Kind: api.TransferRecordKindPullRequest,
+		PullRequest: &api.TransferPullRequest{
+			PullRequestId:     "pr-1",
+			PullRequestName:   "feature",
+			AuthorId:          "u1",
+			Status:            api.MERGED,
+			AssignedReviewers: []string{"u2"},
+			FallbackReviewers: &[]string{"u2"},
+			CreatedAt:         &createdAt,
+			MergedAt:          &mergedAt,
+			Repository:        &repository,
+			TeamName:          &teamName,
+		},
+	}, record)
+
+	// экспортированная запись читается импортом без потерь
+	converted, err := FromAPITransferRecord(record, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "backend", converted.PR.TeamName)
+	assert.Equal(t, []string{"u2"}, converted.PR.FallbackReviewers)
+	assert.Equal(t, models.PRStatusMERGED, converted.PR.Status)
+}
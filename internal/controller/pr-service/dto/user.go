@@ -1,18 +1,98 @@
 package dto
 
 import (
+	"fmt"
+	"time"
+
 	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
 	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
 )
 
 func ToAPIUser(user *models.User) *api.User {
 	if user == nil {
 		return nil
 	}
-	return &api.User{
-		UserId:   user.ID,
-		Username: user.Name,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+	apiUser := &api.User{
+		UserId:         user.ID,
+		Username:       user.Name,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+	}
+	if len(user.TeamNames) > 0 {
+		apiUser.TeamNames = &user.TeamNames
+	}
+	if user.TimeZone != "" {
+		apiUser.TimeZone = &user.TimeZone
+	}
+	if user.WorkingHours != nil {
+		start := formatLocalTime(user.WorkingHours.Start)
+		end := formatLocalTime(user.WorkingHours.End)
+		apiUser.WorkStart = &start
+		apiUser.WorkEnd = &end
+	}
+	return apiUser
+}
+
+func FromAPIWorkingHours(start, end *api.LocalTime) (*models.WorkingHours, error) {
+	if start == nil && end == nil {
+		return nil, nil
+	}
+	if start == nil || end == nil {
+		return nil, modelsErr.ErrInvalidSchedule
+	}
+
+	startOffset, err := parseLocalTime(*start)
+	if err != nil {
+		return nil, err
+	}
+	endOffset, err := parseLocalTime(*end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.WorkingHours{
+		Start: startOffset,
+		End:   endOffset,
+	}, nil
+}
+
+func parseLocalTime(value api.LocalTime) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, modelsErr.ErrInvalidSchedule
+	}
+	return time.Duration(parsed.Hour())*time.Hour +
+		time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+func formatLocalTime(offset time.Duration) api.LocalTime {
+	minutes := int(offset / time.Minute)
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func FromAPIOutOfOffice(body api.PostUsersOutOfOfficeJSONRequestBody) models.OutOfOffice {
+	outOfOffice := models.OutOfOffice{
+		UserID: body.UserId,
+		From:   body.From.UTC(),
+		To:     body.To.UTC(),
+	}
+	if body.ReassignReviews != nil {
+		outOfOffice.ReassignReviews = *body.ReassignReviews
+	}
+	return outOfOffice
+}
+
+func ToAPIOutOfOffice(outOfOffice *models.OutOfOffice) *api.OutOfOffice {
+	if outOfOffice == nil {
+		return nil
+	}
+	return &api.OutOfOffice{
+		Id:              outOfOffice.ID,
+		UserId:          outOfOffice.UserID,
+		From:            outOfOffice.From,
+		To:              outOfOffice.To,
+		ReassignReviews: outOfOffice.ReassignReviews,
 	}
 }
@@ -2,12 +2,14 @@ package dto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
 	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
 )
 
 func TestToAPIUser(t *testing.T) {
@@ -33,6 +35,23 @@ func TestToAPIUser(t *testing.T) {
 				IsActive: true,
 			},
 		},
+		{
+			name: "user in several teams",
+			input: &models.User{
+				ID:        "789",
+				Name:      "Carol",
+				TeamName:  "backend",
+				TeamNames: []string{"backend", "platform"},
+				IsActive:  true,
+			},
+			expected: &api.User{
+				UserId:    "789",
+				Username:  "Carol",
+				TeamName:  "backend",
+				TeamNames: &[]string{"backend", "platform"},
+				IsActive:  true,
+			},
+		},
 		{
 			name: "inactive user",
 			input: &models.User{
@@ -44,6 +63,23 @@ func TestToAPIUser(t *testing.T) {
 				IsActive: false,
 			},
 		},
+		{
+			name: "user with schedule",
+			input: &models.User{
+				ID:       "789",
+				TimeZone: "Asia/Tokyo",
+				WorkingHours: &models.WorkingHours{
+					Start: 22 * time.Hour,
+					End:   6*time.Hour + 30*time.Minute,
+				},
+			},
+			expected: &api.User{
+				UserId:    "789",
+				TimeZone:  ptr("Asia/Tokyo"),
+				WorkStart: ptr("22:00"),
+				WorkEnd:   ptr("06:30"),
+			},
+		},
 		{
 			name:     "nil user",
 			input:    nil,
@@ -66,3 +102,74 @@ func TestToAPIUser(t *testing.T) {
 		})
 	}
 }
+
+func TestFromAPIWorkingHours(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		start    *api.LocalTime
+		end      *api.LocalTime
+		expected *models.WorkingHours
+		wantErr  error
+	}{
+		{
+			name:     "both set",
+			start:    ptr("09:15"),
+			end:      ptr("17:45"),
+			expected: &models.WorkingHours{Start: 9*time.Hour + 15*time.Minute, End: 17*time.Hour + 45*time.Minute},
+		},
+		{
+			name:     "none set",
+			expected: nil,
+		},
+		{
+			name:    "only end",
+			end:     ptr("18:00"),
+			wantErr: modelsErr.ErrInvalidSchedule,
+		},
+		{
+			name:    "malformed",
+			start:   ptr("9am"),
+			end:     ptr("18:00"),
+			wantErr: modelsErr.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := FromAPIWorkingHours(tt.start, tt.end)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestFromAPIOutOfOffice(t *testing.T) {
+	t.Parallel()
+
+	moscow := time.FixedZone("UTC+3", 3*60*60)
+	result := FromAPIOutOfOffice(api.PostUsersOutOfOfficeJSONRequestBody{
+		UserId:          "u1",
+		From:            time.Date(2025, 12, 22, 9, 0, 0, 0, moscow),
+		To:              time.Date(2025, 12, 29, 18, 0, 0, 0, moscow),
+		ReassignReviews: ptr(true),
+	})
+
+	assert.Equal(t, models.OutOfOffice{
+		UserID:          "u1",
+		From:            time.Date(2025, 12, 22, 6, 0, 0, 0, time.UTC),
+		To:              time.Date(2025, 12, 29, 15, 0, 0, 0, time.UTC),
+		ReassignReviews: true,
+	}, result)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
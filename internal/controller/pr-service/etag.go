@@ -0,0 +1,32 @@
+package pr_service
+
+import (
+	"strconv"
+	"strings"
+
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// нераспознанный If-Match не совпадает ни с одной версией
+func parseIfMatch(ifMatch *string) (*int64, error) {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "*" {
+		return nil, nil
+	}
+
+	tag := strings.TrimPrefix(strings.TrimSpace(*ifMatch), "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return nil, modelsErr.ErrVersionMismatch
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return nil, modelsErr.ErrVersionMismatch
+	}
+
+	return &version, nil
+}
@@ -2,6 +2,7 @@ package pr_service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Tortik3000/PR-service/internal/models"
 )
@@ -12,16 +13,48 @@ type (
 	userUseCase interface {
 		GetReview(ctx context.Context, userID string) ([]models.PRShort, error)
 		SetIsActive(ctx context.Context, userID string, isActive bool) (*models.User, error)
+		SetSchedule(ctx context.Context, userID, timeZone string, workingHours *models.WorkingHours) (*models.User, error)
+		SetOutOfOffice(ctx context.Context, outOfOffice models.OutOfOffice) (*models.OutOfOffice, error)
+		SetMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error)
 	}
 
 	teamUseCase interface {
 		TeamAdd(ctx context.Context, team models.Team) error
 		TeamGet(ctx context.Context, teamName string) (*models.Team, error)
+		SetTeamPolicy(ctx context.Context, policy models.TeamPolicy) (*models.TeamPolicy, error)
+		SetTeamFallbacks(ctx context.Context, teamName string, fallbackTeams []string) ([]string, error)
+		SetCodeOwners(ctx context.Context, teamName, content string) ([]models.CodeOwnersRule, error)
+		GetCodeOwners(ctx context.Context, teamName string) ([]models.CodeOwnersRule, error)
+		TeamUpdateMembers(ctx context.Context, change models.TeamMembersChange, expectedVersion *int64) (*models.Team, error)
+		TeamRename(ctx context.Context, teamName, newTeamName string, expectedVersion *int64) (*models.Team, error)
+		TeamDelete(ctx context.Context, teamName string, openPRPolicy models.OpenPRPolicy, expectedVersion *int64) (*models.TeamDeletion, error)
+		TeamAudit(ctx context.Context, teamName string) ([]models.TeamAuditEntry, error)
 	}
 
 	pullRequestUseCase interface {
-		PullRequestCreate(ctx context.Context, authorID, prID, prName string) (*models.PR, error)
-		PullRequestMerge(ctx context.Context, prID string) (*models.PR, error)
-		PullRequestReassign(ctx context.Context, prID, oldUserID string) (*models.PR, string, error)
+		PullRequestCreate(ctx context.Context, repository, authorID, prID, prName, teamName string, changedFiles []string) (*models.PR, int, error)
+		PullRequestGet(ctx context.Context, repository, prID string) (*models.PR, error)
+		PullRequestMerge(ctx context.Context, repository, prID string, expectedVersion *int64) (*models.PR, error)
+		PullRequestReassign(ctx context.Context, repository, prID, oldUserID, newUserID string, excludedUserIDs []string, expectedVersion *int64) (*models.PR, string, error)
+		PullRequestAddReviewer(ctx context.Context, repository, prID, reviewerID string, expectedVersion *int64) (*models.PR, error)
+		PullRequestRemoveReviewer(ctx context.Context, repository, prID, reviewerID string, expectedVersion *int64) (*models.PR, error)
+	}
+
+	repositoryUseCase interface {
+		RepositoryAdd(ctx context.Context, repository models.Repository) (*models.Repository, error)
+		RepositoryGet(ctx context.Context, name string) (*models.Repository, error)
+		RepositoryList(ctx context.Context) ([]models.Repository, error)
+		RepositoryUpdate(ctx context.Context, repository models.Repository) (*models.Repository, error)
+		RepositoryDelete(ctx context.Context, name string) error
+	}
+
+	statsUseCase interface {
+		TeamStats(ctx context.Context, query models.TeamStatsQuery) ([]models.TeamStats, uint64, error)
+		TeamPairings(ctx context.Context, teamName string, days int) ([]models.ReviewPairing, time.Time, error)
+	}
+
+	transferUseCase interface {
+		Import(ctx context.Context, batch models.ImportBatch, dryRun bool) (*models.ImportReport, error)
+		Export(ctx context.Context, fn func(record models.TransferRecord) error) error
 	}
 )
@@ -17,21 +17,32 @@ func (p *prService) PostPullRequestCreate(
 ) (api.PostPullRequestCreateResponseObject, error) {
 	body := request.Body
 	p.logger.Info("PostPullRequestCreate called",
+		zap.Stringp("repository", body.Repository),
 		zap.String("author_id", body.AuthorId),
 		zap.String("pr_id", body.PullRequestId),
 		zap.String("pr_name", body.PullRequestName),
 	)
 
-	pr, err := p.pullRequestUseCase.PullRequestCreate(
+	var changedFiles []string
+	if body.ChangedFiles != nil {
+		changedFiles = *body.ChangedFiles
+	}
+
+	pr, shortfall, err := p.pullRequestUseCase.PullRequestCreate(
 		ctx,
+		valueOrDefault(body.Repository, ""),
 		body.AuthorId,
 		body.PullRequestId,
 		body.PullRequestName,
+		valueOrDefault(body.TeamName, ""),
+		changedFiles,
 	)
 
 	if err != nil {
 		switch {
-		case errors.Is(err, modelsErr.ErrTeamNotFound) || errors.Is(err, modelsErr.ErrUserNotFound):
+		case errors.Is(err, modelsErr.ErrTeamNotFound) ||
+			errors.Is(err, modelsErr.ErrUserNotFound) ||
+			errors.Is(err, modelsErr.ErrRepositoryNotFound):
 			return api.PostPullRequestCreate404JSONResponse{
 				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
 			}, nil
@@ -40,6 +51,21 @@ func (p *prService) PostPullRequestCreate(
 			return api.PostPullRequestCreate409JSONResponse{
 				Error: newErrorResponse(api.PREXISTS, err.Error()).Error,
 			}, nil
+
+		case errors.Is(err, modelsErr.ErrNotEnoughReviewers):
+			return api.PostPullRequestCreate409JSONResponse{
+				Error: newErrorResponse(api.NOCANDIDATE, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrUserNotInTeam):
+			return api.PostPullRequestCreate409JSONResponse{
+				Error: newErrorResponse(api.NOTTEAMMEMBER, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrTeamRequired):
+			return api.PostPullRequestCreate400JSONResponse{
+				Error: newErrorResponse(api.TEAMREQUIRED, err.Error()).Error,
+			}, nil
 		default:
 			return nil, modelsErr.ErrInternal
 		}
@@ -51,10 +77,44 @@ func (p *prService) PostPullRequestCreate(
 		zap.String("pr_id", apiPR.PullRequestId),
 		zap.String("pr_name", apiPR.PullRequestName),
 		zap.Strings("pr_assigned_reviewers", apiPR.AssignedReviewers),
+		zap.Int("reviewer_shortfall", shortfall),
 	)
-	return api.PostPullRequestCreate201JSONResponse{
-		Pr: apiPR,
-	}, nil
+	response := api.PostPullRequestCreate201JSONResponse{
+		Headers: api.PostPullRequestCreate201ResponseHeaders{ETag: formatETag(pr.Version)},
+	}
+	response.Body.Pr = apiPR
+	response.Body.ReviewerShortfall = &shortfall
+	return response, nil
+}
+
+func (p *prService) GetPullRequestGet(
+	ctx context.Context,
+	request api.GetPullRequestGetRequestObject,
+) (api.GetPullRequestGetResponseObject, error) {
+	p.logger.Info("GetPullRequestGet called",
+		zap.Stringp("repository", request.Params.Repository),
+		zap.String("pr_id", request.Params.PullRequestId),
+	)
+
+	pr, err := p.pullRequestUseCase.PullRequestGet(
+		ctx, valueOrDefault(request.Params.Repository, ""), request.Params.PullRequestId)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrPRNotFound):
+			return api.GetPullRequestGet404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, "pull request not found").Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	response := api.GetPullRequestGet200JSONResponse{
+		Headers: api.GetPullRequestGet200ResponseHeaders{ETag: formatETag(pr.Version)},
+	}
+	response.Body.Pr = dto.ToAPIPullRequest(pr)
+	return response, nil
 }
 
 func (p *prService) PostPullRequestMerge(
@@ -63,10 +123,19 @@ func (p *prService) PostPullRequestMerge(
 ) (api.PostPullRequestMergeResponseObject, error) {
 	body := request.Body
 	p.logger.Info("PostPullRequestMerge called",
+		zap.Stringp("repository", body.Repository),
 		zap.String("pr_id", body.PullRequestId),
 	)
 
-	pr, err := p.pullRequestUseCase.PullRequestMerge(ctx, body.PullRequestId)
+	expectedVersion, err := parseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return api.PostPullRequestMerge412JSONResponse{
+			Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+		}, nil
+	}
+
+	pr, err := p.pullRequestUseCase.PullRequestMerge(
+		ctx, valueOrDefault(body.Repository, ""), body.PullRequestId, expectedVersion)
 	if err != nil {
 		switch {
 		case errors.Is(err, modelsErr.ErrPRNotFound):
@@ -74,6 +143,11 @@ func (p *prService) PostPullRequestMerge(
 				Error: newErrorResponse(api.NOTFOUND, "pull request not found").Error,
 			}, nil
 
+		case errors.Is(err, modelsErr.ErrVersionMismatch):
+			return api.PostPullRequestMerge412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+			}, nil
+
 		default:
 			return nil, modelsErr.ErrInternal
 		}
@@ -84,9 +158,11 @@ func (p *prService) PostPullRequestMerge(
 		zap.String("pr_id", apiPR.PullRequestId),
 		zap.String("pr_status", string(apiPR.Status)),
 	)
-	return api.PostPullRequestMerge200JSONResponse{
-		Pr: apiPR,
-	}, nil
+	response := api.PostPullRequestMerge200JSONResponse{
+		Headers: api.PostPullRequestMerge200ResponseHeaders{ETag: formatETag(pr.Version)},
+	}
+	response.Body.Pr = apiPR
+	return response, nil
 }
 
 func (p *prService) PostPullRequestReassign(
@@ -95,14 +171,37 @@ func (p *prService) PostPullRequestReassign(
 ) (api.PostPullRequestReassignResponseObject, error) {
 	body := request.Body
 	p.logger.Info("PostPullRequestReassign called",
+		zap.Stringp("repository", body.Repository),
 		zap.String("pr_id", body.PullRequestId),
 		zap.String("old_user_id", body.OldUserId),
+		zap.Stringp("new_user_id", body.NewUserId),
+		zap.Any("exclude_user_ids", body.ExcludeUserIds),
 	)
 
+	var newUserID string
+	if body.NewUserId != nil {
+		newUserID = *body.NewUserId
+	}
+	var excludedUserIDs []string
+	if body.ExcludeUserIds != nil {
+		excludedUserIDs = *body.ExcludeUserIds
+	}
+
+	expectedVersion, err := parseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return api.PostPullRequestReassign412JSONResponse{
+			Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+		}, nil
+	}
+
 	pr, replacedBy, err := p.pullRequestUseCase.PullRequestReassign(
 		ctx,
+		valueOrDefault(body.Repository, ""),
 		body.PullRequestId,
 		body.OldUserId,
+		newUserID,
+		excludedUserIDs,
+		expectedVersion,
 	)
 	if err != nil {
 		switch {
@@ -124,6 +223,31 @@ func (p *prService) PostPullRequestReassign(
 				Error: newErrorResponse(api.NOCANDIDATE, err.Error()).Error,
 			}, nil
 
+		case errors.Is(err, modelsErr.ErrAlreadyAssigned):
+			return api.PostPullRequestReassign409JSONResponse{
+				Error: newErrorResponse(api.ALREADYASSIGNED, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrAuthorReviewer):
+			return api.PostPullRequestReassign409JSONResponse{
+				Error: newErrorResponse(api.AUTHORNOTALLOWED, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrNotTeamMember):
+			return api.PostPullRequestReassign409JSONResponse{
+				Error: newErrorResponse(api.NOTTEAMMEMBER, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrUserInactive):
+			return api.PostPullRequestReassign409JSONResponse{
+				Error: newErrorResponse(api.USERINACTIVE, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrVersionMismatch):
+			return api.PostPullRequestReassign412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+			}, nil
+
 		default:
 			return nil, modelsErr.ErrInternal
 		}
@@ -135,8 +259,143 @@ func (p *prService) PostPullRequestReassign(
 		zap.Strings("pr_assigned_reviewers", apiPR.AssignedReviewers),
 		zap.String("new_user_id", replacedBy),
 	)
-	return api.PostPullRequestReassign200JSONResponse{
-		Pr:         *apiPR,
-		ReplacedBy: replacedBy,
-	}, nil
+	response := api.PostPullRequestReassign200JSONResponse{
+		Headers: api.PostPullRequestReassign200ResponseHeaders{ETag: formatETag(pr.Version)},
+	}
+	response.Body.Pr = *apiPR
+	response.Body.ReplacedBy = replacedBy
+	return response, nil
+}
+
+func (p *prService) PostPullRequestAddReviewer(
+	ctx context.Context,
+	request api.PostPullRequestAddReviewerRequestObject,
+) (api.PostPullRequestAddReviewerResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostPullRequestAddReviewer called",
+		zap.Stringp("repository", body.Repository),
+		zap.String("pr_id", body.PullRequestId),
+		zap.String("user_id", body.UserId),
+	)
+
+	expectedVersion, err := parseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return api.PostPullRequestAddReviewer412JSONResponse{
+			Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+		}, nil
+	}
+
+	pr, err := p.pullRequestUseCase.PullRequestAddReviewer(
+		ctx, valueOrDefault(body.Repository, ""), body.PullRequestId, body.UserId, expectedVersion)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrPRNotFound) || errors.Is(err, modelsErr.ErrUserNotFound):
+			return api.PostPullRequestAddReviewer404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrPRMerged):
+			return api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.PRMERGED, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrAlreadyAssigned):
+			return api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.ALREADYASSIGNED, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrAuthorReviewer):
+			return api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.AUTHORNOTALLOWED, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrNotTeamMember):
+			return api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.NOTTEAMMEMBER, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrUserInactive):
+			return api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.USERINACTIVE, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrVersionMismatch):
+			return api.PostPullRequestAddReviewer412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	apiPR := dto.ToAPIPullRequest(pr)
+	p.logger.Info("PostPullRequestAddReviewer success",
+		zap.String("pr_id", apiPR.PullRequestId),
+		zap.Strings("pr_assigned_reviewers", apiPR.AssignedReviewers),
+	)
+	response := api.PostPullRequestAddReviewer200JSONResponse{
+		Headers: api.PostPullRequestAddReviewer200ResponseHeaders{ETag: formatETag(pr.Version)},
+	}
+	response.Body.Pr = *apiPR
+	return response, nil
+}
+
+func (p *prService) PostPullRequestRemoveReviewer(
+	ctx context.Context,
+	request api.PostPullRequestRemoveReviewerRequestObject,
+) (api.PostPullRequestRemoveReviewerResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostPullRequestRemoveReviewer called",
+		zap.Stringp("repository", body.Repository),
+		zap.String("pr_id", body.PullRequestId),
+		zap.String("user_id", body.UserId),
+	)
+
+	expectedVersion, err := parseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return api.PostPullRequestRemoveReviewer412JSONResponse{
+			Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+		}, nil
+	}
+
+	pr, err := p.pullRequestUseCase.PullRequestRemoveReviewer(
+		ctx, valueOrDefault(body.Repository, ""), body.PullRequestId, body.UserId, expectedVersion)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrPRNotFound):
+			return api.PostPullRequestRemoveReviewer404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrPRMerged):
+			return api.PostPullRequestRemoveReviewer409JSONResponse{
+				Error: newErrorResponse(api.PRMERGED, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrNotAssigned):
+			return api.PostPullRequestRemoveReviewer409JSONResponse{
+				Error: newErrorResponse(api.NOTASSIGNED, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrVersionMismatch):
+			return api.PostPullRequestRemoveReviewer412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	apiPR := dto.ToAPIPullRequest(pr)
+	p.logger.Info("PostPullRequestRemoveReviewer success",
+		zap.String("pr_id", apiPR.PullRequestId),
+		zap.Strings("pr_assigned_reviewers", apiPR.AssignedReviewers),
+	)
+	response := api.PostPullRequestRemoveReviewer200JSONResponse{
+		Headers: api.PostPullRequestRemoveReviewer200ResponseHeaders{ETag: formatETag(pr.Version)},
+	}
+	response.Body.Pr = *apiPR
+	return response, nil
 }
@@ -25,7 +25,15 @@ func TestPostPullRequestCreate(t *testing.T) {
 		Name:              "My PR",
 		Status:            models.PRStatusOPEN,
 		AssignedReviewers: []string{"u2", "u3"},
+		Version:           1,
 	}
+	noShortfall := 0
+	createTeamName := "frontend"
+	created := api.PostPullRequestCreate201JSONResponse{
+		Headers: api.PostPullRequestCreate201ResponseHeaders{ETag: `"1"`},
+	}
+	created.Body.Pr = dto.ToAPIPullRequest(pr)
+	created.Body.ReviewerShortfall = &noShortfall
 	tests := []struct {
 		name         string
 		body         *api.PostPullRequestCreateJSONRequestBody
@@ -42,13 +50,27 @@ func TestPostPullRequestCreate(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestCreate(gomock.Any(), "user1", "pr123", "My PR").
-					Return(pr, nil)
+					PullRequestCreate(gomock.Any(), "", "user1", "pr123", "My PR", "", nil).
+					Return(pr, 0, nil)
 			},
-			expected: api.PostPullRequestCreate201JSONResponse{
-				Pr: dto.ToAPIPullRequest(pr),
+			expected: created,
+			wantErr:  nil,
+		},
+		{
+			name: "changed files are passed to use case",
+			body: &api.PostPullRequestCreateJSONRequestBody{
+				AuthorId:        "user1",
+				PullRequestId:   "pr123",
+				PullRequestName: "My PR",
+				ChangedFiles:    &[]string{"api/pr-service/pr-service.yml"},
 			},
-			wantErr: nil,
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestCreate(gomock.Any(), "", "user1", "pr123", "My PR", "", []string{"api/pr-service/pr-service.yml"}).
+					Return(pr, 0, nil)
+			},
+			expected: created,
+			wantErr:  nil,
 		},
 		{
 			name: "team not found → 404",
@@ -59,8 +81,8 @@ func TestPostPullRequestCreate(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestCreate(gomock.Any(), "userX", "pr404", "PR 404").
-					Return(nil, modelsErr.ErrTeamNotFound)
+					PullRequestCreate(gomock.Any(), "", "userX", "pr404", "PR 404", "", nil).
+					Return(nil, 0, modelsErr.ErrTeamNotFound)
 			},
 			expected: api.PostPullRequestCreate404JSONResponse{
 				Error: struct {
@@ -82,8 +104,8 @@ func TestPostPullRequestCreate(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestCreate(gomock.Any(), "user1", "duplicated", "DUP").
-					Return(nil, modelsErr.ErrPullRequestExist)
+					PullRequestCreate(gomock.Any(), "", "user1", "duplicated", "DUP", "", nil).
+					Return(nil, 0, modelsErr.ErrPullRequestExist)
 			},
 			expected: api.PostPullRequestCreate409JSONResponse{
 				Error: struct {
@@ -96,6 +118,71 @@ func TestPostPullRequestCreate(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "not enough reviewers → 409",
+			body: &api.PostPullRequestCreateJSONRequestBody{
+				AuthorId:        "user1",
+				PullRequestId:   "lonely",
+				PullRequestName: "Lonely",
+			},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestCreate(gomock.Any(), "", "user1", "lonely", "Lonely", "", nil).
+					Return(nil, 0, modelsErr.ErrNotEnoughReviewers)
+			},
+			expected: api.PostPullRequestCreate409JSONResponse{
+				Error: newErrorResponse(api.NOCANDIDATE, modelsErr.ErrNotEnoughReviewers.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "explicit team → 201",
+			body: &api.PostPullRequestCreateJSONRequestBody{
+				AuthorId:        "user1",
+				PullRequestId:   "pr123",
+				PullRequestName: "My PR",
+				TeamName:        &createTeamName,
+			},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestCreate(gomock.Any(), "", "user1", "pr123", "My PR", "frontend", nil).
+					Return(pr, 0, nil)
+			},
+			expected: created,
+		},
+		{
+			name: "team required → 400",
+			body: &api.PostPullRequestCreateJSONRequestBody{
+				AuthorId:        "user1",
+				PullRequestId:   "pr123",
+				PullRequestName: "My PR",
+			},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestCreate(gomock.Any(), "", "user1", "pr123", "My PR", "", nil).
+					Return(nil, 0, modelsErr.ErrTeamRequired)
+			},
+			expected: api.PostPullRequestCreate400JSONResponse{
+				Error: newErrorResponse(api.TEAMREQUIRED, modelsErr.ErrTeamRequired.Error()).Error,
+			},
+		},
+		{
+			name: "not a member of team → 409",
+			body: &api.PostPullRequestCreateJSONRequestBody{
+				AuthorId:        "user1",
+				PullRequestId:   "pr123",
+				PullRequestName: "My PR",
+				TeamName:        &createTeamName,
+			},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestCreate(gomock.Any(), "", "user1", "pr123", "My PR", "frontend", nil).
+					Return(nil, 0, modelsErr.ErrUserNotInTeam)
+			},
+			expected: api.PostPullRequestCreate409JSONResponse{
+				Error: newErrorResponse(api.NOTTEAMMEMBER, modelsErr.ErrUserNotInTeam.Error()).Error,
+			},
+		},
 		{
 			name: "unexpected error → 500 (err returned)",
 			body: &api.PostPullRequestCreateJSONRequestBody{
@@ -105,8 +192,8 @@ func TestPostPullRequestCreate(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestCreate(gomock.Any(), "user1", "some", "Some").
-					Return(nil, errors.New("db crash"))
+					PullRequestCreate(gomock.Any(), "", "user1", "some", "Some", "", nil).
+					Return(nil, 0, errors.New("db crash"))
 			},
 			expected: nil,
 			wantErr:  modelsErr.ErrInternal,
@@ -128,6 +215,9 @@ func TestPostPullRequestCreate(t *testing.T) {
 				nil,
 				nil,
 				mockPR,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := svc.PostPullRequestCreate(t.Context(), api.PostPullRequestCreateRequestObject{
@@ -145,6 +235,102 @@ func TestPostPullRequestCreate(t *testing.T) {
 	}
 }
 
+func TestGetPullRequestGet(t *testing.T) {
+	t.Parallel()
+
+	pr := &models.PR{
+		ID:                "pr1",
+		Name:              "Test PR",
+		AuthorID:          "u1",
+		Repository:        "backend-api",
+		Status:            models.PRStatusOPEN,
+		AssignedReviewers: []string{"u2"},
+		Version:           2,
+	}
+	found := api.GetPullRequestGet200JSONResponse{
+		Headers: api.GetPullRequestGet200ResponseHeaders{ETag: `"2"`},
+	}
+	found.Body.Pr = dto.ToAPIPullRequest(pr)
+	repository := "backend-api"
+
+	tests := []struct {
+		name         string
+		params       api.GetPullRequestGetParams
+		mockBehavior func(m *mocks.MockpullRequestUseCase)
+		expected     api.GetPullRequestGetResponseObject
+		wantErr      error
+	}{
+		{
+			name:   "success 200",
+			params: api.GetPullRequestGetParams{PullRequestId: "pr1", Repository: &repository},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestGet(gomock.Any(), "backend-api", "pr1").
+					Return(pr, nil)
+			},
+			expected: found,
+			wantErr:  nil,
+		},
+		{
+			name:   "PR not found → 404",
+			params: api.GetPullRequestGetParams{PullRequestId: "not_found"},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestGet(gomock.Any(), "", "not_found").
+					Return(nil, modelsErr.ErrPRNotFound)
+			},
+			expected: api.GetPullRequestGet404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, "pull request not found").Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "unexpected error → 500",
+			params: api.GetPullRequestGetParams{PullRequestId: "prX"},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestGet(gomock.Any(), "", "prX").
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPR := mocks.NewMockpullRequestUseCase(ctrl)
+			tt.mockBehavior(mockPR)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				nil,
+				mockPR,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.GetPullRequestGet(t.Context(),
+				api.GetPullRequestGetRequestObject{Params: tt.params})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestPostPullRequestMerge(t *testing.T) {
 	t.Parallel()
 
@@ -154,10 +340,19 @@ func TestPostPullRequestMerge(t *testing.T) {
 		AuthorID:          "u1",
 		Status:            models.PRStatusMERGED,
 		AssignedReviewers: []string{"u2", "u3"},
+		Version:           3,
+	}
+	merged := api.PostPullRequestMerge200JSONResponse{
+		Headers: api.PostPullRequestMerge200ResponseHeaders{ETag: `"3"`},
 	}
+	merged.Body.Pr = dto.ToAPIPullRequest(mergedPR)
+	ifMatch := `"2"`
+	expectedVersion := int64(2)
+	malformedIfMatch := "not-a-version"
 
 	tests := []struct {
 		name         string
+		ifMatch      *string
 		body         *api.PostPullRequestMergeJSONRequestBody
 		mockBehavior func(m *mocks.MockpullRequestUseCase)
 		expected     api.PostPullRequestMergeResponseObject
@@ -170,11 +365,51 @@ func TestPostPullRequestMerge(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestMerge(gomock.Any(), "pr1").
+					PullRequestMerge(gomock.Any(), "", "pr1", nil).
 					Return(mergedPR, nil)
 			},
-			expected: api.PostPullRequestMerge200JSONResponse{
-				Pr: dto.ToAPIPullRequest(mergedPR),
+			expected: merged,
+			wantErr:  nil,
+		},
+		{
+			name:    "If-Match is passed to use case",
+			ifMatch: &ifMatch,
+			body: &api.PostPullRequestMergeJSONRequestBody{
+				PullRequestId: "pr1",
+			},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestMerge(gomock.Any(), "", "pr1", &expectedVersion).
+					Return(mergedPR, nil)
+			},
+			expected: merged,
+			wantErr:  nil,
+		},
+		{
+			name:    "stale If-Match → 412",
+			ifMatch: &ifMatch,
+			body: &api.PostPullRequestMergeJSONRequestBody{
+				PullRequestId: "pr1",
+			},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestMerge(gomock.Any(), "", "pr1", &expectedVersion).
+					Return(nil, modelsErr.ErrVersionMismatch)
+			},
+			expected: api.PostPullRequestMerge412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, modelsErr.ErrVersionMismatch.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "malformed If-Match → 412",
+			ifMatch: &malformedIfMatch,
+			body: &api.PostPullRequestMergeJSONRequestBody{
+				PullRequestId: "pr1",
+			},
+			mockBehavior: func(_ *mocks.MockpullRequestUseCase) {},
+			expected: api.PostPullRequestMerge412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, modelsErr.ErrVersionMismatch.Error()).Error,
 			},
 			wantErr: nil,
 		},
@@ -185,7 +420,7 @@ func TestPostPullRequestMerge(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestMerge(gomock.Any(), "not_found").
+					PullRequestMerge(gomock.Any(), "", "not_found", nil).
 					Return(nil, modelsErr.ErrPRNotFound)
 			},
 			expected: api.PostPullRequestMerge404JSONResponse{
@@ -206,7 +441,7 @@ func TestPostPullRequestMerge(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestMerge(gomock.Any(), "prX").
+					PullRequestMerge(gomock.Any(), "", "prX", nil).
 					Return(nil, errors.New("db fail"))
 			},
 			expected: nil,
@@ -229,11 +464,15 @@ func TestPostPullRequestMerge(t *testing.T) {
 				nil,
 				nil,
 				mockPR,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := svc.PostPullRequestMerge(t.Context(),
 				api.PostPullRequestMergeRequestObject{
-					Body: tt.body,
+					Params: api.PostPullRequestMergeParams{IfMatch: tt.ifMatch},
+					Body:   tt.body,
 				})
 
 			if tt.wantErr == nil {
@@ -256,6 +495,16 @@ func TestPostPullRequestReassign(t *testing.T) {
 		AuthorID:          "u1",
 		Status:            models.PRStatusOPEN,
 		AssignedReviewers: []string{"u2", "u3"},
+		Version:           2,
+	}
+	pinnedUserID := "u4"
+	reassigned := func(replacedBy string) api.PostPullRequestReassign200JSONResponse {
+		response := api.PostPullRequestReassign200JSONResponse{
+			Headers: api.PostPullRequestReassign200ResponseHeaders{ETag: `"2"`},
+		}
+		response.Body.Pr = *dto.ToAPIPullRequest(pr)
+		response.Body.ReplacedBy = replacedBy
+		return response
 	}
 
 	tests := []struct {
@@ -273,12 +522,38 @@ func TestPostPullRequestReassign(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestReassign(gomock.Any(), pr.ID, "u2").
+					PullRequestReassign(gomock.Any(), "", pr.ID, "u2", "", nil, nil).
 					Return(pr, "NEWUSER", nil)
 			},
-			expected: api.PostPullRequestReassign200JSONResponse{
-				Pr:         *dto.ToAPIPullRequest(pr),
-				ReplacedBy: "NEWUSER",
+			expected: reassigned("NEWUSER"),
+			wantErr:  nil,
+		},
+		{
+			name: "pinned replacement with exclusions",
+			body: &api.PostPullRequestReassignJSONRequestBody{
+				PullRequestId:  pr.ID,
+				OldUserId:      "u2",
+				NewUserId:      &pinnedUserID,
+				ExcludeUserIds: &[]string{"u5"},
+			},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestReassign(gomock.Any(), "", pr.ID, "u2", "u4", []string{"u5"}, nil).
+					Return(pr, "u4", nil)
+			},
+			expected: reassigned("u4"),
+			wantErr:  nil,
+		},
+		{
+			name: "pinned replacement inactive 409",
+			body: &api.PostPullRequestReassignJSONRequestBody{},
+			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
+				m.EXPECT().
+					PullRequestReassign(gomock.Any(), "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(nil, "", modelsErr.ErrUserInactive)
+			},
+			expected: api.PostPullRequestReassign409JSONResponse{
+				Error: newErrorResponse(api.USERINACTIVE, modelsErr.ErrUserInactive.Error()).Error,
 			},
 			wantErr: nil,
 		},
@@ -287,7 +562,7 @@ func TestPostPullRequestReassign(t *testing.T) {
 			body: &api.PostPullRequestReassignJSONRequestBody{},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestReassign(gomock.Any(), gomock.Any(), gomock.Any()).
+					PullRequestReassign(gomock.Any(), "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil).
 					Return(nil, "", modelsErr.ErrPRNotFound)
 			},
 			expected: api.PostPullRequestReassign404JSONResponse{
@@ -300,7 +575,7 @@ func TestPostPullRequestReassign(t *testing.T) {
 			body: &api.PostPullRequestReassignJSONRequestBody{},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestReassign(gomock.Any(), gomock.Any(), gomock.Any()).
+					PullRequestReassign(gomock.Any(), "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil).
 					Return(nil, "", modelsErr.ErrUserNotFound)
 			},
 			expected: api.PostPullRequestReassign404JSONResponse{
@@ -313,7 +588,7 @@ func TestPostPullRequestReassign(t *testing.T) {
 			body: &api.PostPullRequestReassignJSONRequestBody{},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestReassign(gomock.Any(), gomock.Any(), gomock.Any()).
+					PullRequestReassign(gomock.Any(), "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil).
 					Return(nil, "", modelsErr.ErrPRMerged)
 			},
 			expected: api.PostPullRequestReassign409JSONResponse{
@@ -326,7 +601,7 @@ func TestPostPullRequestReassign(t *testing.T) {
 			body: &api.PostPullRequestReassignJSONRequestBody{},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestReassign(gomock.Any(), gomock.Any(), gomock.Any()).
+					PullRequestReassign(gomock.Any(), "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil).
 					Return(nil, "", modelsErr.ErrNotAssigned)
 			},
 			expected: api.PostPullRequestReassign409JSONResponse{
@@ -342,7 +617,7 @@ func TestPostPullRequestReassign(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestReassign(gomock.Any(), gomock.Any(), gomock.Any()).
+					PullRequestReassign(gomock.Any(), "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil).
 					Return(nil, "", modelsErr.ErrNotActiveCandidate)
 			},
 			expected: api.PostPullRequestReassign409JSONResponse{
@@ -358,7 +633,7 @@ func TestPostPullRequestReassign(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockpullRequestUseCase) {
 				m.EXPECT().
-					PullRequestReassign(gomock.Any(), "err", "err").
+					PullRequestReassign(gomock.Any(), "", "err", "err", "", nil, nil).
 					Return(nil, "", errors.New("db fail"))
 			},
 			expected: nil,
@@ -381,6 +656,9 @@ func TestPostPullRequestReassign(t *testing.T) {
 				nil,
 				nil,
 				mockPR,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := svc.PostPullRequestReassign(t.Context(),
@@ -398,3 +676,239 @@ func TestPostPullRequestReassign(t *testing.T) {
 		})
 	}
 }
+
+func TestPostPullRequestAddReviewer(t *testing.T) {
+	t.Parallel()
+
+	pr := &models.PR{
+		ID:                "pr1",
+		Name:              "PR name",
+		AuthorID:          "u1",
+		Status:            models.PRStatusOPEN,
+		AssignedReviewers: []string{"u2", "u3"},
+		Version:           4,
+	}
+	added := api.PostPullRequestAddReviewer200JSONResponse{
+		Headers: api.PostPullRequestAddReviewer200ResponseHeaders{ETag: `"4"`},
+	}
+	added.Body.Pr = *dto.ToAPIPullRequest(pr)
+	body := &api.PostPullRequestAddReviewerJSONRequestBody{
+		PullRequestId: pr.ID,
+		UserId:        "u3",
+	}
+
+	tests := []struct {
+		name       string
+		useCasePR  *models.PR
+		useCaseErr error
+		expected   api.PostPullRequestAddReviewerResponseObject
+		wantErr    error
+	}{
+		{
+			name:      "success 200",
+			useCasePR: pr,
+			expected:  added,
+		},
+		{
+			name:       "stale If-Match 412",
+			useCaseErr: modelsErr.ErrVersionMismatch,
+			expected: api.PostPullRequestAddReviewer412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, modelsErr.ErrVersionMismatch.Error()).Error,
+			},
+		},
+		{
+			name:       "PR not found 404",
+			useCaseErr: modelsErr.ErrPRNotFound,
+			expected: api.PostPullRequestAddReviewer404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrPRNotFound.Error()).Error,
+			},
+		},
+		{
+			name:       "user not found 404",
+			useCaseErr: modelsErr.ErrUserNotFound,
+			expected: api.PostPullRequestAddReviewer404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrUserNotFound.Error()).Error,
+			},
+		},
+		{
+			name:       "PR merged 409",
+			useCaseErr: modelsErr.ErrPRMerged,
+			expected: api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.PRMERGED, modelsErr.ErrPRMerged.Error()).Error,
+			},
+		},
+		{
+			name:       "already assigned 409",
+			useCaseErr: modelsErr.ErrAlreadyAssigned,
+			expected: api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.ALREADYASSIGNED, modelsErr.ErrAlreadyAssigned.Error()).Error,
+			},
+		},
+		{
+			name:       "author 409",
+			useCaseErr: modelsErr.ErrAuthorReviewer,
+			expected: api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.AUTHORNOTALLOWED, modelsErr.ErrAuthorReviewer.Error()).Error,
+			},
+		},
+		{
+			name:       "not team member 409",
+			useCaseErr: modelsErr.ErrNotTeamMember,
+			expected: api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.NOTTEAMMEMBER, modelsErr.ErrNotTeamMember.Error()).Error,
+			},
+		},
+		{
+			name:       "inactive 409",
+			useCaseErr: modelsErr.ErrUserInactive,
+			expected: api.PostPullRequestAddReviewer409JSONResponse{
+				Error: newErrorResponse(api.USERINACTIVE, modelsErr.ErrUserInactive.Error()).Error,
+			},
+		},
+		{
+			name:       "unexpected error 500",
+			useCaseErr: errors.New("db fail"),
+			wantErr:    modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPR := mocks.NewMockpullRequestUseCase(ctrl)
+			mockPR.EXPECT().
+				PullRequestAddReviewer(gomock.Any(), "", pr.ID, "u3", nil).
+				Return(tt.useCasePR, tt.useCaseErr)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				nil,
+				mockPR,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostPullRequestAddReviewer(t.Context(), api.PostPullRequestAddReviewerRequestObject{
+				Body: body,
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPostPullRequestRemoveReviewer(t *testing.T) {
+	t.Parallel()
+
+	pr := &models.PR{
+		ID:                "pr1",
+		Name:              "PR name",
+		AuthorID:          "u1",
+		Status:            models.PRStatusOPEN,
+		AssignedReviewers: []string{"u2"},
+		Version:           5,
+	}
+	removed := api.PostPullRequestRemoveReviewer200JSONResponse{
+		Headers: api.PostPullRequestRemoveReviewer200ResponseHeaders{ETag: `"5"`},
+	}
+	removed.Body.Pr = *dto.ToAPIPullRequest(pr)
+	body := &api.PostPullRequestRemoveReviewerJSONRequestBody{
+		PullRequestId: pr.ID,
+		UserId:        "u3",
+	}
+
+	tests := []struct {
+		name       string
+		useCasePR  *models.PR
+		useCaseErr error
+		expected   api.PostPullRequestRemoveReviewerResponseObject
+		wantErr    error
+	}{
+		{
+			name:      "success 200",
+			useCasePR: pr,
+			expected:  removed,
+		},
+		{
+			name:       "stale If-Match 412",
+			useCaseErr: modelsErr.ErrVersionMismatch,
+			expected: api.PostPullRequestRemoveReviewer412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, modelsErr.ErrVersionMismatch.Error()).Error,
+			},
+		},
+		{
+			name:       "PR not found 404",
+			useCaseErr: modelsErr.ErrPRNotFound,
+			expected: api.PostPullRequestRemoveReviewer404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrPRNotFound.Error()).Error,
+			},
+		},
+		{
+			name:       "PR merged 409",
+			useCaseErr: modelsErr.ErrPRMerged,
+			expected: api.PostPullRequestRemoveReviewer409JSONResponse{
+				Error: newErrorResponse(api.PRMERGED, modelsErr.ErrPRMerged.Error()).Error,
+			},
+		},
+		{
+			name:       "not assigned 409",
+			useCaseErr: modelsErr.ErrNotAssigned,
+			expected: api.PostPullRequestRemoveReviewer409JSONResponse{
+				Error: newErrorResponse(api.NOTASSIGNED, modelsErr.ErrNotAssigned.Error()).Error,
+			},
+		},
+		{
+			name:       "unexpected error 500",
+			useCaseErr: errors.New("db fail"),
+			wantErr:    modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPR := mocks.NewMockpullRequestUseCase(ctrl)
+			mockPR.EXPECT().
+				PullRequestRemoveReviewer(gomock.Any(), "", pr.ID, "u3", nil).
+				Return(tt.useCasePR, tt.useCaseErr)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				nil,
+				mockPR,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostPullRequestRemoveReviewer(t.Context(), api.PostPullRequestRemoveReviewerRequestObject{
+				Body: body,
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
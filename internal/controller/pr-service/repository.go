@@ -0,0 +1,165 @@
+package pr_service
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/dto"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (p *prService) PostRepositoryAdd(
+	ctx context.Context,
+	request api.PostRepositoryAddRequestObject,
+) (api.PostRepositoryAddResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostRepositoryAdd called",
+		zap.String("repository", body.RepositoryName),
+		zap.Stringp("owner_team", body.OwnerTeam),
+	)
+
+	repository, err := p.repositoryUseCase.RepositoryAdd(ctx, dto.FromAPIRepository(*body))
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrRepositoryExist):
+			return api.PostRepositoryAdd400JSONResponse{
+				Error: newErrorResponse(api.REPOSITORYEXISTS, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.PostRepositoryAdd404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostRepositoryAdd success",
+		zap.String("repository", repository.Name),
+	)
+
+	return api.PostRepositoryAdd201JSONResponse{
+		Repository: *dto.ToAPIRepository(repository),
+	}, nil
+}
+
+func (p *prService) GetRepositoryGet(
+	ctx context.Context,
+	request api.GetRepositoryGetRequestObject,
+) (api.GetRepositoryGetResponseObject, error) {
+	p.logger.Info("GetRepositoryGet called",
+		zap.String("repository", request.Params.RepositoryName),
+	)
+
+	repository, err := p.repositoryUseCase.RepositoryGet(ctx, request.Params.RepositoryName)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrRepositoryNotFound):
+			return api.GetRepositoryGet404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("GetRepositoryGet success",
+		zap.String("repository", repository.Name),
+	)
+
+	return api.GetRepositoryGet200JSONResponse(*dto.ToAPIRepository(repository)), nil
+}
+
+func (p *prService) GetRepositoryList(
+	ctx context.Context,
+	_ api.GetRepositoryListRequestObject,
+) (api.GetRepositoryListResponseObject, error) {
+	p.logger.Info("GetRepositoryList called")
+
+	repositories, err := p.repositoryUseCase.RepositoryList(ctx)
+	if err != nil {
+		return nil, modelsErr.ErrInternal
+	}
+
+	p.logger.Info("GetRepositoryList success",
+		zap.Int("repositories", len(repositories)),
+	)
+
+	return api.GetRepositoryList200JSONResponse{
+		Repositories: dto.ToAPIRepositories(repositories),
+	}, nil
+}
+
+func (p *prService) PutRepositoryUpdate(
+	ctx context.Context,
+	request api.PutRepositoryUpdateRequestObject,
+) (api.PutRepositoryUpdateResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PutRepositoryUpdate called",
+		zap.String("repository", body.RepositoryName),
+		zap.Stringp("owner_team", body.OwnerTeam),
+	)
+
+	repository, err := p.repositoryUseCase.RepositoryUpdate(ctx, dto.FromAPIRepository(*body))
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrRepositoryNotFound) || errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.PutRepositoryUpdate404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PutRepositoryUpdate success",
+		zap.String("repository", repository.Name),
+	)
+
+	return api.PutRepositoryUpdate200JSONResponse{
+		Repository: *dto.ToAPIRepository(repository),
+	}, nil
+}
+
+func (p *prService) DeleteRepository(
+	ctx context.Context,
+	request api.DeleteRepositoryRequestObject,
+) (api.DeleteRepositoryResponseObject, error) {
+	name := request.Params.RepositoryName
+	p.logger.Info("DeleteRepository called",
+		zap.String("repository", name),
+	)
+
+	err := p.repositoryUseCase.RepositoryDelete(ctx, name)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrRepositoryNotFound):
+			return api.DeleteRepository404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrRepositoryHasPRs):
+			return api.DeleteRepository409JSONResponse{
+				Error: newErrorResponse(api.REPOSITORYHASPRS, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("DeleteRepository success",
+		zap.String("repository", name),
+	)
+
+	return api.DeleteRepository200JSONResponse{
+		RepositoryName: name,
+	}, nil
+}
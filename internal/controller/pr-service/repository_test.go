@@ -0,0 +1,257 @@
+package pr_service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/dto"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/mocks"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func TestPostRepositoryAdd(t *testing.T) {
+	t.Parallel()
+
+	three := 3
+	repository := &models.Repository{
+		Name:          "api",
+		OwnerTeam:     "backend",
+		OwnerTeamID:   "1",
+		ReviewerCount: &three,
+	}
+	ownerTeam := "backend"
+	body := &api.PostRepositoryAddJSONRequestBody{
+		RepositoryName: "api",
+		OwnerTeam:      &ownerTeam,
+		ReviewerCount:  &three,
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(m *mocks.MockrepositoryUseCase)
+		expected     api.PostRepositoryAddResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 201",
+			mockBehavior: func(m *mocks.MockrepositoryUseCase) {
+				m.EXPECT().
+					RepositoryAdd(gomock.Any(), models.Repository{
+						Name:          "api",
+						OwnerTeam:     "backend",
+						ReviewerCount: &three,
+					}).
+					Return(repository, nil)
+			},
+			expected: api.PostRepositoryAdd201JSONResponse{
+				Repository: *dto.ToAPIRepository(repository),
+			},
+		},
+		{
+			name: "repository exists 400",
+			mockBehavior: func(m *mocks.MockrepositoryUseCase) {
+				m.EXPECT().
+					RepositoryAdd(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrRepositoryExist)
+			},
+			expected: api.PostRepositoryAdd400JSONResponse{
+				Error: newErrorResponse(api.REPOSITORYEXISTS, modelsErr.ErrRepositoryExist.Error()).Error,
+			},
+		},
+		{
+			name: "owner team not found 404",
+			mockBehavior: func(m *mocks.MockrepositoryUseCase) {
+				m.EXPECT().
+					RepositoryAdd(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.PostRepositoryAdd404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+		},
+		{
+			name: "unexpected error 500",
+			mockBehavior: func(m *mocks.MockrepositoryUseCase) {
+				m.EXPECT().
+					RepositoryAdd(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mocks.NewMockrepositoryUseCase(ctrl)
+			tt.mockBehavior(mockRepository)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				nil,
+				nil,
+				nil,
+				mockRepository,
+				nil,
+			)
+
+			resp, err := svc.PostRepositoryAdd(t.Context(),
+				api.PostRepositoryAddRequestObject{Body: body})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPutRepositoryUpdate(t *testing.T) {
+	t.Parallel()
+
+	reject := api.RepositoryShortfallReject
+	rejectPolicy := models.ShortfallPolicyReject
+	repository := &models.Repository{
+		Name:            "api",
+		ShortfallPolicy: &rejectPolicy,
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(m *mocks.MockrepositoryUseCase)
+		expected     api.PutRepositoryUpdateResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			mockBehavior: func(m *mocks.MockrepositoryUseCase) {
+				m.EXPECT().
+					RepositoryUpdate(gomock.Any(), *repository).
+					Return(repository, nil)
+			},
+			expected: api.PutRepositoryUpdate200JSONResponse{
+				Repository: *dto.ToAPIRepository(repository),
+			},
+		},
+		{
+			name: "repository not found 404",
+			mockBehavior: func(m *mocks.MockrepositoryUseCase) {
+				m.EXPECT().
+					RepositoryUpdate(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrRepositoryNotFound)
+			},
+			expected: api.PutRepositoryUpdate404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrRepositoryNotFound.Error()).Error,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mocks.NewMockrepositoryUseCase(ctrl)
+			tt.mockBehavior(mockRepository)
+
+			svc := NewPRService(zap.NewNop(), nil, nil, nil, nil, mockRepository, nil)
+
+			resp, err := svc.PutRepositoryUpdate(t.Context(), api.PutRepositoryUpdateRequestObject{
+				Body: &api.PutRepositoryUpdateJSONRequestBody{
+					RepositoryName:  "api",
+					ShortfallPolicy: &reject,
+				},
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestDeleteRepository(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		useCaseErr error
+		expected   api.DeleteRepositoryResponseObject
+		wantErr    error
+	}{
+		{
+			name: "success 200",
+			expected: api.DeleteRepository200JSONResponse{
+				RepositoryName: "api",
+			},
+		},
+		{
+			name:       "repository not found 404",
+			useCaseErr: modelsErr.ErrRepositoryNotFound,
+			expected: api.DeleteRepository404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrRepositoryNotFound.Error()).Error,
+			},
+		},
+		{
+			name:       "repository has PRs 409",
+			useCaseErr: modelsErr.ErrRepositoryHasPRs,
+			expected: api.DeleteRepository409JSONResponse{
+				Error: newErrorResponse(api.REPOSITORYHASPRS, modelsErr.ErrRepositoryHasPRs.Error()).Error,
+			},
+		},
+		{
+			name:       "unexpected error 500",
+			useCaseErr: errors.New("db fail"),
+			expected:   nil,
+			wantErr:    modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mocks.NewMockrepositoryUseCase(ctrl)
+			mockRepository.EXPECT().RepositoryDelete(gomock.Any(), "api").Return(tt.useCaseErr)
+
+			svc := NewPRService(zap.NewNop(), nil, nil, nil, nil, mockRepository, nil)
+
+			resp, err := svc.DeleteRepository(t.Context(), api.DeleteRepositoryRequestObject{
+				Params: api.DeleteRepositoryParams{RepositoryName: "api"},
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
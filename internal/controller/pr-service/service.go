@@ -9,17 +9,26 @@ type prService struct {
 	userUseCase        userUseCase
 	teamUseCase        teamUseCase
 	pullRequestUseCase pullRequestUseCase
+	statsUseCase       statsUseCase
+	repositoryUseCase  repositoryUseCase
+	transferUseCase    transferUseCase
 }
 
 func NewPRService(
 	logger *zap.Logger,
 	userUseCase userUseCase,
 	teamUseCase teamUseCase,
-	pullRequestUseCase pullRequestUseCase) *prService {
+	pullRequestUseCase pullRequestUseCase,
+	statsUseCase statsUseCase,
+	repositoryUseCase repositoryUseCase,
+	transferUseCase transferUseCase) *prService {
 	return &prService{
 		logger:             logger,
 		userUseCase:        userUseCase,
 		teamUseCase:        teamUseCase,
 		pullRequestUseCase: pullRequestUseCase,
+		statsUseCase:       statsUseCase,
+		repositoryUseCase:  repositoryUseCase,
+		transferUseCase:    transferUseCase,
 	}
 }
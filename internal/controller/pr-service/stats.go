@@ -0,0 +1,112 @@
+package pr_service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/dto"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+const (
+	defaultPage      = 1
+	defaultPageSize  = 20
+	defaultWeeks     = 4
+	defaultStaleDays = 7
+	defaultPairDays  = 30
+)
+
+func (p *prService) GetStatsTeams(
+	ctx context.Context,
+	request api.GetStatsTeamsRequestObject,
+) (api.GetStatsTeamsResponseObject, error) {
+	params := request.Params
+	query := models.TeamStatsQuery{
+		Page:      uint64(valueOrDefault(params.Page, defaultPage)),
+		PageSize:  uint64(valueOrDefault(params.PageSize, defaultPageSize)),
+		Weeks:     valueOrDefault(params.Weeks, defaultWeeks),
+		StaleDays: valueOrDefault(params.StaleDays, defaultStaleDays),
+	}
+	p.logger.Info("GetStatsTeams called",
+		zap.Uint64("page", query.Page),
+		zap.Uint64("page_size", query.PageSize),
+		zap.Int("weeks", query.Weeks),
+		zap.Int("stale_days", query.StaleDays),
+	)
+
+	stats, total, err := p.statsUseCase.TeamStats(ctx, query)
+	if err != nil {
+		return nil, modelsErr.ErrInternal
+	}
+
+	p.logger.Info("GetStatsTeams success",
+		zap.Int("teams", len(stats)),
+		zap.Uint64("total", total),
+	)
+
+	if acceptsCSV(ctx) {
+		body, err := dto.TeamStatsToCSV(stats)
+		if err != nil {
+			p.logger.Error("GetStatsTeams csv", zap.Error(err))
+			return nil, modelsErr.ErrInternal
+		}
+		return api.GetStatsTeams200TextcsvResponse{
+			Body:          bytes.NewReader(body),
+			ContentLength: int64(len(body)),
+		}, nil
+	}
+
+	return api.GetStatsTeams200JSONResponse{
+		Teams:    dto.ToAPITeamStats(stats),
+		Page:     int(query.Page),
+		PageSize: int(query.PageSize),
+		Total:    int(total),
+	}, nil
+}
+
+func (p *prService) GetStatsPairings(
+	ctx context.Context,
+	request api.GetStatsPairingsRequestObject,
+) (api.GetStatsPairingsResponseObject, error) {
+	teamName := request.Params.TeamName
+	days := valueOrDefault(request.Params.Days, defaultPairDays)
+	p.logger.Info("GetStatsPairings called",
+		zap.String("team_name", teamName),
+		zap.Int("days", days),
+	)
+
+	pairings, since, err := p.statsUseCase.TeamPairings(ctx, teamName, days)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.GetStatsPairings404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("GetStatsPairings success",
+		zap.String("team_name", teamName),
+		zap.Int("pairings", len(pairings)),
+	)
+
+	return api.GetStatsPairings200JSONResponse{
+		TeamName: teamName,
+		Since:    since,
+		Pairings: dto.ToAPIReviewPairings(pairings),
+	}, nil
+}
+
+func valueOrDefault[T any](value *T, def T) T {
+	if value == nil {
+		return def
+	}
+	return *value
+}
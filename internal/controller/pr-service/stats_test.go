@@ -0,0 +1,258 @@
+package pr_service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/dto"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/mocks"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func TestGetStatsTeams(t *testing.T) {
+	t.Parallel()
+
+	p50 := time.Hour
+	stats := []models.TeamStats{
+		{
+			TeamName: "backend",
+			Weekly: []models.WeeklyThroughput{
+				{WeekStart: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), Opened: 2, Merged: 1},
+			},
+			TimeToMergeP50: &p50,
+			ActiveMembers:  1,
+			ReviewerCount:  2,
+			Understaffed:   true,
+		},
+	}
+	page, pageSize := 2, 5
+
+	tests := []struct {
+		name         string
+		params       api.GetStatsTeamsParams
+		accept       string
+		mockBehavior func(m *mocks.MockstatsUseCase)
+		expected     api.GetStatsTeamsResponseObject
+		expectedCSV  string
+		wantErr      error
+	}{
+		{
+			name:   "defaults json 200",
+			params: api.GetStatsTeamsParams{},
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamStats(gomock.Any(), models.TeamStatsQuery{
+						Page:      defaultPage,
+						PageSize:  defaultPageSize,
+						Weeks:     defaultWeeks,
+						StaleDays: defaultStaleDays,
+					}).
+					Return(stats, uint64(1), nil)
+			},
+			expected: api.GetStatsTeams200JSONResponse{
+				Teams:    dto.ToAPITeamStats(stats),
+				Page:     defaultPage,
+				PageSize: defaultPageSize,
+				Total:    1,
+			},
+		},
+		{
+			name:   "explicit page json 200",
+			params: api.GetStatsTeamsParams{Page: &page, PageSize: &pageSize},
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamStats(gomock.Any(), models.TeamStatsQuery{
+						Page:      2,
+						PageSize:  5,
+						Weeks:     defaultWeeks,
+						StaleDays: defaultStaleDays,
+					}).
+					Return([]models.TeamStats{}, uint64(3), nil)
+			},
+			expected: api.GetStatsTeams200JSONResponse{
+				Teams:    []api.TeamStats{},
+				Page:     2,
+				PageSize: 5,
+				Total:    3,
+			},
+		},
+		{
+			name:   "csv 200",
+			params: api.GetStatsTeamsParams{},
+			accept: "text/csv",
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamStats(gomock.Any(), gomock.Any()).
+					Return(stats, uint64(1), nil)
+			},
+			expectedCSV: "team_name,week_start,opened,merged,time_to_merge_p50_seconds,time_to_merge_p90_seconds," +
+				"stale_open_prs,active_members,reviewer_count,understaffed\n" +
+				"backend,2025-10-20,2,1,3600,,0,1,2,true\n",
+		},
+		{
+			name:   "unexpected error 500",
+			params: api.GetStatsTeamsParams{},
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamStats(gomock.Any(), gomock.Any()).
+					Return(nil, uint64(0), modelsErr.ErrInternal)
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStats := mocks.NewMockstatsUseCase(ctrl)
+			tt.mockBehavior(mockStats)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				nil,
+				nil,
+				mockStats,
+				nil,
+				nil,
+			)
+
+			ctx := context.WithValue(t.Context(), acceptHeaderKey{}, tt.accept)
+			resp, err := svc.GetStatsTeams(ctx, api.GetStatsTeamsRequestObject{
+				Params: tt.params,
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expectedCSV != "" {
+				csvResp, ok := resp.(api.GetStatsTeams200TextcsvResponse)
+				require.True(t, ok)
+				body, err := io.ReadAll(csvResp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCSV, string(body))
+				return
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetStatsPairings(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, 9, 22, 15, 0, 0, 0, time.UTC)
+	pairings := []models.ReviewPairing{
+		{AuthorID: "u1", ReviewerID: "u2", Reviews: 3},
+	}
+	days := 7
+
+	tests := []struct {
+		name         string
+		params       api.GetStatsPairingsParams
+		mockBehavior func(m *mocks.MockstatsUseCase)
+		expected     api.GetStatsPairingsResponseObject
+		wantErr      error
+	}{
+		{
+			name:   "default window 200",
+			params: api.GetStatsPairingsParams{TeamName: "core"},
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamPairings(gomock.Any(), "core", defaultPairDays).
+					Return(pairings, since, nil)
+			},
+			expected: api.GetStatsPairings200JSONResponse{
+				TeamName: "core",
+				Since:    since,
+				Pairings: dto.ToAPIReviewPairings(pairings),
+			},
+		},
+		{
+			name:   "explicit window 200",
+			params: api.GetStatsPairingsParams{TeamName: "core", Days: &days},
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamPairings(gomock.Any(), "core", 7).
+					Return([]models.ReviewPairing{}, since, nil)
+			},
+			expected: api.GetStatsPairings200JSONResponse{
+				TeamName: "core",
+				Since:    since,
+				Pairings: []api.ReviewPairing{},
+			},
+		},
+		{
+			name:   "team not found 404",
+			params: api.GetStatsPairingsParams{TeamName: "missing"},
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamPairings(gomock.Any(), "missing", defaultPairDays).
+					Return(nil, time.Time{}, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.GetStatsPairings404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+		},
+		{
+			name:   "unexpected error 500",
+			params: api.GetStatsPairingsParams{TeamName: "core"},
+			mockBehavior: func(m *mocks.MockstatsUseCase) {
+				m.EXPECT().
+					TeamPairings(gomock.Any(), "core", defaultPairDays).
+					Return(nil, time.Time{}, modelsErr.ErrInternal)
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStats := mocks.NewMockstatsUseCase(ctrl)
+			tt.mockBehavior(mockStats)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				nil,
+				nil,
+				mockStats,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.GetStatsPairings(t.Context(), api.GetStatsPairingsRequestObject{
+				Params: tt.params,
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
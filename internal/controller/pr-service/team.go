@@ -33,6 +33,11 @@ func (p *prService) PostTeamAdd(
 				Error: newErrorResponse(api.TEAMEXISTS, err.Error()).Error,
 			}, nil
 
+		case errors.Is(err, modelsErr.ErrUserIDTaken):
+			return api.PostTeamAdd400JSONResponse{
+				Error: newErrorResponse(api.USERIDTAKEN, err.Error()).Error,
+			}, nil
+
 		default:
 			return nil, modelsErr.ErrInternal
 		}
@@ -73,7 +78,355 @@ func (p *prService) GetTeamGet(
 		zap.String("team_name", team.Name),
 	)
 	return api.GetTeamGet200JSONResponse{
-		TeamName: team.Name,
-		Members:  dto.ToAPIMembers(team.Members),
+		Body: api.Team{
+			TeamName: team.Name,
+			Members:  dto.ToAPIMembers(team.Members),
+		},
+		Headers: api.GetTeamGet200ResponseHeaders{ETag: formatETag(team.Version)},
+	}, nil
+}
+
+func (p *prService) PostTeamSetPolicy(
+	ctx context.Context,
+	request api.PostTeamSetPolicyRequestObject,
+) (api.PostTeamSetPolicyResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostTeamSetPolicy called",
+		zap.String("team_name", body.TeamName),
+		zap.Any("review_sla_minutes", body.ReviewSlaMinutes),
+		zap.String("escalation_policy", string(body.EscalationPolicy)),
+	)
+
+	policy, err := p.teamUseCase.SetTeamPolicy(ctx, dto.FromAPITeamPolicy(*body))
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.PostTeamSetPolicy404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostTeamSetPolicy success",
+		zap.String("team_name", policy.TeamName),
+	)
+
+	return api.PostTeamSetPolicy200JSONResponse{
+		Policy: *dto.ToAPITeamPolicy(policy),
+	}, nil
+}
+
+func (p *prService) PostTeamSetFallbacks(
+	ctx context.Context,
+	request api.PostTeamSetFallbacksRequestObject,
+) (api.PostTeamSetFallbacksResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostTeamSetFallbacks called",
+		zap.String("team_name", body.TeamName),
+		zap.Strings("fallback_teams", body.FallbackTeams),
+	)
+
+	fallbackTeams, err := p.teamUseCase.SetTeamFallbacks(ctx, body.TeamName, body.FallbackTeams)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrInvalidFallback):
+			return api.PostTeamSetFallbacks400JSONResponse{
+				Error: newErrorResponse(api.INVALIDFALLBACK, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.PostTeamSetFallbacks404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostTeamSetFallbacks success",
+		zap.String("team_name", body.TeamName),
+		zap.Strings("fallback_teams", fallbackTeams),
+	)
+
+	return api.PostTeamSetFallbacks200JSONResponse{
+		TeamName:      body.TeamName,
+		FallbackTeams: fallbackTeams,
+	}, nil
+}
+
+func (p *prService) PostTeamSetCodeowners(
+	ctx context.Context,
+	request api.PostTeamSetCodeownersRequestObject,
+) (api.PostTeamSetCodeownersResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostTeamSetCodeowners called",
+		zap.String("team_name", body.TeamName),
+	)
+
+	rules, err := p.teamUseCase.SetCodeOwners(ctx, body.TeamName, body.Content)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrInvalidCodeOwners):
+			return api.PostTeamSetCodeowners400JSONResponse{
+				Error: newErrorResponse(api.INVALIDCODEOWNERS, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.PostTeamSetCodeowners404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostTeamSetCodeowners success",
+		zap.String("team_name", body.TeamName),
+		zap.Int("rules", len(rules)),
+	)
+
+	return api.PostTeamSetCodeowners200JSONResponse{
+		TeamName: body.TeamName,
+		Rules:    dto.ToAPICodeOwnersRules(rules),
+	}, nil
+}
+
+func (p *prService) GetTeamCodeowners(
+	ctx context.Context,
+	request api.GetTeamCodeownersRequestObject,
+) (api.GetTeamCodeownersResponseObject, error) {
+	p.logger.Info("GetTeamCodeowners called",
+		zap.String("team_name", request.Params.TeamName),
+	)
+
+	rules, err := p.teamUseCase.GetCodeOwners(ctx, request.Params.TeamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.GetTeamCodeowners404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("GetTeamCodeowners success",
+		zap.String("team_name", request.Params.TeamName),
+		zap.Int("rules", len(rules)),
+	)
+
+	return api.GetTeamCodeowners200JSONResponse{
+		TeamName: request.Params.TeamName,
+		Rules:    dto.ToAPICodeOwnersRules(rules),
+	}, nil
+}
+
+func (p *prService) PutTeamMembers(
+	ctx context.Context,
+	request api.PutTeamMembersRequestObject,
+) (api.PutTeamMembersResponseObject, error) {
+	change := dto.FromAPITeamMembersChange(*request.Body)
+	p.logger.Info("PutTeamMembers called",
+		zap.String("team_name", change.TeamName),
+		zap.Int("add", len(change.Add)),
+		zap.Strings("remove", change.Remove),
+		zap.Bool("move", change.Move),
+	)
+
+	expectedVersion, err := parseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return api.PutTeamMembers412JSONResponse{
+			Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+		}, nil
+	}
+
+	team, err := p.teamUseCase.TeamUpdateMembers(ctx, change, expectedVersion)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrInvalidMembers):
+			return api.PutTeamMembers400JSONResponse{
+				Error: newErrorResponse(api.INVALIDMEMBERS, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrEmptyTeam):
+			return api.PutTeamMembers400JSONResponse{
+				Error: newErrorResponse(api.EMPTYTEAM, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrUserIDTaken):
+			return api.PutTeamMembers400JSONResponse{
+				Error: newErrorResponse(api.USERIDTAKEN, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.PutTeamMembers404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrUserNotInTeam):
+			return api.PutTeamMembers409JSONResponse{
+				Error: newErrorResponse(api.NOTTEAMMEMBER, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrVersionMismatch):
+			return api.PutTeamMembers412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PutTeamMembers success",
+		zap.String("team_name", team.Name),
+		zap.Int("members", len(team.Members)),
+	)
+
+	response := api.PutTeamMembers200JSONResponse{
+		Headers: api.PutTeamMembers200ResponseHeaders{ETag: formatETag(team.Version)},
+	}
+	response.Body.Team = *dto.ToAPITeam(team)
+	return response, nil
+}
+
+func (p *prService) PostTeamRename(
+	ctx context.Context,
+	request api.PostTeamRenameRequestObject,
+) (api.PostTeamRenameResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostTeamRename called",
+		zap.String("team_name", body.TeamName),
+		zap.String("new_team_name", body.NewTeamName),
+	)
+
+	expectedVersion, err := parseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return api.PostTeamRename412JSONResponse{
+			Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+		}, nil
+	}
+
+	team, err := p.teamUseCase.TeamRename(ctx, body.TeamName, body.NewTeamName, expectedVersion)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrTeamExist):
+			return api.PostTeamRename400JSONResponse{
+				Error: newErrorResponse(api.TEAMEXISTS, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.PostTeamRename404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrVersionMismatch):
+			return api.PostTeamRename412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostTeamRename success",
+		zap.String("team_name", team.Name),
+	)
+
+	response := api.PostTeamRename200JSONResponse{
+		Headers: api.PostTeamRename200ResponseHeaders{ETag: formatETag(team.Version)},
+	}
+	response.Body.Team = *dto.ToAPITeam(team)
+	return response, nil
+}
+
+func (p *prService) DeleteTeam(
+	ctx context.Context,
+	request api.DeleteTeamRequestObject,
+) (api.DeleteTeamResponseObject, error) {
+	teamName := request.Params.TeamName
+	openPRPolicy := models.OpenPRPolicy(valueOrDefault(request.Params.OpenPrs, api.OpenPRsReject))
+	p.logger.Info("DeleteTeam called",
+		zap.String("team_name", teamName),
+		zap.String("open_prs", string(openPRPolicy)),
+	)
+
+	expectedVersion, err := parseIfMatch(request.Params.IfMatch)
+	if err != nil {
+		return api.DeleteTeam412JSONResponse{
+			Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+		}, nil
+	}
+
+	deletion, err := p.teamUseCase.TeamDelete(ctx, teamName, openPRPolicy, expectedVersion)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.DeleteTeam404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrTeamHasOpenPRs):
+			return api.DeleteTeam409JSONResponse{
+				Error: newErrorResponse(api.TEAMHASOPENPRS, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrVersionMismatch):
+			return api.DeleteTeam412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("DeleteTeam success",
+		zap.String("team_name", teamName),
+		zap.Strings("released_members", deletion.ReleasedMembers),
+		zap.Int("unassigned_reviews", deletion.UnassignedReviews),
+	)
+
+	return api.DeleteTeam200JSONResponse(dto.ToAPITeamDeletion(deletion)), nil
+}
+
+func (p *prService) GetTeamAudit(
+	ctx context.Context,
+	request api.GetTeamAuditRequestObject,
+) (api.GetTeamAuditResponseObject, error) {
+	teamName := request.Params.TeamName
+	p.logger.Info("GetTeamAudit called",
+		zap.String("team_name", teamName),
+	)
+
+	entries, err := p.teamUseCase.TeamAudit(ctx, teamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrTeamNotFound):
+			return api.GetTeamAudit404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("GetTeamAudit success",
+		zap.String("team_name", teamName),
+		zap.Int("entries", len(entries)),
+	)
+
+	return api.GetTeamAudit200JSONResponse{
+		TeamName: teamName,
+		Entries:  dto.ToAPITeamAuditEntries(entries),
 	}, nil
 }
@@ -2,7 +2,9 @@ package pr_service
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -70,6 +72,19 @@ func TestPostTeamAdd(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "user id taken 400",
+			body: &api.PostTeamAddJSONRequestBody{},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamAdd(gomock.Any(), gomock.Any()).
+					Return(modelsErr.ErrUserIDTaken)
+			},
+			expected: api.PostTeamAdd400JSONResponse{
+				Error: newErrorResponse(api.USERIDTAKEN, modelsErr.ErrUserIDTaken.Error()).Error,
+			},
+			wantErr: nil,
+		},
 		{
 			name: "unexpected error 500",
 			body: &api.PostTeamAddJSONRequestBody{
@@ -100,6 +115,9 @@ func TestPostTeamAdd(t *testing.T) {
 				nil,
 				mockTeam,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := svc.PostTeamAdd(t.Context(),
@@ -125,6 +143,7 @@ func TestGetTeamGet(t *testing.T) {
 			{UserID: "a"},
 			{UserID: "b"},
 		},
+		Version: 7,
 	}
 
 	tests := []struct {
@@ -145,8 +164,11 @@ func TestGetTeamGet(t *testing.T) {
 					Return(team, nil)
 			},
 			expected: api.GetTeamGet200JSONResponse{
-				TeamName: team.Name,
-				Members:  dto.ToAPIMembers(team.Members),
+				Body: api.Team{
+					TeamName: team.Name,
+					Members:  dto.ToAPIMembers(team.Members),
+				},
+				Headers: api.GetTeamGet200ResponseHeaders{ETag: `"7"`},
 			},
 			wantErr: nil,
 		},
@@ -191,6 +213,9 @@ func TestGetTeamGet(t *testing.T) {
 				nil,
 				mockTeam,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := svc.GetTeamGet(t.Context(),
@@ -208,3 +233,852 @@ func TestGetTeamGet(t *testing.T) {
 		})
 	}
 }
+
+func TestPostTeamSetPolicy(t *testing.T) {
+	t.Parallel()
+
+	sla := 24 * time.Hour
+	policy := &models.TeamPolicy{
+		TeamName:         "core",
+		ReviewSLA:        &sla,
+		EscalationPolicy: models.EscalationPolicyAddReviewer,
+		ShortfallPolicy:  models.ShortfallPolicyAllow,
+		SelectionMode:    models.SelectionModeDefault,
+		RotationWindow:   30 * 24 * time.Hour,
+	}
+	slaMinutes := 1440
+
+	tests := []struct {
+		name         string
+		body         *api.PostTeamSetPolicyJSONRequestBody
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.PostTeamSetPolicyResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			body: &api.PostTeamSetPolicyJSONRequestBody{
+				TeamName:         "core",
+				ReviewSlaMinutes: &slaMinutes,
+				EscalationPolicy: api.ADDREVIEWER,
+			},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetTeamPolicy(gomock.Any(), *policy).
+					Return(policy, nil)
+			},
+			expected: api.PostTeamSetPolicy200JSONResponse{
+				Policy: *dto.ToAPITeamPolicy(policy),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "not found 404",
+			body: &api.PostTeamSetPolicyJSONRequestBody{TeamName: "missing"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetTeamPolicy(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.PostTeamSetPolicy404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unexpected 500",
+			body: &api.PostTeamSetPolicyJSONRequestBody{TeamName: "crash"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetTeamPolicy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				mockTeam,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostTeamSetPolicy(t.Context(),
+				api.PostTeamSetPolicyRequestObject{Body: tt.body})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPostTeamSetFallbacks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		body         *api.PostTeamSetFallbacksJSONRequestBody
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.PostTeamSetFallbacksResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			body: &api.PostTeamSetFallbacksJSONRequestBody{
+				TeamName:      "mobile",
+				FallbackTeams: []string{"backend", "platform"},
+			},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetTeamFallbacks(gomock.Any(), "mobile", []string{"backend", "platform"}).
+					Return([]string{"backend", "platform"}, nil)
+			},
+			expected: api.PostTeamSetFallbacks200JSONResponse{
+				TeamName:      "mobile",
+				FallbackTeams: []string{"backend", "platform"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "self fallback 400",
+			body: &api.PostTeamSetFallbacksJSONRequestBody{
+				TeamName:      "mobile",
+				FallbackTeams: []string{"mobile"},
+			},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetTeamFallbacks(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrInvalidFallback)
+			},
+			expected: api.PostTeamSetFallbacks400JSONResponse{
+				Error: newErrorResponse(api.INVALIDFALLBACK, modelsErr.ErrInvalidFallback.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "not found 404",
+			body: &api.PostTeamSetFallbacksJSONRequestBody{TeamName: "missing"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetTeamFallbacks(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.PostTeamSetFallbacks404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unexpected 500",
+			body: &api.PostTeamSetFallbacksJSONRequestBody{TeamName: "crash"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetTeamFallbacks(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				mockTeam,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostTeamSetFallbacks(t.Context(),
+				api.PostTeamSetFallbacksRequestObject{Body: tt.body})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPostTeamSetCodeowners(t *testing.T) {
+	t.Parallel()
+
+	rules := []models.CodeOwnersRule{
+		{Pattern: "*", Owners: []models.CodeOwner{{Kind: models.CodeOwnerUser, Name: "u1"}}},
+		{Pattern: "/api/", Owners: []models.CodeOwner{{Kind: models.CodeOwnerTeam, Name: "platform"}}},
+	}
+	invalidErr := fmt.Errorf("%w: line 1: unsupported owner %q", modelsErr.ErrInvalidCodeOwners, "dev@example.com")
+
+	tests := []struct {
+		name         string
+		body         *api.PostTeamSetCodeownersJSONRequestBody
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.PostTeamSetCodeownersResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			body: &api.PostTeamSetCodeownersJSONRequestBody{
+				TeamName: "backend",
+				Content:  "* @u1\n/api/ @acme/platform\n",
+			},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetCodeOwners(gomock.Any(), "backend", "* @u1\n/api/ @acme/platform\n").
+					Return(rules, nil)
+			},
+			expected: api.PostTeamSetCodeowners200JSONResponse{
+				TeamName: "backend",
+				Rules: []api.CodeOwnersRule{
+					{Pattern: "*", Owners: []api.CodeOwner{{Kind: api.USER, Name: "u1"}}},
+					{Pattern: "/api/", Owners: []api.CodeOwner{{Kind: api.TEAM, Name: "platform"}}},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid file 400",
+			body: &api.PostTeamSetCodeownersJSONRequestBody{
+				TeamName: "backend",
+				Content:  "* dev@example.com\n",
+			},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetCodeOwners(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, invalidErr)
+			},
+			expected: api.PostTeamSetCodeowners400JSONResponse{
+				Error: newErrorResponse(api.INVALIDCODEOWNERS, invalidErr.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "not found 404",
+			body: &api.PostTeamSetCodeownersJSONRequestBody{TeamName: "missing"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetCodeOwners(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.PostTeamSetCodeowners404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unexpected 500",
+			body: &api.PostTeamSetCodeownersJSONRequestBody{TeamName: "crash"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					SetCodeOwners(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				mockTeam,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostTeamSetCodeowners(t.Context(),
+				api.PostTeamSetCodeownersRequestObject{Body: tt.body})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetTeamCodeowners(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		teamName     string
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.GetTeamCodeownersResponseObject
+		wantErr      error
+	}{
+		{
+			name:     "success 200",
+			teamName: "backend",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					GetCodeOwners(gomock.Any(), "backend").
+					Return([]models.CodeOwnersRule{{Pattern: "docs/"}}, nil)
+			},
+			expected: api.GetTeamCodeowners200JSONResponse{
+				TeamName: "backend",
+				Rules:    []api.CodeOwnersRule{{Pattern: "docs/", Owners: []api.CodeOwner{}}},
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "not found 404",
+			teamName: "missing",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					GetCodeOwners(gomock.Any(), "missing").
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.GetTeamCodeowners404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "unexpected 500",
+			teamName: "crash",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					GetCodeOwners(gomock.Any(), "crash").
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				nil,
+				mockTeam,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.GetTeamCodeowners(t.Context(), api.GetTeamCodeownersRequestObject{
+				Params: api.GetTeamCodeownersParams{TeamName: tt.teamName},
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPutTeamMembers(t *testing.T) {
+	t.Parallel()
+
+	team := &models.Team{
+		Name: "core",
+		Members: []models.Member{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u3", Username: "Carol", IsActive: true},
+		},
+		Version: 4,
+	}
+	add := []api.TeamMember{{UserId: "u3", Username: "Carol", IsActive: true}}
+	remove := []string{"u2"}
+	move := true
+	updated := api.PutTeamMembers200JSONResponse{
+		Headers: api.PutTeamMembers200ResponseHeaders{ETag: `"4"`},
+	}
+	updated.Body.Team = *dto.ToAPITeam(team)
+	ifMatch := `W/"3"`
+	expectedVersion := int64(3)
+
+	tests := []struct {
+		name         string
+		ifMatch      *string
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.PutTeamMembersResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), models.TeamMembersChange{
+						TeamName: "core",
+						Add:      []models.Member{{UserID: "u3", Username: "Carol", IsActive: true}},
+						Remove:   remove,
+						Move:     true,
+					}, nil).
+					Return(team, nil)
+			},
+			expected: updated,
+		},
+		{
+			name:    "weak If-Match is passed to use case",
+			ifMatch: &ifMatch,
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), &expectedVersion).
+					Return(team, nil)
+			},
+			expected: updated,
+		},
+		{
+			name:    "stale version 412",
+			ifMatch: &ifMatch,
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), &expectedVersion).
+					Return(nil, modelsErr.ErrVersionMismatch)
+			},
+			expected: api.PutTeamMembers412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, modelsErr.ErrVersionMismatch.Error()).Error,
+			},
+		},
+		{
+			name: "invalid members 400",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), nil).
+					Return(nil, modelsErr.ErrInvalidMembers)
+			},
+			expected: api.PutTeamMembers400JSONResponse{
+				Error: newErrorResponse(api.INVALIDMEMBERS, modelsErr.ErrInvalidMembers.Error()).Error,
+			},
+		},
+		{
+			name: "empty team 400",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), nil).
+					Return(nil, modelsErr.ErrEmptyTeam)
+			},
+			expected: api.PutTeamMembers400JSONResponse{
+				Error: newErrorResponse(api.EMPTYTEAM, modelsErr.ErrEmptyTeam.Error()).Error,
+			},
+		},
+		{
+			name: "user id taken 400",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), nil).
+					Return(nil, modelsErr.ErrUserIDTaken)
+			},
+			expected: api.PutTeamMembers400JSONResponse{
+				Error: newErrorResponse(api.USERIDTAKEN, modelsErr.ErrUserIDTaken.Error()).Error,
+			},
+		},
+		{
+			name: "team not found 404",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), nil).
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.PutTeamMembers404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+		},
+		{
+			name: "not a member 409",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), nil).
+					Return(nil, modelsErr.ErrUserNotInTeam)
+			},
+			expected: api.PutTeamMembers409JSONResponse{
+				Error: newErrorResponse(api.NOTTEAMMEMBER, modelsErr.ErrUserNotInTeam.Error()).Error,
+			},
+		},
+		{
+			name: "unexpected error 500",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamUpdateMembers(gomock.Any(), gomock.Any(), nil).
+					Return(nil, errors.New("db fail"))
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(zap.NewNop(), nil, mockTeam, nil, nil, nil, nil)
+
+			resp, err := svc.PutTeamMembers(t.Context(), api.PutTeamMembersRequestObject{
+				Params: api.PutTeamMembersParams{IfMatch: tt.ifMatch},
+				Body: &api.PutTeamMembersJSONRequestBody{
+					TeamName: "core",
+					Add:      &add,
+					Remove:   &remove,
+					Move:     &move,
+				},
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPostTeamRename(t *testing.T) {
+	t.Parallel()
+
+	team := &models.Team{
+		Name:    "platform",
+		Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+		Version: 2,
+	}
+	renamed := api.PostTeamRename200JSONResponse{
+		Headers: api.PostTeamRename200ResponseHeaders{ETag: `"2"`},
+	}
+	renamed.Body.Team = *dto.ToAPITeam(team)
+
+	tests := []struct {
+		name         string
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.PostTeamRenameResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamRename(gomock.Any(), "backend", "platform", nil).
+					Return(team, nil)
+			},
+			expected: renamed,
+		},
+		{
+			name: "name taken 400",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamRename(gomock.Any(), "backend", "platform", nil).
+					Return(nil, modelsErr.ErrTeamExist)
+			},
+			expected: api.PostTeamRename400JSONResponse{
+				Error: newErrorResponse(api.TEAMEXISTS, modelsErr.ErrTeamExist.Error()).Error,
+			},
+		},
+		{
+			name: "team not found 404",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamRename(gomock.Any(), "backend", "platform", nil).
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.PostTeamRename404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+		},
+		{
+			name: "unexpected error 500",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamRename(gomock.Any(), "backend", "platform", nil).
+					Return(nil, errors.New("db fail"))
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(zap.NewNop(), nil, mockTeam, nil, nil, nil, nil)
+
+			resp, err := svc.PostTeamRename(t.Context(), api.PostTeamRenameRequestObject{
+				Body: &api.PostTeamRenameJSONRequestBody{
+					TeamName:    "backend",
+					NewTeamName: "platform",
+				},
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestDeleteTeam(t *testing.T) {
+	t.Parallel()
+
+	unassign := api.OpenPRsUnassign
+	staleIfMatch := `"1"`
+	staleVersion := int64(1)
+
+	tests := []struct {
+		name         string
+		params       api.DeleteTeamParams
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.DeleteTeamResponseObject
+		wantErr      error
+	}{
+		{
+			name:   "reject by default 200",
+			params: api.DeleteTeamParams{TeamName: "core"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamDelete(gomock.Any(), "core", models.OpenPRPolicyReject, nil).
+					Return(&models.TeamDeletion{TeamName: "core", ReleasedMembers: []string{"u1"}}, nil)
+			},
+			expected: api.DeleteTeam200JSONResponse{
+				TeamName:        "core",
+				ReleasedMembers: []string{"u1"},
+			},
+		},
+		{
+			name:   "unassign 200",
+			params: api.DeleteTeamParams{TeamName: "core", OpenPrs: &unassign},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamDelete(gomock.Any(), "core", models.OpenPRPolicyUnassign, nil).
+					Return(&models.TeamDeletion{
+						TeamName:          "core",
+						ReleasedMembers:   []string{"u1", "u2"},
+						UnassignedReviews: 3,
+					}, nil)
+			},
+			expected: api.DeleteTeam200JSONResponse{
+				TeamName:          "core",
+				ReleasedMembers:   []string{"u1", "u2"},
+				UnassignedReviews: 3,
+			},
+		},
+		{
+			name:   "team not found 404",
+			params: api.DeleteTeamParams{TeamName: "missing"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamDelete(gomock.Any(), "missing", models.OpenPRPolicyReject, nil).
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.DeleteTeam404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+		},
+		{
+			name:   "stale If-Match 412",
+			params: api.DeleteTeamParams{TeamName: "core", IfMatch: &staleIfMatch},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamDelete(gomock.Any(), "core", models.OpenPRPolicyReject, &staleVersion).
+					Return(nil, modelsErr.ErrVersionMismatch)
+			},
+			expected: api.DeleteTeam412JSONResponse{
+				Error: newErrorResponse(api.VERSIONMISMATCH, modelsErr.ErrVersionMismatch.Error()).Error,
+			},
+		},
+		{
+			name:   "open PRs 409",
+			params: api.DeleteTeamParams{TeamName: "core"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamDelete(gomock.Any(), "core", models.OpenPRPolicyReject, nil).
+					Return(nil, modelsErr.ErrTeamHasOpenPRs)
+			},
+			expected: api.DeleteTeam409JSONResponse{
+				Error: newErrorResponse(api.TEAMHASOPENPRS, modelsErr.ErrTeamHasOpenPRs.Error()).Error,
+			},
+		},
+		{
+			name:   "unexpected error 500",
+			params: api.DeleteTeamParams{TeamName: "core"},
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamDelete(gomock.Any(), "core", models.OpenPRPolicyReject, nil).
+					Return(nil, errors.New("db fail"))
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(zap.NewNop(), nil, mockTeam, nil, nil, nil, nil)
+
+			resp, err := svc.DeleteTeam(t.Context(), api.DeleteTeamRequestObject{Params: tt.params})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetTeamAudit(t *testing.T) {
+	t.Parallel()
+
+	userID := "u1"
+	previous := "backend"
+	entries := []models.TeamAuditEntry{
+		{
+			CreatedAt: time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC),
+			Action:    models.TeamAuditTeamCreated,
+			TeamName:  "core",
+		},
+		{
+			CreatedAt:        time.Date(2025, 11, 21, 10, 0, 0, 0, time.UTC),
+			Action:           models.TeamAuditMemberMoved,
+			TeamName:         "core",
+			UserID:           &userID,
+			PreviousTeamName: &previous,
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(m *mocks.MockteamUseCase)
+		expected     api.GetTeamAuditResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamAudit(gomock.Any(), "core").
+					Return(entries, nil)
+			},
+			expected: api.GetTeamAudit200JSONResponse{
+				TeamName: "core",
+				Entries:  dto.ToAPITeamAuditEntries(entries),
+			},
+		},
+		{
+			name: "team not found 404",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamAudit(gomock.Any(), "core").
+					Return(nil, modelsErr.ErrTeamNotFound)
+			},
+			expected: api.GetTeamAudit404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrTeamNotFound.Error()).Error,
+			},
+		},
+		{
+			name: "unexpected error 500",
+			mockBehavior: func(m *mocks.MockteamUseCase) {
+				m.EXPECT().
+					TeamAudit(gomock.Any(), "core").
+					Return(nil, errors.New("db fail"))
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeam := mocks.NewMockteamUseCase(ctrl)
+			tt.mockBehavior(mockTeam)
+
+			svc := NewPRService(zap.NewNop(), nil, mockTeam, nil, nil, nil, nil)
+
+			resp, err := svc.GetTeamAudit(t.Context(), api.GetTeamAuditRequestObject{
+				Params: api.GetTeamAuditParams{TeamName: "core"},
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
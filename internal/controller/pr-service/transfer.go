@@ -0,0 +1,149 @@
+package pr_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/dto"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+const (
+	mimeApplicationJSON   = "application/json"
+	mimeApplicationNDJSON = "application/x-ndjson"
+
+	// выгрузка сбрасывается клиенту пачками, а не после каждой записи
+	exportFlushEvery = 100
+)
+
+func (p *prService) PostAdminImport(
+	ctx context.Context,
+	request api.PostAdminImportRequestObject,
+) (api.PostAdminImportResponseObject, error) {
+	dryRun := valueOrDefault(request.Params.DryRun, false)
+
+	var batch models.ImportBatch
+	if request.JSONBody != nil {
+		batch = dto.FromAPITransferRecords(*request.JSONBody)
+	} else {
+		batch = dto.ParseNDJSON(request.Body)
+	}
+	p.logger.Info("PostAdminImport called",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("records", len(batch.Records)),
+		zap.Int("parse_errors", len(batch.Errors)),
+	)
+
+	report, err := p.transferUseCase.Import(ctx, batch, dryRun)
+	if err != nil {
+		p.logger.Error("PostAdminImport", zap.Error(err))
+		return nil, modelsErr.ErrInternal
+	}
+
+	p.logger.Info("PostAdminImport done",
+		zap.Bool("applied", report.Applied),
+		zap.Int("errors", len(report.Errors)),
+	)
+
+	if len(report.Errors) > 0 {
+		return api.PostAdminImport422JSONResponse(dto.ToAPIImportReport(report)), nil
+	}
+	return api.PostAdminImport200JSONResponse(dto.ToAPIImportReport(report)), nil
+}
+
+func (p *prService) GetAdminExport(
+	ctx context.Context,
+	_ api.GetAdminExportRequestObject,
+) (api.GetAdminExportResponseObject, error) {
+	accept, _ := ctx.Value(acceptHeaderKey{}).(string)
+	jsonArray := strings.Contains(accept, mimeApplicationJSON)
+	p.logger.Info("GetAdminExport called", zap.Bool("json_array", jsonArray))
+
+	return exportResponse{
+		ctx:       ctx,
+		logger:    p.logger,
+		useCase:   p.transferUseCase,
+		jsonArray: jsonArray,
+	}, nil
+}
+
+// exportResponse пишет записи в ответ по мере чтения из хранилища, не собирая
+// выгрузку в памяти. Заголовки отправляются с первой записью: до неё ошибку ещё
+// можно вернуть обычным ответом 500, после — остаётся только оборвать соединение.
+type exportResponse struct {
+	ctx       context.Context
+	logger    *zap.Logger
+	useCase   transferUseCase
+	jsonArray bool
+}
+
+func (e exportResponse) VisitGetAdminExportResponse(w http.ResponseWriter) error {
+	contentType := mimeApplicationNDJSON
+	if e.jsonArray {
+		contentType = mimeApplicationJSON
+	}
+
+	rc := http.NewResponseController(w)
+	encoder := json.NewEncoder(w)
+	written := 0
+
+	err := e.useCase.Export(e.ctx, func(record models.TransferRecord) error {
+		if written == 0 {
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(http.StatusOK)
+			if e.jsonArray {
+				if _, err := w.Write([]byte("[")); err != nil {
+					return err
+				}
+			}
+		} else if e.jsonArray {
+			if _, err := w.Write([]byte(",")); err != nil {
+				return err
+			}
+		}
+
+		// Encode дописывает перевод строки, для NDJSON это разделитель записей
+		if err := encoder.Encode(dto.ToAPITransferRecord(record)); err != nil {
+			return err
+		}
+		written++
+
+		if written%exportFlushEvery == 0 {
+			if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if written == 0 {
+			e.logger.Error("GetAdminExport", zap.Error(err))
+			return modelsErr.ErrInternal
+		}
+		e.logger.Error("GetAdminExport aborted", zap.Int("written", written), zap.Error(err))
+		panic(http.ErrAbortHandler)
+	}
+
+	if written == 0 {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusOK)
+		if e.jsonArray {
+			_, err = w.Write([]byte("[]"))
+		}
+		return err
+	}
+	if e.jsonArray {
+		_, err = w.Write([]byte("]"))
+	}
+
+	e.logger.Info("GetAdminExport success", zap.Int("records", written))
+
+	return err
+}
@@ -0,0 +1,191 @@
+package pr_service
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/controller/pr-service/mocks"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func TestPostAdminImport(t *testing.T) {
+	t.Parallel()
+
+	dryRun := true
+	user := models.User{ID: "u1", Name: "Alice", IsActive: true}
+
+	tests := []struct {
+		name         string
+		request      api.PostAdminImportRequestObject
+		mockBehavior func(m *mocks.MocktransferUseCase)
+		expected     api.PostAdminImportResponseObject
+		wantErr      error
+	}{
+		{
+			name: "ndjson 200",
+			request: api.PostAdminImportRequestObject{
+				Body: strings.NewReader(`{"kind":"user","user":{"user_id":"u1","username":"Alice","is_active":true}}` + "\n"),
+			},
+			mockBehavior: func(m *mocks.MocktransferUseCase) {
+				m.EXPECT().Import(gomock.Any(), models.ImportBatch{Records: []models.TransferRecord{
+					{Kind: models.TransferKindUser, User: &user, Line: 1},
+				}}, false).Return(&models.ImportReport{
+					Users:   models.ImportCounts{Created: 1},
+					Applied: true,
+				}, nil)
+			},
+			expected: api.PostAdminImport200JSONResponse{
+				Users:   api.ImportCounts{Created: 1},
+				Applied: true,
+				Errors:  []api.ImportError{},
+			},
+		},
+		{
+			name: "json array dry run 422",
+			request: api.PostAdminImportRequestObject{
+				Params: api.PostAdminImportParams{DryRun: &dryRun},
+				JSONBody: &[]api.TransferRecord{
+					{Kind: api.TransferRecordKindUser},
+				},
+			},
+			mockBehavior: func(m *mocks.MocktransferUseCase) {
+				m.EXPECT().Import(gomock.Any(), models.ImportBatch{Records: []models.TransferRecord{
+					{Kind: models.TransferKindUser, Line: 1},
+				}}, true).Return(&models.ImportReport{
+					DryRun: true,
+					Errors: []models.ImportError{{Line: 1, Message: "user is required for kind user"}},
+				}, nil)
+			},
+			expected: api.PostAdminImport422JSONResponse{
+				DryRun: true,
+				Errors: []api.ImportError{{Line: 1, Message: "user is required for kind user"}},
+			},
+		},
+		{
+			name:    "internal error",
+			request: api.PostAdminImportRequestObject{Body: strings.NewReader("")},
+			mockBehavior: func(m *mocks.MocktransferUseCase) {
+				m.EXPECT().Import(gomock.Any(), models.ImportBatch{}, false).Return(nil, modelsErr.ErrInternal)
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransfer := mocks.NewMocktransferUseCase(ctrl)
+			tt.mockBehavior(mockTransfer)
+
+			svc := NewPRService(zap.NewNop(), nil, nil, nil, nil, nil, mockTransfer)
+
+			resp, err := svc.PostAdminImport(t.Context(), tt.request)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestGetAdminExport(t *testing.T) {
+	t.Parallel()
+
+	records := []models.TransferRecord{
+		{Kind: models.TransferKindTeam, Team: &models.Team{
+			Name:    "backend",
+			Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+		}},
+		{Kind: models.TransferKindUser, User: &models.User{ID: "u1", Name: "Alice", IsActive: true, TimeZone: "UTC"}},
+	}
+	teamLine := `{"kind":"team","team":{"members":[{"is_active":true,"user_id":"u1","username":"Alice"}],"team_name":"backend"}}`
+	userLine := `{"kind":"user","user":{"is_active":true,"max_open_reviews":null,"time_zone":"UTC","user_id":"u1","username":"Alice","work_end":null,"work_start":null}}`
+
+	tests := []struct {
+		name            string
+		accept          string
+		records         []models.TransferRecord
+		exportErr       error
+		wantContentType string
+		wantBody        string
+		wantErr         error
+	}{
+		{
+			name:            "ndjson by default",
+			records:         records,
+			wantContentType: mimeApplicationNDJSON,
+			wantBody:        teamLine + "\n" + userLine + "\n",
+		},
+		{
+			name:            "json array",
+			accept:          "application/json",
+			records:         records,
+			wantContentType: mimeApplicationJSON,
+			wantBody:        "[" + teamLine + "\n," + userLine + "\n]",
+		},
+		{
+			name:            "empty json array",
+			accept:          "application/json",
+			wantContentType: mimeApplicationJSON,
+			wantBody:        "[]",
+		},
+		{
+			name:      "error before first record",
+			exportErr: modelsErr.ErrInternal,
+			wantErr:   modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransfer := mocks.NewMocktransferUseCase(ctrl)
+			mockTransfer.EXPECT().Export(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(models.TransferRecord) error) error {
+					for _, record := range tt.records {
+						if err := fn(record); err != nil {
+							return err
+						}
+					}
+					return tt.exportErr
+				},
+			)
+
+			svc := NewPRService(zap.NewNop(), nil, nil, nil, nil, nil, mockTransfer)
+
+			ctx := context.WithValue(t.Context(), acceptHeaderKey{}, tt.accept)
+			resp, err := svc.GetAdminExport(ctx, api.GetAdminExportRequestObject{})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			err = resp.VisitGetAdminExportResponse(recorder)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, recorder.Flushed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantBody, recorder.Body.String())
+		})
+	}
+}
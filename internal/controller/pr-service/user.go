@@ -66,3 +66,120 @@ func (p *prService) PostUsersSetIsActive(
 		User: dto.ToAPIUser(user),
 	}, nil
 }
+
+func (p *prService) PostUsersSetSchedule(
+	ctx context.Context,
+	request api.PostUsersSetScheduleRequestObject,
+) (api.PostUsersSetScheduleResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostUsersSetSchedule called",
+		zap.String("user_id", body.UserId),
+		zap.String("time_zone", body.TimeZone),
+	)
+
+	workingHours, err := dto.FromAPIWorkingHours(body.WorkStart, body.WorkEnd)
+	if err != nil {
+		return api.PostUsersSetSchedule400JSONResponse{
+			Error: newErrorResponse(api.INVALIDSCHEDULE, err.Error()).Error,
+		}, nil
+	}
+
+	user, err := p.userUseCase.SetSchedule(ctx, body.UserId, body.TimeZone, workingHours)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrInvalidTimeZone):
+			return api.PostUsersSetSchedule400JSONResponse{
+				Error: newErrorResponse(api.INVALIDSCHEDULE, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrUserNotFound):
+			return api.PostUsersSetSchedule404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostUsersSetSchedule success",
+		zap.String("user_id", user.ID),
+		zap.String("time_zone", user.TimeZone),
+	)
+
+	return api.PostUsersSetSchedule200JSONResponse{
+		User: dto.ToAPIUser(user),
+	}, nil
+}
+
+func (p *prService) PostUsersOutOfOffice(
+	ctx context.Context,
+	request api.PostUsersOutOfOfficeRequestObject,
+) (api.PostUsersOutOfOfficeResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostUsersOutOfOffice called",
+		zap.String("user_id", body.UserId),
+		zap.Time("from", body.From),
+		zap.Time("to", body.To),
+	)
+
+	outOfOffice, err := p.userUseCase.SetOutOfOffice(ctx, dto.FromAPIOutOfOffice(*body))
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrInvalidPeriod):
+			return api.PostUsersOutOfOffice400JSONResponse{
+				Error: newErrorResponse(api.INVALIDPERIOD, err.Error()).Error,
+			}, nil
+
+		case errors.Is(err, modelsErr.ErrUserNotFound):
+			return api.PostUsersOutOfOffice404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostUsersOutOfOffice success",
+		zap.Int64("out_of_office_id", outOfOffice.ID),
+		zap.String("user_id", outOfOffice.UserID),
+	)
+
+	return api.PostUsersOutOfOffice201JSONResponse{
+		OutOfOffice: *dto.ToAPIOutOfOffice(outOfOffice),
+	}, nil
+}
+
+func (p *prService) PostUsersSetMaxOpenReviews(
+	ctx context.Context,
+	request api.PostUsersSetMaxOpenReviewsRequestObject,
+) (api.PostUsersSetMaxOpenReviewsResponseObject, error) {
+	body := request.Body
+	p.logger.Info("PostUsersSetMaxOpenReviews called",
+		zap.String("user_id", body.UserId),
+		zap.Any("max_open_reviews", body.MaxOpenReviews),
+	)
+
+	user, err := p.userUseCase.SetMaxOpenReviews(ctx, body.UserId, body.MaxOpenReviews)
+	if err != nil {
+		switch {
+		case errors.Is(err, modelsErr.ErrUserNotFound):
+			return api.PostUsersSetMaxOpenReviews404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, err.Error()).Error,
+			}, nil
+
+		default:
+			return nil, modelsErr.ErrInternal
+		}
+	}
+
+	p.logger.Info("PostUsersSetMaxOpenReviews success",
+		zap.String("user_id", user.ID),
+		zap.Any("max_open_reviews", user.MaxOpenReviews),
+	)
+
+	return api.PostUsersSetMaxOpenReviews200JSONResponse{
+		User: dto.ToAPIUser(user),
+	}, nil
+}
@@ -3,6 +3,7 @@ package pr_service
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -85,6 +86,9 @@ func TestGetUsersGetReview(t *testing.T) {
 				mockUser,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := svc.GetUsersGetReview(t.Context(),
@@ -177,6 +181,9 @@ func TestPostUsersSetIsActive(t *testing.T) {
 				mockUser,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := svc.PostUsersSetIsActive(t.Context(),
@@ -194,3 +201,344 @@ func TestPostUsersSetIsActive(t *testing.T) {
 		})
 	}
 }
+
+func TestPostUsersSetSchedule(t *testing.T) {
+	t.Parallel()
+
+	workingHours := &models.WorkingHours{Start: 9 * time.Hour, End: 18 * time.Hour}
+	user := &models.User{
+		ID:           "u1",
+		Name:         "John",
+		IsActive:     true,
+		TimeZone:     "Europe/Berlin",
+		WorkingHours: workingHours,
+	}
+	start, end := "09:00", "18:00"
+
+	tests := []struct {
+		name         string
+		body         *api.PostUsersSetScheduleJSONRequestBody
+		mockBehavior func(m *mocks.MockuserUseCase)
+		expected     api.PostUsersSetScheduleResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			body: &api.PostUsersSetScheduleJSONRequestBody{
+				UserId:    "u1",
+				TimeZone:  "Europe/Berlin",
+				WorkStart: &start,
+				WorkEnd:   &end,
+			},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetSchedule(gomock.Any(), "u1", "Europe/Berlin", workingHours).
+					Return(user, nil)
+			},
+			expected: api.PostUsersSetSchedule200JSONResponse{
+				User: dto.ToAPIUser(user),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "only start 400",
+			body: &api.PostUsersSetScheduleJSONRequestBody{
+				UserId:    "u1",
+				TimeZone:  "UTC",
+				WorkStart: &start,
+			},
+			mockBehavior: func(_ *mocks.MockuserUseCase) {},
+			expected: api.PostUsersSetSchedule400JSONResponse{
+				Error: newErrorResponse(api.INVALIDSCHEDULE, modelsErr.ErrInvalidSchedule.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown time zone 400",
+			body: &api.PostUsersSetScheduleJSONRequestBody{UserId: "u1", TimeZone: "Mars/Olympus"},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetSchedule(gomock.Any(), "u1", "Mars/Olympus", nil).
+					Return(nil, modelsErr.ErrInvalidTimeZone)
+			},
+			expected: api.PostUsersSetSchedule400JSONResponse{
+				Error: newErrorResponse(api.INVALIDSCHEDULE, modelsErr.ErrInvalidTimeZone.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "user not found 404",
+			body: &api.PostUsersSetScheduleJSONRequestBody{UserId: "missing", TimeZone: "UTC"},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetSchedule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrUserNotFound)
+			},
+			expected: api.PostUsersSetSchedule404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrUserNotFound.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unexpected error 500",
+			body: &api.PostUsersSetScheduleJSONRequestBody{UserId: "u1", TimeZone: "UTC"},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetSchedule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUser := mocks.NewMockuserUseCase(ctrl)
+			tt.mockBehavior(mockUser)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				mockUser,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostUsersSetSchedule(t.Context(),
+				api.PostUsersSetScheduleRequestObject{
+					Body: tt.body,
+				})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPostUsersOutOfOffice(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC)
+	to := from.Add(14 * 24 * time.Hour)
+	reassign := true
+	outOfOffice := &models.OutOfOffice{
+		ID:              1,
+		UserID:          "u1",
+		From:            from,
+		To:              to,
+		ReassignReviews: true,
+	}
+
+	tests := []struct {
+		name         string
+		body         *api.PostUsersOutOfOfficeJSONRequestBody
+		mockBehavior func(m *mocks.MockuserUseCase)
+		expected     api.PostUsersOutOfOfficeResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 201",
+			body: &api.PostUsersOutOfOfficeJSONRequestBody{
+				UserId:          "u1",
+				From:            from,
+				To:              to,
+				ReassignReviews: &reassign,
+			},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetOutOfOffice(gomock.Any(), models.OutOfOffice{
+						UserID:          "u1",
+						From:            from,
+						To:              to,
+						ReassignReviews: true,
+					}).
+					Return(outOfOffice, nil)
+			},
+			expected: api.PostUsersOutOfOffice201JSONResponse{
+				OutOfOffice: *dto.ToAPIOutOfOffice(outOfOffice),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid period 400",
+			body: &api.PostUsersOutOfOfficeJSONRequestBody{UserId: "u1", From: to, To: from},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetOutOfOffice(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrInvalidPeriod)
+			},
+			expected: api.PostUsersOutOfOffice400JSONResponse{
+				Error: newErrorResponse(api.INVALIDPERIOD, modelsErr.ErrInvalidPeriod.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "user not found 404",
+			body: &api.PostUsersOutOfOfficeJSONRequestBody{UserId: "missing", From: from, To: to},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetOutOfOffice(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrUserNotFound)
+			},
+			expected: api.PostUsersOutOfOffice404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrUserNotFound.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unexpected error 500",
+			body: &api.PostUsersOutOfOfficeJSONRequestBody{UserId: "u1", From: from, To: to},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetOutOfOffice(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUser := mocks.NewMockuserUseCase(ctrl)
+			tt.mockBehavior(mockUser)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				mockUser,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostUsersOutOfOffice(t.Context(),
+				api.PostUsersOutOfOfficeRequestObject{
+					Body: tt.body,
+				})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestPostUsersSetMaxOpenReviews(t *testing.T) {
+	t.Parallel()
+
+	maxOpenReviews := 3
+	user := &models.User{
+		ID:             "u1",
+		Name:           "John",
+		IsActive:       true,
+		MaxOpenReviews: &maxOpenReviews,
+	}
+
+	tests := []struct {
+		name         string
+		body         *api.PostUsersSetMaxOpenReviewsJSONRequestBody
+		mockBehavior func(m *mocks.MockuserUseCase)
+		expected     api.PostUsersSetMaxOpenReviewsResponseObject
+		wantErr      error
+	}{
+		{
+			name: "success 200",
+			body: &api.PostUsersSetMaxOpenReviewsJSONRequestBody{
+				UserId:         "u1",
+				MaxOpenReviews: &maxOpenReviews,
+			},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetMaxOpenReviews(gomock.Any(), "u1", &maxOpenReviews).
+					Return(user, nil)
+			},
+			expected: api.PostUsersSetMaxOpenReviews200JSONResponse{
+				User: dto.ToAPIUser(user),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "user not found 404",
+			body: &api.PostUsersSetMaxOpenReviewsJSONRequestBody{UserId: "missing"},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetMaxOpenReviews(gomock.Any(), "missing", nil).
+					Return(nil, modelsErr.ErrUserNotFound)
+			},
+			expected: api.PostUsersSetMaxOpenReviews404JSONResponse{
+				Error: newErrorResponse(api.NOTFOUND, modelsErr.ErrUserNotFound.Error()).Error,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unexpected error 500",
+			body: &api.PostUsersSetMaxOpenReviewsJSONRequestBody{UserId: "u1"},
+			mockBehavior: func(m *mocks.MockuserUseCase) {
+				m.EXPECT().
+					SetMaxOpenReviews(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db fail"))
+			},
+			expected: nil,
+			wantErr:  modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUser := mocks.NewMockuserUseCase(ctrl)
+			tt.mockBehavior(mockUser)
+
+			svc := NewPRService(
+				zap.NewNop(),
+				mockUser,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := svc.PostUsersSetMaxOpenReviews(t.Context(),
+				api.PostUsersSetMaxOpenReviewsRequestObject{
+					Body: tt.body,
+				})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, tt.wantErr, err)
+			}
+
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
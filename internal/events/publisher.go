@@ -0,0 +1,60 @@
+package events
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+const (
+	outcomeReassigned    = "reassigned"
+	outcomeReviewerAdded = "reviewer_added"
+	outcomeNoCandidate   = "no_candidate"
+)
+
+type logPublisher struct {
+	logger  *zap.Logger
+	counter *prometheus.CounterVec
+}
+
+func NewLogPublisher(logger *zap.Logger, counter *prometheus.CounterVec) *logPublisher {
+	return &logPublisher{
+		logger:  logger,
+		counter: counter,
+	}
+}
+
+func (p *logPublisher) PublishEscalation(
+	_ context.Context,
+	escalation models.Escalation,
+) error {
+	outcome := escalationOutcome(escalation)
+	p.counter.WithLabelValues(string(escalation.Policy), outcome).Inc()
+
+	p.logger.Info("review escalated",
+		zap.String("event", "review.escalated"),
+		zap.String("pr_id", escalation.PRID),
+		zap.String("team_id", escalation.TeamID),
+		zap.String("idle_reviewer_id", escalation.IdleReviewerID),
+		zap.String("new_reviewer_id", escalation.NewReviewerID),
+		zap.String("policy", string(escalation.Policy)),
+		zap.String("outcome", outcome),
+		zap.Time("created_at", escalation.CreatedAt),
+	)
+
+	return nil
+}
+
+func escalationOutcome(escalation models.Escalation) string {
+	switch {
+	case escalation.NewReviewerID == "":
+		return outcomeNoCandidate
+	case escalation.Policy == models.EscalationPolicyAddReviewer:
+		return outcomeReviewerAdded
+	default:
+		return outcomeReassigned
+	}
+}
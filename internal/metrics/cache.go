@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	err := prometheus.Register(CacheRequestsTotal)
+	if err != nil {
+		log.Warn("CacheRequestsTotal already register")
+	}
+}
+
+var (
+	CacheRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Обращения к кэшу репозитория: hit, miss или bypass внутри транзакции",
+	}, []string{"operation", "result"})
+)
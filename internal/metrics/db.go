@@ -10,6 +10,18 @@ func init() {
 	if err != nil {
 		log.Warn("DBQueryLatency already register")
 	}
+	err = prometheus.Register(DBTxRetriesTotal)
+	if err != nil {
+		log.Warn("DBTxRetriesTotal already register")
+	}
+	err = prometheus.Register(DBReadQueriesTotal)
+	if err != nil {
+		log.Warn("DBReadQueriesTotal already register")
+	}
+	err = prometheus.Register(DBPoolHealthy)
+	if err != nil {
+		log.Warn("DBPoolHealthy already register")
+	}
 }
 
 var (
@@ -22,4 +34,25 @@ var (
 		},
 		[]string{"operation"},
 	)
+
+	DBTxRetriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "tx_retries_total",
+		Help:      "Повторы транзакций после serialization failure и deadlock",
+	}, []string{"sqlstate", "outcome"})
+
+	DBReadQueriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "read_queries_total",
+		Help:      "Запросы на чтение вне транзакций по пулам соединений",
+	}, []string{"pool"})
+
+	DBPoolHealthy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "pool_healthy",
+		Help:      "Доступность пула соединений по последней проверке (1 — доступен)",
+	}, []string{"pool"})
 )
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dbPoolConnectionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db", "pool_connections"),
+		"Соединения пула по состояниям",
+		[]string{"pool", "state"}, nil,
+	)
+	dbPoolMaxConnectionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db", "pool_max_connections"),
+		"Максимальный размер пула",
+		[]string{"pool"}, nil,
+	)
+	dbPoolAcquiresDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db", "pool_acquires_total"),
+		"Число выдач соединений из пула",
+		[]string{"pool"}, nil,
+	)
+	dbPoolEmptyAcquiresDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db", "pool_empty_acquires_total"),
+		"Выдачи соединений, которым пришлось ждать освобождения пула",
+		[]string{"pool"}, nil,
+	)
+	dbPoolAcquireDurationDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db", "pool_acquire_duration_seconds_total"),
+		"Суммарное время ожидания соединений из пула",
+		[]string{"pool"}, nil,
+	)
+)
+
+// dbPoolCollector снимает статистику pgxpool в момент сбора метрик
+type dbPoolCollector struct {
+	name string
+	pool *pgxpool.Pool
+}
+
+func RegisterDBPool(name string, pool *pgxpool.Pool) error {
+	return prometheus.Register(&dbPoolCollector{name: name, pool: pool})
+}
+
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dbPoolConnectionsDesc
+	ch <- dbPoolMaxConnectionsDesc
+	ch <- dbPoolAcquiresDesc
+	ch <- dbPoolEmptyAcquiresDesc
+	ch <- dbPoolAcquireDurationDesc
+}
+
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(dbPoolConnectionsDesc, prometheus.GaugeValue,
+		float64(stat.AcquiredConns()), c.name, "acquired")
+	ch <- prometheus.MustNewConstMetric(dbPoolConnectionsDesc, prometheus.GaugeValue,
+		float64(stat.IdleConns()), c.name, "idle")
+	ch <- prometheus.MustNewConstMetric(dbPoolConnectionsDesc, prometheus.GaugeValue,
+		float64(stat.ConstructingConns()), c.name, "constructing")
+	ch <- prometheus.MustNewConstMetric(dbPoolMaxConnectionsDesc, prometheus.GaugeValue,
+		float64(stat.MaxConns()), c.name)
+	ch <- prometheus.MustNewConstMetric(dbPoolAcquiresDesc, prometheus.CounterValue,
+		float64(stat.AcquireCount()), c.name)
+	ch <- prometheus.MustNewConstMetric(dbPoolEmptyAcquiresDesc, prometheus.CounterValue,
+		float64(stat.EmptyAcquireCount()), c.name)
+	ch <- prometheus.MustNewConstMetric(dbPoolAcquireDurationDesc, prometheus.CounterValue,
+		stat.AcquireDuration().Seconds(), c.name)
+}
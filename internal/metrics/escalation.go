@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	err := prometheus.Register(EscalationsTotal)
+	if err != nil {
+		log.Warn("EscalationsTotal already register")
+	}
+}
+
+var (
+	EscalationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "escalation",
+		Name:      "total",
+		Help:      "Число эскалаций зависших ревью",
+	}, []string{"policy", "outcome"})
+)
@@ -0,0 +1,205 @@
+package repo_middleware
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+// команды с одним именем и пользователи есть в разных организациях, поэтому ключ включает организацию
+type cacheKey struct {
+	organization string
+	name         string
+}
+
+type cacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// middlewareCacheRepo кэширует состав команд. Остальные методы уходят в next
+// без изменений, поэтому Repository встроен, а не проксируется вручную.
+type middlewareCacheRepo struct {
+	Repository
+	transactor Transactor
+	clock      clock.Clock
+	ttl        time.Duration
+	requests   *prometheus.CounterVec
+
+	mu         sync.Mutex
+	generation uint64
+	teams      map[cacheKey]cacheEntry[*models.Team]
+	userTeams  map[cacheKey]cacheEntry[[]models.UserTeam]
+}
+
+type cacheTxKey struct{}
+
+// Внутри транзакции кэш не используется при любом уровне изоляции: решения о назначении
+// ревьюверов принимаются в транзакциях и должны опираться на текущие составы, а не на
+// прочитанные до TTL назад.
+type cacheTxState struct {
+	// транзакция меняла команды: после коммита кэш сбрасывается ещё раз
+	dirty atomic.Bool
+}
+
+// NewMiddlewareCacheRepo возвращает репозиторий и транзактор: транзакции нужно
+// открывать через него, иначе кэш не узнает о них.
+func NewMiddlewareCacheRepo(
+	repo Repository,
+	transactor Transactor,
+	clock clock.Clock,
+	ttl time.Duration,
+	requests *prometheus.CounterVec,
+) *middlewareCacheRepo {
+	return &middlewareCacheRepo{
+		Repository: repo,
+		transactor: transactor,
+		clock:      clock,
+		ttl:        ttl,
+		requests:   requests,
+		teams:      make(map[cacheKey]cacheEntry[*models.Team]),
+		userTeams:  make(map[cacheKey]cacheEntry[[]models.UserTeam]),
+	}
+}
+
+func (m *middlewareCacheRepo) WithTx(
+	ctx context.Context,
+	function func(ctx context.Context) error,
+	opts ...models.TxOption,
+) error {
+	if _, ok := ctx.Value(cacheTxKey{}).(*cacheTxState); ok {
+		return m.transactor.WithTx(ctx, function, opts...)
+	}
+
+	state := &cacheTxState{}
+
+	err := m.transactor.WithTx(ctx, func(ctx context.Context) error {
+		return function(context.WithValue(ctx, cacheTxKey{}, state))
+	}, opts...)
+
+	// до коммита другие запросы могли снова закэшировать старые данные
+	if state.dirty.Load() {
+		m.invalidate()
+	}
+
+	return err
+}
+
+func (m *middlewareCacheRepo) TeamGet(ctx context.Context, teamName string) (*models.Team, error) {
+	return cached(ctx, m, "TeamGet", m.teams, teamName, cloneTeam, func(ctx context.Context) (*models.Team, error) {
+		return m.Repository.TeamGet(ctx, teamName)
+	})
+}
+
+func (m *middlewareCacheRepo) GetUserTeams(ctx context.Context, userID string) ([]models.UserTeam, error) {
+	return cached(ctx, m, "GetUserTeams", m.userTeams, userID, slices.Clone, func(ctx context.Context) ([]models.UserTeam, error) {
+		return m.Repository.GetUserTeams(ctx, userID)
+	})
+}
+
+func (m *middlewareCacheRepo) TeamAdd(ctx context.Context, team models.Team) error {
+	defer m.invalidateAfterWrite(ctx)
+	return m.Repository.TeamAdd(ctx, team)
+}
+
+func (m *middlewareCacheRepo) SetIsActive(ctx context.Context, userID string, isActive bool) (*models.User, error) {
+	defer m.invalidateAfterWrite(ctx)
+	return m.Repository.SetIsActive(ctx, userID, isActive)
+}
+
+func (m *middlewareCacheRepo) ImportUser(ctx context.Context, user models.User) error {
+	defer m.invalidateAfterWrite(ctx)
+	return m.Repository.ImportUser(ctx, user)
+}
+
+func (m *middlewareCacheRepo) TeamUpdateMembers(ctx context.Context, change models.TeamMembersChange) error {
+	defer m.invalidateAfterWrite(ctx)
+	return m.Repository.TeamUpdateMembers(ctx, change)
+}
+
+func (m *middlewareCacheRepo) TeamRename(ctx context.Context, teamName, newTeamName string) error {
+	defer m.invalidateAfterWrite(ctx)
+	return m.Repository.TeamRename(ctx, teamName, newTeamName)
+}
+
+func (m *middlewareCacheRepo) TeamDelete(ctx context.Context, teamName string, openPRPolicy models.OpenPRPolicy) (*models.TeamDeletion, error) {
+	defer m.invalidateAfterWrite(ctx)
+	return m.Repository.TeamDelete(ctx, teamName, openPRPolicy)
+}
+
+func cached[V any](
+	ctx context.Context,
+	m *middlewareCacheRepo,
+	operation string,
+	entries map[cacheKey]cacheEntry[V],
+	name string,
+	clone func(V) V,
+	load func(ctx context.Context) (V, error),
+) (V, error) {
+	if _, ok := ctx.Value(cacheTxKey{}).(*cacheTxState); ok {
+		m.requests.WithLabelValues(operation, "bypass").Inc()
+		return load(ctx)
+	}
+
+	key := cacheKey{organization: models.OrganizationFromContext(ctx), name: name}
+
+	m.mu.Lock()
+	entry, found := entries[key]
+	generation := m.generation
+	m.mu.Unlock()
+
+	if found && m.clock.Now().Before(entry.expiresAt) {
+		m.requests.WithLabelValues(operation, "hit").Inc()
+		return clone(entry.value), nil
+	}
+
+	m.requests.WithLabelValues(operation, "miss").Inc()
+	// отстающая реплика вернула бы данные до инвалидации, и они остались бы в кэше на весь TTL
+	value, err := load(models.WithPrimaryRead(ctx))
+	if err != nil {
+		return value, err
+	}
+
+	m.mu.Lock()
+	// инвалидация во время чтения: прочитанное значение могло устареть
+	if m.generation == generation {
+		entries[key] = cacheEntry[V]{value: clone(value), expiresAt: m.clock.Now().Add(m.ttl)}
+	}
+	m.mu.Unlock()
+
+	return value, nil
+}
+
+func (m *middlewareCacheRepo) invalidateAfterWrite(ctx context.Context) {
+	if state, ok := ctx.Value(cacheTxKey{}).(*cacheTxState); ok {
+		state.dirty.Store(true)
+	}
+	m.invalidate()
+}
+
+// составы команд меняются редко, поэтому любое изменение сбрасывает весь кэш
+func (m *middlewareCacheRepo) invalidate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.generation++
+	clear(m.teams)
+	clear(m.userTeams)
+}
+
+func cloneTeam(team *models.Team) *models.Team {
+	if team == nil {
+		return nil
+	}
+
+	clone := *team
+	clone.Members = slices.Clone(team.Members)
+	return &clone
+}
@@ -0,0 +1,252 @@
+package repo_middleware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/middleware/repo_middleware"
+	"github.com/Tortik3000/PR-service/internal/models"
+	"github.com/Tortik3000/PR-service/internal/repository/memory"
+	"github.com/Tortik3000/PR-service/internal/repository/repositorytest"
+)
+
+const cacheTTL = time.Minute
+
+type countingRepo struct {
+	repo_middleware.Repository
+	teamGets      int
+	userTeamsGets int
+	primaryReads  int
+}
+
+func (r *countingRepo) TeamGet(ctx context.Context, teamName string) (*models.Team, error) {
+	r.teamGets++
+	if models.IsPrimaryRead(ctx) {
+		r.primaryReads++
+	}
+	return r.Repository.TeamGet(ctx, teamName)
+}
+
+func (r *countingRepo) GetUserTeams(ctx context.Context, userID string) ([]models.UserTeam, error) {
+	r.userTeamsGets++
+	if models.IsPrimaryRead(ctx) {
+		r.primaryReads++
+	}
+	return r.Repository.GetUserTeams(ctx, userID)
+}
+
+func newCacheRequests() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(prometheus.CounterOpts{Name: "cache_requests"}, []string{"operation", "result"})
+}
+
+func TestCacheConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Backend {
+		repo := memory.NewRepo(zap.NewNop())
+		cache := repo_middleware.NewMiddlewareCacheRepo(repo, repo, clock.New(), cacheTTL, newCacheRequests())
+		return repositorytest.Backend{Repository: cache, Transactor: cache}
+	})
+}
+
+func TestMiddlewareCacheRepo(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	team := models.Team{
+		Name:    "backend",
+		Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+	}
+
+	type cacheRepo interface {
+		repo_middleware.Repository
+		repo_middleware.Transactor
+	}
+
+	tests := []struct {
+		name          string
+		run           func(t *testing.T, cache cacheRepo, clock *clock.Fake)
+		wantTeamGets  int
+		wantUserTeams int
+		wantResults   map[string]float64
+	}{
+		{
+			name: "repeated lookups hit cache",
+			run: func(t *testing.T, cache cacheRepo, _ *clock.Fake) {
+				for range 3 {
+					got, err := cache.TeamGet(t.Context(), "backend")
+					require.NoError(t, err)
+					assert.Equal(t, team.Members, got.Members)
+
+					teams, err := cache.GetUserTeams(t.Context(), "u1")
+					require.NoError(t, err)
+					require.Len(t, teams, 1)
+					assert.Equal(t, "backend", teams[0].Name)
+				}
+			},
+			wantTeamGets:  1,
+			wantUserTeams: 1,
+			wantResults:   map[string]float64{"hit": 4, "miss": 2},
+		},
+		{
+			name: "organizations do not share cache entries",
+			run: func(t *testing.T, cache cacheRepo, _ *clock.Fake) {
+				for _, organization := range []string{"org-a", "org-b", "org-a"} {
+					ctx := models.WithOrganization(t.Context(), organization)
+					_, err := cache.TeamGet(ctx, "backend")
+					require.NoError(t, err)
+				}
+			},
+			wantTeamGets: 2,
+			wantResults:  map[string]float64{"hit": 1, "miss": 2},
+		},
+		{
+			name: "cached team is not shared with callers",
+			run: func(t *testing.T, cache cacheRepo, _ *clock.Fake) {
+				got, err := cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+				got.Members[0].Username = "changed"
+
+				got, err = cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+				assert.Equal(t, "Alice", got.Members[0].Username)
+			},
+			wantTeamGets: 1,
+			wantResults:  map[string]float64{"hit": 1, "miss": 1},
+		},
+		{
+			name: "entries expire after TTL",
+			run: func(t *testing.T, cache cacheRepo, clock *clock.Fake) {
+				_, err := cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+
+				clock.Set(start.Add(cacheTTL))
+				_, err = cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+			},
+			wantTeamGets: 2,
+			wantResults:  map[string]float64{"miss": 2},
+		},
+		{
+			name: "writes invalidate cache",
+			run: func(t *testing.T, cache cacheRepo, _ *clock.Fake) {
+				_, err := cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+
+				_, err = cache.SetIsActive(t.Context(), "u1", false)
+				require.NoError(t, err)
+
+				got, err := cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+				assert.False(t, got.Members[0].IsActive)
+
+				err = cache.TeamUpdateMembers(t.Context(), models.TeamMembersChange{
+					TeamName: "backend",
+					Add:      []models.Member{{UserID: "u2", Username: "Bob", IsActive: true}},
+				})
+				require.NoError(t, err)
+
+				got, err = cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+				assert.Len(t, got.Members, 2)
+
+				err = cache.ImportUser(t.Context(), models.User{ID: "u2", Name: "Robert", IsActive: true, TimeZone: "UTC"})
+				require.NoError(t, err)
+
+				got, err = cache.TeamGet(t.Context(), "backend")
+				require.NoError(t, err)
+				assert.Equal(t, "Robert", got.Members[1].Username)
+			},
+			wantTeamGets: 4,
+			wantResults:  map[string]float64{"miss": 4},
+		},
+		{
+			name: "read committed transaction bypasses cache",
+			run: func(t *testing.T, cache cacheRepo, _ *clock.Fake) {
+				_, err := cache.GetUserTeams(t.Context(), "u1")
+				require.NoError(t, err)
+
+				err = cache.WithTx(t.Context(), func(ctx context.Context) error {
+					_, err := cache.GetUserTeams(ctx, "u1")
+					return err
+				})
+				require.NoError(t, err)
+			},
+			wantUserTeams: 2,
+			wantResults:   map[string]float64{"miss": 1, "bypass": 1},
+		},
+		{
+			name: "serializable transaction bypasses cache",
+			run: func(t *testing.T, cache cacheRepo, _ *clock.Fake) {
+				_, err := cache.GetUserTeams(t.Context(), "u1")
+				require.NoError(t, err)
+
+				err = cache.WithTx(t.Context(), func(ctx context.Context) error {
+					_, err := cache.GetUserTeams(ctx, "u1")
+					return err
+				}, models.WithIsolationLevel(models.IsolationLevelSerializable))
+				require.NoError(t, err)
+			},
+			wantUserTeams: 2,
+			wantResults:   map[string]float64{"miss": 1, "bypass": 1},
+		},
+		{
+			name: "transaction reads its own team changes",
+			run: func(t *testing.T, cache cacheRepo, _ *clock.Fake) {
+				err := cache.WithTx(t.Context(), func(ctx context.Context) error {
+					if err := cache.TeamRename(ctx, "backend", "platform"); err != nil {
+						return err
+					}
+
+					teams, err := cache.GetUserTeams(ctx, "u1")
+					require.NoError(t, err)
+					assert.Equal(t, "platform", teams[0].Name)
+					return nil
+				})
+				require.NoError(t, err)
+
+				teams, err := cache.GetUserTeams(t.Context(), "u1")
+				require.NoError(t, err)
+				assert.Equal(t, "platform", teams[0].Name)
+			},
+			wantUserTeams: 2,
+			wantResults:   map[string]float64{"miss": 1, "bypass": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := memory.NewRepo(zap.NewNop())
+			require.NoError(t, repo.TeamAdd(t.Context(), team))
+
+			counting := &countingRepo{Repository: repo}
+			fakeClock := clock.NewFake(start)
+			requests := newCacheRequests()
+			cache := repo_middleware.NewMiddlewareCacheRepo(counting, repo, fakeClock, cacheTTL, requests)
+
+			tt.run(t, cache, fakeClock)
+
+			assert.Equal(t, tt.wantTeamGets, counting.teamGets)
+			assert.Equal(t, tt.wantUserTeams, counting.userTeamsGets)
+			// промахи читаются из основной бд, чтобы не закэшировать данные отстающей реплики
+			assert.InDelta(t, tt.wantResults["miss"], counting.primaryReads, 0)
+			for _, result := range []string{"hit", "miss", "bypass"} {
+				var got float64
+				for _, operation := range []string{"TeamGet", "GetUserTeams"} {
+					got += testutil.ToFloat64(requests.WithLabelValues(operation, result))
+				}
+				assert.InDelta(t, tt.wantResults[result], got, 0, result)
+			}
+		})
+	}
+}
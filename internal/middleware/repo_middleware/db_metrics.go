@@ -10,11 +10,11 @@ import (
 )
 
 type middlewareMetricsRepo struct {
-	next      metricsRepo
+	next      Repository
 	histogram *prometheus.HistogramVec
 }
 
-func NewMiddlewareMetricsRepo(repo metricsRepo, histogram *prometheus.HistogramVec) metricsRepo {
+func NewMiddlewareMetricsRepo(repo Repository, histogram *prometheus.HistogramVec) Repository {
 	return &middlewareMetricsRepo{
 		next:      repo,
 		histogram: histogram,
@@ -39,39 +39,45 @@ func observeNoResult(histogram *prometheus.HistogramVec, operation string, fn fu
 	return err
 }
 
-func (m *middlewareMetricsRepo) PullRequestCreate(ctx context.Context, authorID, prID, prName string, reviewers []string) (*models.PR, error) {
+func (m *middlewareMetricsRepo) PullRequestCreate(ctx context.Context, pr models.PR) (*models.PR, error) {
 	return observe(m.histogram, "PullRequestCreate", func() (*models.PR, error) {
-		return m.next.PullRequestCreate(ctx, authorID, prID, prName, reviewers)
+		return m.next.PullRequestCreate(ctx, pr)
 	})
 }
 
-func (m *middlewareMetricsRepo) PullRequestMerge(ctx context.Context, prID string) (*models.PR, error) {
+func (m *middlewareMetricsRepo) PullRequestMerge(ctx context.Context, repository, prID string) (*models.PR, error) {
 	return observe(m.histogram, "PullRequestMerge", func() (*models.PR, error) {
-		return m.next.PullRequestMerge(ctx, prID)
+		return m.next.PullRequestMerge(ctx, repository, prID)
 	})
 }
 
-func (m *middlewareMetricsRepo) GetPullRequest(ctx context.Context, prID string) (*models.PR, error) {
+func (m *middlewareMetricsRepo) GetPullRequest(ctx context.Context, repository, prID string) (*models.PR, error) {
 	return observe(m.histogram, "GetPullRequest", func() (*models.PR, error) {
-		return m.next.GetPullRequest(ctx, prID)
+		return m.next.GetPullRequest(ctx, repository, prID)
 	})
 }
 
-func (m *middlewareMetricsRepo) PullRequestReassign(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+func (m *middlewareMetricsRepo) PullRequestGet(ctx context.Context, repository, prID string) (*models.PR, error) {
+	return observe(m.histogram, "PullRequestGet", func() (*models.PR, error) {
+		return m.next.PullRequestGet(ctx, repository, prID)
+	})
+}
+
+func (m *middlewareMetricsRepo) PullRequestReassign(ctx context.Context, repository, prID, oldReviewerID, newReviewerID string, fromFallback bool) error {
 	return observeNoResult(m.histogram, "PullRequestReassign", func() error {
-		return m.next.PullRequestReassign(ctx, prID, oldReviewerID, newReviewerID)
+		return m.next.PullRequestReassign(ctx, repository, prID, oldReviewerID, newReviewerID, fromFallback)
 	})
 }
 
-func (m *middlewareMetricsRepo) GetTeamIDByUserID(ctx context.Context, userID string) (string, error) {
-	return observe(m.histogram, "GetTeamIDByUserID", func() (string, error) {
-		return m.next.GetTeamIDByUserID(ctx, userID)
+func (m *middlewareMetricsRepo) GetUserTeams(ctx context.Context, userID string) ([]models.UserTeam, error) {
+	return observe(m.histogram, "GetUserTeams", func() ([]models.UserTeam, error) {
+		return m.next.GetUserTeams(ctx, userID)
 	})
 }
 
-func (m *middlewareMetricsRepo) GetActiveTeammates(ctx context.Context, teamID string, excludedUsers []string, count uint64) ([]string, error) {
-	return observe(m.histogram, "GetActiveTeammates", func() ([]string, error) {
-		return m.next.GetActiveTeammates(ctx, teamID, excludedUsers, count)
+func (m *middlewareMetricsRepo) GetActiveTeammates(ctx context.Context, teamID string, excludedUsers []string, now time.Time) ([]models.Candidate, error) {
+	return observe(m.histogram, "GetActiveTeammates", func() ([]models.Candidate, error) {
+		return m.next.GetActiveTeammates(ctx, teamID, excludedUsers, now)
 	})
 }
 
@@ -98,3 +104,260 @@ func (m *middlewareMetricsRepo) SetIsActive(ctx context.Context, userID string,
 		return m.next.SetIsActive(ctx, userID, isActive)
 	})
 }
+
+func (m *middlewareMetricsRepo) TeamStats(ctx context.Context, filter models.TeamStatsFilter) ([]models.TeamStats, uint64, error) {
+	var total uint64
+	stats, err := observe(m.histogram, "TeamStats", func() ([]models.TeamStats, error) {
+		var stats []models.TeamStats
+		var err error
+		stats, total, err = m.next.TeamStats(ctx, filter)
+		return stats, err
+	})
+	return stats, total, err
+}
+
+func (m *middlewareMetricsRepo) SetTeamPolicy(ctx context.Context, policy models.TeamPolicy) (*models.TeamPolicy, error) {
+	return observe(m.histogram, "SetTeamPolicy", func() (*models.TeamPolicy, error) {
+		return m.next.SetTeamPolicy(ctx, policy)
+	})
+}
+
+func (m *middlewareMetricsRepo) PullRequestAddReviewer(ctx context.Context, repository, prID, reviewerID string, fromFallback bool) error {
+	return observeNoResult(m.histogram, "PullRequestAddReviewer", func() error {
+		return m.next.PullRequestAddReviewer(ctx, repository, prID, reviewerID, fromFallback)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetStaleReviews(ctx context.Context, now time.Time, limit uint64) ([]models.StaleReview, error) {
+	return observe(m.histogram, "GetStaleReviews", func() ([]models.StaleReview, error) {
+		return m.next.GetStaleReviews(ctx, now, limit)
+	})
+}
+
+func (m *middlewareMetricsRepo) IsReviewEscalated(ctx context.Context, repository, prID, reviewerID string) (bool, error) {
+	return observe(m.histogram, "IsReviewEscalated", func() (bool, error) {
+		return m.next.IsReviewEscalated(ctx, repository, prID, reviewerID)
+	})
+}
+
+func (m *middlewareMetricsRepo) CreateEscalation(ctx context.Context, escalation models.Escalation) error {
+	return observeNoResult(m.histogram, "CreateEscalation", func() error {
+		return m.next.CreateEscalation(ctx, escalation)
+	})
+}
+
+func (m *middlewareMetricsRepo) SetSchedule(ctx context.Context, userID, timeZone string, workingHours *models.WorkingHours) (*models.User, error) {
+	return observe(m.histogram, "SetSchedule", func() (*models.User, error) {
+		return m.next.SetSchedule(ctx, userID, timeZone, workingHours)
+	})
+}
+
+func (m *middlewareMetricsRepo) CreateOutOfOffice(ctx context.Context, outOfOffice models.OutOfOffice) (*models.OutOfOffice, error) {
+	return observe(m.histogram, "CreateOutOfOffice", func() (*models.OutOfOffice, error) {
+		return m.next.CreateOutOfOffice(ctx, outOfOffice)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetStartedOutOfOffice(ctx context.Context, now time.Time, limit uint64) ([]models.OutOfOffice, error) {
+	return observe(m.histogram, "GetStartedOutOfOffice", func() ([]models.OutOfOffice, error) {
+		return m.next.GetStartedOutOfOffice(ctx, now, limit)
+	})
+}
+
+func (m *middlewareMetricsRepo) MarkOutOfOfficeReassigned(ctx context.Context, id int64, reassignedAt time.Time) error {
+	return observeNoResult(m.histogram, "MarkOutOfOfficeReassigned", func() error {
+		return m.next.MarkOutOfOfficeReassigned(ctx, id, reassignedAt)
+	})
+}
+
+func (m *middlewareMetricsRepo) SetMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error) {
+	return observe(m.histogram, "SetMaxOpenReviews", func() (*models.User, error) {
+		return m.next.SetMaxOpenReviews(ctx, userID, maxOpenReviews)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetTeamPolicy(ctx context.Context, teamID string) (*models.TeamPolicy, error) {
+	return observe(m.histogram, "GetTeamPolicy", func() (*models.TeamPolicy, error) {
+		return m.next.GetTeamPolicy(ctx, teamID)
+	})
+}
+
+func (m *middlewareMetricsRepo) SetTeamFallbacks(ctx context.Context, teamName string, fallbackTeams []string) error {
+	return observeNoResult(m.histogram, "SetTeamFallbacks", func() error {
+		return m.next.SetTeamFallbacks(ctx, teamName, fallbackTeams)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetFallbackTeamIDs(ctx context.Context, teamID string) ([]string, error) {
+	return observe(m.histogram, "GetFallbackTeamIDs", func() ([]string, error) {
+		return m.next.GetFallbackTeamIDs(ctx, teamID)
+	})
+}
+
+func (m *middlewareMetricsRepo) SetCodeOwners(ctx context.Context, teamName string, rules []models.CodeOwnersRule) error {
+	return observeNoResult(m.histogram, "SetCodeOwners", func() error {
+		return m.next.SetCodeOwners(ctx, teamName, rules)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetTeamCodeOwners(ctx context.Context, teamName string) ([]models.CodeOwnersRule, error) {
+	return observe(m.histogram, "GetTeamCodeOwners", func() ([]models.CodeOwnersRule, error) {
+		return m.next.GetTeamCodeOwners(ctx, teamName)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetCodeOwners(ctx context.Context, teamID string) ([]models.CodeOwnersRule, error) {
+	return observe(m.histogram, "GetCodeOwners", func() ([]models.CodeOwnersRule, error) {
+		return m.next.GetCodeOwners(ctx, teamID)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetActiveCodeOwners(ctx context.Context, owners []models.CodeOwner, excludedUsers []string, now time.Time) ([]models.Candidate, error) {
+	return observe(m.histogram, "GetActiveCodeOwners", func() ([]models.Candidate, error) {
+		return m.next.GetActiveCodeOwners(ctx, owners, excludedUsers, now)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetUser(ctx context.Context, userID string) (*models.User, error) {
+	return observe(m.histogram, "GetUser", func() (*models.User, error) {
+		return m.next.GetUser(ctx, userID)
+	})
+}
+
+func (m *middlewareMetricsRepo) PullRequestRemoveReviewer(ctx context.Context, repository, prID, reviewerID string) error {
+	return observeNoResult(m.histogram, "PullRequestRemoveReviewer", func() error {
+		return m.next.PullRequestRemoveReviewer(ctx, repository, prID, reviewerID)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetRecentPairings(ctx context.Context, authorID string, reviewerIDs []string, since time.Time) (map[string]int, error) {
+	return observe(m.histogram, "GetRecentPairings", func() (map[string]int, error) {
+		return m.next.GetRecentPairings(ctx, authorID, reviewerIDs, since)
+	})
+}
+
+func (m *middlewareMetricsRepo) TeamPairings(ctx context.Context, teamName string, since time.Time) ([]models.ReviewPairing, error) {
+	return observe(m.histogram, "TeamPairings", func() ([]models.ReviewPairing, error) {
+		return m.next.TeamPairings(ctx, teamName, since)
+	})
+}
+
+func (m *middlewareMetricsRepo) TeamUpdateMembers(ctx context.Context, change models.TeamMembersChange) error {
+	return observeNoResult(m.histogram, "TeamUpdateMembers", func() error {
+		return m.next.TeamUpdateMembers(ctx, change)
+	})
+}
+
+func (m *middlewareMetricsRepo) TeamRename(ctx context.Context, teamName, newTeamName string) error {
+	return observeNoResult(m.histogram, "TeamRename", func() error {
+		return m.next.TeamRename(ctx, teamName, newTeamName)
+	})
+}
+
+func (m *middlewareMetricsRepo) TeamDelete(ctx context.Context, teamName string, openPRPolicy models.OpenPRPolicy) (*models.TeamDeletion, error) {
+	return observe(m.histogram, "TeamDelete", func() (*models.TeamDeletion, error) {
+		return m.next.TeamDelete(ctx, teamName, openPRPolicy)
+	})
+}
+
+func (m *middlewareMetricsRepo) TeamAudit(ctx context.Context, teamName string) ([]models.TeamAuditEntry, error) {
+	return observe(m.histogram, "TeamAudit", func() ([]models.TeamAuditEntry, error) {
+		return m.next.TeamAudit(ctx, teamName)
+	})
+}
+
+func (m *middlewareMetricsRepo) RepositoryAdd(ctx context.Context, repository models.Repository) (*models.Repository, error) {
+	return observe(m.histogram, "RepositoryAdd", func() (*models.Repository, error) {
+		return m.next.RepositoryAdd(ctx, repository)
+	})
+}
+
+func (m *middlewareMetricsRepo) RepositoryGet(ctx context.Context, name string) (*models.Repository, error) {
+	return observe(m.histogram, "RepositoryGet", func() (*models.Repository, error) {
+		return m.next.RepositoryGet(ctx, name)
+	})
+}
+
+func (m *middlewareMetricsRepo) RepositoryList(ctx context.Context) ([]models.Repository, error) {
+	return observe(m.histogram, "RepositoryList", func() ([]models.Repository, error) {
+		return m.next.RepositoryList(ctx)
+	})
+}
+
+func (m *middlewareMetricsRepo) RepositoryUpdate(ctx context.Context, repository models.Repository) (*models.Repository, error) {
+	return observe(m.histogram, "RepositoryUpdate", func() (*models.Repository, error) {
+		return m.next.RepositoryUpdate(ctx, repository)
+	})
+}
+
+func (m *middlewareMetricsRepo) RepositoryDelete(ctx context.Context, name string) error {
+	return observeNoResult(m.histogram, "RepositoryDelete", func() error {
+		return m.next.RepositoryDelete(ctx, name)
+	})
+}
+
+func (m *middlewareMetricsRepo) ReserveIdempotencyKey(ctx context.Context, request models.IdempotentRequest, now time.Time) (*models.IdempotentRequest, error) {
+	return observe(m.histogram, "ReserveIdempotencyKey", func() (*models.IdempotentRequest, error) {
+		return m.next.ReserveIdempotencyKey(ctx, request, now)
+	})
+}
+
+func (m *middlewareMetricsRepo) SaveIdempotentResponse(ctx context.Context, request models.IdempotentRequest) error {
+	return observeNoResult(m.histogram, "SaveIdempotentResponse", func() error {
+		return m.next.SaveIdempotentResponse(ctx, request)
+	})
+}
+
+func (m *middlewareMetricsRepo) ReleaseIdempotencyKey(ctx context.Context, key, path string) error {
+	return observeNoResult(m.histogram, "ReleaseIdempotencyKey", func() error {
+		return m.next.ReleaseIdempotencyKey(ctx, key, path)
+	})
+}
+
+func (m *middlewareMetricsRepo) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	return observe(m.histogram, "DeleteExpiredIdempotencyKeys", func() (int64, error) {
+		return m.next.DeleteExpiredIdempotencyKeys(ctx, now)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetPullRequestVersion(ctx context.Context, repository, prID string) (int64, error) {
+	return observe(m.histogram, "GetPullRequestVersion", func() (int64, error) {
+		return m.next.GetPullRequestVersion(ctx, repository, prID)
+	})
+}
+
+func (m *middlewareMetricsRepo) GetTeamVersion(ctx context.Context, teamName string) (int64, error) {
+	return observe(m.histogram, "GetTeamVersion", func() (int64, error) {
+		return m.next.GetTeamVersion(ctx, teamName)
+	})
+}
+
+func (m *middlewareMetricsRepo) ExportTeams(ctx context.Context, fn func(team models.Team) error) error {
+	return observeNoResult(m.histogram, "ExportTeams", func() error {
+		return m.next.ExportTeams(ctx, fn)
+	})
+}
+
+func (m *middlewareMetricsRepo) ExportUsers(ctx context.Context, fn func(user models.User) error) error {
+	return observeNoResult(m.histogram, "ExportUsers", func() error {
+		return m.next.ExportUsers(ctx, fn)
+	})
+}
+
+func (m *middlewareMetricsRepo) ExportPullRequests(ctx context.Context, fn func(pr models.PR) error) error {
+	return observeNoResult(m.histogram, "ExportPullRequests", func() error {
+		return m.next.ExportPullRequests(ctx, fn)
+	})
+}
+
+func (m *middlewareMetricsRepo) ImportUser(ctx context.Context, user models.User) error {
+	return observeNoResult(m.histogram, "ImportUser", func() error {
+		return m.next.ImportUser(ctx, user)
+	})
+}
+
+func (m *middlewareMetricsRepo) ImportPullRequest(ctx context.Context, pr models.PR) error {
+	return observeNoResult(m.histogram, "ImportPullRequest", func() error {
+		return m.next.ImportPullRequest(ctx, pr)
+	})
+}
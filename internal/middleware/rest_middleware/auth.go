@@ -0,0 +1,65 @@
+package rest_middleware
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+var errUnauthorized = errors.New("missing or unknown bearer token")
+
+// AuthMiddleware кладёт в контекст организацию, которой выдан токен из Authorization.
+// Организация берётся только из токена: поля запроса на неё не влияют.
+// Без токенов (apiKeys пуст) все запросы относятся к организации по умолчанию.
+func AuthMiddleware(apiKeys map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(apiKeys) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				writeError(w, http.StatusUnauthorized, api.UNAUTHORIZED, errUnauthorized)
+				return
+			}
+
+			organization, found := lookupOrganization(apiKeys, token)
+			if !found {
+				writeError(w, http.StatusUnauthorized, api.UNAUTHORIZED, errUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(models.WithOrganization(r.Context(), organization)))
+		})
+	}
+}
+
+// lookupOrganization сравнивает токен со всеми ключами за постоянное время,
+// чтобы по времени ответа нельзя было подобрать токен
+func lookupOrganization(apiKeys map[string]string, token string) (string, bool) {
+	var organization string
+	found := false
+	for key, org := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(token)) == 1 {
+			organization, found = org, true
+		}
+	}
+	return organization, found
+}
+
+func writeError(w http.ResponseWriter, status int, code api.ErrorResponseErrorCode, err error) {
+	var response api.ErrorResponse
+	response.Error.Code = code
+	response.Error.Message = err.Error()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
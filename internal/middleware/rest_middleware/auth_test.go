@@ -0,0 +1,81 @@
+package rest_middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	apiKeys := map[string]string{"t1": "acme", "t2": "globex"}
+
+	tests := []struct {
+		name             string
+		apiKeys          map[string]string
+		authorization    string
+		wantCode         int
+		wantOrganization string
+	}{
+		{
+			name:             "token selects organization",
+			apiKeys:          apiKeys,
+			authorization:    "Bearer t2",
+			wantCode:         http.StatusOK,
+			wantOrganization: "globex",
+		},
+		{
+			name:     "missing token",
+			apiKeys:  apiKeys,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "unknown token",
+			apiKeys:       apiKeys,
+			authorization: "Bearer t3",
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:          "not a bearer token",
+			apiKeys:       apiKeys,
+			authorization: "Basic t1",
+			wantCode:      http.StatusUnauthorized,
+		},
+		{
+			name:             "no keys configured",
+			authorization:    "Bearer t1",
+			wantCode:         http.StatusOK,
+			wantOrganization: models.DefaultOrganization,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var organization string
+			handler := AuthMiddleware(tt.apiKeys)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				organization = models.OrganizationFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.wantOrganization, organization)
+			if tt.wantCode == http.StatusUnauthorized {
+				assert.JSONEq(t, `{"error":{"code":"UNAUTHORIZED","message":"missing or unknown bearer token"}}`, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package rest_middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	api "github.com/Tortik3000/PR-service/generated/api/pr-service"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+//go:generate mockgen_uber -source=idempotency.go -destination=mocks/idempotency_mock.go -package=mocks
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	idempotencyStoreTimeLimit = 5 * time.Second
+	// с запасом больше таймаута записи HTTP-сервера: к этому времени ответ первого запроса
+	// клиенту уже не доставить
+	idempotencyLease = time.Minute
+)
+
+type idempotencyUseCase interface {
+	BeginIdempotentRequest(ctx context.Context, request models.IdempotentRequest) (*models.IdempotentRequest, error)
+	CompleteIdempotentRequest(ctx context.Context, request models.IdempotentRequest) error
+	AbortIdempotentRequest(ctx context.Context, key, path string) error
+}
+
+func IdempotencyMiddleware(
+	logger *zap.Logger,
+	useCase idempotencyUseCase,
+	ttl time.Duration,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > maxIdempotencyKeyLength {
+				http.Error(w, "Invalid request: Idempotency-Key is too long", http.StatusBadRequest)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "Invalid request: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			request := models.IdempotentRequest{
+				Key:         key,
+				Path:        r.URL.Path,
+				Fingerprint: fingerprint(r, body),
+				TTL:         ttl,
+				Lease:       idempotencyLease,
+			}
+			logger := logger.With(
+				zap.String("idempotency_key", request.Key),
+				zap.String("path", request.Path),
+			)
+
+			stored, err := useCase.BeginIdempotentRequest(r.Context(), request)
+			if err != nil {
+				switch {
+				case errors.Is(err, modelsErr.ErrIdempotencyKeyReused):
+					writeError(w, http.StatusUnprocessableEntity, api.IDEMPOTENCYKEYREUSED, err)
+
+				case errors.Is(err, modelsErr.ErrIdempotencyKeyInProgress):
+					writeError(w, http.StatusConflict, api.IDEMPOTENCYKEYINPROGRESS, err)
+
+				default:
+					logger.Error("begin idempotent request", zap.Error(err))
+					http.Error(w, modelsErr.ErrInternal.Error(), http.StatusInternalServerError)
+				}
+				return
+			}
+
+			if stored != nil {
+				logger.Info("replaying idempotent response", zap.Int("status_code", stored.StatusCode))
+				replay(w, stored)
+				return
+			}
+
+			var response bytes.Buffer
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&response)
+
+			next.ServeHTTP(ww, r)
+
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), idempotencyStoreTimeLimit)
+			defer cancel()
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			// 5xx не сохраняем, чтобы повтор запроса выполнился заново
+			if status >= http.StatusInternalServerError {
+				if err = useCase.AbortIdempotentRequest(ctx, request.Key, request.Path); err != nil {
+					logger.Error("abort idempotent request", zap.Error(err))
+				}
+				return
+			}
+
+			request.StatusCode = status
+			request.ContentType = ww.Header().Get("Content-Type")
+			request.ETag = ww.Header().Get("ETag")
+			request.Response = response.Bytes()
+			if err = useCase.CompleteIdempotentRequest(ctx, request); err != nil {
+				logger.Error("complete idempotent request", zap.Error(err))
+			}
+		})
+	}
+}
+
+func fingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, stored *models.IdempotentRequest) {
+	if stored.ContentType != "" {
+		w.Header().Set("Content-Type", stored.ContentType)
+	}
+	// без ETag клиент не сможет сделать следующий условный запрос по повторённому ответу
+	if stored.ETag != "" {
+		w.Header().Set("ETag", stored.ETag)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(stored.StatusCode)
+	_, _ = w.Write(stored.Response)
+}
@@ -0,0 +1,203 @@
+package rest_middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/middleware/rest_middleware/mocks"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const (
+		key  = "key-1"
+		path = "/pullRequest/create"
+		body = `{"pull_request_id":"pr-1"}`
+	)
+	stored := models.IdempotentRequest{
+		Key:         key,
+		Path:        path,
+		StatusCode:  http.StatusCreated,
+		ContentType: "application/json",
+		ETag:        `"7"`,
+		Response:    []byte(`{"stored":true}`),
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		key          string
+		handlerCode  int
+		setup        func(m *mocks.MockidempotencyUseCase)
+		wantCalled   bool
+		wantCode     int
+		wantBody     string
+		wantETag     string
+		wantReplayed bool
+	}{
+		{
+			name:        "no key",
+			method:      http.MethodPost,
+			handlerCode: http.StatusCreated,
+			setup:       func(*mocks.MockidempotencyUseCase) {},
+			wantCalled:  true,
+			wantCode:    http.StatusCreated,
+			wantBody:    `{"created":true}`,
+		},
+		{
+			name:        "not a post",
+			method:      http.MethodGet,
+			key:         key,
+			handlerCode: http.StatusOK,
+			setup:       func(*mocks.MockidempotencyUseCase) {},
+			wantCalled:  true,
+			wantCode:    http.StatusOK,
+			wantBody:    `{"created":true}`,
+		},
+		{
+			name:        "first request is stored",
+			method:      http.MethodPost,
+			key:         key,
+			handlerCode: http.StatusCreated,
+			setup: func(m *mocks.MockidempotencyUseCase) {
+				m.EXPECT().
+					BeginIdempotentRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request models.IdempotentRequest) (*models.IdempotentRequest, error) {
+						assert.Equal(t, key, request.Key)
+						assert.Equal(t, path, request.Path)
+						assert.Equal(t, time.Hour, request.TTL)
+						assert.Equal(t, idempotencyLease, request.Lease)
+						assert.NotEmpty(t, request.Fingerprint)
+						return nil, nil
+					})
+				m.EXPECT().
+					CompleteIdempotentRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request models.IdempotentRequest) error {
+						assert.Equal(t, http.StatusCreated, request.StatusCode)
+						assert.Equal(t, "application/json", request.ContentType)
+						assert.Equal(t, `"3"`, request.ETag)
+						assert.JSONEq(t, `{"created":true}`, string(request.Response))
+						return nil
+					})
+			},
+			wantCalled: true,
+			wantCode:   http.StatusCreated,
+			wantBody:   `{"created":true}`,
+			wantETag:   `"3"`,
+		},
+		{
+			name:        "retry replays stored response",
+			method:      http.MethodPost,
+			key:         key,
+			handlerCode: http.StatusCreated,
+			setup: func(m *mocks.MockidempotencyUseCase) {
+				m.EXPECT().
+					BeginIdempotentRequest(gomock.Any(), gomock.Any()).
+					Return(&stored, nil)
+			},
+			wantCode:     http.StatusCreated,
+			wantBody:     `{"stored":true}`,
+			wantETag:     `"7"`,
+			wantReplayed: true,
+		},
+		{
+			name:   "key reused with different body",
+			method: http.MethodPost,
+			key:    key,
+			setup: func(m *mocks.MockidempotencyUseCase) {
+				m.EXPECT().
+					BeginIdempotentRequest(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrIdempotencyKeyReused)
+			},
+			wantCode: http.StatusUnprocessableEntity,
+			wantBody: `{"error":{"code":"IDEMPOTENCY_KEY_REUSED","message":"idempotency key was already used with a different request"}}`,
+		},
+		{
+			name:   "first request in progress",
+			method: http.MethodPost,
+			key:    key,
+			setup: func(m *mocks.MockidempotencyUseCase) {
+				m.EXPECT().
+					BeginIdempotentRequest(gomock.Any(), gomock.Any()).
+					Return(nil, modelsErr.ErrIdempotencyKeyInProgress)
+			},
+			wantCode: http.StatusConflict,
+			wantBody: `{"error":{"code":"IDEMPOTENCY_KEY_IN_PROGRESS","message":"a request with this idempotency key is still in progress"}}`,
+		},
+		{
+			name:        "server error releases key",
+			method:      http.MethodPost,
+			key:         key,
+			handlerCode: http.StatusInternalServerError,
+			setup: func(m *mocks.MockidempotencyUseCase) {
+				m.EXPECT().
+					BeginIdempotentRequest(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				m.EXPECT().
+					AbortIdempotentRequest(gomock.Any(), key, path).
+					Return(nil)
+			},
+			wantCalled: true,
+			wantCode:   http.StatusInternalServerError,
+			wantBody:   `{"created":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := mocks.NewMockidempotencyUseCase(ctrl)
+			tt.setup(mockUseCase)
+
+			called := false
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				received, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				if tt.method == http.MethodPost {
+					assert.Equal(t, body, string(received))
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("ETag", `"3"`)
+				w.WriteHeader(tt.handlerCode)
+				_, _ = w.Write([]byte(`{"created":true}`))
+			})
+
+			req := httptest.NewRequest(tt.method, path, strings.NewReader(body))
+			if tt.key != "" {
+				req.Header.Set(IdempotencyKeyHeader, tt.key)
+			}
+			rec := httptest.NewRecorder()
+
+			IdempotencyMiddleware(zap.NewNop(), mockUseCase, time.Hour)(handler).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCalled, called)
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			if tt.wantETag != "" {
+				assert.Equal(t, tt.wantETag, rec.Header().Get("ETag"))
+			}
+			if tt.wantReplayed {
+				assert.Equal(t, "true", rec.Header().Get(IdempotentReplayedHeader))
+			}
+		})
+	}
+}
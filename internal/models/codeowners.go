@@ -0,0 +1,18 @@
+package models
+
+type CodeOwnerKind string
+
+const (
+	CodeOwnerUser CodeOwnerKind = "USER"
+	CodeOwnerTeam CodeOwnerKind = "TEAM"
+)
+
+type CodeOwner struct {
+	Kind CodeOwnerKind
+	Name string
+}
+
+type CodeOwnersRule struct {
+	Pattern string
+	Owners  []CodeOwner
+}
@@ -5,14 +5,39 @@ import "errors"
 var (
 	ErrTeamExist        = errors.New("team with this name already exists")
 	ErrPullRequestExist = errors.New("pull request with this ID already exists")
+	ErrRepositoryExist  = errors.New("repository with this name already exists")
+	ErrUserIDTaken      = errors.New("user id is taken by another organization")
 
-	ErrUserNotFound = errors.New("user not found")
-	ErrTeamNotFound = errors.New("team not found")
-	ErrPRNotFound   = errors.New("pull request not found")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrTeamNotFound       = errors.New("team not found")
+	ErrPRNotFound         = errors.New("pull request not found")
+	ErrRepositoryNotFound = errors.New("repository not found")
 
 	ErrPRMerged           = errors.New("pr already merged")
 	ErrNotAssigned        = errors.New("the user was not assigned as a reviewer for this PR")
 	ErrNotActiveCandidate = errors.New("no active replacement candidate in team")
+	ErrNotEnoughReviewers = errors.New("not enough reviewers below their review limit in team")
+	ErrAlreadyAssigned    = errors.New("the user is already assigned as a reviewer for this PR")
+	ErrAuthorReviewer     = errors.New("the author cannot review their own PR")
+	ErrNotTeamMember      = errors.New("the user is not a member of the PR's team or its fallback teams")
+	ErrUserInactive       = errors.New("the user is not active")
+	ErrUserNotInTeam      = errors.New("the user is not a member of this team")
+	ErrEmptyTeam          = errors.New("team must keep at least one member")
+	ErrTeamHasOpenPRs     = errors.New("team has open pull requests")
+	ErrTeamRequired       = errors.New("the author belongs to several teams, team_name is required")
+	ErrRepositoryHasPRs   = errors.New("repository has pull requests")
+
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+
+	ErrVersionMismatch = errors.New("the resource was modified by another request")
+
+	ErrInvalidTimeZone   = errors.New("unknown time zone")
+	ErrInvalidSchedule   = errors.New("work_start and work_end must be set together")
+	ErrInvalidPeriod     = errors.New("period end must be after its start")
+	ErrInvalidFallback   = errors.New("team cannot be its own fallback")
+	ErrInvalidCodeOwners = errors.New("invalid CODEOWNERS file")
+	ErrInvalidMembers    = errors.New("the same user cannot be both added and removed")
 
 	ErrInternal = errors.New("internal error")
 )
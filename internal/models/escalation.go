@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+type EscalationPolicy string
+
+const (
+	EscalationPolicyReassign    EscalationPolicy = "REASSIGN"
+	EscalationPolicyAddReviewer EscalationPolicy = "ADD_REVIEWER"
+)
+
+type ShortfallPolicy string
+
+const (
+	ShortfallPolicyAllow  ShortfallPolicy = "ALLOW"
+	ShortfallPolicyReject ShortfallPolicy = "REJECT"
+)
+
+type SelectionMode string
+
+const (
+	SelectionModeDefault  SelectionMode = "DEFAULT"
+	SelectionModeRotation SelectionMode = "ROTATION"
+)
+
+type TeamPolicy struct {
+	ReviewSLA             *time.Duration
+	DefaultMaxOpenReviews *int
+	TeamName              string
+	EscalationPolicy      EscalationPolicy
+	ShortfallPolicy       ShortfallPolicy
+	SelectionMode         SelectionMode
+	RotationWindow        time.Duration
+}
+
+type StaleReview struct {
+	AssignedAt   time.Time
+	PRID         string
+	AuthorID     string
+	ReviewerID   string
+	TeamID       string
+	Repository   string
+	Organization string
+	Policy       EscalationPolicy
+}
+
+type Escalation struct {
+	CreatedAt      time.Time
+	PRID           string
+	TeamID         string
+	IdleReviewerID string
+	NewReviewerID  string
+	Repository     string
+	Policy         EscalationPolicy
+}
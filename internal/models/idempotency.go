@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+type IdempotentRequest struct {
+	ExpiresAt time.Time
+	TTL       time.Duration
+	// Lease — сколько незавершённый запрос держит ключ: если обработчик не сохранил
+	// ответ и не освободил ключ (например, процесс упал), по истечении срока ключ перезанимается
+	Lease       time.Duration
+	Key         string
+	Path        string
+	Fingerprint string
+	ContentType string
+	ETag        string
+	Response    []byte
+	StatusCode  int
+}
+
+// StatusCode == 0 — первый запрос с этим ключом ещё выполняется
+func (r *IdempotentRequest) Completed() bool {
+	return r.StatusCode != 0
+}
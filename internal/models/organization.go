@@ -0,0 +1,21 @@
+package models
+
+import "context"
+
+// DefaultOrganization получают запросы, когда ключи API не настроены: сервис работает как однотенантный
+const DefaultOrganization = "default"
+
+type organizationKey struct{}
+
+// WithOrganization задаёт организацию, в рамках которой выполняются запросы к репозиторию.
+// Организация берётся из проверенной личности вызывающего, а не из тела запроса.
+func WithOrganization(ctx context.Context, organization string) context.Context {
+	return context.WithValue(ctx, organizationKey{}, organization)
+}
+
+func OrganizationFromContext(ctx context.Context) string {
+	if organization, ok := ctx.Value(organizationKey{}).(string); ok && organization != "" {
+		return organization
+	}
+	return DefaultOrganization
+}
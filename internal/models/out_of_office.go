@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+)
+
+type OutOfOffice struct {
+	From            time.Time
+	To              time.Time
+	UserID          string
+	Organization    string
+	ID              int64
+	ReassignReviews bool
+}
@@ -6,19 +6,25 @@ import (
 
 type PR struct {
 	AssignedReviewers []string
+	FallbackReviewers []string
 	AuthorID          string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
 	ID                string
 	Name              string
 	Status            PRStatus
+	TeamID            string
+	TeamName          string
+	Repository        string
+	Version           int64
 }
 
 type PRShort struct {
-	AuthorID string
-	ID       string
-	Name     string
-	Status   PRStatus
+	AuthorID   string
+	ID         string
+	Name       string
+	Status     PRStatus
+	Repository string
 }
 
 type PRStatus int
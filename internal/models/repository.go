@@ -0,0 +1,10 @@
+package models
+
+type Repository struct {
+	ReviewerCount   *int
+	ShortfallPolicy *ShortfallPolicy
+	SelectionMode   *SelectionMode
+	Name            string
+	OwnerTeam       string
+	OwnerTeamID     string
+}
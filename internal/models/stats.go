@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+type TeamStats struct {
+	Weekly         []WeeklyThroughput
+	TimeToMergeP50 *time.Duration
+	TimeToMergeP90 *time.Duration
+	TeamName       string
+	StaleOpenPRs   int
+	ActiveMembers  int
+	ReviewerCount  int
+	Understaffed   bool
+}
+
+type WeeklyThroughput struct {
+	WeekStart time.Time
+	Opened    int
+	Merged    int
+}
+
+type TeamStatsQuery struct {
+	Page      uint64
+	PageSize  uint64
+	Weeks     int
+	StaleDays int
+}
+
+type TeamStatsFilter struct {
+	Since       time.Time
+	StaleBefore time.Time
+	Limit       uint64
+	Offset      uint64
+}
+
+type ReviewPairing struct {
+	AuthorID   string
+	ReviewerID string
+	Reviews    int
+}
@@ -1,8 +1,18 @@
 package models
 
+import (
+	"time"
+)
+
 type Team struct {
 	Members []Member
 	Name    string
+	Version int64
+}
+
+type UserTeam struct {
+	ID   string
+	Name string
 }
 
 type Member struct {
@@ -10,3 +20,42 @@ type Member struct {
 	UserID   string
 	Username string
 }
+
+type TeamMembersChange struct {
+	TeamName string
+	Add      []Member
+	Remove   []string
+	Move     bool
+}
+
+type OpenPRPolicy string
+
+const (
+	OpenPRPolicyReject   OpenPRPolicy = "REJECT"
+	OpenPRPolicyUnassign OpenPRPolicy = "UNASSIGN"
+)
+
+type TeamDeletion struct {
+	TeamName          string
+	ReleasedMembers   []string
+	UnassignedReviews int
+}
+
+type TeamAuditAction string
+
+const (
+	TeamAuditTeamCreated   TeamAuditAction = "TEAM_CREATED"
+	TeamAuditTeamRenamed   TeamAuditAction = "TEAM_RENAMED"
+	TeamAuditTeamDeleted   TeamAuditAction = "TEAM_DELETED"
+	TeamAuditMemberAdded   TeamAuditAction = "MEMBER_ADDED"
+	TeamAuditMemberRemoved TeamAuditAction = "MEMBER_REMOVED"
+	TeamAuditMemberMoved   TeamAuditAction = "MEMBER_MOVED"
+)
+
+type TeamAuditEntry struct {
+	CreatedAt        time.Time
+	UserID           *string
+	PreviousTeamName *string
+	Action           TeamAuditAction
+	TeamName         string
+}
@@ -0,0 +1,44 @@
+package models
+
+type TransferKind string
+
+const (
+	TransferKindTeam        TransferKind = "team"
+	TransferKindUser        TransferKind = "user"
+	TransferKindPullRequest TransferKind = "pull_request"
+)
+
+// TransferRecord — одна сущность импорта или экспорта, заполнено поле, соответствующее Kind
+type TransferRecord struct {
+	Team *Team
+	User *User
+	PR   *PR
+	Kind TransferKind
+	Line int
+}
+
+// ImportBatch — разобранный файл импорта вместе с ошибками разбора отдельных строк
+type ImportBatch struct {
+	Records []TransferRecord
+	Errors  []ImportError
+}
+
+type ImportError struct {
+	Message string
+	Line    int
+}
+
+type ImportCounts struct {
+	Created   int
+	Updated   int
+	Unchanged int
+}
+
+type ImportReport struct {
+	Errors       []ImportError
+	Teams        ImportCounts
+	Users        ImportCounts
+	PullRequests ImportCounts
+	DryRun       bool
+	Applied      bool
+}
@@ -0,0 +1,59 @@
+package models
+
+import "context"
+
+type IsolationLevel string
+
+const (
+	IsolationLevelDefault        IsolationLevel = ""
+	IsolationLevelReadCommitted  IsolationLevel = "read committed"
+	IsolationLevelRepeatableRead IsolationLevel = "repeatable read"
+	IsolationLevelSerializable   IsolationLevel = "serializable"
+)
+
+type TxOptions struct {
+	IsolationLevel IsolationLevel
+	ReadOnly       bool
+	// nil — используется значение транзактора по умолчанию
+	MaxRetries *int
+}
+
+type TxOption func(*TxOptions)
+
+func WithIsolationLevel(level IsolationLevel) TxOption {
+	return func(o *TxOptions) {
+		o.IsolationLevel = level
+	}
+}
+
+func WithReadOnly() TxOption {
+	return func(o *TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
+func WithMaxRetries(maxRetries int) TxOption {
+	return func(o *TxOptions) {
+		o.MaxRetries = &maxRetries
+	}
+}
+
+func NewTxOptions(opts ...TxOption) TxOptions {
+	var options TxOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+type primaryReadKey struct{}
+
+// WithPrimaryRead направляет чтение вне транзакции в основную бд, даже если подключена реплика
+func WithPrimaryRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadKey{}, true)
+}
+
+func IsPrimaryRead(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryReadKey{}).(bool)
+	return primary
+}
@@ -1,8 +1,28 @@
 package models
 
+import (
+	"time"
+)
+
 type User struct {
-	IsActive bool
-	TeamName string
-	ID       string
-	Name     string
+	WorkingHours   *WorkingHours
+	MaxOpenReviews *int
+	IsActive       bool
+	TeamName       string
+	TeamNames      []string
+	ID             string
+	Name           string
+	TimeZone       string
+}
+
+type WorkingHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+type Candidate struct {
+	WorkingHours   *WorkingHours
+	UserID         string
+	TimeZone       string
+	RecentPairings int
 }
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) SetCodeOwners(
+	ctx context.Context,
+	teamName string,
+	rules []models.CodeOwnersRule,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		delete(st.codeOwners, team.id)
+		if len(rules) == 0 {
+			return nil
+		}
+
+		stored := make([]models.CodeOwnersRule, len(rules))
+		for i, rule := range rules {
+			owners := slices.Clone(rule.Owners)
+			if owners == nil {
+				owners = []models.CodeOwner{}
+			}
+			stored[i] = models.CodeOwnersRule{Pattern: rule.Pattern, Owners: owners}
+		}
+		st.codeOwners[team.id] = stored
+		return nil
+	})
+}
+
+func (m *memoryRepo) GetTeamCodeOwners(
+	ctx context.Context,
+	teamName string,
+) (rules []models.CodeOwnersRule, err error) {
+	err = m.view(ctx, func(st *state) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+		rules = append(make([]models.CodeOwnersRule, 0), st.codeOwners[team.id]...)
+		return nil
+	})
+	return rules, err
+}
+
+func (m *memoryRepo) GetCodeOwners(
+	ctx context.Context,
+	teamID string,
+) (rules []models.CodeOwnersRule, err error) {
+	err = m.view(ctx, func(st *state) error {
+		id, _ := parseID(teamID)
+		rules = slices.Clone(st.codeOwners[id])
+		return nil
+	})
+	return rules, err
+}
@@ -0,0 +1,18 @@
+package memory
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Backend {
+		repo := NewRepo(zap.NewNop())
+		return repositorytest.Backend{Repository: repo, Transactor: repo}
+	})
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) GetStaleReviews(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) (reviews []models.StaleReview, err error) {
+	err = m.viewAll(ctx, func(org string, st *state) error {
+		for _, pr := range st.sortedPullRequests() {
+			team, ok := st.teams[pr.teamID]
+			if pr.status != models.PRStatusOPEN || !ok || team.reviewSLA == nil {
+				continue
+			}
+
+			key := prKey{id: pr.id, repositoryID: pr.repositoryID}
+			for _, r := range pr.reviewers {
+				if !r.assignedAt.Add(*team.reviewSLA).Before(now) || st.isEscalated(key, r) {
+					continue
+				}
+				reviews = append(reviews, models.StaleReview{
+					PRID:         pr.id,
+					Repository:   st.repositories[pr.repositoryID].name,
+					Organization: org,
+					AuthorID:     pr.authorID,
+					ReviewerID:   r.userID,
+					TeamID:       formatID(team.id),
+					Policy:       team.escalationPolicy,
+					AssignedAt:   r.assignedAt,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(reviews, func(a, b models.StaleReview) int {
+		return a.AssignedAt.Compare(b.AssignedAt)
+	})
+	return reviews[:min(uint64(len(reviews)), limit)], nil
+}
+
+func (st *state) isEscalated(key prKey, r reviewer) bool {
+	return slices.ContainsFunc(st.escalations, func(e escalation) bool {
+		return e.pr == key && e.idleReviewerID == r.userID && !e.createdAt.Before(r.assignedAt)
+	})
+}
+
+func (m *memoryRepo) IsReviewEscalated(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+) (escalated bool, err error) {
+	err = m.view(ctx, func(st *state) error {
+		key, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return nil
+		}
+
+		for _, r := range pr.reviewers {
+			if r.userID == reviewerID {
+				escalated = st.isEscalated(key, r)
+			}
+		}
+		return nil
+	})
+	return escalated, err
+}
+
+func (m *memoryRepo) CreateEscalation(
+	ctx context.Context,
+	e models.Escalation,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		key, _, ok := st.pullRequest(e.Repository, e.PRID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+
+		teamID, _ := parseID(e.TeamID)
+		team, ok := st.teams[teamID]
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		st.escalations = append(st.escalations, escalation{
+			pr:             key,
+			teamID:         teamID,
+			teamName:       team.name,
+			idleReviewerID: e.IdleReviewerID,
+			newReviewerID:  e.NewReviewerID,
+			policy:         e.Policy,
+			createdAt:      e.CreatedAt,
+		})
+		return nil
+	})
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) SetTeamFallbacks(
+	ctx context.Context,
+	teamName string,
+	fallbackTeams []string,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		fallbackIDs := make([]int64, 0, len(fallbackTeams))
+		for _, name := range fallbackTeams {
+			fallback, ok := st.teamByName(name)
+			if !ok {
+				return modelsErr.ErrTeamNotFound
+			}
+			if slices.Contains(fallbackIDs, fallback.id) {
+				return fmt.Errorf("duplicate fallback team %s", name)
+			}
+			fallbackIDs = append(fallbackIDs, fallback.id)
+		}
+
+		delete(st.fallbacks, team.id)
+		if len(fallbackIDs) > 0 {
+			st.fallbacks[team.id] = fallbackIDs
+		}
+		return nil
+	})
+}
+
+func (m *memoryRepo) GetFallbackTeamIDs(
+	ctx context.Context,
+	teamID string,
+) (fallbackTeamIDs []string, err error) {
+	err = m.view(ctx, func(st *state) error {
+		id, _ := parseID(teamID)
+		for _, fallbackID := range st.fallbacks[id] {
+			fallbackTeamIDs = append(fallbackTeamIDs, formatID(fallbackID))
+		}
+		return nil
+	})
+	return fallbackTeamIDs, err
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (m *memoryRepo) ReserveIdempotencyKey(
+	ctx context.Context,
+	request models.IdempotentRequest,
+	now time.Time,
+) (stored *models.IdempotentRequest, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		key := idempotencyKey{key: request.Key, path: request.Path}
+
+		// просроченный ключ и ключ, чей запрос не завершился за время аренды, перезанимаются,
+		// живой возвращается как есть
+		existing, ok := st.idempotency[key]
+		leaseExpired := !existing.Completed() && !existing.createdAt.After(now.Add(-request.Lease))
+		if ok && existing.ExpiresAt.After(now) && !leaseExpired {
+			stored = &existing.IdempotentRequest
+			return nil
+		}
+
+		st.idempotency[key] = idempotentRequest{
+			createdAt: now,
+			IdempotentRequest: models.IdempotentRequest{
+				Key:         request.Key,
+				Path:        request.Path,
+				Fingerprint: request.Fingerprint,
+				ExpiresAt:   request.ExpiresAt,
+			},
+		}
+		return nil
+	})
+	return stored, err
+}
+
+func (m *memoryRepo) SaveIdempotentResponse(
+	ctx context.Context,
+	request models.IdempotentRequest,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		key := idempotencyKey{key: request.Key, path: request.Path}
+
+		stored, ok := st.idempotency[key]
+		if !ok || stored.Fingerprint != request.Fingerprint {
+			return nil
+		}
+		stored.StatusCode = request.StatusCode
+		stored.ContentType = request.ContentType
+		stored.ETag = request.ETag
+		stored.Response = request.Response
+		st.idempotency[key] = stored
+		return nil
+	})
+}
+
+func (m *memoryRepo) ReleaseIdempotencyKey(
+	ctx context.Context,
+	key, path string,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		k := idempotencyKey{key: key, path: path}
+		if stored, ok := st.idempotency[k]; ok && !stored.Completed() {
+			delete(st.idempotency, k)
+		}
+		return nil
+	})
+}
+
+func (m *memoryRepo) DeleteExpiredIdempotencyKeys(
+	ctx context.Context,
+	now time.Time,
+) (deleted int64, err error) {
+	err = m.updateAll(ctx, func(st *state, _ time.Time) error {
+		for key, stored := range st.idempotency {
+			if !stored.ExpiresAt.After(now) {
+				delete(st.idempotency, key)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,329 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var errReadOnlyTx = errors.New("cannot execute write in a read-only transaction")
+
+type (
+	team struct {
+		reviewSLA             *time.Duration
+		defaultMaxOpenReviews *int
+		name                  string
+		escalationPolicy      models.EscalationPolicy
+		shortfallPolicy       models.ShortfallPolicy
+		selectionMode         models.SelectionMode
+		rotationWindow        time.Duration
+		id                    int64
+		version               int64
+	}
+
+	user struct {
+		workingHours   *models.WorkingHours
+		maxOpenReviews *int
+		id             string
+		name           string
+		timeZone       string
+		isActive       bool
+	}
+
+	membership struct {
+		joinedAt time.Time
+		userID   string
+		teamID   int64
+	}
+
+	// repositoryID == 0 — PR без репозитория
+	prKey struct {
+		id           string
+		repositoryID int64
+	}
+
+	reviewer struct {
+		assignedAt   time.Time
+		userID       string
+		fromFallback bool
+	}
+
+	pullRequest struct {
+		createdAt    time.Time
+		mergedAt     *time.Time
+		reviewers    []reviewer
+		id           string
+		name         string
+		authorID     string
+		status       models.PRStatus
+		teamID       int64
+		repositoryID int64
+		version      int64
+		seq          int64
+	}
+
+	pairing struct {
+		assignedAt time.Time
+		pr         prKey
+		authorID   string
+		reviewerID string
+	}
+
+	auditRecord struct {
+		createdAt    time.Time
+		userID       *string
+		fromTeamName *string
+		action       models.TeamAuditAction
+		teamName     string
+		teamID       int64
+		fromTeamID   int64
+	}
+
+	escalation struct {
+		createdAt      time.Time
+		pr             prKey
+		idleReviewerID string
+		newReviewerID  string
+		teamName       string
+		policy         models.EscalationPolicy
+		teamID         int64
+	}
+
+	outOfOffice struct {
+		reassignedAt *time.Time
+		models.OutOfOffice
+	}
+
+	repository struct {
+		reviewerCount   *int
+		shortfallPolicy *models.ShortfallPolicy
+		selectionMode   *models.SelectionMode
+		name            string
+		id              int64
+		ownerTeamID     int64
+	}
+
+	idempotentRequest struct {
+		createdAt time.Time
+		models.IdempotentRequest
+	}
+
+	idempotencyKey struct {
+		key  string
+		path string
+	}
+
+	state struct {
+		teams        map[int64]team
+		users        map[string]user
+		pullRequests map[prKey]pullRequest
+		fallbacks    map[int64][]int64
+		codeOwners   map[int64][]models.CodeOwnersRule
+		repositories map[int64]repository
+		idempotency  map[idempotencyKey]idempotentRequest
+		memberships  []membership
+		pairings     []pairing
+		audit        []auditRecord
+		escalations  []escalation
+		outOfOffice  []outOfOffice
+
+		lastPRSeq         int64
+		lastRepositoryID  int64
+		lastOutOfOfficeID int64
+	}
+)
+
+// memoryRepo хранит отдельное состояние на каждую организацию, поэтому запросы
+// одной организации не видят данных другой. Общий у организаций только
+// идентификатор пользователя: он уникален во всём сервисе, как в postgres.
+type memoryRepo struct {
+	mu     sync.RWMutex
+	orgs   map[string]*state
+	logger *zap.Logger
+	// идентификаторы команд сквозные, как serial в postgres, и не откатываются вместе с транзакцией
+	lastTeamID int64
+}
+
+func NewRepo(logger *zap.Logger) *memoryRepo {
+	return &memoryRepo{
+		orgs:   make(map[string]*state),
+		logger: logger,
+	}
+}
+
+func newState() *state {
+	return &state{
+		teams:        make(map[int64]team),
+		users:        make(map[string]user),
+		pullRequests: make(map[prKey]pullRequest),
+		fallbacks:    make(map[int64][]int64),
+		codeOwners:   make(map[int64][]models.CodeOwnersRule),
+		repositories: make(map[int64]repository),
+		idempotency:  make(map[idempotencyKey]idempotentRequest),
+	}
+}
+
+// вложенные слайсы копируются, чтобы изменения внутри транзакции не задели снимок
+func (s *state) clone() *state {
+	cloned := *s
+
+	cloned.teams = maps.Clone(s.teams)
+	cloned.users = maps.Clone(s.users)
+	cloned.repositories = maps.Clone(s.repositories)
+	cloned.idempotency = maps.Clone(s.idempotency)
+	cloned.memberships = slices.Clone(s.memberships)
+	cloned.pairings = slices.Clone(s.pairings)
+	cloned.audit = slices.Clone(s.audit)
+	cloned.escalations = slices.Clone(s.escalations)
+	cloned.outOfOffice = slices.Clone(s.outOfOffice)
+
+	cloned.pullRequests = make(map[prKey]pullRequest, len(s.pullRequests))
+	for key, pr := range s.pullRequests {
+		pr.reviewers = slices.Clone(pr.reviewers)
+		cloned.pullRequests[key] = pr
+	}
+
+	cloned.fallbacks = make(map[int64][]int64, len(s.fallbacks))
+	for teamID, fallbacks := range s.fallbacks {
+		cloned.fallbacks[teamID] = slices.Clone(fallbacks)
+	}
+
+	cloned.codeOwners = make(map[int64][]models.CodeOwnersRule, len(s.codeOwners))
+	for teamID, rules := range s.codeOwners {
+		cloned.codeOwners[teamID] = slices.Clone(rules)
+	}
+
+	return &cloned
+}
+
+func cloneOrgs(orgs map[string]*state) map[string]*state {
+	cloned := make(map[string]*state, len(orgs))
+	for org, st := range orgs {
+		cloned[org] = st.clone()
+	}
+	return cloned
+}
+
+// view выполняет чтение: внутри транзакции блокировка уже взята в WithTx
+func (m *memoryRepo) view(ctx context.Context, fn func(st *state) error) error {
+	org := models.OrganizationFromContext(ctx)
+	if _, ok := m.extractTx(ctx); ok {
+		return fn(m.orgState(org))
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return fn(m.orgState(org))
+}
+
+// viewAll читает состояния всех организаций, для фоновых задач
+func (m *memoryRepo) viewAll(ctx context.Context, fn func(org string, st *state) error) error {
+	if _, ok := m.extractTx(ctx); !ok {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+	}
+
+	for _, org := range slices.Sorted(maps.Keys(m.orgs)) {
+		if err := fn(org, m.orgs[org]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orgState не создаёт состояние, чтобы чтение под RLock ничего не меняло
+func (m *memoryRepo) orgState(org string) *state {
+	if st, ok := m.orgs[org]; ok {
+		return st
+	}
+	return newState()
+}
+
+// update выполняет запись атомарно: при ошибке изменения откатываются,
+// как откатывается упавший запрос в postgres
+func (m *memoryRepo) update(ctx context.Context, fn func(st *state, now time.Time) error) error {
+	tx, ok := m.extractTx(ctx)
+	if ok {
+		if tx.readOnly {
+			return errReadOnlyTx
+		}
+		return m.apply(models.OrganizationFromContext(ctx), tx.now, fn)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.apply(models.OrganizationFromContext(ctx), now(), fn)
+}
+
+// updateAll меняет состояния всех организаций, для фоновых задач
+func (m *memoryRepo) updateAll(ctx context.Context, fn func(st *state, now time.Time) error) error {
+	tx, ok := m.extractTx(ctx)
+	if ok && tx.readOnly {
+		return errReadOnlyTx
+	}
+	if !ok {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+
+	snapshot := cloneOrgs(m.orgs)
+	for _, st := range m.orgs {
+		if err := fn(st, now()); err != nil {
+			m.orgs = snapshot
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryRepo) apply(org string, now time.Time, fn func(st *state, now time.Time) error) error {
+	st, ok := m.orgs[org]
+	if !ok {
+		st = newState()
+		m.orgs[org] = st
+	}
+
+	snapshot := st.clone()
+	if err := fn(st, now); err != nil {
+		m.orgs[org] = snapshot
+		return err
+	}
+	return nil
+}
+
+// userOrganization возвращает организацию, которой принадлежит пользователь.
+// Вызывается под блокировкой, взятой в view или update.
+func (m *memoryRepo) userOrganization(userID string) (string, bool) {
+	for org, st := range m.orgs {
+		if _, ok := st.users[userID]; ok {
+			return org, true
+		}
+	}
+	return "", false
+}
+
+// checkUserIDs не даёт завести пользователя с идентификатором, занятым в другой организации
+func (m *memoryRepo) checkUserIDs(ctx context.Context, userIDs ...string) error {
+	org := models.OrganizationFromContext(ctx)
+	for _, userID := range userIDs {
+		if owner, ok := m.userOrganization(userID); ok && owner != org {
+			return modelsErr.ErrUserIDTaken
+		}
+	}
+	return nil
+}
+
+// время хранится с точностью до микросекунд, как timestamp в postgres
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) CreateOutOfOffice(
+	ctx context.Context,
+	period models.OutOfOffice,
+) (result *models.OutOfOffice, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		if _, ok := st.users[period.UserID]; !ok {
+			return modelsErr.ErrUserNotFound
+		}
+
+		st.lastOutOfOfficeID++
+		period.ID = st.lastOutOfOfficeID
+		st.outOfOffice = append(st.outOfOffice, outOfOffice{OutOfOffice: period})
+
+		result = &period
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) GetStartedOutOfOffice(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) (periods []models.OutOfOffice, err error) {
+	err = m.viewAll(ctx, func(org string, st *state) error {
+		for _, o := range st.outOfOffice {
+			if o.ReassignReviews && o.reassignedAt == nil && !o.From.After(now) && o.To.After(now) {
+				period := o.OutOfOffice
+				period.Organization = org
+				periods = append(periods, period)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(periods, func(a, b models.OutOfOffice) int {
+		return a.From.Compare(b.From)
+	})
+	return periods[:min(uint64(len(periods)), limit)], nil
+}
+
+func (m *memoryRepo) MarkOutOfOfficeReassigned(
+	ctx context.Context,
+	id int64,
+	reassignedAt time.Time,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		for i := range st.outOfOffice {
+			if st.outOfOffice[i].ID == id {
+				st.outOfOffice[i].reassignedAt = &reassignedAt
+			}
+		}
+		return nil
+	})
+}
+
+func (st *state) isOutOfOffice(userID string, now time.Time) bool {
+	return slices.ContainsFunc(st.outOfOffice, func(o outOfOffice) bool {
+		return o.UserID == userID && !o.From.After(now) && o.To.After(now)
+	})
+}
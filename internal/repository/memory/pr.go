@@ -0,0 +1,316 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) PullRequestCreate(
+	ctx context.Context,
+	pr models.PR,
+) (result *models.PR, err error) {
+	err = m.update(ctx, func(st *state, now time.Time) error {
+		key := prKey{id: pr.ID}
+		if pr.Repository != "" {
+			repository, ok := st.repositoryByName(pr.Repository)
+			if !ok {
+				return modelsErr.ErrRepositoryNotFound
+			}
+			key.repositoryID = repository.id
+		}
+
+		if _, ok := st.pullRequests[key]; ok {
+			return modelsErr.ErrPullRequestExist
+		}
+		teamID, _ := parseID(pr.TeamID)
+		if _, ok := st.teams[teamID]; !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+		if err := st.checkUsers(append([]string{pr.AuthorID}, pr.AssignedReviewers...)...); err != nil {
+			return err
+		}
+
+		st.lastPRSeq++
+		created := pullRequest{
+			id:           pr.ID,
+			name:         pr.Name,
+			authorID:     pr.AuthorID,
+			status:       models.PRStatusOPEN,
+			teamID:       teamID,
+			repositoryID: key.repositoryID,
+			createdAt:    now,
+			version:      1,
+			seq:          st.lastPRSeq,
+		}
+		for _, reviewerID := range pr.AssignedReviewers {
+			if err := created.assign(reviewerID, slices.Contains(pr.FallbackReviewers, reviewerID), now); err != nil {
+				return err
+			}
+		}
+		st.pullRequests[key] = created
+		st.recordPairings(key, created.authorID, pr.AssignedReviewers, now)
+
+		pr.CreatedAt = &now
+		pr.Version = created.version
+		pr.Status = models.PRStatusOPEN
+		result = &pr
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) PullRequestMerge(
+	ctx context.Context,
+	repository, prID string,
+) (result *models.PR, err error) {
+	err = m.update(ctx, func(st *state, now time.Time) error {
+		key, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+
+		if pr.status != models.PRStatusMERGED {
+			pr.version++
+		}
+		pr.status = models.PRStatusMERGED
+		if pr.mergedAt == nil {
+			pr.mergedAt = &now
+		}
+		st.pullRequests[key] = pr
+
+		result = &models.PR{
+			ID:         pr.id,
+			Name:       pr.name,
+			AuthorID:   pr.authorID,
+			CreatedAt:  &pr.createdAt,
+			MergedAt:   pr.mergedAt,
+			Status:     pr.status,
+			Version:    pr.version,
+			Repository: repository,
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) GetPullRequest(
+	ctx context.Context,
+	repository, prID string,
+) (result *models.PR, err error) {
+	err = m.view(ctx, func(st *state) error {
+		_, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+		if pr.status == models.PRStatusMERGED {
+			return modelsErr.ErrPRMerged
+		}
+
+		result = pr.toModel(repository)
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) PullRequestGet(
+	ctx context.Context,
+	repository, prID string,
+) (result *models.PR, err error) {
+	err = m.view(ctx, func(st *state) error {
+		_, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+
+		result = pr.toModel(repository)
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) PullRequestReassign(
+	ctx context.Context,
+	repository, prID, oldReviewerID, newReviewerID string,
+	fromFallback bool,
+) error {
+	return m.update(ctx, func(st *state, now time.Time) error {
+		key, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+
+		if err := st.checkUsers(newReviewerID); err != nil {
+			return err
+		}
+
+		i := pr.reviewerIndex(oldReviewerID)
+		if i >= 0 {
+			if pr.reviewerIndex(newReviewerID) >= 0 {
+				return fmt.Errorf("reviewer %s is already assigned", newReviewerID)
+			}
+			pr.reviewers[i] = reviewer{userID: newReviewerID, fromFallback: fromFallback, assignedAt: now}
+		}
+		pr.version++
+		st.pullRequests[key] = pr
+
+		st.recordPairings(key, pr.authorID, []string{newReviewerID}, now)
+		return nil
+	})
+}
+
+func (m *memoryRepo) PullRequestAddReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+	fromFallback bool,
+) error {
+	return m.update(ctx, func(st *state, now time.Time) error {
+		key, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+
+		if err := st.checkUsers(reviewerID); err != nil {
+			return err
+		}
+		if err := pr.assign(reviewerID, fromFallback, now); err != nil {
+			return err
+		}
+		pr.version++
+		st.pullRequests[key] = pr
+
+		st.recordPairings(key, pr.authorID, []string{reviewerID}, now)
+		return nil
+	})
+}
+
+func (m *memoryRepo) PullRequestRemoveReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		key, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+
+		i := pr.reviewerIndex(reviewerID)
+		if i < 0 {
+			return modelsErr.ErrNotAssigned
+		}
+		pr.reviewers = slices.Delete(pr.reviewers, i, i+1)
+		pr.version++
+		st.pullRequests[key] = pr
+		return nil
+	})
+}
+
+func (m *memoryRepo) GetPullRequestVersion(
+	ctx context.Context,
+	repository, prID string,
+) (version int64, err error) {
+	err = m.view(ctx, func(st *state) error {
+		_, pr, ok := st.pullRequest(repository, prID)
+		if !ok {
+			return modelsErr.ErrPRNotFound
+		}
+		version = pr.version
+		return nil
+	})
+	return version, err
+}
+
+func (m *memoryRepo) GetRecentPairings(
+	ctx context.Context,
+	authorID string,
+	reviewerIDs []string,
+	since time.Time,
+) (pairings map[string]int, err error) {
+	err = m.view(ctx, func(st *state) error {
+		pairings = make(map[string]int, len(reviewerIDs))
+		for _, p := range st.pairings {
+			if p.authorID == authorID && slices.Contains(reviewerIDs, p.reviewerID) && !p.assignedAt.Before(since) {
+				pairings[p.reviewerID]++
+			}
+		}
+		return nil
+	})
+	return pairings, err
+}
+
+// PR без репозитория ищется среди PR без репозитория, как prRepositoryEq в postgres
+func (st *state) pullRequest(repository, prID string) (prKey, pullRequest, bool) {
+	key := prKey{id: prID}
+	if repository != "" {
+		r, ok := st.repositoryByName(repository)
+		if !ok {
+			return prKey{}, pullRequest{}, false
+		}
+		key.repositoryID = r.id
+	}
+
+	pr, ok := st.pullRequests[key]
+	return key, pr, ok
+}
+
+func (pr pullRequest) toModel(repository string) *models.PR {
+	result := &models.PR{
+		ID:         pr.id,
+		Name:       pr.name,
+		AuthorID:   pr.authorID,
+		CreatedAt:  &pr.createdAt,
+		MergedAt:   pr.mergedAt,
+		Status:     pr.status,
+		TeamID:     formatID(pr.teamID),
+		Version:    pr.version,
+		Repository: repository,
+	}
+	for _, r := range pr.reviewers {
+		result.AssignedReviewers = append(result.AssignedReviewers, r.userID)
+		if r.fromFallback {
+			result.FallbackReviewers = append(result.FallbackReviewers, r.userID)
+		}
+	}
+	return result
+}
+
+func (st *state) sortedPullRequests() []pullRequest {
+	prs := make([]pullRequest, 0, len(st.pullRequests))
+	for _, pr := range st.pullRequests {
+		prs = append(prs, pr)
+	}
+	slices.SortFunc(prs, func(a, b pullRequest) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	return prs
+}
+
+func (st *state) recordPairings(key prKey, authorID string, reviewerIDs []string, now time.Time) {
+	for _, reviewerID := range reviewerIDs {
+		st.pairings = append(st.pairings, pairing{
+			pr:         key,
+			authorID:   authorID,
+			reviewerID: reviewerID,
+			assignedAt: now,
+		})
+	}
+}
+
+func (pr *pullRequest) assign(reviewerID string, fromFallback bool, now time.Time) error {
+	if pr.reviewerIndex(reviewerID) >= 0 {
+		return fmt.Errorf("reviewer %s is already assigned", reviewerID)
+	}
+	pr.reviewers = append(pr.reviewers, reviewer{userID: reviewerID, fromFallback: fromFallback, assignedAt: now})
+	return nil
+}
+
+func (pr *pullRequest) reviewerIndex(userID string) int {
+	return slices.IndexFunc(pr.reviewers, func(r reviewer) bool {
+		return r.userID == userID
+	})
+}
@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) RepositoryAdd(
+	ctx context.Context,
+	r models.Repository,
+) (result *models.Repository, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		ownerTeamID, err := st.ownerTeamID(r.OwnerTeam)
+		if err != nil {
+			return err
+		}
+		if _, ok := st.repositoryByName(r.Name); ok {
+			return modelsErr.ErrRepositoryExist
+		}
+
+		st.lastRepositoryID++
+		added := repository{
+			id:              st.lastRepositoryID,
+			name:            r.Name,
+			ownerTeamID:     ownerTeamID,
+			reviewerCount:   r.ReviewerCount,
+			shortfallPolicy: r.ShortfallPolicy,
+			selectionMode:   r.SelectionMode,
+		}
+		st.repositories[added.id] = added
+
+		result = st.toRepository(added)
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) RepositoryGet(
+	ctx context.Context,
+	name string,
+) (result *models.Repository, err error) {
+	err = m.view(ctx, func(st *state) error {
+		r, ok := st.repositoryByName(name)
+		if !ok {
+			return modelsErr.ErrRepositoryNotFound
+		}
+		result = st.toRepository(r)
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) RepositoryList(
+	ctx context.Context,
+) (repositories []models.Repository, err error) {
+	err = m.view(ctx, func(st *state) error {
+		repositories = []models.Repository{}
+		for _, r := range st.repositories {
+			repositories = append(repositories, *st.toRepository(r))
+		}
+		return nil
+	})
+
+	slices.SortFunc(repositories, func(a, b models.Repository) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return repositories, err
+}
+
+func (m *memoryRepo) RepositoryUpdate(
+	ctx context.Context,
+	r models.Repository,
+) (result *models.Repository, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		ownerTeamID, err := st.ownerTeamID(r.OwnerTeam)
+		if err != nil {
+			return err
+		}
+		updated, ok := st.repositoryByName(r.Name)
+		if !ok {
+			return modelsErr.ErrRepositoryNotFound
+		}
+
+		updated.ownerTeamID = ownerTeamID
+		updated.reviewerCount = r.ReviewerCount
+		updated.shortfallPolicy = r.ShortfallPolicy
+		updated.selectionMode = r.SelectionMode
+		st.repositories[updated.id] = updated
+
+		result = st.toRepository(updated)
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) RepositoryDelete(
+	ctx context.Context,
+	name string,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		r, ok := st.repositoryByName(name)
+		if !ok {
+			return modelsErr.ErrRepositoryNotFound
+		}
+		for key := range st.pullRequests {
+			if key.repositoryID == r.id {
+				return modelsErr.ErrRepositoryHasPRs
+			}
+		}
+
+		delete(st.repositories, r.id)
+		return nil
+	})
+}
+
+func (st *state) ownerTeamID(teamName string) (int64, error) {
+	if teamName == "" {
+		return 0, nil
+	}
+	team, ok := st.teamByName(teamName)
+	if !ok {
+		return 0, modelsErr.ErrTeamNotFound
+	}
+	return team.id, nil
+}
+
+func (st *state) repositoryByName(name string) (repository, bool) {
+	for _, r := range st.repositories {
+		if r.name == name {
+			return r, true
+		}
+	}
+	return repository{}, false
+}
+
+func (st *state) toRepository(r repository) *models.Repository {
+	return &models.Repository{
+		Name:            r.name,
+		OwnerTeam:       st.teams[r.ownerTeamID].name,
+		OwnerTeamID:     formatID(r.ownerTeamID),
+		ReviewerCount:   r.reviewerCount,
+		ShortfallPolicy: r.shortfallPolicy,
+		SelectionMode:   r.selectionMode,
+	}
+}
@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) TeamStats(
+	ctx context.Context,
+	filter models.TeamStatsFilter,
+) (stats []models.TeamStats, total uint64, err error) {
+	err = m.view(ctx, func(st *state) error {
+		teams := make([]team, 0, len(st.teams))
+		for _, team := range st.teams {
+			teams = append(teams, team)
+		}
+		slices.SortFunc(teams, func(a, b team) int {
+			return cmp.Compare(a.name, b.name)
+		})
+
+		total = uint64(len(teams))
+		start := min(filter.Offset, total)
+		end := min(start+filter.Limit, total)
+
+		stats = []models.TeamStats{}
+		for _, team := range teams[start:end] {
+			stats = append(stats, st.teamStats(team, filter))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return stats, total, nil
+}
+
+func (st *state) teamStats(team team, filter models.TeamStatsFilter) models.TeamStats {
+	stats := models.TeamStats{TeamName: team.name}
+	for _, userID := range st.teamMemberIDs(team.id) {
+		if st.users[userID].isActive {
+			stats.ActiveMembers++
+		}
+	}
+
+	weeks := make(map[time.Time]*models.WeeklyThroughput)
+	week := func(t time.Time) *models.WeeklyThroughput {
+		start := weekStart(t)
+		if _, ok := weeks[start]; !ok {
+			weeks[start] = &models.WeeklyThroughput{WeekStart: start}
+		}
+		return weeks[start]
+	}
+
+	var timeToMerge []float64
+	for _, pr := range st.pullRequests {
+		if pr.teamID != team.id {
+			continue
+		}
+
+		if !pr.createdAt.Before(filter.Since) {
+			week(pr.createdAt).Opened++
+		}
+
+		switch {
+		case pr.status == models.PRStatusMERGED && pr.mergedAt != nil && !pr.mergedAt.Before(filter.Since):
+			week(*pr.mergedAt).Merged++
+			timeToMerge = append(timeToMerge, pr.mergedAt.Sub(pr.createdAt).Seconds())
+		case pr.status == models.PRStatusOPEN && pr.createdAt.Before(filter.StaleBefore):
+			stats.StaleOpenPRs++
+		}
+	}
+
+	for _, w := range weeks {
+		stats.Weekly = append(stats.Weekly, *w)
+	}
+	slices.SortFunc(stats.Weekly, func(a, b models.WeeklyThroughput) int {
+		return a.WeekStart.Compare(b.WeekStart)
+	})
+
+	if len(timeToMerge) > 0 {
+		slices.Sort(timeToMerge)
+		stats.TimeToMergeP50 = secondsToDuration(percentile(timeToMerge, 0.5))
+		stats.TimeToMergeP90 = secondsToDuration(percentile(timeToMerge, 0.9))
+	}
+
+	return stats
+}
+
+// неделя начинается в понедельник, как date_trunc('week', ...) в postgres
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// линейная интерполяция, как percentile_cont в postgres; values отсортированы
+func percentile(values []float64, p float64) float64 {
+	position := p * float64(len(values)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	return values[lower] + (values[upper]-values[lower])*(position-float64(lower))
+}
+
+func secondsToDuration(seconds float64) *time.Duration {
+	d := time.Duration(seconds * float64(time.Second))
+	return &d
+}
+
+func (m *memoryRepo) TeamPairings(
+	ctx context.Context,
+	teamName string,
+	since time.Time,
+) (pairings []models.ReviewPairing, err error) {
+	err = m.view(ctx, func(st *state) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		members := st.teamMemberIDs(team.id)
+		counts := make(map[[2]string]int)
+		for _, p := range st.pairings {
+			if slices.Contains(members, p.authorID) && !p.assignedAt.Before(since) {
+				counts[[2]string{p.authorID, p.reviewerID}]++
+			}
+		}
+
+		pairings = make([]models.ReviewPairing, 0, len(counts))
+		for pair, reviews := range counts {
+			pairings = append(pairings, models.ReviewPairing{
+				AuthorID:   pair[0],
+				ReviewerID: pair[1],
+				Reviews:    reviews,
+			})
+		}
+		slices.SortFunc(pairings, func(a, b models.ReviewPairing) int {
+			return cmp.Or(cmp.Compare(a.AuthorID, b.AuthorID), cmp.Compare(a.ReviewerID, b.ReviewerID))
+		})
+		return nil
+	})
+	return pairings, err
+}
@@ -0,0 +1,319 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+const defaultRotationWindow = 30 * 24 * time.Hour
+
+func (m *memoryRepo) TeamAdd(
+	ctx context.Context,
+	team models.Team,
+) error {
+	if len(team.Members) == 0 {
+		return modelsErr.ErrEmptyTeam
+	}
+
+	return m.update(ctx, func(st *state, now time.Time) error {
+		if _, ok := st.teamByName(team.Name); ok {
+			return modelsErr.ErrTeamExist
+		}
+
+		m.lastTeamID++
+		teamID := st.createTeam(m.lastTeamID, team.Name)
+
+		userIDs := make([]string, len(team.Members))
+		for i, member := range team.Members {
+			userIDs[i] = member.UserID
+		}
+		if err := m.checkUserIDs(ctx, userIDs...); err != nil {
+			return err
+		}
+
+		st.upsertUsers(team.Members)
+		st.addMemberships(teamID, userIDs, now)
+
+		audit := []auditRecord{{action: models.TeamAuditTeamCreated, teamID: teamID, teamName: team.Name}}
+		for _, userID := range userIDs {
+			audit = append(audit, auditRecord{
+				action:   models.TeamAuditMemberAdded,
+				teamID:   teamID,
+				teamName: team.Name,
+				userID:   &userID,
+			})
+		}
+		st.recordAudit(audit, now)
+
+		return nil
+	})
+}
+
+func (m *memoryRepo) TeamGet(
+	ctx context.Context,
+	teamName string,
+) (*models.Team, error) {
+	result := models.Team{Members: make([]models.Member, 0)}
+
+	err := m.view(ctx, func(st *state) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return nil
+		}
+
+		result.Name = team.name
+		result.Version = team.version
+		for _, userID := range st.teamMemberIDs(team.id) {
+			user := st.users[userID]
+			result.Members = append(result.Members, models.Member{
+				UserID:   user.id,
+				Username: user.name,
+				IsActive: user.isActive,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (m *memoryRepo) GetActiveTeammates(
+	ctx context.Context,
+	teamID string,
+	excludedUsers []string,
+	now time.Time,
+) (candidates []models.Candidate, err error) {
+	err = m.view(ctx, func(st *state) error {
+		id, _ := parseID(teamID)
+		candidates = st.activeCandidates(func(u user) bool {
+			return st.isMember(id, u.id)
+		}, excludedUsers, now)
+		return nil
+	})
+	return candidates, err
+}
+
+func (m *memoryRepo) GetActiveCodeOwners(
+	ctx context.Context,
+	owners []models.CodeOwner,
+	excludedUsers []string,
+	now time.Time,
+) (candidates []models.Candidate, err error) {
+	var userIDs, teamNames []string
+	for _, owner := range owners {
+		switch owner.Kind {
+		case models.CodeOwnerUser:
+			userIDs = append(userIDs, owner.Name)
+		case models.CodeOwnerTeam:
+			teamNames = append(teamNames, owner.Name)
+		}
+	}
+
+	err = m.view(ctx, func(st *state) error {
+		candidates = st.activeCandidates(func(u user) bool {
+			if slices.Contains(userIDs, u.id) {
+				return true
+			}
+			for _, team := range st.userTeams(u.id) {
+				if slices.Contains(teamNames, team.name) {
+					return true
+				}
+			}
+			return false
+		}, excludedUsers, now)
+		return nil
+	})
+	return candidates, err
+}
+
+func (m *memoryRepo) GetTeamVersion(
+	ctx context.Context,
+	teamName string,
+) (version int64, err error) {
+	err = m.view(ctx, func(st *state) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+		version = team.version
+		return nil
+	})
+	return version, err
+}
+
+func (m *memoryRepo) GetUserTeams(
+	ctx context.Context,
+	userID string,
+) (teams []models.UserTeam, err error) {
+	err = m.view(ctx, func(st *state) error {
+		if _, ok := st.users[userID]; !ok {
+			return modelsErr.ErrUserNotFound
+		}
+
+		teams = make([]models.UserTeam, 0)
+		for _, team := range st.userTeams(userID) {
+			teams = append(teams, models.UserTeam{ID: formatID(team.id), Name: team.name})
+		}
+		return nil
+	})
+	return teams, err
+}
+
+func (m *memoryRepo) SetTeamPolicy(
+	ctx context.Context,
+	policy models.TeamPolicy,
+) (result *models.TeamPolicy, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		team, ok := st.teamByName(policy.TeamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		// в postgres длительности хранятся в секундах
+		team.reviewSLA = nil
+		if policy.ReviewSLA != nil {
+			reviewSLA := policy.ReviewSLA.Truncate(time.Second)
+			team.reviewSLA = &reviewSLA
+		}
+		team.escalationPolicy = policy.EscalationPolicy
+		team.defaultMaxOpenReviews = policy.DefaultMaxOpenReviews
+		team.shortfallPolicy = policy.ShortfallPolicy
+		team.selectionMode = policy.SelectionMode
+		team.rotationWindow = policy.RotationWindow.Truncate(time.Second)
+		st.teams[team.id] = team
+
+		result = team.policy()
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) GetTeamPolicy(
+	ctx context.Context,
+	teamID string,
+) (policy *models.TeamPolicy, err error) {
+	err = m.view(ctx, func(st *state) error {
+		team, ok := st.teamByID(teamID)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+		policy = team.policy()
+		return nil
+	})
+	return policy, err
+}
+
+func (t team) policy() *models.TeamPolicy {
+	return &models.TeamPolicy{
+		TeamName:              t.name,
+		ReviewSLA:             t.reviewSLA,
+		EscalationPolicy:      t.escalationPolicy,
+		DefaultMaxOpenReviews: t.defaultMaxOpenReviews,
+		ShortfallPolicy:       t.shortfallPolicy,
+		SelectionMode:         t.selectionMode,
+		RotationWindow:        t.rotationWindow,
+	}
+}
+
+func (st *state) createTeam(id int64, name string) int64 {
+	st.teams[id] = team{
+		id:               id,
+		name:             name,
+		version:          1,
+		escalationPolicy: models.EscalationPolicyReassign,
+		shortfallPolicy:  models.ShortfallPolicyAllow,
+		selectionMode:    models.SelectionModeDefault,
+		rotationWindow:   defaultRotationWindow,
+	}
+	return id
+}
+
+func (st *state) teamByName(name string) (team, bool) {
+	for _, team := range st.teams {
+		if team.name == name {
+			return team, true
+		}
+	}
+	return team{}, false
+}
+
+func (st *state) teamByID(teamID string) (team, bool) {
+	id, ok := parseID(teamID)
+	if !ok {
+		return team{}, false
+	}
+	team, ok := st.teams[id]
+	return team, ok
+}
+
+func (st *state) bumpTeamVersion(teamID int64) {
+	team := st.teams[teamID]
+	team.version++
+	st.teams[teamID] = team
+}
+
+// Участнику нескольких команд без личного лимита достаётся самый строгий из лимитов его команд.
+func (st *state) activeCandidates(
+	condition func(u user) bool,
+	excludedUsers []string,
+	now time.Time,
+) []models.Candidate {
+	var candidates []models.Candidate
+	for _, u := range st.sortedUsers() {
+		if !u.isActive || slices.Contains(excludedUsers, u.id) || !condition(u) || st.isOutOfOffice(u.id, now) {
+			continue
+		}
+
+		maxOpen := u.maxOpenReviews
+		if maxOpen == nil {
+			for _, team := range st.userTeams(u.id) {
+				if team.defaultMaxOpenReviews != nil && (maxOpen == nil || *team.defaultMaxOpenReviews < *maxOpen) {
+					maxOpen = team.defaultMaxOpenReviews
+				}
+			}
+		}
+		if maxOpen != nil && st.openReviews(u.id) >= *maxOpen {
+			continue
+		}
+
+		candidates = append(candidates, models.Candidate{
+			UserID:       u.id,
+			TimeZone:     u.timeZone,
+			WorkingHours: u.workingHours,
+		})
+	}
+	return candidates
+}
+
+func (st *state) openReviews(userID string) int {
+	count := 0
+	for _, pr := range st.pullRequests {
+		if pr.status != models.PRStatusOPEN {
+			continue
+		}
+		if slices.ContainsFunc(pr.reviewers, func(r reviewer) bool { return r.userID == userID }) {
+			count++
+		}
+	}
+	return count
+}
+
+func formatID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+func parseID(id string) (int64, bool) {
+	parsed, err := strconv.ParseInt(id, 10, 64)
+	return parsed, err == nil
+}
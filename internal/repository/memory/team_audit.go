@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (st *state) recordAudit(records []auditRecord, now time.Time) {
+	for _, record := range records {
+		record.createdAt = now
+		st.audit = append(st.audit, record)
+	}
+}
+
+func (m *memoryRepo) TeamAudit(
+	ctx context.Context,
+	teamName string,
+) (entries []models.TeamAuditEntry, err error) {
+	err = m.view(ctx, func(st *state) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		entries = make([]models.TeamAuditEntry, 0)
+		for _, record := range st.audit {
+			if record.teamID != team.id && record.fromTeamID != team.id {
+				continue
+			}
+			entries = append(entries, models.TeamAuditEntry{
+				Action:           record.action,
+				TeamName:         record.teamName,
+				UserID:           record.userID,
+				PreviousTeamName: record.fromTeamName,
+				CreatedAt:        record.createdAt,
+			})
+		}
+		return nil
+	})
+	return entries, err
+}
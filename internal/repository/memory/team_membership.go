@@ -0,0 +1,312 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) TeamUpdateMembers(
+	ctx context.Context,
+	change models.TeamMembersChange,
+) error {
+	return m.update(ctx, func(st *state, now time.Time) error {
+		team, ok := st.teamByName(change.TeamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		var audit []auditRecord
+		if len(change.Add) > 0 {
+			for _, member := range change.Add {
+				if err := m.checkUserIDs(ctx, member.UserID); err != nil {
+					return err
+				}
+			}
+			audit = st.addMembers(team.id, change, now)
+		}
+
+		for _, userID := range change.Remove {
+			if !st.isMember(team.id, userID) {
+				return fmt.Errorf("%w: %s", modelsErr.ErrUserNotInTeam, userID)
+			}
+			st.memberships = slices.DeleteFunc(st.memberships, func(m membership) bool {
+				return m.teamID == team.id && m.userID == userID
+			})
+			audit = append(audit, auditRecord{
+				action:   models.TeamAuditMemberRemoved,
+				teamID:   team.id,
+				teamName: change.TeamName,
+				userID:   &userID,
+			})
+		}
+
+		if len(st.teamMemberIDs(team.id)) == 0 {
+			return modelsErr.ErrEmptyTeam
+		}
+
+		st.bumpTeamVersion(team.id)
+		bumped := []int64{team.id}
+		for _, record := range audit {
+			if record.fromTeamID != 0 && !slices.Contains(bumped, record.fromTeamID) {
+				st.bumpTeamVersion(record.fromTeamID)
+				bumped = append(bumped, record.fromTeamID)
+			}
+		}
+
+		st.recordAudit(audit, now)
+		return nil
+	})
+}
+
+func (st *state) addMembers(
+	teamID int64,
+	change models.TeamMembersChange,
+	now time.Time,
+) []auditRecord {
+	userIDs := make([]string, len(change.Add))
+	for i, m := range change.Add {
+		userIDs[i] = m.UserID
+	}
+
+	var audit []auditRecord
+	var movedUsers []string
+	for _, userID := range userIDs {
+		var member bool
+		var otherTeams []team
+		for _, current := range st.userTeams(userID) {
+			if current.id == teamID {
+				member = true
+			} else {
+				otherTeams = append(otherTeams, current)
+			}
+		}
+
+		if change.Move && len(otherTeams) > 0 {
+			movedUsers = append(movedUsers, userID)
+			for _, from := range otherTeams {
+				audit = append(audit, auditRecord{
+					action:       models.TeamAuditMemberMoved,
+					teamID:       teamID,
+					teamName:     change.TeamName,
+					userID:       &userID,
+					fromTeamID:   from.id,
+					fromTeamName: &from.name,
+				})
+			}
+			continue
+		}
+
+		if !member {
+			audit = append(audit, auditRecord{
+				action:   models.TeamAuditMemberAdded,
+				teamID:   teamID,
+				teamName: change.TeamName,
+				userID:   &userID,
+			})
+		}
+	}
+
+	st.upsertUsers(change.Add)
+	st.addMemberships(teamID, userIDs, now)
+
+	st.memberships = slices.DeleteFunc(st.memberships, func(m membership) bool {
+		return m.teamID != teamID && slices.Contains(movedUsers, m.userID)
+	})
+
+	return audit
+}
+
+func (st *state) upsertUsers(members []models.Member) {
+	for _, member := range members {
+		u, ok := st.users[member.UserID]
+		if !ok {
+			u = user{id: member.UserID, timeZone: "UTC"}
+		}
+		u.name = member.Username
+		u.isActive = member.IsActive
+		st.users[member.UserID] = u
+	}
+}
+
+func (st *state) addMemberships(teamID int64, userIDs []string, now time.Time) {
+	for _, userID := range userIDs {
+		if !st.isMember(teamID, userID) {
+			st.memberships = append(st.memberships, membership{
+				teamID:   teamID,
+				userID:   userID,
+				joinedAt: now,
+			})
+		}
+	}
+}
+
+func (st *state) isMember(teamID int64, userID string) bool {
+	return slices.ContainsFunc(st.memberships, func(m membership) bool {
+		return m.teamID == teamID && m.userID == userID
+	})
+}
+
+// участники команды в порядке вступления
+func (st *state) teamMemberIDs(teamID int64) []string {
+	var members []membership
+	for _, m := range st.memberships {
+		if m.teamID == teamID {
+			members = append(members, m)
+		}
+	}
+	slices.SortFunc(members, func(a, b membership) int {
+		return cmp.Or(a.joinedAt.Compare(b.joinedAt), cmp.Compare(a.userID, b.userID))
+	})
+
+	userIDs := make([]string, len(members))
+	for i, m := range members {
+		userIDs[i] = m.userID
+	}
+	return userIDs
+}
+
+// команды пользователя в порядке вступления
+func (st *state) userTeams(userID string) []team {
+	var memberships []membership
+	for _, m := range st.memberships {
+		if m.userID == userID {
+			memberships = append(memberships, m)
+		}
+	}
+	slices.SortFunc(memberships, func(a, b membership) int {
+		return cmp.Or(a.joinedAt.Compare(b.joinedAt), cmp.Compare(a.teamID, b.teamID))
+	})
+
+	teams := make([]team, len(memberships))
+	for i, m := range memberships {
+		teams[i] = st.teams[m.teamID]
+	}
+	return teams
+}
+
+func (m *memoryRepo) TeamRename(
+	ctx context.Context,
+	teamName, newTeamName string,
+) error {
+	return m.update(ctx, func(st *state, now time.Time) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+		if other, ok := st.teamByName(newTeamName); ok && other.id != team.id {
+			return modelsErr.ErrTeamExist
+		}
+
+		team.name = newTeamName
+		team.version++
+		st.teams[team.id] = team
+
+		st.recordAudit([]auditRecord{{
+			action:       models.TeamAuditTeamRenamed,
+			teamID:       team.id,
+			teamName:     newTeamName,
+			fromTeamName: &teamName,
+		}}, now)
+		return nil
+	})
+}
+
+func (m *memoryRepo) TeamDelete(
+	ctx context.Context,
+	teamName string,
+	openPRPolicy models.OpenPRPolicy,
+) (deletion *models.TeamDeletion, err error) {
+	err = m.update(ctx, func(st *state, now time.Time) error {
+		team, ok := st.teamByName(teamName)
+		if !ok {
+			return modelsErr.ErrTeamNotFound
+		}
+
+		members := st.teamMemberIDs(team.id)
+		if members == nil {
+			members = []string{}
+		}
+		st.memberships = slices.DeleteFunc(st.memberships, func(m membership) bool {
+			return m.teamID == team.id
+		})
+
+		deletion = &models.TeamDeletion{
+			TeamName:        teamName,
+			ReleasedMembers: members,
+		}
+
+		for key, pr := range st.pullRequests {
+			if pr.teamID != team.id || pr.status != models.PRStatusOPEN {
+				continue
+			}
+			if openPRPolicy != models.OpenPRPolicyUnassign {
+				return modelsErr.ErrTeamHasOpenPRs
+			}
+			deletion.UnassignedReviews += len(pr.reviewers)
+			pr.reviewers = nil
+			pr.version++
+			st.pullRequests[key] = pr
+		}
+
+		st.deleteTeam(team.id)
+
+		audit := []auditRecord{{action: models.TeamAuditTeamDeleted, teamID: team.id, teamName: teamName}}
+		for _, userID := range members {
+			audit = append(audit, auditRecord{
+				action:   models.TeamAuditMemberRemoved,
+				teamID:   team.id,
+				teamName: teamName,
+				userID:   &userID,
+			})
+		}
+		st.recordAudit(audit, now)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+// повторяет ON DELETE у внешних ключей на team
+func (st *state) deleteTeam(teamID int64) {
+	delete(st.teams, teamID)
+	delete(st.fallbacks, teamID)
+	delete(st.codeOwners, teamID)
+
+	for id, fallbacks := range st.fallbacks {
+		st.fallbacks[id] = slices.DeleteFunc(fallbacks, func(fallbackID int64) bool {
+			return fallbackID == teamID
+		})
+		if len(st.fallbacks[id]) == 0 {
+			delete(st.fallbacks, id)
+		}
+	}
+
+	for i, e := range st.escalations {
+		if e.teamID == teamID {
+			st.escalations[i].teamID = 0
+		}
+	}
+
+	for key, pr := range st.pullRequests {
+		if pr.teamID == teamID {
+			pr.teamID = 0
+			st.pullRequests[key] = pr
+		}
+	}
+
+	for id, repository := range st.repositories {
+		if repository.ownerTeamID == teamID {
+			repository.ownerTeamID = 0
+			st.repositories[id] = repository
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+type txInjector struct{}
+
+type tx struct {
+	repo     *memoryRepo
+	now      time.Time
+	readOnly bool
+}
+
+// WithTx держит эксклюзивную блокировку на всё время транзакции, поэтому
+// транзакции выполняются последовательно и уровень изоляции не важен.
+// При ошибке состояние восстанавливается из снимка, сделанного при старте.
+func (m *memoryRepo) WithTx(
+	ctx context.Context,
+	function func(ctx context.Context) error,
+	opts ...models.TxOption,
+) error {
+	if _, ok := m.extractTx(ctx); ok {
+		if err := function(ctx); err != nil {
+			return fmt.Errorf("function execution error: %w", err)
+		}
+		return nil
+	}
+
+	options := models.NewTxOptions(opts...)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := m.orgs
+	if !options.ReadOnly {
+		snapshot = cloneOrgs(m.orgs)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			m.orgs = snapshot
+			m.logger.Debug("transaction rolled back")
+		}
+	}()
+
+	txCtx := context.WithValue(ctx, txInjector{}, &tx{
+		repo:     m,
+		now:      now(),
+		readOnly: options.ReadOnly,
+	})
+	if err := function(txCtx); err != nil {
+		return fmt.Errorf("function execution error: %w", err)
+	}
+
+	committed = true
+	return nil
+}
+
+func (m *memoryRepo) extractTx(ctx context.Context) (*tx, bool) {
+	tx, ok := ctx.Value(txInjector{}).(*tx)
+	if !ok || tx.repo != m {
+		return nil, false
+	}
+	return tx, true
+}
@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func TestWithTx(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+	team := models.Team{
+		Name:    "backend",
+		Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+	}
+
+	tests := []struct {
+		name      string
+		opts      []models.TxOption
+		fn        func(ctx context.Context, repo *memoryRepo) error
+		wantErr   error
+		wantTeams int
+	}{
+		{
+			name: "commit",
+			fn: func(ctx context.Context, repo *memoryRepo) error {
+				return repo.TeamAdd(ctx, team)
+			},
+			wantTeams: 1,
+		},
+		{
+			name: "rollback on error",
+			fn: func(ctx context.Context, repo *memoryRepo) error {
+				if err := repo.TeamAdd(ctx, team); err != nil {
+					return err
+				}
+				return errTest
+			},
+			wantErr:   errTest,
+			wantTeams: 0,
+		},
+		{
+			name: "nested tx rolls back with outer",
+			fn: func(ctx context.Context, repo *memoryRepo) error {
+				err := repo.WithTx(ctx, func(ctx context.Context) error {
+					return repo.TeamAdd(ctx, team)
+				})
+				if err != nil {
+					return err
+				}
+				return errTest
+			},
+			wantErr:   errTest,
+			wantTeams: 0,
+		},
+		{
+			name: "read-only tx rejects writes",
+			opts: []models.TxOption{models.WithReadOnly()},
+			fn: func(ctx context.Context, repo *memoryRepo) error {
+				return repo.TeamAdd(ctx, team)
+			},
+			wantErr:   errReadOnlyTx,
+			wantTeams: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			repo := NewRepo(zap.NewNop())
+
+			err := repo.WithTx(ctx, func(ctx context.Context) error {
+				return tt.fn(ctx, repo)
+			}, tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			stats, total, err := repo.TeamStats(ctx, models.TeamStatsFilter{Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, stats, tt.wantTeams)
+			require.EqualValues(t, tt.wantTeams, total)
+		})
+	}
+}
+
+func TestUpdateRollsBackFailedStatement(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := NewRepo(zap.NewNop())
+
+	require.NoError(t, repo.TeamAdd(ctx, models.Team{
+		Name:    "backend",
+		Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+	}))
+
+	err := repo.TeamUpdateMembers(ctx, models.TeamMembersChange{
+		TeamName: "backend",
+		Add:      []models.Member{{UserID: "u2", Username: "Bob", IsActive: true}},
+		Remove:   []string{"u3"},
+	})
+	require.ErrorIs(t, err, modelsErr.ErrUserNotInTeam)
+
+	team, err := repo.TeamGet(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}}, team.Members)
+	require.EqualValues(t, 1, team.Version)
+
+	_, err = repo.GetUser(ctx, "u2")
+	require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+}
@@ -0,0 +1,202 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+// ExportTeams собирает снимок под блокировкой и вызывает fn уже без неё,
+// чтобы медленный получатель не задерживал запись
+func (m *memoryRepo) ExportTeams(
+	ctx context.Context,
+	fn func(team models.Team) error,
+) error {
+	var teams []models.Team
+	err := m.view(ctx, func(st *state) error {
+		for _, teamID := range slices.Sorted(maps.Keys(st.teams)) {
+			memberIDs := st.teamMemberIDs(teamID)
+			if len(memberIDs) == 0 {
+				continue
+			}
+
+			team := models.Team{Name: st.teams[teamID].name}
+			for _, userID := range memberIDs {
+				user := st.users[userID]
+				team.Members = append(team.Members, models.Member{
+					UserID:   user.id,
+					Username: user.name,
+					IsActive: user.isActive,
+				})
+			}
+			teams = append(teams, team)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, team := range teams {
+		if err = fn(team); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryRepo) ExportUsers(
+	ctx context.Context,
+	fn func(user models.User) error,
+) error {
+	var users []models.User
+	err := m.view(ctx, func(st *state) error {
+		for _, u := range st.sortedUsers() {
+			users = append(users, models.User{
+				ID:             u.id,
+				Name:           u.name,
+				IsActive:       u.isActive,
+				TimeZone:       u.timeZone,
+				WorkingHours:   u.workingHours,
+				MaxOpenReviews: u.maxOpenReviews,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if err = fn(user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryRepo) ExportPullRequests(
+	ctx context.Context,
+	fn func(pr models.PR) error,
+) error {
+	var prs []models.PR
+	err := m.view(ctx, func(st *state) error {
+		sorted := st.sortedPullRequests()
+		slices.SortStableFunc(sorted, func(a, b pullRequest) int {
+			return a.createdAt.Compare(b.createdAt)
+		})
+
+		for _, pr := range sorted {
+			exported := pr.toModel(st.repositories[pr.repositoryID].name)
+			exported.TeamID = ""
+			exported.Version = 0
+			exported.TeamName = st.teams[pr.teamID].name
+			if exported.AssignedReviewers == nil {
+				exported.AssignedReviewers = make([]string, 0)
+			}
+			prs = append(prs, *exported)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pr := range prs {
+		if err = fn(pr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportUser создаёт пользователя или перезаписывает все его поля
+func (m *memoryRepo) ImportUser(
+	ctx context.Context,
+	imported models.User,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		if err := m.checkUserIDs(ctx, imported.ID); err != nil {
+			return err
+		}
+
+		st.users[imported.ID] = user{
+			workingHours:   imported.WorkingHours,
+			maxOpenReviews: imported.MaxOpenReviews,
+			id:             imported.ID,
+			name:           imported.Name,
+			timeZone:       imported.TimeZone,
+			isActive:       imported.IsActive,
+		}
+		return nil
+	})
+}
+
+// ImportPullRequest сохраняет PR с его статусом и временем создания и мержа.
+// Назначения ревьюверов и пары автор–ревьювер датируются временем создания PR.
+func (m *memoryRepo) ImportPullRequest(
+	ctx context.Context,
+	pr models.PR,
+) error {
+	return m.update(ctx, func(st *state, _ time.Time) error {
+		key := prKey{id: pr.ID}
+		if pr.Repository != "" {
+			repository, ok := st.repositoryByName(pr.Repository)
+			if !ok {
+				return modelsErr.ErrRepositoryNotFound
+			}
+			key.repositoryID = repository.id
+		}
+
+		if _, ok := st.pullRequests[key]; ok {
+			return modelsErr.ErrPullRequestExist
+		}
+		if _, ok := st.users[pr.AuthorID]; !ok {
+			return fmt.Errorf("author %s does not exist", pr.AuthorID)
+		}
+
+		var teamID int64
+		if pr.TeamName != "" {
+			team, _ := st.teamByName(pr.TeamName)
+			teamID = team.id
+		}
+
+		// время хранится с точностью до микросекунд, как timestamp в postgres
+		createdAt := pr.CreatedAt.UTC().Truncate(time.Microsecond)
+		var mergedAt *time.Time
+		if pr.MergedAt != nil {
+			merged := pr.MergedAt.UTC().Truncate(time.Microsecond)
+			mergedAt = &merged
+		}
+		st.lastPRSeq++
+		imported := pullRequest{
+			id:           pr.ID,
+			name:         pr.Name,
+			authorID:     pr.AuthorID,
+			status:       pr.Status,
+			teamID:       teamID,
+			repositoryID: key.repositoryID,
+			createdAt:    createdAt,
+			mergedAt:     mergedAt,
+			version:      1,
+			seq:          st.lastPRSeq,
+		}
+		for _, reviewerID := range pr.AssignedReviewers {
+			if _, ok := st.users[reviewerID]; !ok {
+				return fmt.Errorf("reviewer %s does not exist", reviewerID)
+			}
+			if err := imported.assign(reviewerID, slices.Contains(pr.FallbackReviewers, reviewerID), createdAt); err != nil {
+				return err
+			}
+		}
+		st.pullRequests[key] = imported
+		st.recordPairings(key, imported.authorID, pr.AssignedReviewers, createdAt)
+
+		return nil
+	})
+}
@@ -0,0 +1,162 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (m *memoryRepo) GetReview(
+	ctx context.Context,
+	userID string,
+) (prs []models.PRShort, err error) {
+	err = m.view(ctx, func(st *state) error {
+		for _, pr := range st.sortedPullRequests() {
+			if !slices.ContainsFunc(pr.reviewers, func(r reviewer) bool { return r.userID == userID }) {
+				continue
+			}
+			prs = append(prs, models.PRShort{
+				ID:         pr.id,
+				Name:       pr.name,
+				AuthorID:   pr.authorID,
+				Status:     pr.status,
+				Repository: st.repositories[pr.repositoryID].name,
+			})
+		}
+		return nil
+	})
+	return prs, err
+}
+
+func (m *memoryRepo) SetIsActive(
+	ctx context.Context,
+	userID string,
+	isActive bool,
+) (result *models.User, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return modelsErr.ErrUserNotFound
+		}
+		u.isActive = isActive
+		st.users[userID] = u
+
+		result = st.toUser(u)
+		result.TimeZone = ""
+		result.WorkingHours = nil
+		result.MaxOpenReviews = nil
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) SetSchedule(
+	ctx context.Context,
+	userID, timeZone string,
+	workingHours *models.WorkingHours,
+) (result *models.User, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return modelsErr.ErrUserNotFound
+		}
+		u.timeZone = timeZone
+		u.workingHours = nil
+		// в postgres рабочие часы хранятся в минутах
+		if workingHours != nil {
+			u.workingHours = &models.WorkingHours{
+				Start: workingHours.Start.Truncate(time.Minute),
+				End:   workingHours.End.Truncate(time.Minute),
+			}
+		}
+		st.users[userID] = u
+
+		result = st.toUser(u)
+		result.MaxOpenReviews = nil
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) SetMaxOpenReviews(
+	ctx context.Context,
+	userID string,
+	maxOpenReviews *int,
+) (result *models.User, err error) {
+	err = m.update(ctx, func(st *state, _ time.Time) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return modelsErr.ErrUserNotFound
+		}
+		u.maxOpenReviews = maxOpenReviews
+		st.users[userID] = u
+
+		result = st.toUser(u)
+		result.TimeZone = ""
+		result.WorkingHours = nil
+		return nil
+	})
+	return result, err
+}
+
+func (m *memoryRepo) GetUser(
+	ctx context.Context,
+	userID string,
+) (result *models.User, err error) {
+	err = m.view(ctx, func(st *state) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return modelsErr.ErrUserNotFound
+		}
+		result = st.toUser(u)
+		return nil
+	})
+	return result, err
+}
+
+func (st *state) toUser(u user) *models.User {
+	teamNames := make([]string, 0)
+	for _, team := range st.userTeams(u.id) {
+		teamNames = append(teamNames, team.name)
+	}
+
+	result := &models.User{
+		ID:             u.id,
+		Name:           u.name,
+		IsActive:       u.isActive,
+		TeamNames:      teamNames,
+		TimeZone:       u.timeZone,
+		WorkingHours:   u.workingHours,
+		MaxOpenReviews: u.maxOpenReviews,
+	}
+	if len(teamNames) > 0 {
+		result.TeamName = teamNames[0]
+	}
+	return result
+}
+
+func (st *state) sortedUsers() []user {
+	users := make([]user, 0, len(st.users))
+	for _, u := range st.users {
+		users = append(users, u)
+	}
+	slices.SortFunc(users, func(a, b user) int {
+		return cmp.Compare(a.id, b.id)
+	})
+	return users
+}
+
+// пользователи другой организации для этой не существуют
+func (st *state) checkUsers(userIDs ...string) error {
+	for _, userID := range userIDs {
+		if _, ok := st.users[userID]; !ok {
+			return fmt.Errorf("%w: %s", modelsErr.ErrUserNotFound, userID)
+		}
+	}
+	return nil
+}
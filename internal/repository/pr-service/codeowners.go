@@ -0,0 +1,217 @@
+package pr_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (p *postgresRepo) SetCodeOwners(
+	ctx context.Context,
+	teamName string,
+	rules []models.CodeOwnersRule,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("team_name", teamName),
+		zap.Int("rules", len(rules)),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	getTeamID := p.queryBuilder.Select("id").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"name": teamName},
+			orgEq(ctx, "organization"),
+		}).
+		Suffix("FOR UPDATE")
+
+	getTeamIDStr, args, err := getTeamID.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get team ID)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing get team ID SQL",
+		zap.String("query", getTeamIDStr),
+		zap.Any("args", args),
+	)
+
+	var teamID string
+	if err = tx.QueryRow(ctx, getTeamIDStr, args...).Scan(&teamID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return modelsErr.ErrTeamNotFound
+		}
+		logger.Error("get team ID query", zap.Error(err))
+		return err
+	}
+
+	deleteRules := p.queryBuilder.Delete("codeowners_rule").
+		Where(sq.Eq{"team_id": teamID})
+
+	deleteRulesStr, args, err := deleteRules.ToSql()
+	if err != nil {
+		logger.Error("build SQL (delete codeowners rules)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing delete codeowners rules SQL",
+		zap.String("query", deleteRulesStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, deleteRulesStr, args...); err != nil {
+		logger.Error("delete codeowners rules", zap.Error(err))
+		return err
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	insertRules := p.queryBuilder.Insert("codeowners_rule").
+		Columns("team_id", "position", "pattern", "owners")
+	for position, rule := range rules {
+		owners := rule.Owners
+		if owners == nil {
+			owners = []models.CodeOwner{}
+		}
+		insertRules = insertRules.Values(teamID, position, rule.Pattern, owners)
+	}
+
+	insertRulesStr, args, err := insertRules.ToSql()
+	if err != nil {
+		logger.Error("build SQL (insert codeowners rules)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing insert codeowners rules SQL",
+		zap.String("query", insertRulesStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, insertRulesStr, args...); err != nil {
+		logger.Error("insert codeowners rules", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) GetTeamCodeOwners(
+	ctx context.Context,
+	teamName string,
+) ([]models.CodeOwnersRule, error) {
+	logger := p.logger.With(zap.String("team_name", teamName))
+
+	getRules := p.queryBuilder.Select("r.pattern", "r.owners").
+		From("team t").
+		LeftJoin("codeowners_rule r ON r.team_id = t.id").
+		Where(sq.And{
+			sq.Eq{"t.name": teamName},
+			orgEq(ctx, "t.organization"),
+		}).
+		OrderBy("r.position")
+
+	getRulesStr, args, err := getRules.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get team codeowners)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get team codeowners SQL",
+		zap.String("query", getRulesStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.db.Query(ctx, getRulesStr, args...)
+	if err != nil {
+		logger.Error("get team codeowners query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	teamFound := false
+	rules := make([]models.CodeOwnersRule, 0)
+	for rows.Next() {
+		teamFound = true
+
+		var pattern *string
+		var owners []models.CodeOwner
+		if err = rows.Scan(&pattern, &owners); err != nil {
+			logger.Error("scan codeowners rule", zap.Error(err))
+			return nil, err
+		}
+		if pattern != nil {
+			rules = append(rules, models.CodeOwnersRule{Pattern: *pattern, Owners: owners})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate codeowners rules", zap.Error(err))
+		return nil, err
+	}
+
+	if !teamFound {
+		logger.Warn("team not found")
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return rules, nil
+}
+
+func (p *postgresRepo) GetCodeOwners(
+	ctx context.Context,
+	teamID string,
+) ([]models.CodeOwnersRule, error) {
+	logger := p.logger.With(zap.String("team_id", teamID))
+
+	getRules := p.queryBuilder.Select("pattern", "owners").
+		From("codeowners_rule").
+		Where(sq.And{
+			sq.Eq{"team_id": teamID},
+			teamInOrg(ctx, "team_id"),
+		}).
+		OrderBy("position")
+
+	getRulesStr, args, err := getRules.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get codeowners)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get codeowners SQL",
+		zap.String("query", getRulesStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.conn(ctx).Query(ctx, getRulesStr, args...)
+	if err != nil {
+		logger.Error("get codeowners query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []models.CodeOwnersRule
+	for rows.Next() {
+		var rule models.CodeOwnersRule
+		if err = rows.Scan(&rule.Pattern, &rule.Owners); err != nil {
+			logger.Error("scan codeowners rule", zap.Error(err))
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
@@ -0,0 +1,197 @@
+package pr_service
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (p *postgresRepo) GetStaleReviews(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) ([]models.StaleReview, error) {
+	logger := p.logger.With(
+		zap.Time("now", now),
+		zap.Uint64("limit", limit),
+	)
+
+	getStale := p.queryBuilder.Select(
+		"pr.external_id",
+		"COALESCE(r.name, '')",
+		"pr.organization",
+		"pr.author_id",
+		"ar.user_id",
+		"pr.team_id",
+		"t.escalation_policy",
+		"ar.assigned_at",
+	).
+		From("assigned_reviewer ar").
+		Join("pull_request pr ON pr.id = ar.pr_id").
+		Join("team t ON t.id = pr.team_id").
+		LeftJoin("repository r ON r.id = pr.repository_id").
+		Where(sq.And{
+			sq.Eq{"pr.status": models.PRStatusOPEN},
+			sq.NotEq{"t.review_sla_seconds": nil},
+			sq.Expr("ar.assigned_at + t.review_sla_seconds * interval '1 second' < ?", now),
+			sq.Expr(`NOT EXISTS (
+				SELECT 1 FROM escalation e
+				WHERE e.pr_id = ar.pr_id
+					AND e.idle_reviewer_id = ar.user_id
+					AND e.created_at >= ar.assigned_at
+			)`),
+		}).
+		OrderBy("ar.assigned_at").
+		Limit(limit)
+
+	getStaleStr, args, err := getStale.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get stale reviews)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get stale reviews SQL",
+		zap.String("query", getStaleStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.db.Query(ctx, getStaleStr, args...)
+	if err != nil {
+		logger.Error("get stale reviews query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []models.StaleReview
+	for rows.Next() {
+		var review models.StaleReview
+		if err = rows.Scan(
+			&review.PRID,
+			&review.Repository,
+			&review.Organization,
+			&review.AuthorID,
+			&review.ReviewerID,
+			&review.TeamID,
+			&review.Policy,
+			&review.AssignedAt,
+		); err != nil {
+			logger.Error("scan stale review row", zap.Error(err))
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, rows.Err()
+}
+
+// IsReviewEscalated проверяет, была ли эскалация ревьювера после его текущего назначения
+func (p *postgresRepo) IsReviewEscalated(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+) (bool, error) {
+	logger := p.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+		zap.String("reviewer_id", reviewerID),
+	)
+
+	isEscalated := p.queryBuilder.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("escalation e").
+		Join("pull_request pr ON pr.id = e.pr_id").
+		Join("assigned_reviewer ar ON ar.pr_id = e.pr_id AND ar.user_id = e.idle_reviewer_id").
+		Where(sq.And{
+			sq.Eq{
+				"pr.external_id":     prID,
+				"e.idle_reviewer_id": reviewerID,
+			},
+			prRepositoryEq(ctx, repository),
+			sq.Expr("e.created_at >= ar.assigned_at"),
+		}).
+		Suffix(")")
+
+	isEscalatedStr, args, err := isEscalated.ToSql()
+	if err != nil {
+		logger.Error("build SQL (is review escalated)", zap.Error(err))
+		return false, err
+	}
+
+	logger.Debug("Executing is review escalated SQL",
+		zap.String("query", isEscalatedStr),
+		zap.Any("args", args),
+	)
+
+	var escalated bool
+	if err = p.conn(ctx).QueryRow(ctx, isEscalatedStr, args...).Scan(&escalated); err != nil {
+		logger.Error("is review escalated query", zap.Error(err))
+		return false, err
+	}
+
+	return escalated, nil
+}
+
+func (p *postgresRepo) CreateEscalation(
+	ctx context.Context,
+	escalation models.Escalation,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", escalation.Repository),
+		zap.String("pr_id", escalation.PRID),
+		zap.String("idle_reviewer_id", escalation.IdleReviewerID),
+		zap.String("new_reviewer_id", escalation.NewReviewerID),
+		zap.String("policy", string(escalation.Policy)),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	prKey, err := p.getPRKey(ctx, tx, escalation.Repository, escalation.PRID)
+	if err != nil {
+		logger.Error("get PR key", zap.Error(err))
+		return err
+	}
+
+	var newReviewerID *string
+	if escalation.NewReviewerID != "" {
+		newReviewerID = &escalation.NewReviewerID
+	}
+
+	createEscalation := p.queryBuilder.Insert("escalation").
+		Columns("pr_id", "team_id", "team_name", "idle_reviewer_id", "new_reviewer_id", "policy", "created_at").
+		Values(
+			prKey,
+			escalation.TeamID,
+			sq.Expr("(SELECT name FROM team WHERE id = ?)", escalation.TeamID),
+			escalation.IdleReviewerID,
+			newReviewerID,
+			escalation.Policy,
+			escalation.CreatedAt,
+		)
+
+	createEscalationStr, args, err := createEscalation.ToSql()
+	if err != nil {
+		logger.Error("build SQL (create escalation)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing create escalation SQL",
+		zap.String("query", createEscalationStr),
+		zap.Any("args", args),
+	)
+
+	_, err = tx.Exec(ctx, createEscalationStr, args...)
+	if err != nil {
+		logger.Error("create escalation", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
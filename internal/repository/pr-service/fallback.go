@@ -0,0 +1,169 @@
+package pr_service
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (p *postgresRepo) SetTeamFallbacks(
+	ctx context.Context,
+	teamName string,
+	fallbackTeams []string,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("team_name", teamName),
+		zap.Strings("fallback_teams", fallbackTeams),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	names := append([]string{teamName}, fallbackTeams...)
+	getTeamIDs := p.queryBuilder.Select("id", "name").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"name": names},
+			orgEq(ctx, "organization"),
+		}).
+		Suffix("FOR UPDATE")
+
+	getTeamIDsStr, args, err := getTeamIDs.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get fallback team IDs)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing get fallback team IDs SQL",
+		zap.String("query", getTeamIDsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := tx.Query(ctx, getTeamIDsStr, args...)
+	if err != nil {
+		logger.Error("get fallback team IDs query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	teamIDs := make(map[string]string, len(names))
+	for rows.Next() {
+		var id, name string
+		if err = rows.Scan(&id, &name); err != nil {
+			logger.Error("scan team ID", zap.Error(err))
+			return err
+		}
+		teamIDs[name] = id
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team IDs", zap.Error(err))
+		return err
+	}
+	rows.Close()
+
+	for _, name := range names {
+		if _, ok := teamIDs[name]; !ok {
+			logger.Warn("team not found", zap.String("missing_team", name))
+			return modelsErr.ErrTeamNotFound
+		}
+	}
+
+	deleteFallbacks := p.queryBuilder.Delete("team_fallback").
+		Where(sq.Eq{"team_id": teamIDs[teamName]})
+
+	deleteFallbacksStr, args, err := deleteFallbacks.ToSql()
+	if err != nil {
+		logger.Error("build SQL (delete fallbacks)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing delete fallbacks SQL",
+		zap.String("query", deleteFallbacksStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, deleteFallbacksStr, args...); err != nil {
+		logger.Error("delete fallbacks", zap.Error(err))
+		return err
+	}
+
+	if len(fallbackTeams) == 0 {
+		return nil
+	}
+
+	insertFallbacks := p.queryBuilder.Insert("team_fallback").
+		Columns("team_id", "fallback_team_id", "priority")
+	for priority, name := range fallbackTeams {
+		insertFallbacks = insertFallbacks.Values(teamIDs[teamName], teamIDs[name], priority)
+	}
+
+	insertFallbacksStr, args, err := insertFallbacks.ToSql()
+	if err != nil {
+		logger.Error("build SQL (insert fallbacks)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing insert fallbacks SQL",
+		zap.String("query", insertFallbacksStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, insertFallbacksStr, args...); err != nil {
+		logger.Error("insert fallbacks", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) GetFallbackTeamIDs(
+	ctx context.Context,
+	teamID string,
+) ([]string, error) {
+	logger := p.logger.With(zap.String("team_id", teamID))
+
+	getFallbacks := p.queryBuilder.Select("fallback_team_id").
+		From("team_fallback").
+		Where(sq.And{
+			sq.Eq{"team_id": teamID},
+			teamInOrg(ctx, "team_id"),
+		}).
+		OrderBy("priority")
+
+	getFallbacksStr, args, err := getFallbacks.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get fallback teams)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get fallback teams SQL",
+		zap.String("query", getFallbacksStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.conn(ctx).Query(ctx, getFallbacksStr, args...)
+	if err != nil {
+		logger.Error("get fallback teams query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fallbackTeamIDs []string
+	for rows.Next() {
+		var fallbackTeamID string
+		if err = rows.Scan(&fallbackTeamID); err != nil {
+			logger.Error("scan fallback team", zap.Error(err))
+			return nil, err
+		}
+		fallbackTeamIDs = append(fallbackTeamIDs, fallbackTeamID)
+	}
+
+	return fallbackTeamIDs, rows.Err()
+}
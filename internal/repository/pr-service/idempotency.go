@@ -0,0 +1,224 @@
+package pr_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (p *postgresRepo) ReserveIdempotencyKey(
+	ctx context.Context,
+	request models.IdempotentRequest,
+	now time.Time,
+) (*models.IdempotentRequest, error) {
+	logger := p.logger.With(
+		zap.String("idempotency_key", request.Key),
+		zap.String("path", request.Path),
+	)
+
+	// просроченный ключ и ключ, чей запрос не завершился за время аренды, перезанимаются,
+	// живой возвращается как есть
+	reserveKey := p.queryBuilder.Insert("idempotency_key").
+		Columns("organization", "key", "path", "fingerprint", "created_at", "expires_at").
+		Values(
+			models.OrganizationFromContext(ctx),
+			request.Key,
+			request.Path,
+			request.Fingerprint,
+			now,
+			request.ExpiresAt,
+		).
+		Suffix(`ON CONFLICT (organization, key, path) DO UPDATE
+			SET fingerprint = EXCLUDED.fingerprint,
+				status_code = NULL,
+				content_type = NULL,
+				etag = NULL,
+				response = NULL,
+				created_at = EXCLUDED.created_at,
+				expires_at = EXCLUDED.expires_at
+			WHERE idempotency_key.expires_at <= ?
+				OR (idempotency_key.status_code IS NULL AND idempotency_key.created_at <= ?)
+			RETURNING key`, now, now.Add(-request.Lease))
+
+	reserveKeyStr, args, err := reserveKey.ToSql()
+	if err != nil {
+		logger.Error("build SQL (reserve idempotency key)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing reserve idempotency key SQL",
+		zap.String("query", reserveKeyStr),
+		zap.Any("args", args),
+	)
+
+	var key string
+	err = p.db.QueryRow(ctx, reserveKeyStr, args...).Scan(&key)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		logger.Error("reserve idempotency key query", zap.Error(err))
+		return nil, err
+	}
+
+	getStored := p.queryBuilder.Select(
+		"fingerprint",
+		"COALESCE(status_code, 0)",
+		"COALESCE(content_type, '')",
+		"COALESCE(etag, '')",
+		"response",
+		"expires_at",
+	).
+		From("idempotency_key").
+		Where(sq.And{
+			sq.Eq{"key": request.Key, "path": request.Path},
+			orgEq(ctx, "organization"),
+		})
+
+	getStoredStr, args, err := getStored.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get idempotent request)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get idempotent request SQL",
+		zap.String("query", getStoredStr),
+		zap.Any("args", args),
+	)
+
+	stored := models.IdempotentRequest{
+		Key:  request.Key,
+		Path: request.Path,
+	}
+	err = p.db.QueryRow(ctx, getStoredStr, args...).Scan(
+		&stored.Fingerprint,
+		&stored.StatusCode,
+		&stored.ContentType,
+		&stored.ETag,
+		&stored.Response,
+		&stored.ExpiresAt,
+	)
+	if err != nil {
+		// ключ освободили между запросами — считаем, что он ещё занят
+		if errors.Is(err, sql.ErrNoRows) {
+			stored.Fingerprint = request.Fingerprint
+			return &stored, nil
+		}
+		logger.Error("get idempotent request query", zap.Error(err))
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+func (p *postgresRepo) SaveIdempotentResponse(
+	ctx context.Context,
+	request models.IdempotentRequest,
+) error {
+	logger := p.logger.With(
+		zap.String("idempotency_key", request.Key),
+		zap.String("path", request.Path),
+		zap.Int("status_code", request.StatusCode),
+	)
+
+	saveResponse := p.queryBuilder.Update("idempotency_key").
+		Set("status_code", request.StatusCode).
+		Set("content_type", request.ContentType).
+		Set("etag", request.ETag).
+		Set("response", request.Response).
+		Where(sq.Eq{
+			"organization": models.OrganizationFromContext(ctx),
+			"key":          request.Key,
+			"path":         request.Path,
+			"fingerprint":  request.Fingerprint,
+		})
+
+	saveResponseStr, args, err := saveResponse.ToSql()
+	if err != nil {
+		logger.Error("build SQL (save idempotent response)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing save idempotent response SQL",
+		zap.String("query", saveResponseStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = p.db.Exec(ctx, saveResponseStr, args...); err != nil {
+		logger.Error("save idempotent response query", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) ReleaseIdempotencyKey(
+	ctx context.Context,
+	key, path string,
+) error {
+	logger := p.logger.With(
+		zap.String("idempotency_key", key),
+		zap.String("path", path),
+	)
+
+	releaseKey := p.queryBuilder.Delete("idempotency_key").
+		Where(sq.Eq{
+			"organization": models.OrganizationFromContext(ctx),
+			"key":          key,
+			"path":         path,
+			"status_code":  nil,
+		})
+
+	releaseKeyStr, args, err := releaseKey.ToSql()
+	if err != nil {
+		logger.Error("build SQL (release idempotency key)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing release idempotency key SQL",
+		zap.String("query", releaseKeyStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = p.db.Exec(ctx, releaseKeyStr, args...); err != nil {
+		logger.Error("release idempotency key query", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) DeleteExpiredIdempotencyKeys(
+	ctx context.Context,
+	now time.Time,
+) (int64, error) {
+	logger := p.logger.With(zap.Time("now", now))
+
+	deleteExpired := p.queryBuilder.Delete("idempotency_key").
+		Where(sq.LtOrEq{"expires_at": now})
+
+	deleteExpiredStr, args, err := deleteExpired.ToSql()
+	if err != nil {
+		logger.Error("build SQL (delete expired idempotency keys)", zap.Error(err))
+		return 0, err
+	}
+
+	logger.Debug("Executing delete expired idempotency keys SQL",
+		zap.String("query", deleteExpiredStr),
+		zap.Any("args", args),
+	)
+
+	tag, err := p.db.Exec(ctx, deleteExpiredStr, args...)
+	if err != nil {
+		logger.Error("delete expired idempotency keys query", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,164 @@
+package pr_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (p *postgresRepo) CreateOutOfOffice(
+	ctx context.Context,
+	outOfOffice models.OutOfOffice,
+) (*models.OutOfOffice, error) {
+	logger := p.logger.With(
+		zap.String("user_id", outOfOffice.UserID),
+		zap.Time("from", outOfOffice.From),
+		zap.Time("to", outOfOffice.To),
+	)
+
+	// starts_at и ends_at — TIMESTAMP без зоны: pgx записывает локальное время как есть,
+	// поэтому границы приводятся к UTC, как и остальные метки времени сервиса.
+	// Пользователь другой организации не найдётся, и вставка не вернёт строк.
+	createOutOfOffice := p.queryBuilder.Insert("out_of_office").
+		Columns("user_id", "starts_at", "ends_at", "reassign_reviews").
+		Select(p.queryBuilder.Select("id").
+			Column("?::timestamp", outOfOffice.From.UTC()).
+			Column("?::timestamp", outOfOffice.To.UTC()).
+			Column("?::boolean", outOfOffice.ReassignReviews).
+			From("users").
+			Where(sq.And{
+				sq.Eq{"id": outOfOffice.UserID},
+				orgEq(ctx, "organization"),
+			})).
+		Suffix("RETURNING id")
+
+	createOutOfOfficeStr, args, err := createOutOfOffice.ToSql()
+	if err != nil {
+		logger.Error("build SQL (create out of office)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing create out of office SQL",
+		zap.String("query", createOutOfOfficeStr),
+		zap.Any("args", args),
+	)
+
+	err = p.db.QueryRow(ctx, createOutOfOfficeStr, args...).Scan(&outOfOffice.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("create out of office query", zap.Error(modelsErr.ErrUserNotFound))
+			return nil, modelsErr.ErrUserNotFound
+		}
+		logger.Error("create out of office query", zap.Error(err))
+		return nil, err
+	}
+
+	return &outOfOffice, nil
+}
+
+func (p *postgresRepo) GetStartedOutOfOffice(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) ([]models.OutOfOffice, error) {
+	logger := p.logger.With(
+		zap.Time("now", now),
+		zap.Uint64("limit", limit),
+	)
+
+	getStarted := p.queryBuilder.Select(
+		"o.id",
+		"o.user_id",
+		"u.organization",
+		"o.starts_at",
+		"o.ends_at",
+		"o.reassign_reviews",
+	).
+		From("out_of_office o").
+		Join("users u ON u.id = o.user_id").
+		Where(sq.And{
+			sq.Eq{"o.reassign_reviews": true},
+			sq.Eq{"o.reviews_reassigned_at": nil},
+			sq.LtOrEq{"o.starts_at": now},
+			sq.Gt{"o.ends_at": now},
+		}).
+		OrderBy("o.starts_at").
+		Limit(limit)
+
+	getStartedStr, args, err := getStarted.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get started out of office)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get started out of office SQL",
+		zap.String("query", getStartedStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.db.Query(ctx, getStartedStr, args...)
+	if err != nil {
+		logger.Error("get started out of office query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []models.OutOfOffice
+	for rows.Next() {
+		var period models.OutOfOffice
+		if err = rows.Scan(
+			&period.ID,
+			&period.UserID,
+			&period.Organization,
+			&period.From,
+			&period.To,
+			&period.ReassignReviews,
+		); err != nil {
+			logger.Error("scan out of office row", zap.Error(err))
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+
+	return periods, rows.Err()
+}
+
+func (p *postgresRepo) MarkOutOfOfficeReassigned(
+	ctx context.Context,
+	id int64,
+	reassignedAt time.Time,
+) error {
+	logger := p.logger.With(zap.Int64("out_of_office_id", id))
+
+	markReassigned := p.queryBuilder.Update("out_of_office").
+		Set("reviews_reassigned_at", reassignedAt).
+		Where(sq.And{
+			sq.Eq{"id": id},
+			userInOrg(ctx, "user_id"),
+		})
+
+	markReassignedStr, args, err := markReassigned.ToSql()
+	if err != nil {
+		logger.Error("build SQL (mark out of office reassigned)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing mark out of office reassigned SQL",
+		zap.String("query", markReassignedStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = p.db.Exec(ctx, markReassignedStr, args...); err != nil {
+		logger.Error("mark out of office reassigned", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
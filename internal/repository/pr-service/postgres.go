@@ -5,29 +5,68 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
 )
 
-const uniqueKeyViolationCode = "23505"
+const (
+	uniqueKeyViolationCode   = "23505"
+	foreignKeyViolationCode  = "23503"
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+)
+
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
 
 type postgresRepo struct {
 	db           *pgxpool.Pool
+	replica      *Replica
 	logger       *zap.Logger
 	queryBuilder sq.StatementBuilderType
 }
 
+// replica может быть nil, тогда всё чтение идёт в основной пул
 func NewPostgresRepo(
 	logger *zap.Logger,
 	db *pgxpool.Pool,
+	replica *Replica,
 ) *postgresRepo {
 	return &postgresRepo{
 		db:           db,
+		replica:      replica,
 		logger:       logger,
 		queryBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
 	}
 }
 
+// reader отдаёт транзакцию из контекста, а вне транзакции — реплику, если она доступна
+// и чтение не запрошено из основной бд
+func (p *postgresRepo) reader(ctx context.Context) querier {
+	if tx, err := extractTx(ctx); err == nil {
+		return tx
+	}
+	if p.replica == nil || models.IsPrimaryRead(ctx) {
+		return p.db
+	}
+
+	return p.replica.reader(p.db)
+}
+
+// conn отдаёт транзакцию из контекста, а вне транзакции — основной пул
+func (p *postgresRepo) conn(ctx context.Context) querier {
+	if tx, err := extractTx(ctx); err == nil {
+		return tx
+	}
+	return p.db
+}
+
 func (p *postgresRepo) beginTx(
 	ctx context.Context,
 ) (pgx.Tx, func(txErr error), error) {
@@ -56,3 +95,18 @@ func (p *postgresRepo) beginTx(
 
 	return tx, rollbackFunc, nil
 }
+
+// orgEq ограничивает запрос организацией вызывающего из ctx
+func orgEq(ctx context.Context, column string) sq.Eq {
+	return sq.Eq{column: models.OrganizationFromContext(ctx)}
+}
+
+// teamInOrg ограничивает строки дочерних таблиц командами организации из ctx
+func teamInOrg(ctx context.Context, column string) sq.Sqlizer {
+	return sq.Expr(column+" IN (SELECT id FROM team WHERE organization = ?)", models.OrganizationFromContext(ctx))
+}
+
+// userInOrg ограничивает строки дочерних таблиц пользователями организации из ctx
+func userInOrg(ctx context.Context, column string) sq.Sqlizer {
+	return sq.Expr(column+" IN (SELECT id FROM users WHERE organization = ?)", models.OrganizationFromContext(ctx))
+}
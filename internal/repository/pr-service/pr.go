@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 
@@ -16,14 +19,16 @@ import (
 
 func (p *postgresRepo) PullRequestCreate(
 	ctx context.Context,
-	authorID, prID, prName string,
-	reviewers []string,
-) (pr *models.PR, txErr error) {
+	pr models.PR,
+) (_ *models.PR, txErr error) {
 	logger := p.logger.With(
-		zap.String("author_id", authorID),
-		zap.String("pr_id", prID),
-		zap.String("pr_name", prName),
-		zap.Any("reviewers", reviewers),
+		zap.String("author_id", pr.AuthorID),
+		zap.String("repository", pr.Repository),
+		zap.String("pr_id", pr.ID),
+		zap.String("pr_name", pr.Name),
+		zap.String("team_id", pr.TeamID),
+		zap.Any("reviewers", pr.AssignedReviewers),
+		zap.Any("fallback_reviewers", pr.FallbackReviewers),
 	)
 
 	tx, rollback, err := p.beginTx(ctx)
@@ -33,10 +38,37 @@ func (p *postgresRepo) PullRequestCreate(
 	}
 	defer rollback(txErr)
 
+	var repositoryID *int64
+	if pr.Repository != "" {
+		repositoryID, err = p.getRepositoryID(ctx, tx, pr.Repository)
+		if err != nil {
+			logger.Error("get repository id", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	if err = p.checkTeam(ctx, tx, pr.TeamID); err != nil {
+		logger.Warn("check team", zap.Error(err))
+		return nil, err
+	}
+	if err = p.checkUsers(ctx, tx, append([]string{pr.AuthorID}, pr.AssignedReviewers...)); err != nil {
+		logger.Warn("check users", zap.Error(err))
+		return nil, err
+	}
+
+	// внешний ID уникален только в организации и репозитории, поэтому PR хранится под суррогатным ключом
 	createPR := p.queryBuilder.Insert("pull_request").
-		Columns("id", "name", "author_id").
-		Values(prID, prName, authorID).
-		Suffix("RETURNING created_at")
+		Columns("id", "organization", "external_id", "repository_id", "name", "author_id", "team_id").
+		Values(
+			sq.Expr("gen_random_uuid()::text"),
+			models.OrganizationFromContext(ctx),
+			pr.ID,
+			repositoryID,
+			pr.Name,
+			pr.AuthorID,
+			pr.TeamID,
+		).
+		Suffix("RETURNING id, created_at, version")
 
 	createPRStr, args, err := createPR.ToSql()
 	if err != nil {
@@ -49,8 +81,8 @@ func (p *postgresRepo) PullRequestCreate(
 		zap.Any("args", args),
 	)
 
-	var createdAt *time.Time
-	err = tx.QueryRow(ctx, createPRStr, args...).Scan(&createdAt)
+	var key string
+	err = tx.QueryRow(ctx, createPRStr, args...).Scan(&key, &pr.CreatedAt, &pr.Version)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == uniqueKeyViolationCode {
@@ -60,13 +92,13 @@ func (p *postgresRepo) PullRequestCreate(
 		logger.Error("create PR query", zap.Error(err))
 		return nil, err
 	}
-	if len(reviewers) > 0 {
+	if len(pr.AssignedReviewers) > 0 {
 		updateAssignedReviewers := p.queryBuilder.Insert("assigned_reviewer").
-			Columns("user_id", "pr_id")
+			Columns("user_id", "pr_id", "from_fallback")
 
-		for _, reviewerID := range reviewers {
+		for _, reviewerID := range pr.AssignedReviewers {
 			updateAssignedReviewers = updateAssignedReviewers.
-				Values(reviewerID, prID)
+				Values(reviewerID, key, slices.Contains(pr.FallbackReviewers, reviewerID))
 		}
 
 		updateAssignedReviewersStr, args, err := updateAssignedReviewers.ToSql()
@@ -85,32 +117,164 @@ func (p *postgresRepo) PullRequestCreate(
 			logger.Error("insert reviewers", zap.Error(err))
 			return nil, err
 		}
+
+		if err = p.recordPairings(ctx, tx, key, pr.AssignedReviewers); err != nil {
+			logger.Error("record pairings", zap.Error(err))
+			return nil, err
+		}
 	}
 
-	pr = &models.PR{
-		ID:                prID,
-		Name:              prName,
-		AuthorID:          authorID,
-		AssignedReviewers: reviewers,
-		CreatedAt:         createdAt,
-		Status:            models.PRStatusOPEN,
+	pr.Status = models.PRStatusOPEN
+
+	return &pr, nil
+}
+
+func prRepositoryEq(ctx context.Context, repository string) sq.Sqlizer {
+	if repository == "" {
+		return sq.And{orgEq(ctx, "pr.organization"), sq.Eq{"pr.repository_id": nil}}
 	}
+	return sq.And{
+		orgEq(ctx, "pr.organization"),
+		sq.Expr("pr.repository_id = (SELECT id FROM repository WHERE name = ? AND organization = ?)",
+			repository, models.OrganizationFromContext(ctx)),
+	}
+}
 
-	return pr, nil
+// checkTeam проверяет, что команда принадлежит организации из ctx
+func (p *postgresRepo) checkTeam(
+	ctx context.Context,
+	tx pgx.Tx,
+	teamID string,
+) error {
+	getTeam := p.queryBuilder.Select("id").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"id": teamID},
+			orgEq(ctx, "organization"),
+		})
+
+	getTeamStr, args, err := getTeam.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing check team SQL",
+		zap.String("query", getTeamStr),
+		zap.Any("args", args),
+	)
+
+	var id int64
+	if err = tx.QueryRow(ctx, getTeamStr, args...).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return modelsErr.ErrTeamNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// checkUsers проверяет, что пользователи принадлежат организации из ctx:
+// внешний ключ на users этого не гарантирует, идентификаторы общие для всех организаций
+func (p *postgresRepo) checkUsers(
+	ctx context.Context,
+	tx pgx.Tx,
+	userIDs []string,
+) error {
+	getUsers := p.queryBuilder.Select("id").
+		From("users").
+		Where(sq.And{
+			sq.Eq{"id": userIDs},
+			orgEq(ctx, "organization"),
+		})
+
+	getUsersStr, args, err := getUsers.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing check users SQL",
+		zap.String("query", getUsersStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := tx.Query(ctx, getUsersStr, args...)
+	if err != nil {
+		return err
+	}
+	found, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if !slices.Contains(found, userID) {
+			return fmt.Errorf("%w: %s", modelsErr.ErrUserNotFound, userID)
+		}
+	}
+	return nil
+}
+
+func (p *postgresRepo) getPRKey(
+	ctx context.Context,
+	tx pgx.Tx,
+	repository, prID string,
+) (string, error) {
+	getKey := p.queryBuilder.Select("pr.id").
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		})
+
+	getKeyStr, args, err := getKey.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	p.logger.Debug("Executing get PR key SQL",
+		zap.String("query", getKeyStr),
+		zap.Any("args", args),
+	)
+
+	var key string
+	err = tx.QueryRow(ctx, getKeyStr, args...).Scan(&key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", modelsErr.ErrPRNotFound
+		}
+		return "", err
+	}
+
+	return key, nil
 }
 
 func (p *postgresRepo) PullRequestMerge(
 	ctx context.Context,
-	prID string,
-) (*models.PR, error) {
-	logger := p.logger.With(zap.String("pr_id", prID))
+	repository, prID string,
+) (_ *models.PR, txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
 
-	updateStatus := p.queryBuilder.Update("pull_request").
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return nil, err
+	}
+	defer rollback(txErr)
+
+	updateStatus := p.queryBuilder.Update("pull_request pr").
 		Set("status", models.PRStatusMERGED).
 		SetMap(map[string]interface{}{
-			"merged_at": sq.Expr("COALESCE(merged_at, ?)", time.Now())}).
-		Where(sq.Eq{"id": prID}).
-		Suffix("RETURNING id, name, author_id, created_at, merged_at, status")
+			"merged_at": sq.Expr("COALESCE(merged_at, ?)", time.Now()),
+			"version":   sq.Expr("version + CASE WHEN status = ? THEN 0 ELSE 1 END", models.PRStatusMERGED)}).
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		}).
+		Suffix("RETURNING external_id, name, author_id, created_at, merged_at, status, version")
 
 	updateStatusStr, args, err := updateStatus.ToSql()
 	if err != nil {
@@ -124,13 +288,14 @@ func (p *postgresRepo) PullRequestMerge(
 	)
 
 	var dbPr models.PR
-	err = p.db.QueryRow(ctx, updateStatusStr, args...).Scan(
+	err = tx.QueryRow(ctx, updateStatusStr, args...).Scan(
 		&dbPr.ID,
 		&dbPr.Name,
 		&dbPr.AuthorID,
 		&dbPr.CreatedAt,
 		&dbPr.MergedAt,
 		&dbPr.Status,
+		&dbPr.Version,
 	)
 
 	if err != nil {
@@ -141,15 +306,19 @@ func (p *postgresRepo) PullRequestMerge(
 		logger.Error("merge query", zap.Error(err))
 		return nil, err
 	}
+	dbPr.Repository = repository
 
 	return &dbPr, nil
 }
 
 func (p *postgresRepo) GetPullRequest(
 	ctx context.Context,
-	prID string,
+	repository, prID string,
 ) (pr *models.PR, txErr error) {
-	logger := p.logger.With(zap.String("pr_id", prID))
+	logger := p.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
 
 	tx, rollback, err := p.beginTx(ctx)
 	if err != nil {
@@ -158,17 +327,58 @@ func (p *postgresRepo) GetPullRequest(
 	}
 	defer rollback(txErr)
 
+	pr, err = p.selectPullRequest(ctx, tx, logger, repository, prID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr.Status == models.PRStatusMERGED {
+		logger.Error("PR is already merged", zap.Error(modelsErr.ErrPRMerged))
+		return nil, modelsErr.ErrPRMerged
+	}
+
+	return pr, nil
+}
+
+// PullRequestGet читает PR в любом статусе без блокировки строк
+func (p *postgresRepo) PullRequestGet(
+	ctx context.Context,
+	repository, prID string,
+) (*models.PR, error) {
+	logger := p.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
+
+	return p.selectPullRequest(ctx, p.reader(ctx), logger, repository, prID, false)
+}
+
+func (p *postgresRepo) selectPullRequest(
+	ctx context.Context,
+	db querier,
+	logger *zap.Logger,
+	repository, prID string,
+	forUpdate bool,
+) (*models.PR, error) {
 	getPR := p.queryBuilder.Select(
-		"id",
-		"name",
-		"author_id",
-		"created_at",
-		"merged_at",
-		"status",
+		"pr.id",
+		"pr.external_id",
+		"pr.name",
+		"pr.author_id",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.status",
+		"COALESCE(pr.team_id::text, '')",
+		"pr.version",
 	).
-		From("pull_request").
-		Where(sq.Eq{"id": prID}).
-		Suffix("FOR UPDATE")
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		})
+	if forUpdate {
+		getPR = getPR.Suffix("FOR UPDATE")
+	}
 
 	getPRSql, args, err := getPR.ToSql()
 	if err != nil {
@@ -181,14 +391,18 @@ func (p *postgresRepo) GetPullRequest(
 		zap.Any("args", args),
 	)
 
-	pr = &models.PR{}
-	err = tx.QueryRow(ctx, getPRSql, args...).Scan(
+	var key string
+	pr := &models.PR{Repository: repository}
+	err = db.QueryRow(ctx, getPRSql, args...).Scan(
+		&key,
 		&pr.ID,
 		&pr.Name,
 		&pr.AuthorID,
 		&pr.CreatedAt,
 		&pr.MergedAt,
 		&pr.Status,
+		&pr.TeamID,
+		&pr.Version,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -199,15 +413,12 @@ func (p *postgresRepo) GetPullRequest(
 		return nil, err
 	}
 
-	if pr.Status == models.PRStatusMERGED {
-		logger.Error("PR is already merged", zap.Error(modelsErr.ErrPRMerged))
-		return nil, modelsErr.ErrPRMerged
-	}
-
-	getReviewers := p.queryBuilder.Select("user_id").
+	getReviewers := p.queryBuilder.Select("user_id", "from_fallback").
 		From("assigned_reviewer").
-		Where(sq.Eq{"pr_id": prID}).
-		Suffix("FOR UPDATE")
+		Where(sq.Eq{"pr_id": key})
+	if forUpdate {
+		getReviewers = getReviewers.Suffix("FOR UPDATE")
+	}
 
 	getReviewersStr, args, err := getReviewers.ToSql()
 	if err != nil {
@@ -215,12 +426,12 @@ func (p *postgresRepo) GetPullRequest(
 		return nil, err
 	}
 
-	p.logger.Debug("Executing get reviewers SQL",
+	logger.Debug("Executing get reviewers SQL",
 		zap.String("query", getReviewersStr),
 		zap.Any("args", args),
 	)
 
-	rows, err := tx.Query(ctx, getReviewersStr, args...)
+	rows, err := db.Query(ctx, getReviewersStr, args...)
 	if err != nil {
 		logger.Error("get reviewers", zap.Error(err))
 		return nil, err
@@ -229,12 +440,16 @@ func (p *postgresRepo) GetPullRequest(
 
 	for rows.Next() {
 		var reviewer string
-		err = rows.Scan(&reviewer)
+		var fromFallback bool
+		err = rows.Scan(&reviewer, &fromFallback)
 		if err != nil {
 			logger.Error("scan reviewer", zap.Error(err))
 			return nil, err
 		}
 		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewer)
+		if fromFallback {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, reviewer)
+		}
 	}
 
 	return pr, nil
@@ -242,12 +457,15 @@ func (p *postgresRepo) GetPullRequest(
 
 func (p *postgresRepo) PullRequestReassign(
 	ctx context.Context,
-	prID, oldReviewerID, newReviewerID string,
+	repository, prID, oldReviewerID, newReviewerID string,
+	fromFallback bool,
 ) (txErr error) {
 	logger := p.logger.With(
+		zap.String("repository", repository),
 		zap.String("pr_id", prID),
 		zap.String("old_reviewer_id", oldReviewerID),
 		zap.String("new_reviewer_id", newReviewerID),
+		zap.Bool("from_fallback", fromFallback),
 	)
 
 	tx, rollback, err := p.beginTx(ctx)
@@ -257,11 +475,24 @@ func (p *postgresRepo) PullRequestReassign(
 	}
 	defer rollback(txErr)
 
+	prKey, err := p.getPRKey(ctx, tx, repository, prID)
+	if err != nil {
+		logger.Error("get PR key", zap.Error(err))
+		return err
+	}
+
+	if err = p.checkUsers(ctx, tx, []string{newReviewerID}); err != nil {
+		logger.Warn("check users", zap.Error(err))
+		return err
+	}
+
 	updateReviewers := p.queryBuilder.Update("assigned_reviewer").
 		Set("user_id", newReviewerID).
+		Set("from_fallback", fromFallback).
+		Set("assigned_at", sq.Expr("now()")).
 		Where(sq.And{
 			sq.Eq{"user_id": oldReviewerID},
-			sq.Eq{"pr_id": prID},
+			sq.Eq{"pr_id": prKey},
 		})
 
 	updateReviewersStr, args, err := updateReviewers.ToSql()
@@ -281,5 +512,289 @@ func (p *postgresRepo) PullRequestReassign(
 		return err
 	}
 
+	if err = p.bumpPRVersion(ctx, tx, prKey); err != nil {
+		logger.Error("bump PR version", zap.Error(err))
+		return err
+	}
+
+	if err = p.recordPairings(ctx, tx, prKey, []string{newReviewerID}); err != nil {
+		logger.Error("record pairings", zap.Error(err))
+		return err
+	}
+
 	return nil
 }
+
+func (p *postgresRepo) PullRequestAddReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+	fromFallback bool,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+		zap.String("reviewer_id", reviewerID),
+		zap.Bool("from_fallback", fromFallback),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	prKey, err := p.getPRKey(ctx, tx, repository, prID)
+	if err != nil {
+		logger.Error("get PR key", zap.Error(err))
+		return err
+	}
+
+	if err = p.checkUsers(ctx, tx, []string{reviewerID}); err != nil {
+		logger.Warn("check users", zap.Error(err))
+		return err
+	}
+
+	addReviewer := p.queryBuilder.Insert("assigned_reviewer").
+		Columns("user_id", "pr_id", "from_fallback").
+		Values(reviewerID, prKey, fromFallback)
+
+	addReviewerStr, args, err := addReviewer.ToSql()
+	if err != nil {
+		logger.Error("build SQL (add reviewer)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing add reviewer SQL",
+		zap.String("query", addReviewerStr),
+		zap.Any("args", args),
+	)
+
+	_, err = tx.Exec(ctx, addReviewerStr, args...)
+	if err != nil {
+		logger.Error("add reviewer", zap.Error(err))
+		return err
+	}
+
+	if err = p.bumpPRVersion(ctx, tx, prKey); err != nil {
+		logger.Error("bump PR version", zap.Error(err))
+		return err
+	}
+
+	if err = p.recordPairings(ctx, tx, prKey, []string{reviewerID}); err != nil {
+		logger.Error("record pairings", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) PullRequestRemoveReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+		zap.String("reviewer_id", reviewerID),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	prKey, err := p.getPRKey(ctx, tx, repository, prID)
+	if err != nil {
+		logger.Error("get PR key", zap.Error(err))
+		return err
+	}
+
+	removeReviewer := p.queryBuilder.Delete("assigned_reviewer").
+		Where(sq.And{
+			sq.Eq{"user_id": reviewerID},
+			sq.Eq{"pr_id": prKey},
+		})
+
+	removeReviewerStr, args, err := removeReviewer.ToSql()
+	if err != nil {
+		logger.Error("build SQL (remove reviewer)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing remove reviewer SQL",
+		zap.String("query", removeReviewerStr),
+		zap.Any("args", args),
+	)
+
+	tag, err := tx.Exec(ctx, removeReviewerStr, args...)
+	if err != nil {
+		logger.Error("remove reviewer", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		logger.Warn("remove reviewer", zap.Error(modelsErr.ErrNotAssigned))
+		return modelsErr.ErrNotAssigned
+	}
+
+	if err = p.bumpPRVersion(ctx, tx, prKey); err != nil {
+		logger.Error("bump PR version", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) GetPullRequestVersion(
+	ctx context.Context,
+	repository, prID string,
+) (_ int64, txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return 0, err
+	}
+	defer rollback(txErr)
+
+	getVersion := p.queryBuilder.Select("pr.version").
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		}).
+		Suffix("FOR UPDATE")
+
+	getVersionStr, args, err := getVersion.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get PR version)", zap.Error(err))
+		return 0, err
+	}
+
+	logger.Debug("Executing get PR version SQL",
+		zap.String("query", getVersionStr),
+		zap.Any("args", args),
+	)
+
+	var version int64
+	if err = tx.QueryRow(ctx, getVersionStr, args...).Scan(&version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("get PR version query", zap.Error(modelsErr.ErrPRNotFound))
+			return 0, modelsErr.ErrPRNotFound
+		}
+		logger.Error("get PR version query", zap.Error(err))
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (p *postgresRepo) bumpPRVersion(
+	ctx context.Context,
+	tx pgx.Tx,
+	prKey string,
+) error {
+	bumpVersion := p.queryBuilder.Update("pull_request").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": prKey})
+
+	bumpVersionStr, args, err := bumpVersion.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing bump PR version SQL",
+		zap.String("query", bumpVersionStr),
+		zap.Any("args", args),
+	)
+
+	_, err = tx.Exec(ctx, bumpVersionStr, args...)
+	return err
+}
+
+func (p *postgresRepo) recordPairings(
+	ctx context.Context,
+	tx pgx.Tx,
+	prKey string,
+	reviewerIDs []string,
+) error {
+	insertPairings := p.queryBuilder.Insert("review_pairing").
+		Columns("pr_id", "author_id", "reviewer_id").
+		Select(sq.Select("pr.id", "pr.author_id", "r.reviewer_id").
+			From("pull_request pr").
+			Join("unnest(?::text[]) AS r(reviewer_id) ON TRUE", reviewerIDs).
+			Where(sq.Eq{"pr.id": prKey}))
+
+	insertPairingsStr, args, err := insertPairings.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing record pairings SQL",
+		zap.String("query", insertPairingsStr),
+		zap.Any("args", args),
+	)
+
+	_, err = tx.Exec(ctx, insertPairingsStr, args...)
+	return err
+}
+
+func (p *postgresRepo) GetRecentPairings(
+	ctx context.Context,
+	authorID string,
+	reviewerIDs []string,
+	since time.Time,
+) (map[string]int, error) {
+	logger := p.logger.With(
+		zap.String("author_id", authorID),
+		zap.Strings("reviewer_ids", reviewerIDs),
+		zap.Time("since", since),
+	)
+
+	getPairings := p.queryBuilder.Select("reviewer_id", "COUNT(*)").
+		From("review_pairing").
+		Where(sq.And{
+			sq.Eq{"author_id": authorID},
+			userInOrg(ctx, "author_id"),
+			sq.Eq{"reviewer_id": reviewerIDs},
+			sq.GtOrEq{"assigned_at": since},
+		}).
+		GroupBy("reviewer_id")
+
+	getPairingsStr, args, err := getPairings.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get recent pairings)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get recent pairings SQL",
+		zap.String("query", getPairingsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.conn(ctx).Query(ctx, getPairingsStr, args...)
+	if err != nil {
+		logger.Error("get recent pairings query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	pairings := make(map[string]int, len(reviewerIDs))
+	for rows.Next() {
+		var reviewerID string
+		var count int
+		if err = rows.Scan(&reviewerID, &count); err != nil {
+			logger.Error("scan recent pairing", zap.Error(err))
+			return nil, err
+		}
+		pairings[reviewerID] = count
+	}
+
+	return pairings, rows.Err()
+}
@@ -0,0 +1,89 @@
+package pr_service
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const (
+	primaryPoolName = "primary"
+	replicaPoolName = "replica"
+
+	replicaPingTimeout = 2 * time.Second
+)
+
+// Replica — пул реплики для чтения вне транзакций. Пока реплика не ответила на
+// проверку доступности, чтение идёт в основной пул.
+type Replica struct {
+	pool    *pgxpool.Pool
+	logger  *zap.Logger
+	reads   *prometheus.CounterVec
+	healthy *prometheus.GaugeVec
+	up      atomic.Bool
+}
+
+func NewReplica(
+	pool *pgxpool.Pool,
+	logger *zap.Logger,
+	reads *prometheus.CounterVec,
+	healthy *prometheus.GaugeVec,
+) *Replica {
+	return &Replica{
+		pool:    pool,
+		logger:  logger.With(zap.String("pool", replicaPoolName)),
+		reads:   reads,
+		healthy: healthy,
+	}
+}
+
+func (r *Replica) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		r.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Replica) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, replicaPingTimeout)
+	defer cancel()
+
+	err := r.pool.Ping(ctx)
+	up := err == nil
+	if r.up.Swap(up) != up {
+		if up {
+			r.logger.Info("replica is available, routing reads to replica")
+		} else {
+			r.logger.Warn("replica is unavailable, routing reads to primary", zap.Error(err))
+		}
+	}
+
+	value := 0.0
+	if up {
+		value = 1
+	}
+	r.healthy.WithLabelValues(replicaPoolName).Set(value)
+}
+
+// reader выбирает пул для чтения и учитывает запрос в метрике своего пула
+func (r *Replica) reader(primary *pgxpool.Pool) querier {
+	if r.up.Load() {
+		r.reads.WithLabelValues(replicaPoolName).Inc()
+		return r.pool
+	}
+
+	r.reads.WithLabelValues(primaryPoolName).Inc()
+	return primary
+}
@@ -0,0 +1,341 @@
+package pr_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (p *postgresRepo) RepositoryAdd(
+	ctx context.Context,
+	repository models.Repository,
+) (_ *models.Repository, txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", repository.Name),
+		zap.String("owner_team", repository.OwnerTeam),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return nil, err
+	}
+	defer rollback(txErr)
+
+	ownerTeamID, err := p.lockOwnerTeam(ctx, tx, repository.OwnerTeam)
+	if err != nil {
+		logger.Error("lock owner team", zap.Error(err))
+		return nil, err
+	}
+
+	addRepository := p.queryBuilder.Insert("repository").
+		Columns("organization", "name", "owner_team_id", "reviewer_count", "shortfall_policy", "selection_mode").
+		Values(
+			models.OrganizationFromContext(ctx),
+			repository.Name,
+			ownerTeamID,
+			repository.ReviewerCount,
+			repository.ShortfallPolicy,
+			repository.SelectionMode,
+		)
+
+	addRepositoryStr, args, err := addRepository.ToSql()
+	if err != nil {
+		logger.Error("build SQL (add repository)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing add repository SQL",
+		zap.String("query", addRepositoryStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, addRepositoryStr, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueKeyViolationCode {
+			logger.Warn("repository already exists", zap.Error(err))
+			return nil, modelsErr.ErrRepositoryExist
+		}
+		logger.Error("add repository query", zap.Error(err))
+		return nil, err
+	}
+
+	return p.getRepository(ctx, tx, repository.Name)
+}
+
+func (p *postgresRepo) RepositoryGet(
+	ctx context.Context,
+	name string,
+) (_ *models.Repository, txErr error) {
+	logger := p.logger.With(zap.String("repository", name))
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return nil, err
+	}
+	defer rollback(txErr)
+
+	repository, err := p.getRepository(ctx, tx, name)
+	if err != nil {
+		logger.Error("get repository", zap.Error(err))
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+func (p *postgresRepo) RepositoryList(
+	ctx context.Context,
+) ([]models.Repository, error) {
+	listRepositories := p.selectRepositories(ctx).
+		OrderBy("r.name")
+
+	listRepositoriesStr, args, err := listRepositories.ToSql()
+	if err != nil {
+		p.logger.Error("build SQL (list repositories)", zap.Error(err))
+		return nil, err
+	}
+
+	p.logger.Debug("Executing list repositories SQL",
+		zap.String("query", listRepositoriesStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.reader(ctx).Query(ctx, listRepositoriesStr, args...)
+	if err != nil {
+		p.logger.Error("list repositories query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	repositories := []models.Repository{}
+	for rows.Next() {
+		repository, err := scanRepository(rows)
+		if err != nil {
+			p.logger.Error("scan repository row", zap.Error(err))
+			return nil, err
+		}
+		repositories = append(repositories, *repository)
+	}
+
+	return repositories, rows.Err()
+}
+
+func (p *postgresRepo) RepositoryUpdate(
+	ctx context.Context,
+	repository models.Repository,
+) (_ *models.Repository, txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", repository.Name),
+		zap.String("owner_team", repository.OwnerTeam),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return nil, err
+	}
+	defer rollback(txErr)
+
+	ownerTeamID, err := p.lockOwnerTeam(ctx, tx, repository.OwnerTeam)
+	if err != nil {
+		logger.Error("lock owner team", zap.Error(err))
+		return nil, err
+	}
+
+	updateRepository := p.queryBuilder.Update("repository").
+		Set("owner_team_id", ownerTeamID).
+		Set("reviewer_count", repository.ReviewerCount).
+		Set("shortfall_policy", repository.ShortfallPolicy).
+		Set("selection_mode", repository.SelectionMode).
+		Where(sq.And{
+			sq.Eq{"name": repository.Name},
+			orgEq(ctx, "organization"),
+		})
+
+	updateRepositoryStr, args, err := updateRepository.ToSql()
+	if err != nil {
+		logger.Error("build SQL (update repository)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing update repository SQL",
+		zap.String("query", updateRepositoryStr),
+		zap.Any("args", args),
+	)
+
+	tag, err := tx.Exec(ctx, updateRepositoryStr, args...)
+	if err != nil {
+		logger.Error("update repository query", zap.Error(err))
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		logger.Warn("repository not found")
+		return nil, modelsErr.ErrRepositoryNotFound
+	}
+
+	return p.getRepository(ctx, tx, repository.Name)
+}
+
+func (p *postgresRepo) RepositoryDelete(
+	ctx context.Context,
+	name string,
+) error {
+	logger := p.logger.With(zap.String("repository", name))
+
+	deleteRepository := p.queryBuilder.Delete("repository").
+		Where(sq.And{
+			sq.Eq{"name": name},
+			orgEq(ctx, "organization"),
+		})
+
+	deleteRepositoryStr, args, err := deleteRepository.ToSql()
+	if err != nil {
+		logger.Error("build SQL (delete repository)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing delete repository SQL",
+		zap.String("query", deleteRepositoryStr),
+		zap.Any("args", args),
+	)
+
+	tag, err := p.db.Exec(ctx, deleteRepositoryStr, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			logger.Warn("repository has pull requests", zap.Error(err))
+			return modelsErr.ErrRepositoryHasPRs
+		}
+		logger.Error("delete repository query", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		logger.Warn("repository not found")
+		return modelsErr.ErrRepositoryNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) lockOwnerTeam(
+	ctx context.Context,
+	tx pgx.Tx,
+	teamName string,
+) (*int64, error) {
+	if teamName == "" {
+		return nil, nil
+	}
+
+	teamID, err := p.lockTeam(ctx, tx, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, modelsErr.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	return &teamID, nil
+}
+
+func (p *postgresRepo) getRepositoryID(
+	ctx context.Context,
+	tx pgx.Tx,
+	name string,
+) (*int64, error) {
+	getID := p.queryBuilder.Select("id").
+		From("repository").
+		Where(sq.And{
+			sq.Eq{"name": name},
+			orgEq(ctx, "organization"),
+		})
+
+	getIDStr, args, err := getID.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Debug("Executing get repository id SQL",
+		zap.String("query", getIDStr),
+		zap.Any("args", args),
+	)
+
+	var id int64
+	if err = tx.QueryRow(ctx, getIDStr, args...).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, modelsErr.ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (p *postgresRepo) getRepository(
+	ctx context.Context,
+	tx pgx.Tx,
+	name string,
+) (*models.Repository, error) {
+	getRepository := p.selectRepositories(ctx).
+		Where(sq.Eq{"r.name": name})
+
+	getRepositoryStr, args, err := getRepository.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Debug("Executing get repository SQL",
+		zap.String("query", getRepositoryStr),
+		zap.Any("args", args),
+	)
+
+	repository, err := scanRepository(tx.QueryRow(ctx, getRepositoryStr, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, modelsErr.ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+func (p *postgresRepo) selectRepositories(ctx context.Context) sq.SelectBuilder {
+	return p.queryBuilder.Select(
+		"r.name",
+		"COALESCE(t.name, '')",
+		"COALESCE(r.owner_team_id::text, '')",
+		"r.reviewer_count",
+		"r.shortfall_policy",
+		"r.selection_mode",
+	).
+		From("repository r").
+		LeftJoin("team t ON t.id = r.owner_team_id").
+		Where(orgEq(ctx, "r.organization"))
+}
+
+func scanRepository(row pgx.Row) (*models.Repository, error) {
+	var repository models.Repository
+	err := row.Scan(
+		&repository.Name,
+		&repository.OwnerTeam,
+		&repository.OwnerTeamID,
+		&repository.ReviewerCount,
+		&repository.ShortfallPolicy,
+		&repository.SelectionMode,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository, nil
+}
@@ -0,0 +1,394 @@
+package pr_service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (p *postgresRepo) TeamStats(
+	ctx context.Context,
+	filter models.TeamStatsFilter,
+) ([]models.TeamStats, uint64, error) {
+	logger := p.logger.With(
+		zap.Time("since", filter.Since),
+		zap.Time("stale_before", filter.StaleBefore),
+		zap.Uint64("limit", filter.Limit),
+		zap.Uint64("offset", filter.Offset),
+	)
+
+	// все запросы страницы идут в один пул, чтобы итог и строки были согласованы
+	db := p.reader(ctx)
+
+	countTeams := p.queryBuilder.Select("COUNT(*)").From("team").
+		Where(orgEq(ctx, "organization"))
+
+	countTeamsStr, args, err := countTeams.ToSql()
+	if err != nil {
+		logger.Error("build SQL (count teams)", zap.Error(err))
+		return nil, 0, err
+	}
+
+	logger.Debug("Executing count teams SQL",
+		zap.String("query", countTeamsStr),
+		zap.Any("args", args),
+	)
+
+	var total uint64
+	if err = db.QueryRow(ctx, countTeamsStr, args...).Scan(&total); err != nil {
+		logger.Error("count teams query", zap.Error(err))
+		return nil, 0, err
+	}
+
+	getTeams := p.queryBuilder.Select(
+		"t.id",
+		"t.name",
+		"COUNT(u.id) FILTER (WHERE u.is_active)",
+	).
+		From("team t").
+		LeftJoin("team_membership m ON m.team_id = t.id").
+		LeftJoin("users u ON u.id = m.user_id").
+		Where(orgEq(ctx, "t.organization")).
+		GroupBy("t.id", "t.name").
+		OrderBy("t.name").
+		Limit(filter.Limit).
+		Offset(filter.Offset)
+
+	getTeamsStr, args, err := getTeams.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get teams page)", zap.Error(err))
+		return nil, 0, err
+	}
+
+	logger.Debug("Executing get teams page SQL",
+		zap.String("query", getTeamsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := db.Query(ctx, getTeamsStr, args...)
+	if err != nil {
+		logger.Error("get teams page query", zap.Error(err))
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var teamIDs []int64
+	statsByTeam := make(map[int64]*models.TeamStats)
+	for rows.Next() {
+		var teamID int64
+		var stats models.TeamStats
+		if err = rows.Scan(&teamID, &stats.TeamName, &stats.ActiveMembers); err != nil {
+			logger.Error("scan team row", zap.Error(err))
+			return nil, 0, err
+		}
+		teamIDs = append(teamIDs, teamID)
+		statsByTeam[teamID] = &stats
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team rows", zap.Error(err))
+		return nil, 0, err
+	}
+
+	if len(teamIDs) == 0 {
+		return []models.TeamStats{}, total, nil
+	}
+
+	if err = p.fillWeeklyThroughput(ctx, db, logger, teamIDs, filter.Since, statsByTeam); err != nil {
+		return nil, 0, err
+	}
+	if err = p.fillTimeToMerge(ctx, db, logger, teamIDs, filter.Since, statsByTeam); err != nil {
+		return nil, 0, err
+	}
+	if err = p.fillStaleOpenPRs(ctx, db, logger, teamIDs, filter.StaleBefore, statsByTeam); err != nil {
+		return nil, 0, err
+	}
+
+	stats := make([]models.TeamStats, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		stats = append(stats, *statsByTeam[teamID])
+	}
+
+	return stats, total, nil
+}
+
+func (p *postgresRepo) fillWeeklyThroughput(
+	ctx context.Context,
+	db querier,
+	logger *zap.Logger,
+	teamIDs []int64,
+	since time.Time,
+	statsByTeam map[int64]*models.TeamStats,
+) error {
+	opened, err := p.countPRsPerWeek(ctx, db, logger, "pr.created_at", sq.And{
+		sq.Eq{"pr.team_id": teamIDs},
+		sq.GtOrEq{"pr.created_at": since},
+	})
+	if err != nil {
+		return err
+	}
+
+	merged, err := p.countPRsPerWeek(ctx, db, logger, "pr.merged_at", sq.And{
+		sq.Eq{"pr.team_id": teamIDs},
+		sq.Eq{"pr.status": models.PRStatusMERGED},
+		sq.GtOrEq{"pr.merged_at": since},
+	})
+	if err != nil {
+		return err
+	}
+
+	for teamID, stats := range statsByTeam {
+		weeks := make(map[time.Time]*models.WeeklyThroughput)
+		for week, count := range opened[teamID] {
+			weeks[week] = &models.WeeklyThroughput{WeekStart: week, Opened: count}
+		}
+		for week, count := range merged[teamID] {
+			if _, ok := weeks[week]; !ok {
+				weeks[week] = &models.WeeklyThroughput{WeekStart: week}
+			}
+			weeks[week].Merged = count
+		}
+
+		for _, week := range weeks {
+			stats.Weekly = append(stats.Weekly, *week)
+		}
+		slices.SortFunc(stats.Weekly, func(a, b models.WeeklyThroughput) int {
+			return a.WeekStart.Compare(b.WeekStart)
+		})
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) countPRsPerWeek(
+	ctx context.Context,
+	db querier,
+	logger *zap.Logger,
+	column string,
+	where sq.Sqlizer,
+) (map[int64]map[time.Time]int, error) {
+	countPerWeek := p.queryBuilder.Select(
+		"pr.team_id",
+		"date_trunc('week', "+column+") AS week",
+		"COUNT(*)",
+	).
+		From("pull_request pr").
+		Where(where).
+		GroupBy("pr.team_id", "week")
+
+	countPerWeekStr, args, err := countPerWeek.ToSql()
+	if err != nil {
+		logger.Error("build SQL (count PRs per week)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing count PRs per week SQL",
+		zap.String("query", countPerWeekStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := db.Query(ctx, countPerWeekStr, args...)
+	if err != nil {
+		logger.Error("count PRs per week query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int64]map[time.Time]int)
+	for rows.Next() {
+		var teamID int64
+		var week time.Time
+		var count int
+		if err = rows.Scan(&teamID, &week, &count); err != nil {
+			logger.Error("scan PRs per week row", zap.Error(err))
+			return nil, err
+		}
+		if counts[teamID] == nil {
+			counts[teamID] = make(map[time.Time]int)
+		}
+		counts[teamID][week] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func (p *postgresRepo) fillTimeToMerge(
+	ctx context.Context,
+	db querier,
+	logger *zap.Logger,
+	teamIDs []int64,
+	since time.Time,
+	statsByTeam map[int64]*models.TeamStats,
+) error {
+	getTimeToMerge := p.queryBuilder.Select(
+		"pr.team_id",
+		"percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at))",
+		"percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at))",
+	).
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.team_id": teamIDs},
+			sq.Eq{"pr.status": models.PRStatusMERGED},
+			sq.GtOrEq{"pr.merged_at": since},
+		}).
+		GroupBy("pr.team_id")
+
+	getTimeToMergeStr, args, err := getTimeToMerge.ToSql()
+	if err != nil {
+		logger.Error("build SQL (time to merge)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing time to merge SQL",
+		zap.String("query", getTimeToMergeStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := db.Query(ctx, getTimeToMergeStr, args...)
+	if err != nil {
+		logger.Error("time to merge query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var teamID int64
+		var p50, p90 float64
+		if err = rows.Scan(&teamID, &p50, &p90); err != nil {
+			logger.Error("scan time to merge row", zap.Error(err))
+			return err
+		}
+		stats := statsByTeam[teamID]
+		stats.TimeToMergeP50 = secondsToDuration(p50)
+		stats.TimeToMergeP90 = secondsToDuration(p90)
+	}
+
+	return rows.Err()
+}
+
+func (p *postgresRepo) fillStaleOpenPRs(
+	ctx context.Context,
+	db querier,
+	logger *zap.Logger,
+	teamIDs []int64,
+	staleBefore time.Time,
+	statsByTeam map[int64]*models.TeamStats,
+) error {
+	getStale := p.queryBuilder.Select("pr.team_id", "COUNT(*)").
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.team_id": teamIDs},
+			sq.Eq{"pr.status": models.PRStatusOPEN},
+			sq.Lt{"pr.created_at": staleBefore},
+		}).
+		GroupBy("pr.team_id")
+
+	getStaleStr, args, err := getStale.ToSql()
+	if err != nil {
+		logger.Error("build SQL (stale PRs)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing stale PRs SQL",
+		zap.String("query", getStaleStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := db.Query(ctx, getStaleStr, args...)
+	if err != nil {
+		logger.Error("stale PRs query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var teamID int64
+		var count int
+		if err = rows.Scan(&teamID, &count); err != nil {
+			logger.Error("scan stale PRs row", zap.Error(err))
+			return err
+		}
+		statsByTeam[teamID].StaleOpenPRs = count
+	}
+
+	return rows.Err()
+}
+
+func secondsToDuration(seconds float64) *time.Duration {
+	d := time.Duration(seconds * float64(time.Second))
+	return &d
+}
+
+func (p *postgresRepo) TeamPairings(
+	ctx context.Context,
+	teamName string,
+	since time.Time,
+) ([]models.ReviewPairing, error) {
+	logger := p.logger.With(
+		zap.String("team_name", teamName),
+		zap.Time("since", since),
+	)
+
+	getPairings := p.queryBuilder.Select("rp.author_id", "rp.reviewer_id", "COUNT(rp.id)").
+		From("team t").
+		LeftJoin("team_membership m ON m.team_id = t.id").
+		LeftJoin("review_pairing rp ON rp.author_id = m.user_id AND rp.assigned_at >= ?", since).
+		Where(sq.Eq{"t.name": teamName}).
+		Where(orgEq(ctx, "t.organization")).
+		GroupBy("rp.author_id", "rp.reviewer_id").
+		OrderBy("rp.author_id", "rp.reviewer_id")
+
+	getPairingsStr, args, err := getPairings.ToSql()
+	if err != nil {
+		logger.Error("build SQL (team pairings)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing team pairings SQL",
+		zap.String("query", getPairingsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.reader(ctx).Query(ctx, getPairingsStr, args...)
+	if err != nil {
+		logger.Error("team pairings query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	teamFound := false
+	pairings := make([]models.ReviewPairing, 0)
+	for rows.Next() {
+		teamFound = true
+
+		var authorID, reviewerID *string
+		var reviews int
+		if err = rows.Scan(&authorID, &reviewerID, &reviews); err != nil {
+			logger.Error("scan team pairing", zap.Error(err))
+			return nil, err
+		}
+		if authorID != nil {
+			pairings = append(pairings, models.ReviewPairing{
+				AuthorID:   *authorID,
+				ReviewerID: *reviewerID,
+				Reviews:    reviews,
+			})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team pairings", zap.Error(err))
+		return nil, err
+	}
+
+	if !teamFound {
+		logger.Warn("team not found")
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return pairings, nil
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"maps"
+	"slices"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 
@@ -22,6 +26,11 @@ func (p *postgresRepo) TeamAdd(
 		zap.Any("members", team.Members),
 	)
 
+	if len(team.Members) == 0 {
+		logger.Warn("team has no members")
+		return modelsErr.ErrEmptyTeam
+	}
+
 	tx, rollback, err := p.beginTx(ctx)
 	if err != nil {
 		logger.Error("beginTx", zap.Error(err))
@@ -30,8 +39,8 @@ func (p *postgresRepo) TeamAdd(
 	defer rollback(txErr)
 
 	createTeam := p.queryBuilder.Insert("team").
-		Columns("name").
-		Values(team.Name).
+		Columns("organization", "name").
+		Values(models.OrganizationFromContext(ctx), team.Name).
 		Suffix("RETURNING id")
 
 	createTeamStr, args, err := createTeam.ToSql()
@@ -45,7 +54,7 @@ func (p *postgresRepo) TeamAdd(
 		zap.Any("args", args),
 	)
 
-	var teamID int
+	var teamID int64
 	err = tx.QueryRow(ctx, createTeamStr, args...).Scan(&teamID)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -57,44 +66,32 @@ func (p *postgresRepo) TeamAdd(
 		return err
 	}
 
-	createUsers := p.queryBuilder.Insert("users").
-		Columns("id", "name", "is_active", "team_id")
-
-	for _, m := range team.Members {
-		createUsers = createUsers.Values(
-			m.UserID,
-			m.Username,
-			m.IsActive,
-			teamID,
-		)
+	userIDs := make([]string, len(team.Members))
+	for i, m := range team.Members {
+		userIDs[i] = m.UserID
 	}
 
-	createUsers = createUsers.Suffix(`
-		ON CONFLICT (id) DO UPDATE
-		SET name = EXCLUDED.name,
-			is_active = EXCLUDED.is_active,
-			team_id = EXCLUDED.team_id
-	`)
-
-	createUsersStr, args, err := createUsers.ToSql()
-	if err != nil {
-		logger.Error("build SQL (insert users)", zap.Error(err))
+	if err = p.upsertUsers(ctx, tx, team.Members); err != nil {
+		logger.Error("insert users", zap.Error(err))
 		return err
 	}
 
-	logger.Debug("Executing insert users SQL",
-		zap.String("query", createUsersStr),
-		zap.Any("args", args),
-	)
-
-	_, err = tx.Exec(ctx, createUsersStr, args...)
-	if err != nil {
-		logger.Error("insert users", zap.Error(err))
+	if err = p.addMemberships(ctx, tx, teamID, userIDs); err != nil {
+		logger.Error("insert memberships", zap.Error(err))
 		return err
 	}
 
-	if err = tx.Commit(ctx); err != nil {
-		logger.Error("commit", zap.Error(err))
+	audit := []teamAuditRecord{{action: models.TeamAuditTeamCreated, teamID: teamID, teamName: team.Name}}
+	for _, userID := range userIDs {
+		audit = append(audit, teamAuditRecord{
+			action:   models.TeamAuditMemberAdded,
+			teamID:   teamID,
+			teamName: team.Name,
+			userID:   &userID,
+		})
+	}
+	if err = p.recordTeamAudit(ctx, tx, audit); err != nil {
+		logger.Error("record team audit", zap.Error(err))
 		return err
 	}
 
@@ -110,13 +107,19 @@ func (p *postgresRepo) TeamGet(
 	query := p.queryBuilder.Select(
 		"t.id as team_id",
 		"t.name as team_name",
+		"t.version",
 		"u.id as user_id",
 		"u.name as username",
 		"u.is_active",
 	).
 		From("team t").
-		Join("users u ON t.id = u.team_id").
-		Where(sq.Eq{"t.name": teamName})
+		Join("team_membership m ON m.team_id = t.id").
+		Join("users u ON u.id = m.user_id").
+		Where(sq.And{
+			sq.Eq{"t.name": teamName},
+			orgEq(ctx, "t.organization"),
+		}).
+		OrderBy("m.joined_at", "u.id")
 
 	queryStr, args, err := query.ToSql()
 	if err != nil {
@@ -129,7 +132,7 @@ func (p *postgresRepo) TeamGet(
 		zap.Any("args", args),
 	)
 
-	rows, err := p.db.Query(ctx, queryStr, args...)
+	rows, err := p.reader(ctx).Query(ctx, queryStr, args...)
 	if err != nil {
 		logger.Error("get team query", zap.Error(err))
 		return nil, err
@@ -146,6 +149,7 @@ func (p *postgresRepo) TeamGet(
 		err = rows.Scan(
 			&teamID,
 			&team.Name,
+			&team.Version,
 			&member.UserID,
 			&member.Username,
 			&member.IsActive,
@@ -165,14 +169,59 @@ func (p *postgresRepo) GetActiveTeammates(
 	ctx context.Context,
 	teamID string,
 	excludedUsers []string,
-	count uint64,
-) (teammateIDs []string, txErr error) {
+	now time.Time,
+) ([]models.Candidate, error) {
 	logger := p.logger.With(
 		zap.String("team_id", teamID),
 		zap.Any("excluded_users", excludedUsers),
-		zap.Uint64("count", count),
+		zap.Time("now", now),
 	)
 
+	return p.getActiveCandidates(ctx, logger, sq.Expr(
+		"EXISTS (SELECT 1 FROM team_membership m WHERE m.user_id = u.id AND m.team_id = ?)",
+		teamID,
+	), excludedUsers, now)
+}
+
+func (p *postgresRepo) GetActiveCodeOwners(
+	ctx context.Context,
+	owners []models.CodeOwner,
+	excludedUsers []string,
+	now time.Time,
+) ([]models.Candidate, error) {
+	logger := p.logger.With(
+		zap.Any("owners", owners),
+		zap.Any("excluded_users", excludedUsers),
+		zap.Time("now", now),
+	)
+
+	var userIDs, teamNames []string
+	for _, owner := range owners {
+		switch owner.Kind {
+		case models.CodeOwnerUser:
+			userIDs = append(userIDs, owner.Name)
+		case models.CodeOwnerTeam:
+			teamNames = append(teamNames, owner.Name)
+		}
+	}
+
+	return p.getActiveCandidates(ctx, logger, sq.Or{
+		sq.Eq{"u.id": userIDs},
+		sq.Expr(`EXISTS (
+			SELECT 1 FROM team_membership m
+			JOIN team t ON t.id = m.team_id
+			WHERE m.user_id = u.id AND t.name = ANY(?)
+		)`, teamNames),
+	}, excludedUsers, now)
+}
+
+func (p *postgresRepo) getActiveCandidates(
+	ctx context.Context,
+	logger *zap.Logger,
+	condition sq.Sqlizer,
+	excludedUsers []string,
+	now time.Time,
+) (candidates []models.Candidate, txErr error) {
 	tx, rollback, err := p.beginTx(ctx)
 	if err != nil {
 		logger.Error("beginTx", zap.Error(err))
@@ -180,83 +229,316 @@ func (p *postgresRepo) GetActiveTeammates(
 	}
 	defer rollback(txErr)
 
-	getTeammate := p.queryBuilder.Select("id").
-		From("users").
+	// Участнику нескольких команд без личного лимита достаётся самый строгий из лимитов его команд.
+	getCandidates := p.queryBuilder.Select(
+		"u.id",
+		"u.time_zone",
+		"u.work_start_minute",
+		"u.work_end_minute",
+		`COALESCE(u.max_open_reviews, (
+			SELECT MIN(t.default_max_open_reviews)
+			FROM team_membership m
+			JOIN team t ON t.id = m.team_id
+			WHERE m.user_id = u.id
+		))`,
+	).
+		From("users u").
 		Where(
 			sq.And{
-				sq.Eq{"team_id": teamID},
-				sq.Eq{"is_active": true},
-				sq.NotEq{"id": excludedUsers},
+				condition,
+				orgEq(ctx, "u.organization"),
+				sq.Eq{"u.is_active": true},
+				sq.NotEq{"u.id": excludedUsers},
+				sq.Expr(`NOT EXISTS (
+					SELECT 1 FROM out_of_office o
+					WHERE o.user_id = u.id
+						AND o.starts_at <= ?
+						AND o.ends_at > ?
+				)`, now, now),
 			},
 		).
-		Limit(count).
-		Suffix("FOR UPDATE")
+		OrderBy("u.id").
+		Suffix("FOR UPDATE OF u")
 
-	getTeammateStr, args, err := getTeammate.ToSql()
+	getCandidatesStr, args, err := getCandidates.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get active candidates)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get active candidates SQL",
+		zap.String("query", getCandidatesStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := tx.Query(ctx, getCandidatesStr, args...)
+	if err != nil {
+		logger.Error("get active candidates query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	maxOpenReviews := make(map[string]int)
+	for rows.Next() {
+		var candidate models.Candidate
+		var workStart, workEnd, maxOpen *int
+		if err = rows.Scan(&candidate.UserID, &candidate.TimeZone, &workStart, &workEnd, &maxOpen); err != nil {
+			logger.Error("scan candidate", zap.Error(err))
+			return nil, err
+		}
+		candidate.WorkingHours = toWorkingHours(workStart, workEnd)
+		if maxOpen != nil {
+			maxOpenReviews[candidate.UserID] = *maxOpen
+		}
+		candidates = append(candidates, candidate)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate candidates", zap.Error(err))
+		return nil, err
+	}
+	rows.Close()
+
+	if len(maxOpenReviews) == 0 {
+		return candidates, nil
+	}
+
+	// Счётчики читаются отдельным запросом уже после FOR UPDATE, чтобы увидеть
+	// назначения транзакций, которые держали блокировку на этих кандидатах.
+	openReviews, err := p.countOpenReviews(ctx, tx, slices.Collect(maps.Keys(maxOpenReviews)))
+	if err != nil {
+		logger.Error("count open reviews", zap.Error(err))
+		return nil, err
+	}
+
+	return slices.DeleteFunc(candidates, func(candidate models.Candidate) bool {
+		maxOpen, capped := maxOpenReviews[candidate.UserID]
+		return capped && openReviews[candidate.UserID] >= maxOpen
+	}), nil
+}
+
+func (p *postgresRepo) countOpenReviews(
+	ctx context.Context,
+	tx pgx.Tx,
+	userIDs []string,
+) (map[string]int, error) {
+	countOpen := p.queryBuilder.Select("ar.user_id", "COUNT(*)").
+		From("assigned_reviewer ar").
+		Join("pull_request pr ON pr.id = ar.pr_id").
+		Where(sq.And{
+			sq.Eq{"ar.user_id": userIDs},
+			sq.Eq{"pr.status": models.PRStatusOPEN},
+		}).
+		GroupBy("ar.user_id")
+
+	countOpenStr, args, err := countOpen.ToSql()
 	if err != nil {
-		logger.Error("build SQL (get active teammates)", zap.Error(err))
 		return nil, err
 	}
 
-	logger.Debug("Executing get teammates SQL",
-		zap.String("query", getTeammateStr),
+	p.logger.Debug("Executing count open reviews SQL",
+		zap.String("query", countOpenStr),
 		zap.Any("args", args),
 	)
 
-	rows, err := tx.Query(ctx, getTeammateStr, args...)
+	rows, err := tx.Query(ctx, countOpenStr, args...)
 	if err != nil {
-		logger.Error("get teammates query", zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
 
+	openReviews := make(map[string]int, len(userIDs))
 	for rows.Next() {
 		var userID string
-		if err = rows.Scan(&userID); err != nil {
-			logger.Error("scan teammate ID", zap.Error(err))
+		var count int
+		if err = rows.Scan(&userID, &count); err != nil {
 			return nil, err
 		}
-		teammateIDs = append(teammateIDs, userID)
+		openReviews[userID] = count
 	}
 
-	return teammateIDs, nil
+	return openReviews, rows.Err()
 }
 
-func (p *postgresRepo) GetTeamIDByUserID(
+func (p *postgresRepo) GetUserTeams(
 	ctx context.Context,
 	userID string,
-) (teamID string, err error) {
+) ([]models.UserTeam, error) {
 	logger := p.logger.With(zap.String("user_id", userID))
 
-	getTeamID := p.queryBuilder.Select("team_id").
-		From("users").
-		Where(sq.Eq{"id": userID})
+	getTeams := p.queryBuilder.Select("t.id::text", "t.name").
+		From("users u").
+		LeftJoin("team_membership m ON m.user_id = u.id").
+		LeftJoin("team t ON t.id = m.team_id").
+		Where(sq.And{
+			sq.Eq{"u.id": userID},
+			orgEq(ctx, "u.organization"),
+		}).
+		OrderBy("m.joined_at", "t.id")
+
+	getTeamsStr, args, err := getTeams.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get user teams)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get user teams SQL",
+		zap.String("query", getTeamsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.conn(ctx).Query(ctx, getTeamsStr, args...)
+	if err != nil {
+		logger.Error("get user teams query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	userFound := false
+	teams := make([]models.UserTeam, 0)
+	for rows.Next() {
+		userFound = true
+
+		var teamID, teamName *string
+		if err = rows.Scan(&teamID, &teamName); err != nil {
+			logger.Error("scan user team", zap.Error(err))
+			return nil, err
+		}
+		if teamID != nil {
+			teams = append(teams, models.UserTeam{ID: *teamID, Name: *teamName})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate user teams", zap.Error(err))
+		return nil, err
+	}
+
+	if !userFound {
+		logger.Warn("user not found")
+		return nil, modelsErr.ErrUserNotFound
+	}
+
+	return teams, nil
+}
+
+func (p *postgresRepo) SetTeamPolicy(
+	ctx context.Context,
+	policy models.TeamPolicy,
+) (*models.TeamPolicy, error) {
+	logger := p.logger.With(
+		zap.String("team_name", policy.TeamName),
+		zap.Any("review_sla", policy.ReviewSLA),
+		zap.String("escalation_policy", string(policy.EscalationPolicy)),
+		zap.Any("default_max_open_reviews", policy.DefaultMaxOpenReviews),
+		zap.String("shortfall_policy", string(policy.ShortfallPolicy)),
+		zap.String("selection_mode", string(policy.SelectionMode)),
+		zap.Duration("rotation_window", policy.RotationWindow),
+	)
+
+	var reviewSLASeconds *int64
+	if policy.ReviewSLA != nil {
+		seconds := int64(policy.ReviewSLA.Seconds())
+		reviewSLASeconds = &seconds
+	}
 
-	getTeamIDStr, args, err := getTeamID.ToSql()
+	setPolicy := p.queryBuilder.Update("team").
+		Set("review_sla_seconds", reviewSLASeconds).
+		Set("escalation_policy", policy.EscalationPolicy).
+		Set("default_max_open_reviews", policy.DefaultMaxOpenReviews).
+		Set("shortfall_policy", policy.ShortfallPolicy).
+		Set("selection_mode", policy.SelectionMode).
+		Set("rotation_window_seconds", int64(policy.RotationWindow.Seconds())).
+		Where(sq.And{
+			sq.Eq{"name": policy.TeamName},
+			orgEq(ctx, "organization"),
+		}).
+		Suffix("RETURNING " + teamPolicyColumns)
+
+	setPolicyStr, args, err := setPolicy.ToSql()
 	if err != nil {
-		logger.Error("build SQL (get team ID)", zap.Error(err))
-		return "", err
+		logger.Error("build SQL (set team policy)", zap.Error(err))
+		return nil, err
 	}
 
-	logger.Debug("Executing get team ID SQL",
-		zap.String("query", getTeamIDStr),
+	logger.Debug("Executing set team policy SQL",
+		zap.String("query", setPolicyStr),
 		zap.Any("args", args),
 	)
 
-	err = p.db.QueryRow(ctx, getTeamIDStr, args...).Scan(&teamID)
+	dbPolicy, err := scanTeamPolicy(p.db.QueryRow(ctx, setPolicyStr, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			logger.Warn("user not found")
-			return "", modelsErr.ErrUserNotFound
+			logger.Warn("team not found")
+			return nil, modelsErr.ErrTeamNotFound
 		}
-		logger.Error("get team ID query", zap.Error(err))
-		return "", err
+		logger.Error("set team policy query", zap.Error(err))
+		return nil, err
+	}
+
+	return dbPolicy, nil
+}
+
+func (p *postgresRepo) GetTeamPolicy(
+	ctx context.Context,
+	teamID string,
+) (*models.TeamPolicy, error) {
+	logger := p.logger.With(zap.String("team_id", teamID))
+
+	getPolicy := p.queryBuilder.Select(teamPolicyColumns).
+		From("team").
+		Where(sq.And{
+			sq.Eq{"id": teamID},
+			orgEq(ctx, "organization"),
+		})
+
+	getPolicyStr, args, err := getPolicy.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get team policy)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing get team policy SQL",
+		zap.String("query", getPolicyStr),
+		zap.Any("args", args),
+	)
+
+	policy, err := scanTeamPolicy(p.conn(ctx).QueryRow(ctx, getPolicyStr, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return nil, modelsErr.ErrTeamNotFound
+		}
+		logger.Error("get team policy query", zap.Error(err))
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+const teamPolicyColumns = "name, review_sla_seconds, escalation_policy, default_max_open_reviews, shortfall_policy, " +
+	"selection_mode, rotation_window_seconds"
+
+func scanTeamPolicy(row pgx.Row) (*models.TeamPolicy, error) {
+	var policy models.TeamPolicy
+	var reviewSLASeconds *int64
+	var rotationWindowSeconds int64
+	err := row.Scan(
+		&policy.TeamName,
+		&reviewSLASeconds,
+		&policy.EscalationPolicy,
+		&policy.DefaultMaxOpenReviews,
+		&policy.ShortfallPolicy,
+		&policy.SelectionMode,
+		&rotationWindowSeconds,
+	)
+	if err != nil {
+		return nil, err
 	}
+	policy.RotationWindow = time.Duration(rotationWindowSeconds) * time.Second
 
-	if teamID == "" {
-		logger.Error("team not found")
-		return "", modelsErr.ErrTeamNotFound
+	if reviewSLASeconds != nil {
+		reviewSLA := time.Duration(*reviewSLASeconds) * time.Second
+		policy.ReviewSLA = &reviewSLA
 	}
 
-	return teamID, nil
+	return &policy, nil
 }
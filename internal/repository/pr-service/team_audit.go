@@ -0,0 +1,136 @@
+package pr_service
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+type teamAuditRecord struct {
+	userID       *string
+	fromTeamID   *int64
+	fromTeamName *string
+	action       models.TeamAuditAction
+	teamName     string
+	teamID       int64
+}
+
+func (p *postgresRepo) recordTeamAudit(
+	ctx context.Context,
+	tx pgx.Tx,
+	records []teamAuditRecord,
+) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	insertAudit := p.queryBuilder.Insert("team_audit").
+		Columns("action", "team_id", "team_name", "user_id", "from_team_id", "from_team_name")
+	for _, record := range records {
+		insertAudit = insertAudit.Values(
+			record.action,
+			record.teamID,
+			record.teamName,
+			record.userID,
+			record.fromTeamID,
+			record.fromTeamName,
+		)
+	}
+
+	insertAuditStr, args, err := insertAudit.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing record team audit SQL",
+		zap.String("query", insertAuditStr),
+		zap.Any("args", args),
+	)
+
+	_, err = tx.Exec(ctx, insertAuditStr, args...)
+	return err
+}
+
+func (p *postgresRepo) TeamAudit(
+	ctx context.Context,
+	teamName string,
+) ([]models.TeamAuditEntry, error) {
+	logger := p.logger.With(zap.String("team_name", teamName))
+
+	getAudit := p.queryBuilder.Select(
+		"a.action",
+		"a.team_name",
+		"a.user_id",
+		"a.from_team_name",
+		"a.created_at",
+	).
+		From("team t").
+		LeftJoin("team_audit a ON a.team_id = t.id OR a.from_team_id = t.id").
+		Where(sq.And{
+			sq.Eq{"t.name": teamName},
+			orgEq(ctx, "t.organization"),
+		}).
+		OrderBy("a.id")
+
+	getAuditStr, args, err := getAudit.ToSql()
+	if err != nil {
+		logger.Error("build SQL (team audit)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing team audit SQL",
+		zap.String("query", getAuditStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.reader(ctx).Query(ctx, getAuditStr, args...)
+	if err != nil {
+		logger.Error("team audit query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	teamFound := false
+	entries := make([]models.TeamAuditEntry, 0)
+	for rows.Next() {
+		teamFound = true
+
+		var action, entryTeamName *string
+		var createdAt *time.Time
+		var entry models.TeamAuditEntry
+		if err = rows.Scan(
+			&action,
+			&entryTeamName,
+			&entry.UserID,
+			&entry.PreviousTeamName,
+			&createdAt,
+		); err != nil {
+			logger.Error("scan team audit entry", zap.Error(err))
+			return nil, err
+		}
+		if action == nil {
+			continue
+		}
+		entry.Action = models.TeamAuditAction(*action)
+		entry.TeamName = *entryTeamName
+		entry.CreatedAt = *createdAt
+		entries = append(entries, entry)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team audit", zap.Error(err))
+		return nil, err
+	}
+
+	if !teamFound {
+		logger.Warn("team not found")
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,674 @@
+package pr_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+type memberTeam struct {
+	teamName string
+	teamID   int64
+}
+
+func (p *postgresRepo) TeamUpdateMembers(
+	ctx context.Context,
+	change models.TeamMembersChange,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("team_name", change.TeamName),
+		zap.Any("add", change.Add),
+		zap.Strings("remove", change.Remove),
+		zap.Bool("move", change.Move),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	teamID, err := p.lockTeam(ctx, tx, change.TeamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return modelsErr.ErrTeamNotFound
+		}
+		logger.Error("lock team", zap.Error(err))
+		return err
+	}
+
+	var audit []teamAuditRecord
+	if len(change.Add) > 0 {
+		audit, err = p.addMembers(ctx, tx, teamID, change)
+		if err != nil {
+			logger.Error("add members", zap.Error(err))
+			return err
+		}
+	}
+
+	if len(change.Remove) > 0 {
+		removeMembers := p.queryBuilder.Delete("team_membership").
+			Where(sq.Eq{"team_id": teamID, "user_id": change.Remove}).
+			Suffix("RETURNING user_id")
+
+		removeMembersStr, args, err := removeMembers.ToSql()
+		if err != nil {
+			logger.Error("build SQL (remove members)", zap.Error(err))
+			return err
+		}
+
+		logger.Debug("Executing remove members SQL",
+			zap.String("query", removeMembersStr),
+			zap.Any("args", args),
+		)
+
+		rows, err := tx.Query(ctx, removeMembersStr, args...)
+		if err != nil {
+			logger.Error("remove members query", zap.Error(err))
+			return err
+		}
+		removed, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			logger.Error("scan removed members", zap.Error(err))
+			return err
+		}
+
+		for _, userID := range change.Remove {
+			if !slices.Contains(removed, userID) {
+				logger.Warn("user is not a team member", zap.String("user_id", userID))
+				return fmt.Errorf("%w: %s", modelsErr.ErrUserNotInTeam, userID)
+			}
+			audit = append(audit, teamAuditRecord{
+				action:   models.TeamAuditMemberRemoved,
+				teamID:   teamID,
+				teamName: change.TeamName,
+				userID:   &userID,
+			})
+		}
+	}
+
+	countMembers := p.queryBuilder.Select("COUNT(*)").
+		From("team_membership").
+		Where(sq.Eq{"team_id": teamID})
+
+	countMembersStr, args, err := countMembers.ToSql()
+	if err != nil {
+		logger.Error("build SQL (count members)", zap.Error(err))
+		return err
+	}
+
+	var members int
+	if err = tx.QueryRow(ctx, countMembersStr, args...).Scan(&members); err != nil {
+		logger.Error("count members query", zap.Error(err))
+		return err
+	}
+	if members == 0 {
+		logger.Warn("team would be left without members")
+		return modelsErr.ErrEmptyTeam
+	}
+
+	if err = p.bumpTeamVersions(ctx, tx, changedTeamIDs(teamID, audit)); err != nil {
+		logger.Error("bump team versions", zap.Error(err))
+		return err
+	}
+
+	if err = p.recordTeamAudit(ctx, tx, audit); err != nil {
+		logger.Error("record team audit", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// версия меняется и у команд, из которых участники ушли при переносе
+func changedTeamIDs(teamID int64, audit []teamAuditRecord) []int64 {
+	teamIDs := []int64{teamID}
+	for _, record := range audit {
+		if record.fromTeamID != nil && !slices.Contains(teamIDs, *record.fromTeamID) {
+			teamIDs = append(teamIDs, *record.fromTeamID)
+		}
+	}
+	return teamIDs
+}
+
+func (p *postgresRepo) addMembers(
+	ctx context.Context,
+	tx pgx.Tx,
+	teamID int64,
+	change models.TeamMembersChange,
+) ([]teamAuditRecord, error) {
+	userIDs := make([]string, len(change.Add))
+	for i, m := range change.Add {
+		userIDs[i] = m.UserID
+	}
+
+	currentTeams, err := p.getMemberTeams(ctx, tx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var audit []teamAuditRecord
+	var movedUsers []string
+	for _, userID := range userIDs {
+		var member bool
+		var otherTeams []memberTeam
+		for _, current := range currentTeams[userID] {
+			if current.teamID == teamID {
+				member = true
+			} else {
+				otherTeams = append(otherTeams, current)
+			}
+		}
+
+		if change.Move && len(otherTeams) > 0 {
+			movedUsers = append(movedUsers, userID)
+			for _, from := range otherTeams {
+				audit = append(audit, teamAuditRecord{
+					action:       models.TeamAuditMemberMoved,
+					teamID:       teamID,
+					teamName:     change.TeamName,
+					userID:       &userID,
+					fromTeamID:   &from.teamID,
+					fromTeamName: &from.teamName,
+				})
+			}
+			continue
+		}
+
+		if !member {
+			audit = append(audit, teamAuditRecord{
+				action:   models.TeamAuditMemberAdded,
+				teamID:   teamID,
+				teamName: change.TeamName,
+				userID:   &userID,
+			})
+		}
+	}
+
+	if err = p.upsertUsers(ctx, tx, change.Add); err != nil {
+		return nil, err
+	}
+
+	if err = p.addMemberships(ctx, tx, teamID, userIDs); err != nil {
+		return nil, err
+	}
+
+	if len(movedUsers) > 0 {
+		leaveTeams := p.queryBuilder.Delete("team_membership").
+			Where(sq.And{
+				sq.Eq{"user_id": movedUsers},
+				sq.NotEq{"team_id": teamID},
+			})
+
+		leaveTeamsStr, args, err := leaveTeams.ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		p.logger.Debug("Executing leave other teams SQL",
+			zap.String("query", leaveTeamsStr),
+			zap.Any("args", args),
+		)
+
+		if _, err = tx.Exec(ctx, leaveTeamsStr, args...); err != nil {
+			return nil, err
+		}
+	}
+
+	return audit, nil
+}
+
+func (p *postgresRepo) upsertUsers(
+	ctx context.Context,
+	tx pgx.Tx,
+	members []models.Member,
+) error {
+	organization := models.OrganizationFromContext(ctx)
+	upsertUsers := p.queryBuilder.Insert("users").
+		Columns("organization", "id", "name", "is_active")
+	for _, m := range members {
+		upsertUsers = upsertUsers.Values(organization, m.UserID, m.Username, m.IsActive)
+	}
+	// пользователь другой организации не обновляется и не попадает в RETURNING
+	upsertUsers = upsertUsers.Suffix(`
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name,
+			is_active = EXCLUDED.is_active
+		WHERE users.organization = EXCLUDED.organization
+		RETURNING id
+	`)
+
+	upsertUsersStr, args, err := upsertUsers.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing upsert users SQL",
+		zap.String("query", upsertUsersStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := tx.Query(ctx, upsertUsersStr, args...)
+	if err != nil {
+		return err
+	}
+	upserted, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		if !slices.Contains(upserted, m.UserID) {
+			return fmt.Errorf("%w: %s", modelsErr.ErrUserIDTaken, m.UserID)
+		}
+	}
+	return nil
+}
+
+func (p *postgresRepo) addMemberships(
+	ctx context.Context,
+	tx pgx.Tx,
+	teamID int64,
+	userIDs []string,
+) error {
+	insertMemberships := p.queryBuilder.Insert("team_membership").
+		Columns("team_id", "user_id")
+	for _, userID := range userIDs {
+		insertMemberships = insertMemberships.Values(teamID, userID)
+	}
+	insertMemberships = insertMemberships.Suffix("ON CONFLICT DO NOTHING")
+
+	insertMembershipsStr, args, err := insertMemberships.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing insert memberships SQL",
+		zap.String("query", insertMembershipsStr),
+		zap.Any("args", args),
+	)
+
+	_, err = tx.Exec(ctx, insertMembershipsStr, args...)
+	return err
+}
+
+func (p *postgresRepo) TeamRename(
+	ctx context.Context,
+	teamName, newTeamName string,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("team_name", teamName),
+		zap.String("new_team_name", newTeamName),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	renameTeam := p.queryBuilder.Update("team").
+		Set("name", newTeamName).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.And{
+			sq.Eq{"name": teamName},
+			orgEq(ctx, "organization"),
+		}).
+		Suffix("RETURNING id")
+
+	renameTeamStr, args, err := renameTeam.ToSql()
+	if err != nil {
+		logger.Error("build SQL (rename team)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing rename team SQL",
+		zap.String("query", renameTeamStr),
+		zap.Any("args", args),
+	)
+
+	var teamID int64
+	if err = tx.QueryRow(ctx, renameTeamStr, args...).Scan(&teamID); err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			logger.Warn("team not found")
+			return modelsErr.ErrTeamNotFound
+		case errors.As(err, &pgErr) && pgErr.Code == uniqueKeyViolationCode:
+			logger.Warn("team already exists", zap.Error(err))
+			return modelsErr.ErrTeamExist
+		}
+		logger.Error("rename team query", zap.Error(err))
+		return err
+	}
+
+	err = p.recordTeamAudit(ctx, tx, []teamAuditRecord{{
+		action:       models.TeamAuditTeamRenamed,
+		teamID:       teamID,
+		teamName:     newTeamName,
+		fromTeamName: &teamName,
+	}})
+	if err != nil {
+		logger.Error("record team audit", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) TeamDelete(
+	ctx context.Context,
+	teamName string,
+	openPRPolicy models.OpenPRPolicy,
+) (deletion *models.TeamDeletion, txErr error) {
+	logger := p.logger.With(
+		zap.String("team_name", teamName),
+		zap.String("open_pr_policy", string(openPRPolicy)),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return nil, err
+	}
+	defer rollback(txErr)
+
+	teamID, err := p.lockTeam(ctx, tx, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return nil, modelsErr.ErrTeamNotFound
+		}
+		logger.Error("lock team", zap.Error(err))
+		return nil, err
+	}
+
+	releaseMembers := p.queryBuilder.Delete("team_membership").
+		Where(sq.Eq{"team_id": teamID}).
+		Suffix("RETURNING user_id")
+
+	releaseMembersStr, args, err := releaseMembers.ToSql()
+	if err != nil {
+		logger.Error("build SQL (release members)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing release members SQL",
+		zap.String("query", releaseMembersStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := tx.Query(ctx, releaseMembersStr, args...)
+	if err != nil {
+		logger.Error("release members query", zap.Error(err))
+		return nil, err
+	}
+	members, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		logger.Error("scan released members", zap.Error(err))
+		return nil, err
+	}
+
+	deletion = &models.TeamDeletion{
+		TeamName:        teamName,
+		ReleasedMembers: members,
+	}
+
+	switch openPRPolicy {
+	case models.OpenPRPolicyUnassign:
+		unassign := p.queryBuilder.Delete("assigned_reviewer").
+			Where(sq.Expr(
+				"pr_id IN (SELECT id FROM pull_request WHERE team_id = ? AND status = ?)",
+				teamID, models.PRStatusOPEN,
+			))
+
+		unassignStr, args, err := unassign.ToSql()
+		if err != nil {
+			logger.Error("build SQL (unassign reviews)", zap.Error(err))
+			return nil, err
+		}
+
+		logger.Debug("Executing unassign reviews SQL",
+			zap.String("query", unassignStr),
+			zap.Any("args", args),
+		)
+
+		tag, err := tx.Exec(ctx, unassignStr, args...)
+		if err != nil {
+			logger.Error("unassign reviews", zap.Error(err))
+			return nil, err
+		}
+		deletion.UnassignedReviews = int(tag.RowsAffected())
+
+		bumpVersions := p.queryBuilder.Update("pull_request").
+			Set("version", sq.Expr("version + 1")).
+			Where(sq.Eq{"team_id": teamID, "status": models.PRStatusOPEN})
+
+		bumpVersionsStr, args, err := bumpVersions.ToSql()
+		if err != nil {
+			logger.Error("build SQL (bump PR versions)", zap.Error(err))
+			return nil, err
+		}
+
+		logger.Debug("Executing bump PR versions SQL",
+			zap.String("query", bumpVersionsStr),
+			zap.Any("args", args),
+		)
+
+		if _, err = tx.Exec(ctx, bumpVersionsStr, args...); err != nil {
+			logger.Error("bump PR versions", zap.Error(err))
+			return nil, err
+		}
+
+	default:
+		countOpen := p.queryBuilder.Select("COUNT(*)").
+			From("pull_request").
+			Where(sq.Eq{"team_id": teamID, "status": models.PRStatusOPEN})
+
+		countOpenStr, args, err := countOpen.ToSql()
+		if err != nil {
+			logger.Error("build SQL (count open PRs)", zap.Error(err))
+			return nil, err
+		}
+
+		logger.Debug("Executing count open PRs SQL",
+			zap.String("query", countOpenStr),
+			zap.Any("args", args),
+		)
+
+		var openPRs int
+		if err = tx.QueryRow(ctx, countOpenStr, args...).Scan(&openPRs); err != nil {
+			logger.Error("count open PRs query", zap.Error(err))
+			return nil, err
+		}
+		if openPRs > 0 {
+			logger.Warn("team has open PRs", zap.Int("open_prs", openPRs))
+			return nil, modelsErr.ErrTeamHasOpenPRs
+		}
+	}
+
+	deleteTeam := p.queryBuilder.Delete("team").
+		Where(sq.Eq{"id": teamID})
+
+	deleteTeamStr, args, err := deleteTeam.ToSql()
+	if err != nil {
+		logger.Error("build SQL (delete team)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing delete team SQL",
+		zap.String("query", deleteTeamStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, deleteTeamStr, args...); err != nil {
+		logger.Error("delete team", zap.Error(err))
+		return nil, err
+	}
+
+	audit := []teamAuditRecord{{action: models.TeamAuditTeamDeleted, teamID: teamID, teamName: teamName}}
+	for _, userID := range members {
+		audit = append(audit, teamAuditRecord{
+			action:   models.TeamAuditMemberRemoved,
+			teamID:   teamID,
+			teamName: teamName,
+			userID:   &userID,
+		})
+	}
+	if err = p.recordTeamAudit(ctx, tx, audit); err != nil {
+		logger.Error("record team audit", zap.Error(err))
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+func (p *postgresRepo) GetTeamVersion(
+	ctx context.Context,
+	teamName string,
+) (_ int64, txErr error) {
+	logger := p.logger.With(zap.String("team_name", teamName))
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return 0, err
+	}
+	defer rollback(txErr)
+
+	getVersion := p.queryBuilder.Select("version").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"name": teamName},
+			orgEq(ctx, "organization"),
+		}).
+		Suffix("FOR UPDATE")
+
+	getVersionStr, args, err := getVersion.ToSql()
+	if err != nil {
+		logger.Error("build SQL (get team version)", zap.Error(err))
+		return 0, err
+	}
+
+	logger.Debug("Executing get team version SQL",
+		zap.String("query", getVersionStr),
+		zap.Any("args", args),
+	)
+
+	var version int64
+	if err = tx.QueryRow(ctx, getVersionStr, args...).Scan(&version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return 0, modelsErr.ErrTeamNotFound
+		}
+		logger.Error("get team version query", zap.Error(err))
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (p *postgresRepo) bumpTeamVersions(
+	ctx context.Context,
+	tx pgx.Tx,
+	teamIDs []int64,
+) error {
+	bumpVersions := p.queryBuilder.Update("team").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": teamIDs})
+
+	bumpVersionsStr, args, err := bumpVersions.ToSql()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Debug("Executing bump team versions SQL",
+		zap.String("query", bumpVersionsStr),
+		zap.Any("args", args),
+	)
+
+	_, err = tx.Exec(ctx, bumpVersionsStr, args...)
+	return err
+}
+
+func (p *postgresRepo) lockTeam(
+	ctx context.Context,
+	tx pgx.Tx,
+	teamName string,
+) (int64, error) {
+	lockTeam := p.queryBuilder.Select("id").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"name": teamName},
+			orgEq(ctx, "organization"),
+		}).
+		Suffix("FOR UPDATE")
+
+	lockTeamStr, args, err := lockTeam.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	p.logger.Debug("Executing lock team SQL",
+		zap.String("query", lockTeamStr),
+		zap.Any("args", args),
+	)
+
+	var teamID int64
+	err = tx.QueryRow(ctx, lockTeamStr, args...).Scan(&teamID)
+	return teamID, err
+}
+
+func (p *postgresRepo) getMemberTeams(
+	ctx context.Context,
+	tx pgx.Tx,
+	userIDs []string,
+) (map[string][]memberTeam, error) {
+	getTeams := p.queryBuilder.Select("m.user_id", "m.team_id", "t.name").
+		From("team_membership m").
+		Join("team t ON t.id = m.team_id").
+		Where(sq.Eq{"m.user_id": userIDs}).
+		OrderBy("m.joined_at", "m.team_id").
+		Suffix("FOR UPDATE OF m")
+
+	getTeamsStr, args, err := getTeams.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Debug("Executing get member teams SQL",
+		zap.String("query", getTeamsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := tx.Query(ctx, getTeamsStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make(map[string][]memberTeam, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var team memberTeam
+		if err = rows.Scan(&userID, &team.teamID, &team.teamName); err != nil {
+			return nil, err
+		}
+		teams[userID] = append(teams[userID], team)
+	}
+
+	return teams, rows.Err()
+}
@@ -2,24 +2,39 @@ package pr_service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+const (
+	defaultTxMaxRetries = 3
+	txRetryBaseDelay    = 10 * time.Millisecond
+	txRetryMaxDelay     = 500 * time.Millisecond
 )
 
 type transactor struct {
-	db     *pgxpool.Pool
-	logger *zap.Logger
+	db      *pgxpool.Pool
+	logger  *zap.Logger
+	retries *prometheus.CounterVec
 }
 
-func NewTransactor(db *pgxpool.Pool, logger *zap.Logger) *transactor {
+func NewTransactor(db *pgxpool.Pool, logger *zap.Logger, retries *prometheus.CounterVec) *transactor {
 	return &transactor{
-		db:     db,
-		logger: logger,
+		db:      db,
+		logger:  logger,
+		retries: retries,
 	}
 }
 
@@ -31,49 +46,98 @@ var ErrTxNotFound = status.Error(codes.NotFound,
 func (t transactor) WithTx(
 	ctx context.Context,
 	function func(ctx context.Context) error,
-) (txErr error) {
-	ctxWithTx, tx, err := injectTx(ctx, t.db)
-	if err != nil {
-		return fmt.Errorf("can not inject transaction, error: %w", err)
+	opts ...models.TxOption,
+) error {
+	// вложенный вызов работает в уже открытой транзакции, повторяет её внешний WithTx
+	if _, err := extractTx(ctx); err == nil {
+		if err = function(ctx); err != nil {
+			return fmt.Errorf("function execution error: %w", err)
+		}
+		return nil
 	}
 
-	defer func() {
-		if txErr != nil {
-			err = tx.Rollback(ctxWithTx)
-			if err != nil {
-				t.logger.Error("failed to rollback transaction", zap.Error(err))
-			}
-			return
+	options := models.NewTxOptions(opts...)
+	maxRetries := defaultTxMaxRetries
+	if options.MaxRetries != nil {
+		maxRetries = *options.MaxRetries
+	}
+	txOptions := pgx.TxOptions{IsoLevel: pgx.TxIsoLevel(options.IsolationLevel)}
+	if options.ReadOnly {
+		txOptions.AccessMode = pgx.ReadOnly
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := t.runTx(ctx, txOptions, function)
+		code, retryable := retryableCode(err)
+		if !retryable {
+			return err
+		}
+		if attempt >= maxRetries {
+			t.retries.WithLabelValues(code, "exhausted").Inc()
+			return err
 		}
 
-		err = tx.Commit(ctxWithTx)
-		if err != nil {
-			t.logger.Error("failed to commit transaction", zap.Error(err))
+		t.retries.WithLabelValues(code, "retried").Inc()
+		t.logger.Warn("retrying transaction",
+			zap.Int("attempt", attempt+1),
+			zap.String("sqlstate", code),
+			zap.Error(err),
+		)
+
+		timer := time.NewTimer(retryDelay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
 		}
-	}()
+	}
+}
 
-	err = function(ctxWithTx)
+func (t transactor) runTx(
+	ctx context.Context,
+	txOptions pgx.TxOptions,
+	function func(ctx context.Context) error,
+) error {
+	tx, err := t.db.BeginTx(ctx, txOptions)
+	if err != nil {
+		return fmt.Errorf("can not begin transaction, error: %w", err)
+	}
+
+	err = function(context.WithValue(ctx, txInjector{}, tx))
 	if err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			t.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+		}
 		return fmt.Errorf("function execution error: %w", err)
 	}
 
+	// при SERIALIZABLE конфликт может обнаружиться только на коммите
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("can not commit transaction, error: %w", err)
+	}
+
 	return nil
 }
 
-func injectTx(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-) (context.Context, pgx.Tx, error) {
-	if tx, err := extractTx(ctx); err == nil {
-		return ctx, tx, nil
+func retryableCode(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return "", false
 	}
 
-	tx, err := pool.Begin(ctx)
-	if err != nil {
-		return nil, nil, err
+	switch pgErr.Code {
+	case serializationFailureCode, deadlockDetectedCode:
+		return pgErr.Code, true
+	default:
+		return "", false
 	}
+}
 
-	return context.WithValue(ctx, txInjector{}, tx), tx, nil
+// экспоненциальная задержка с jitter, чтобы конкурирующие транзакции не повторялись синхронно
+func retryDelay(attempt int) time.Duration {
+	delay := min(txRetryBaseDelay<<min(attempt, 16), txRetryMaxDelay)
+	return delay/2 + rand.N(delay/2+1)
 }
 
 func extractTx(ctx context.Context) (pgx.Tx, error) {
@@ -0,0 +1,419 @@
+package pr_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+// ExportTeams передаёт команды в fn по мере чтения строк, не загружая выборку целиком
+func (p *postgresRepo) ExportTeams(
+	ctx context.Context,
+	fn func(team models.Team) error,
+) error {
+	getTeams := p.queryBuilder.Select(
+		"t.name",
+		"u.id",
+		"u.name",
+		"u.is_active",
+	).
+		From("team t").
+		Join("team_membership m ON m.team_id = t.id").
+		Join("users u ON u.id = m.user_id").
+		Where(orgEq(ctx, "t.organization")).
+		OrderBy("t.id", "m.joined_at", "u.id")
+
+	getTeamsStr, args, err := getTeams.ToSql()
+	if err != nil {
+		p.logger.Error("build SQL (export teams)", zap.Error(err))
+		return err
+	}
+
+	p.logger.Debug("Executing export teams SQL",
+		zap.String("query", getTeamsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.reader(ctx).Query(ctx, getTeamsStr, args...)
+	if err != nil {
+		p.logger.Error("export teams query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	// строки одной команды идут подряд, команда отдаётся, когда началась следующая
+	var team *models.Team
+	for rows.Next() {
+		var teamName string
+		var member models.Member
+		if err = rows.Scan(&teamName, &member.UserID, &member.Username, &member.IsActive); err != nil {
+			p.logger.Error("scan team row", zap.Error(err))
+			return err
+		}
+
+		if team != nil && team.Name != teamName {
+			if err = fn(*team); err != nil {
+				return err
+			}
+			team = nil
+		}
+		if team == nil {
+			team = &models.Team{Name: teamName}
+		}
+		team.Members = append(team.Members, member)
+	}
+	if err = rows.Err(); err != nil {
+		p.logger.Error("iterate team rows", zap.Error(err))
+		return err
+	}
+
+	if team != nil {
+		return fn(*team)
+	}
+	return nil
+}
+
+func (p *postgresRepo) ExportUsers(
+	ctx context.Context,
+	fn func(user models.User) error,
+) error {
+	getUsers := p.queryBuilder.Select(
+		"u.id",
+		"u.name",
+		"u.is_active",
+		"u.time_zone",
+		"u.work_start_minute",
+		"u.work_end_minute",
+		"u.max_open_reviews",
+	).
+		From("users u").
+		Where(orgEq(ctx, "u.organization")).
+		OrderBy("u.id")
+
+	getUsersStr, args, err := getUsers.ToSql()
+	if err != nil {
+		p.logger.Error("build SQL (export users)", zap.Error(err))
+		return err
+	}
+
+	p.logger.Debug("Executing export users SQL",
+		zap.String("query", getUsersStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.reader(ctx).Query(ctx, getUsersStr, args...)
+	if err != nil {
+		p.logger.Error("export users query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		var workStart, workEnd *int
+		err = rows.Scan(
+			&user.ID,
+			&user.Name,
+			&user.IsActive,
+			&user.TimeZone,
+			&workStart,
+			&workEnd,
+			&user.MaxOpenReviews,
+		)
+		if err != nil {
+			p.logger.Error("scan user row", zap.Error(err))
+			return err
+		}
+		user.WorkingHours = toWorkingHours(workStart, workEnd)
+
+		if err = fn(user); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		p.logger.Error("iterate user rows", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresRepo) ExportPullRequests(
+	ctx context.Context,
+	fn func(pr models.PR) error,
+) error {
+	getPRs := p.queryBuilder.Select(
+		"pr.id",
+		"pr.external_id",
+		"pr.name",
+		"pr.author_id",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.status",
+		"COALESCE(r.name, '')",
+		"COALESCE(t.name, '')",
+		"ar.user_id",
+		"COALESCE(ar.from_fallback, FALSE)",
+	).
+		From("pull_request pr").
+		LeftJoin("repository r ON r.id = pr.repository_id").
+		LeftJoin("team t ON t.id = pr.team_id").
+		LeftJoin("assigned_reviewer ar ON ar.pr_id = pr.id").
+		Where(orgEq(ctx, "pr.organization")).
+		OrderBy("pr.created_at", "pr.id", "ar.assigned_at", "ar.user_id")
+
+	getPRsStr, args, err := getPRs.ToSql()
+	if err != nil {
+		p.logger.Error("build SQL (export PRs)", zap.Error(err))
+		return err
+	}
+
+	p.logger.Debug("Executing export PRs SQL",
+		zap.String("query", getPRsStr),
+		zap.Any("args", args),
+	)
+
+	rows, err := p.reader(ctx).Query(ctx, getPRsStr, args...)
+	if err != nil {
+		p.logger.Error("export PRs query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	var pr *models.PR
+	var prKey string
+	for rows.Next() {
+		var key string
+		var row models.PR
+		var reviewerID *string
+		var fromFallback bool
+		err = rows.Scan(
+			&key,
+			&row.ID,
+			&row.Name,
+			&row.AuthorID,
+			&row.CreatedAt,
+			&row.MergedAt,
+			&row.Status,
+			&row.Repository,
+			&row.TeamName,
+			&reviewerID,
+			&fromFallback,
+		)
+		if err != nil {
+			p.logger.Error("scan PR row", zap.Error(err))
+			return err
+		}
+
+		if pr != nil && prKey != key {
+			if err = fn(*pr); err != nil {
+				return err
+			}
+			pr = nil
+		}
+		if pr == nil {
+			pr, prKey = &row, key
+			pr.AssignedReviewers = make([]string, 0)
+		}
+		if reviewerID != nil {
+			pr.AssignedReviewers = append(pr.AssignedReviewers, *reviewerID)
+			if fromFallback {
+				pr.FallbackReviewers = append(pr.FallbackReviewers, *reviewerID)
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		p.logger.Error("iterate PR rows", zap.Error(err))
+		return err
+	}
+
+	if pr != nil {
+		return fn(*pr)
+	}
+	return nil
+}
+
+// ImportUser создаёт пользователя или перезаписывает все его поля
+func (p *postgresRepo) ImportUser(
+	ctx context.Context,
+	user models.User,
+) (txErr error) {
+	logger := p.logger.With(zap.String("user_id", user.ID))
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	var workStart, workEnd *int
+	if user.WorkingHours != nil {
+		start := int(user.WorkingHours.Start.Minutes())
+		end := int(user.WorkingHours.End.Minutes())
+		workStart, workEnd = &start, &end
+	}
+
+	upsertUser := p.queryBuilder.Insert("users").
+		Columns("id", "organization", "name", "is_active", "time_zone", "work_start_minute", "work_end_minute", "max_open_reviews").
+		Values(user.ID, models.OrganizationFromContext(ctx), user.Name, user.IsActive, user.TimeZone, workStart, workEnd, user.MaxOpenReviews).
+		Suffix(`
+			ON CONFLICT (id) DO UPDATE
+			SET name = EXCLUDED.name,
+				is_active = EXCLUDED.is_active,
+				time_zone = EXCLUDED.time_zone,
+				work_start_minute = EXCLUDED.work_start_minute,
+				work_end_minute = EXCLUDED.work_end_minute,
+				max_open_reviews = EXCLUDED.max_open_reviews
+			WHERE users.organization = EXCLUDED.organization
+			RETURNING id
+		`)
+
+	upsertUserStr, args, err := upsertUser.ToSql()
+	if err != nil {
+		logger.Error("build SQL (import user)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing import user SQL",
+		zap.String("query", upsertUserStr),
+		zap.Any("args", args),
+	)
+
+	var id string
+	if err = tx.QueryRow(ctx, upsertUserStr, args...).Scan(&id); err != nil {
+		// строка не вернулась: идентификатор занят пользователем другой организации
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("import user query", zap.Error(modelsErr.ErrUserIDTaken))
+			return modelsErr.ErrUserIDTaken
+		}
+		logger.Error("import user query", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// ImportPullRequest сохраняет PR с его статусом и временем создания и мержа.
+// Назначения ревьюверов и пары автор–ревьювер датируются временем создания PR.
+func (p *postgresRepo) ImportPullRequest(
+	ctx context.Context,
+	pr models.PR,
+) (txErr error) {
+	logger := p.logger.With(
+		zap.String("repository", pr.Repository),
+		zap.String("pr_id", pr.ID),
+		zap.String("team_name", pr.TeamName),
+	)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+	defer rollback(txErr)
+
+	if err = p.checkUsers(ctx, tx, append([]string{pr.AuthorID}, pr.AssignedReviewers...)); err != nil {
+		logger.Error("check users", zap.Error(err))
+		return err
+	}
+
+	var repositoryID *int64
+	if pr.Repository != "" {
+		repositoryID, err = p.getRepositoryID(ctx, tx, pr.Repository)
+		if err != nil {
+			logger.Error("get repository id", zap.Error(err))
+			return err
+		}
+	}
+
+	var teamID any
+	if pr.TeamName != "" {
+		teamID = sq.Expr("(SELECT id FROM team WHERE name = ? AND organization = ?)",
+			pr.TeamName, models.OrganizationFromContext(ctx))
+	}
+
+	createPR := p.queryBuilder.Insert("pull_request").
+		Columns("id", "organization", "external_id", "repository_id", "name", "author_id", "team_id", "created_at", "merged_at", "status").
+		Values(sq.Expr("gen_random_uuid()::text"), models.OrganizationFromContext(ctx), pr.ID, repositoryID, pr.Name, pr.AuthorID, teamID, pr.CreatedAt, pr.MergedAt, pr.Status).
+		Suffix("RETURNING id")
+
+	createPRStr, args, err := createPR.ToSql()
+	if err != nil {
+		logger.Error("build SQL (import PR)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing import PR SQL",
+		zap.String("query", createPRStr),
+		zap.Any("args", args),
+	)
+
+	var key string
+	if err = tx.QueryRow(ctx, createPRStr, args...).Scan(&key); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueKeyViolationCode {
+			logger.Error("import PR query", zap.Error(modelsErr.ErrPullRequestExist))
+			return modelsErr.ErrPullRequestExist
+		}
+		logger.Error("import PR query", zap.Error(err))
+		return err
+	}
+
+	if len(pr.AssignedReviewers) == 0 {
+		return nil
+	}
+
+	insertReviewers := p.queryBuilder.Insert("assigned_reviewer").
+		Columns("user_id", "pr_id", "from_fallback", "assigned_at")
+	insertPairings := p.queryBuilder.Insert("review_pairing").
+		Columns("pr_id", "author_id", "reviewer_id", "assigned_at")
+	for _, reviewerID := range pr.AssignedReviewers {
+		insertReviewers = insertReviewers.
+			Values(reviewerID, key, slices.Contains(pr.FallbackReviewers, reviewerID), pr.CreatedAt)
+		insertPairings = insertPairings.
+			Values(key, pr.AuthorID, reviewerID, pr.CreatedAt)
+	}
+
+	insertReviewersStr, args, err := insertReviewers.ToSql()
+	if err != nil {
+		logger.Error("build SQL (insert reviewers)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing insert reviewers SQL",
+		zap.String("query", insertReviewersStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, insertReviewersStr, args...); err != nil {
+		logger.Error("insert reviewers", zap.Error(err))
+		return err
+	}
+
+	insertPairingsStr, args, err := insertPairings.ToSql()
+	if err != nil {
+		logger.Error("build SQL (record pairings)", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("Executing record pairings SQL",
+		zap.String("query", insertPairingsStr),
+		zap.Any("args", args),
+	)
+
+	if _, err = tx.Exec(ctx, insertPairingsStr, args...); err != nil {
+		logger.Error("record pairings", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"go.uber.org/zap"
@@ -19,14 +20,17 @@ func (p *postgresRepo) GetReview(
 	logger := p.logger.With(zap.String("user_id", userID))
 
 	getPRs := p.queryBuilder.Select(
-		"pr.id",
+		"pr.external_id",
 		"pr.name",
 		"pr.author_id",
 		"pr.status",
+		"COALESCE(r.name, '')",
 	).
 		From("assigned_reviewer ar").
 		Join("pull_request pr ON ar.pr_id = pr.id").
-		Where(sq.Eq{"ar.user_id": userID})
+		LeftJoin("repository r ON r.id = pr.repository_id").
+		Where(sq.Eq{"ar.user_id": userID}).
+		Where(orgEq(ctx, "pr.organization"))
 
 	getPRsStr, args, err := getPRs.ToSql()
 	if err != nil {
@@ -39,7 +43,7 @@ func (p *postgresRepo) GetReview(
 		zap.Any("args", args),
 	)
 
-	rows, err := p.db.Query(ctx, getPRsStr, args...)
+	rows, err := p.reader(ctx).Query(ctx, getPRsStr, args...)
 	if err != nil {
 		logger.Error("GetReview query", zap.Error(err))
 		return nil, err
@@ -54,6 +58,7 @@ func (p *postgresRepo) GetReview(
 			&dbPR.Name,
 			&dbPR.AuthorID,
 			&dbPR.Status,
+			&dbPR.Repository,
 		); err != nil {
 			logger.Error("scan pr row", zap.Error(err))
 			return nil, err
@@ -64,6 +69,20 @@ func (p *postgresRepo) GetReview(
 	return prs, nil
 }
 
+const userTeamNamesColumn = `ARRAY(
+	SELECT t.name FROM team_membership m
+	JOIN team t ON t.id = m.team_id
+	WHERE m.user_id = u.id
+	ORDER BY m.joined_at, t.id
+)`
+
+func primaryTeamName(teamNames []string) string {
+	if len(teamNames) == 0 {
+		return ""
+	}
+	return teamNames[0]
+}
+
 func (p *postgresRepo) SetIsActive(
 	ctx context.Context,
 	userID string,
@@ -74,10 +93,11 @@ func (p *postgresRepo) SetIsActive(
 		zap.Bool("is_active", isActive),
 	)
 
-	setIsActive := p.queryBuilder.Update("users").
+	setIsActive := p.queryBuilder.Update("users u").
 		Set("is_active", isActive).
-		Where(sq.Eq{"id": userID}).
-		Suffix("RETURNING name, team_id")
+		Where(sq.Eq{"u.id": userID}).
+		Where(orgEq(ctx, "u.organization")).
+		Suffix("RETURNING u.name, " + userTeamNamesColumn)
 
 	setIsActiveStr, args, err := setIsActive.ToSql()
 	if err != nil {
@@ -96,7 +116,7 @@ func (p *postgresRepo) SetIsActive(
 
 	err = p.db.QueryRow(ctx, setIsActiveStr, args...).Scan(
 		&user.Name,
-		&user.TeamName,
+		&user.TeamNames,
 	)
 
 	if err != nil {
@@ -107,6 +127,179 @@ func (p *postgresRepo) SetIsActive(
 		logger.Error("SetIsActive query", zap.Error(err))
 		return nil, err
 	}
+	user.TeamName = primaryTeamName(user.TeamNames)
+
+	return &user, nil
+}
+
+func (p *postgresRepo) SetSchedule(
+	ctx context.Context,
+	userID, timeZone string,
+	workingHours *models.WorkingHours,
+) (*models.User, error) {
+	logger := p.logger.With(
+		zap.String("user_id", userID),
+		zap.String("time_zone", timeZone),
+		zap.Any("working_hours", workingHours),
+	)
+
+	var workStart, workEnd *int
+	if workingHours != nil {
+		start := int(workingHours.Start.Minutes())
+		end := int(workingHours.End.Minutes())
+		workStart, workEnd = &start, &end
+	}
+
+	setSchedule := p.queryBuilder.Update("users u").
+		Set("time_zone", timeZone).
+		Set("work_start_minute", workStart).
+		Set("work_end_minute", workEnd).
+		Where(sq.Eq{"u.id": userID}).
+		Where(orgEq(ctx, "u.organization")).
+		Suffix("RETURNING u.name, " + userTeamNamesColumn + ", u.is_active, u.time_zone, u.work_start_minute, u.work_end_minute")
+
+	setScheduleStr, args, err := setSchedule.ToSql()
+	if err != nil {
+		logger.Error("build SQL (SetSchedule)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing SetSchedule SQL",
+		zap.String("query", setScheduleStr),
+		zap.Any("args", args),
+	)
+
+	user := models.User{ID: userID}
+	err = p.db.QueryRow(ctx, setScheduleStr, args...).Scan(
+		&user.Name,
+		&user.TeamNames,
+		&user.IsActive,
+		&user.TimeZone,
+		&workStart,
+		&workEnd,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("SetSchedule query", zap.Error(modelsErr.ErrUserNotFound))
+			return nil, modelsErr.ErrUserNotFound
+		}
+		logger.Error("SetSchedule query", zap.Error(err))
+		return nil, err
+	}
+	user.WorkingHours = toWorkingHours(workStart, workEnd)
+	user.TeamName = primaryTeamName(user.TeamNames)
+
+	return &user, nil
+}
+
+func toWorkingHours(workStart, workEnd *int) *models.WorkingHours {
+	if workStart == nil || workEnd == nil {
+		return nil
+	}
+	return &models.WorkingHours{
+		Start: time.Duration(*workStart) * time.Minute,
+		End:   time.Duration(*workEnd) * time.Minute,
+	}
+}
+
+func (p *postgresRepo) SetMaxOpenReviews(
+	ctx context.Context,
+	userID string,
+	maxOpenReviews *int,
+) (*models.User, error) {
+	logger := p.logger.With(
+		zap.String("user_id", userID),
+		zap.Any("max_open_reviews", maxOpenReviews),
+	)
+
+	setMaxOpenReviews := p.queryBuilder.Update("users u").
+		Set("max_open_reviews", maxOpenReviews).
+		Where(sq.Eq{"u.id": userID}).
+		Where(orgEq(ctx, "u.organization")).
+		Suffix("RETURNING u.name, " + userTeamNamesColumn + ", u.is_active, u.max_open_reviews")
+
+	setMaxOpenReviewsStr, args, err := setMaxOpenReviews.ToSql()
+	if err != nil {
+		logger.Error("build SQL (SetMaxOpenReviews)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing SetMaxOpenReviews SQL",
+		zap.String("query", setMaxOpenReviewsStr),
+		zap.Any("args", args),
+	)
+
+	user := models.User{ID: userID}
+	err = p.db.QueryRow(ctx, setMaxOpenReviewsStr, args...).Scan(
+		&user.Name,
+		&user.TeamNames,
+		&user.IsActive,
+		&user.MaxOpenReviews,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("SetMaxOpenReviews query", zap.Error(modelsErr.ErrUserNotFound))
+			return nil, modelsErr.ErrUserNotFound
+		}
+		logger.Error("SetMaxOpenReviews query", zap.Error(err))
+		return nil, err
+	}
+	user.TeamName = primaryTeamName(user.TeamNames)
+
+	return &user, nil
+}
+
+func (p *postgresRepo) GetUser(
+	ctx context.Context,
+	userID string,
+) (*models.User, error) {
+	logger := p.logger.With(zap.String("user_id", userID))
+
+	getUser := p.queryBuilder.Select(
+		"u.name",
+		userTeamNamesColumn,
+		"u.is_active",
+		"u.time_zone",
+		"u.work_start_minute",
+		"u.work_end_minute",
+		"u.max_open_reviews",
+	).
+		From("users u").
+		Where(sq.Eq{"u.id": userID}).
+		Where(orgEq(ctx, "u.organization"))
+
+	getUserStr, args, err := getUser.ToSql()
+	if err != nil {
+		logger.Error("build SQL (GetUser)", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Executing GetUser SQL",
+		zap.String("query", getUserStr),
+		zap.Any("args", args),
+	)
+
+	user := models.User{ID: userID}
+	var workStart, workEnd *int
+	err = p.conn(ctx).QueryRow(ctx, getUserStr, args...).Scan(
+		&user.Name,
+		&user.TeamNames,
+		&user.IsActive,
+		&user.TimeZone,
+		&workStart,
+		&workEnd,
+		&user.MaxOpenReviews,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("GetUser query", zap.Error(modelsErr.ErrUserNotFound))
+			return nil, modelsErr.ErrUserNotFound
+		}
+		logger.Error("GetUser query", zap.Error(err))
+		return nil, err
+	}
+	user.WorkingHours = toWorkingHours(workStart, workEnd)
+	user.TeamName = primaryTeamName(user.TeamNames)
 
 	return &user, nil
 }
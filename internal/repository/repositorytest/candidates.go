@@ -0,0 +1,154 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+var candidateCases = []testCase{
+	{
+		name: "active teammates",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u3", "u1", "u2", "u4")
+			addTeam(t, repo, "payments", "u5")
+			_, err := repo.SetIsActive(ctx, "u4", false)
+			require.NoError(t, err)
+			workingHours := &models.WorkingHours{Start: 10 * time.Hour, End: 19 * time.Hour}
+			_, err = repo.SetSchedule(ctx, "u2", "Asia/Tokyo", workingHours)
+			require.NoError(t, err)
+
+			tests := []struct {
+				name     string
+				excluded []string
+				want     []string
+			}{
+				{name: "nil exclusion list", excluded: nil, want: []string{"u1", "u2", "u3"}},
+				{name: "empty exclusion list", excluded: []string{}, want: []string{"u1", "u2", "u3"}},
+				{name: "excluded users", excluded: []string{"u1", "u5"}, want: []string{"u2", "u3"}},
+			}
+
+			for _, tt := range tests {
+				candidates, err := repo.GetActiveTeammates(ctx, teamID, tt.excluded, now())
+				require.NoError(t, err, tt.name)
+				require.Equal(t, tt.want, candidateIDs(candidates), tt.name)
+			}
+
+			candidates, err := repo.GetActiveTeammates(ctx, teamID, []string{"u1", "u3"}, now())
+			require.NoError(t, err)
+			require.Equal(t, []models.Candidate{
+				{UserID: "u2", TimeZone: "Asia/Tokyo", WorkingHours: workingHours},
+			}, candidates)
+
+			candidates, err = repo.GetActiveTeammates(ctx, teamID, []string{"u1", "u2", "u3"}, now())
+			require.NoError(t, err)
+			require.Empty(t, candidates)
+		},
+	},
+	{
+		name: "review caps",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			addTeam(t, repo, "payments", "u3")
+
+			_, err := repo.SetMaxOpenReviews(ctx, "u2", ptr(1))
+			require.NoError(t, err)
+			_, err = repo.SetTeamPolicy(ctx, models.TeamPolicy{
+				TeamName:              "payments",
+				EscalationPolicy:      models.EscalationPolicyReassign,
+				DefaultMaxOpenReviews: ptr(1),
+				ShortfallPolicy:       models.ShortfallPolicyAllow,
+				SelectionMode:         models.SelectionModeDefault,
+				RotationWindow:        time.Hour,
+			})
+			require.NoError(t, err)
+
+			pr := createPR(t, repo, teamID, "pr-1", "u1", "u2", "u3")
+
+			candidates, err := repo.GetActiveTeammates(ctx, teamID, nil, now())
+			require.NoError(t, err)
+			require.Equal(t, []string{"u1"}, candidateIDs(candidates))
+
+			_, err = repo.PullRequestMerge(ctx, "", pr.ID)
+			require.NoError(t, err)
+
+			candidates, err = repo.GetActiveTeammates(ctx, teamID, nil, now())
+			require.NoError(t, err)
+			require.Equal(t, []string{"u1", "u2", "u3"}, candidateIDs(candidates))
+		},
+	},
+	{
+		name: "out of office teammates",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2")
+			now := now()
+
+			_, err := repo.CreateOutOfOffice(ctx, models.OutOfOffice{
+				UserID: "u2",
+				From:   now.Add(-time.Hour),
+				To:     now.Add(time.Hour),
+			})
+			require.NoError(t, err)
+
+			tests := []struct {
+				at   time.Time
+				want []string
+			}{
+				{at: now.Add(-2 * time.Hour), want: []string{"u1", "u2"}},
+				{at: now.Add(-time.Hour), want: []string{"u1"}},
+				{at: now, want: []string{"u1"}},
+				{at: now.Add(time.Hour), want: []string{"u1", "u2"}},
+			}
+
+			for _, tt := range tests {
+				candidates, err := repo.GetActiveTeammates(ctx, teamID, nil, tt.at)
+				require.NoError(t, err)
+				require.Equal(t, tt.want, candidateIDs(candidates), tt.at)
+			}
+		},
+	},
+	{
+		name: "active code owners",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2")
+			addTeam(t, repo, "payments", "u3", "u4")
+			addTeam(t, repo, "platform", "u5")
+			_, err := repo.SetIsActive(ctx, "u4", false)
+			require.NoError(t, err)
+
+			owners := []models.CodeOwner{
+				{Kind: models.CodeOwnerUser, Name: "u1"},
+				{Kind: models.CodeOwnerTeam, Name: "payments"},
+				{Kind: models.CodeOwnerTeam, Name: "frontend"},
+			}
+
+			candidates, err := repo.GetActiveCodeOwners(ctx, owners, nil, now())
+			require.NoError(t, err)
+			require.Equal(t, []string{"u1", "u3"}, candidateIDs(candidates))
+
+			candidates, err = repo.GetActiveCodeOwners(ctx, owners, []string{"u1"}, now())
+			require.NoError(t, err)
+			require.Equal(t, []string{"u3"}, candidateIDs(candidates))
+
+			candidates, err = repo.GetActiveCodeOwners(ctx, nil, nil, now())
+			require.NoError(t, err)
+			require.Empty(t, candidates)
+		},
+	},
+}
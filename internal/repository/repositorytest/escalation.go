@@ -0,0 +1,158 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var escalationCases = []testCase{
+	{
+		name: "stale reviews",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			backendID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			paymentsID := addTeam(t, repo, "payments", "u4", "u5")
+			_, err := repo.SetTeamPolicy(ctx, models.TeamPolicy{
+				TeamName:         "backend",
+				ReviewSLA:        ptr(time.Hour),
+				EscalationPolicy: models.EscalationPolicyAddReviewer,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+				RotationWindow:   time.Hour,
+			})
+			require.NoError(t, err)
+
+			createPR(t, repo, backendID, "pr-1", "u1", "u2")
+			createPR(t, repo, backendID, "pr-2", "u1", "u3")
+			createPR(t, repo, paymentsID, "pr-3", "u4", "u5")
+			_, err = repo.PullRequestMerge(ctx, "", "pr-2")
+			require.NoError(t, err)
+
+			reviews, err := repo.GetStaleReviews(ctx, now(), 10)
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+
+			later := now().Add(2 * time.Hour)
+			reviews, err = repo.GetStaleReviews(ctx, later, 10)
+			require.NoError(t, err)
+			require.Len(t, reviews, 1)
+			require.WithinDuration(t, now(), reviews[0].AssignedAt, time.Minute)
+			reviews[0].AssignedAt = time.Time{}
+			require.Equal(t, models.StaleReview{
+				PRID:         "pr-1",
+				Organization: models.DefaultOrganization,
+				AuthorID:     "u1",
+				ReviewerID:   "u2",
+				TeamID:       backendID,
+				Policy:       models.EscalationPolicyAddReviewer,
+			}, reviews[0])
+
+			reviews, err = repo.GetStaleReviews(ctx, later, 0)
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+
+			escalated, err := repo.IsReviewEscalated(ctx, "", "pr-1", "u2")
+			require.NoError(t, err)
+			require.False(t, escalated)
+
+			require.NoError(t, repo.CreateEscalation(ctx, models.Escalation{
+				PRID:           "pr-1",
+				TeamID:         backendID,
+				IdleReviewerID: "u2",
+				NewReviewerID:  "u3",
+				Policy:         models.EscalationPolicyAddReviewer,
+				CreatedAt:      later,
+			}))
+
+			reviews, err = repo.GetStaleReviews(ctx, later, 10)
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+
+			escalated, err = repo.IsReviewEscalated(ctx, "", "pr-1", "u2")
+			require.NoError(t, err)
+			require.True(t, escalated)
+
+			err = repo.CreateEscalation(ctx, models.Escalation{
+				PRID:           "pr-4",
+				TeamID:         backendID,
+				IdleReviewerID: "u2",
+				Policy:         models.EscalationPolicyReassign,
+				CreatedAt:      later,
+			})
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+		},
+	},
+	{
+		name: "reassigned reviewer is stale again",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			_, err := repo.SetTeamPolicy(ctx, models.TeamPolicy{
+				TeamName:         "backend",
+				ReviewSLA:        ptr(time.Hour),
+				EscalationPolicy: models.EscalationPolicyReassign,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+				RotationWindow:   time.Hour,
+			})
+			require.NoError(t, err)
+
+			createPR(t, repo, teamID, "pr-1", "u1", "u2")
+
+			// эскалация, созданная до назначения, не скрывает ревью
+			require.NoError(t, repo.CreateEscalation(ctx, models.Escalation{
+				PRID:           "pr-1",
+				TeamID:         teamID,
+				IdleReviewerID: "u2",
+				Policy:         models.EscalationPolicyReassign,
+				CreatedAt:      now().Add(-time.Hour),
+			}))
+
+			reviews, err := repo.GetStaleReviews(ctx, now().Add(2*time.Hour), 10)
+			require.NoError(t, err)
+			require.Len(t, reviews, 1)
+			require.Equal(t, "u2", reviews[0].ReviewerID)
+
+			escalated, err := repo.IsReviewEscalated(ctx, "", "pr-1", "u2")
+			require.NoError(t, err)
+			require.False(t, escalated)
+		},
+	},	{
+		name: "team deletion keeps escalation history",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			createPR(t, repo, teamID, "pr-1", "u1", "u2")
+
+			require.NoError(t, repo.CreateEscalation(ctx, models.Escalation{
+				PRID:           "pr-1",
+				TeamID:         teamID,
+				IdleReviewerID: "u2",
+				NewReviewerID:  "u3",
+				Policy:         models.EscalationPolicyAddReviewer,
+				CreatedAt:      now().Add(time.Hour),
+			}))
+
+			_, err := repo.PullRequestMerge(ctx, "", "pr-1")
+			require.NoError(t, err)
+			_, err = repo.TeamDelete(ctx, "backend", models.OpenPRPolicyReject)
+			require.NoError(t, err)
+
+			escalated, err := repo.IsReviewEscalated(ctx, "", "pr-1", "u2")
+			require.NoError(t, err)
+			require.True(t, escalated)
+		},
+	},
+}
@@ -0,0 +1,207 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+var idempotencyCases = []testCase{
+	{
+		name: "reserve and save idempotency key",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			now := now()
+			request := models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-1",
+				ExpiresAt:   now.Add(time.Hour),
+				Lease:       time.Minute,
+			}
+
+			stored, err := repo.ReserveIdempotencyKey(ctx, request, now)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			other := request
+			other.Fingerprint = "fingerprint-2"
+			stored, err = repo.ReserveIdempotencyKey(ctx, other, now)
+			require.NoError(t, err)
+			require.Equal(t, &models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-1",
+				ExpiresAt:   request.ExpiresAt,
+			}, stored)
+
+			otherPath := request
+			otherPath.Path = "/team/add"
+			stored, err = repo.ReserveIdempotencyKey(ctx, otherPath, now)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			mismatched := other
+			mismatched.StatusCode = 500
+			require.NoError(t, repo.SaveIdempotentResponse(ctx, mismatched))
+
+			completed := request
+			completed.StatusCode = 201
+			completed.ContentType = "application/json"
+			completed.ETag = `"1"`
+			completed.Response = []byte(`{"pr":{}}`)
+			require.NoError(t, repo.SaveIdempotentResponse(ctx, completed))
+
+			stored, err = repo.ReserveIdempotencyKey(ctx, request, now)
+			require.NoError(t, err)
+			require.Equal(t, &models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-1",
+				ContentType: "application/json",
+				ETag:        `"1"`,
+				Response:    []byte(`{"pr":{}}`),
+				StatusCode:  201,
+				ExpiresAt:   request.ExpiresAt,
+			}, stored)
+
+			// завершённый запрос не освобождается
+			require.NoError(t, repo.ReleaseIdempotencyKey(ctx, "key-1", "/pullRequest/create"))
+
+			stored, err = repo.ReserveIdempotencyKey(ctx, request, now)
+			require.NoError(t, err)
+			require.Equal(t, 201, stored.StatusCode)
+		},
+	},
+	{
+		name: "release idempotency key",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			now := now()
+			request := models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-1",
+				ExpiresAt:   now.Add(time.Hour),
+				Lease:       time.Minute,
+			}
+
+			stored, err := repo.ReserveIdempotencyKey(ctx, request, now)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			require.NoError(t, repo.ReleaseIdempotencyKey(ctx, "key-1", "/pullRequest/create"))
+			require.NoError(t, repo.ReleaseIdempotencyKey(ctx, "key-2", "/pullRequest/create"))
+
+			request.Fingerprint = "fingerprint-2"
+			stored, err = repo.ReserveIdempotencyKey(ctx, request, now)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+		},
+	},
+	{
+		name: "expired idempotency keys",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			now := now()
+			for _, key := range []string{"key-1", "key-2", "key-3"} {
+				stored, err := repo.ReserveIdempotencyKey(ctx, models.IdempotentRequest{
+					Key:         key,
+					Path:        "/pullRequest/create",
+					Fingerprint: "fingerprint-1",
+					ExpiresAt:   now.Add(time.Hour),
+					Lease:       time.Minute,
+				}, now)
+				require.NoError(t, err)
+				require.Nil(t, stored)
+			}
+
+			later := now.Add(time.Hour)
+			stored, err := repo.ReserveIdempotencyKey(ctx, models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-2",
+				ExpiresAt:   later.Add(time.Hour),
+				Lease:       time.Minute,
+			}, later)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			deleted, err := repo.DeleteExpiredIdempotencyKeys(ctx, now)
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+
+			deleted, err = repo.DeleteExpiredIdempotencyKeys(ctx, later)
+			require.NoError(t, err)
+			require.EqualValues(t, 2, deleted)
+
+			stored, err = repo.ReserveIdempotencyKey(ctx, models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-3",
+				ExpiresAt:   later.Add(time.Hour),
+				Lease:       time.Minute,
+			}, later)
+			require.NoError(t, err)
+			require.Equal(t, "fingerprint-2", stored.Fingerprint)
+		},
+	},
+	{
+		name: "abandoned idempotency key is reclaimed after lease",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			now := now()
+			request := models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-1",
+				ExpiresAt:   now.Add(time.Hour),
+				Lease:       time.Minute,
+			}
+
+			// первый запрос занял ключ и так и не завершился
+			stored, err := repo.ReserveIdempotencyKey(ctx, request, now)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			stored, err = repo.ReserveIdempotencyKey(ctx, request, now.Add(30*time.Second))
+			require.NoError(t, err)
+			require.NotNil(t, stored)
+			require.False(t, stored.Completed())
+
+			retried := request
+			retried.ExpiresAt = now.Add(time.Minute + time.Hour)
+			stored, err = repo.ReserveIdempotencyKey(ctx, retried, now.Add(time.Minute))
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			// аренда отсчитывается заново от повторной брони
+			stored, err = repo.ReserveIdempotencyKey(ctx, retried, now.Add(90*time.Second))
+			require.NoError(t, err)
+			require.NotNil(t, stored)
+			require.False(t, stored.Completed())
+
+			completed := retried
+			completed.StatusCode = 201
+			require.NoError(t, repo.SaveIdempotentResponse(ctx, completed))
+
+			// завершённый ответ аренда не затрагивает
+			stored, err = repo.ReserveIdempotencyKey(ctx, retried, now.Add(30*time.Minute))
+			require.NoError(t, err)
+			require.NotNil(t, stored)
+			require.Equal(t, 201, stored.StatusCode)
+		},
+	},
+}
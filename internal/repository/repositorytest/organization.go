@@ -0,0 +1,376 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func organizations() (context.Context, context.Context) {
+	ctx := context.Background()
+	return models.WithOrganization(ctx, "org-a"), models.WithOrganization(ctx, "org-b")
+}
+
+var organizationCases = []testCase{
+	{
+		name: "team names are unique per organization",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			teamA := addOrgTeam(t, orgA, repo, "backend", "u1")
+			teamB := addOrgTeam(t, orgB, repo, "backend", "u2")
+			require.NotEqual(t, teamA, teamB)
+
+			err := repo.TeamAdd(orgA, models.Team{
+				Name:    "backend",
+				Members: []models.Member{{UserID: "u3", Username: "name-u3", IsActive: true}},
+			})
+			require.ErrorIs(t, err, modelsErr.ErrTeamExist)
+
+			team, err := repo.TeamGet(orgA, "backend")
+			require.NoError(t, err)
+			require.Equal(t, []models.Member{{UserID: "u1", Username: "name-u1", IsActive: true}}, team.Members)
+
+			team, err = repo.TeamGet(orgB, "backend")
+			require.NoError(t, err)
+			require.Equal(t, []models.Member{{UserID: "u2", Username: "name-u2", IsActive: true}}, team.Members)
+
+			require.NoError(t, repo.TeamRename(orgB, "backend", "platform"))
+			team, err = repo.TeamGet(orgA, "backend")
+			require.NoError(t, err)
+			require.Len(t, team.Members, 1)
+		},
+	},
+	{
+		name: "teams and users of another organization are not visible",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			teamA := addOrgTeam(t, orgA, repo, "backend", "u1", "u2")
+			addOrgTeam(t, orgB, repo, "payments", "u3")
+
+			team, err := repo.TeamGet(orgB, "backend")
+			require.NoError(t, err)
+			require.Empty(t, team.Members)
+
+			_, err = repo.GetTeamVersion(orgB, "backend")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+			_, err = repo.GetTeamPolicy(orgB, teamA)
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+			_, err = repo.TeamAudit(orgB, "backend")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+			_, err = repo.SetTeamPolicy(orgB, models.TeamPolicy{
+				TeamName:         "backend",
+				EscalationPolicy: models.EscalationPolicyReassign,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+				RotationWindow:   time.Hour,
+			})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+			err = repo.TeamUpdateMembers(orgB, models.TeamMembersChange{
+				TeamName: "backend",
+				Remove:   []string{"u2"},
+			})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+			require.ErrorIs(t, repo.TeamRename(orgB, "backend", "frontend"), modelsErr.ErrTeamNotFound)
+			_, err = repo.TeamDelete(orgB, "backend", models.OpenPRPolicyReject)
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+			require.ErrorIs(t, repo.SetTeamFallbacks(orgB, "payments", []string{"backend"}), modelsErr.ErrTeamNotFound)
+			_, err = repo.RepositoryAdd(orgB, models.Repository{Name: "api", OwnerTeam: "backend"})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			_, err = repo.GetUser(orgB, "u1")
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+			_, err = repo.GetUserTeams(orgB, "u1")
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+			_, err = repo.SetIsActive(orgB, "u1", false)
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+			_, err = repo.SetMaxOpenReviews(orgB, "u1", ptr(1))
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+			_, err = repo.CreateOutOfOffice(orgB, models.OutOfOffice{UserID: "u1", From: now(), To: now().Add(time.Hour)})
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+
+			candidates, err := repo.GetActiveTeammates(orgB, teamA, nil, now())
+			require.NoError(t, err)
+			require.Empty(t, candidates)
+
+			candidates, err = repo.GetActiveCodeOwners(orgB, []models.CodeOwner{
+				{Kind: models.CodeOwnerUser, Name: "u1"},
+				{Kind: models.CodeOwnerTeam, Name: "backend"},
+			}, nil, now())
+			require.NoError(t, err)
+			require.Empty(t, candidates)
+
+			user, err := repo.GetUser(orgA, "u1")
+			require.NoError(t, err)
+			require.True(t, user.IsActive)
+		},
+	},
+	{
+		name: "user ids are unique across organizations",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			addOrgTeam(t, orgA, repo, "backend", "u1")
+			addOrgTeam(t, orgB, repo, "payments", "u2")
+
+			err := repo.TeamAdd(orgB, models.Team{
+				Name:    "frontend",
+				Members: []models.Member{{UserID: "u1", Username: "Mallory", IsActive: false}},
+			})
+			require.ErrorIs(t, err, modelsErr.ErrUserIDTaken)
+
+			err = repo.TeamUpdateMembers(orgB, models.TeamMembersChange{
+				TeamName: "payments",
+				Add:      []models.Member{{UserID: "u1", Username: "Mallory", IsActive: false}},
+			})
+			require.ErrorIs(t, err, modelsErr.ErrUserIDTaken)
+
+			err = repo.ImportUser(orgB, models.User{ID: "u1", Name: "Mallory", TimeZone: "UTC"})
+			require.ErrorIs(t, err, modelsErr.ErrUserIDTaken)
+
+			user, err := repo.GetUser(orgA, "u1")
+			require.NoError(t, err)
+			require.Equal(t, "name-u1", user.Name)
+			require.True(t, user.IsActive)
+			require.Equal(t, []string{"backend"}, user.TeamNames)
+
+			team, err := repo.TeamGet(orgB, "payments")
+			require.NoError(t, err)
+			require.Equal(t, []models.Member{{UserID: "u2", Username: "name-u2", IsActive: true}}, team.Members)
+		},
+	},
+	{
+		name: "pull requests of another organization are not visible",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			teamA := addOrgTeam(t, orgA, repo, "backend", "u1", "u2")
+			teamB := addOrgTeam(t, orgB, repo, "backend", "u3", "u4")
+			createOrgPR(t, orgA, repo, teamA, "pr-1", "u1", "u2")
+
+			_, err := repo.GetPullRequest(orgB, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+			_, err = repo.GetPullRequestVersion(orgB, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+			_, err = repo.PullRequestMerge(orgB, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+			require.ErrorIs(t, repo.PullRequestRemoveReviewer(orgB, "", "pr-1", "u2"), modelsErr.ErrPRNotFound)
+
+			// внешний ID PR уникален только в своей организации
+			createOrgPR(t, orgB, repo, teamB, "pr-1", "u3", "u4")
+
+			reviews, err := repo.GetReview(orgA, "u2")
+			require.NoError(t, err)
+			require.Len(t, reviews, 1)
+			reviews, err = repo.GetReview(orgB, "u2")
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+
+			pr, err := repo.GetPullRequest(orgA, "", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, "u1", pr.AuthorID)
+			require.Equal(t, models.PRStatusOPEN, pr.Status)
+			require.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+		},
+	},
+	{
+		name: "no assignments across organizations",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			teamA := addOrgTeam(t, orgA, repo, "backend", "u1", "u2")
+			teamB := addOrgTeam(t, orgB, repo, "payments", "u3", "u4")
+
+			for _, tc := range []struct {
+				pr      models.PR
+				wantErr error
+			}{
+				{
+					pr:      models.PR{ID: "pr-1", Name: "foreign team", AuthorID: "u3", TeamID: teamA},
+					wantErr: modelsErr.ErrTeamNotFound,
+				},
+				{
+					pr:      models.PR{ID: "pr-1", Name: "foreign author", AuthorID: "u1", TeamID: teamB},
+					wantErr: modelsErr.ErrUserNotFound,
+				},
+				{
+					pr:      models.PR{ID: "pr-1", Name: "foreign reviewer", AuthorID: "u3", TeamID: teamB, AssignedReviewers: []string{"u2"}},
+					wantErr: modelsErr.ErrUserNotFound,
+				},
+			} {
+				_, err := repo.PullRequestCreate(orgB, tc.pr)
+				require.ErrorIs(t, err, tc.wantErr, tc.pr.Name)
+			}
+
+			createOrgPR(t, orgB, repo, teamB, "pr-1", "u3", "u4")
+
+			err := repo.PullRequestReassign(orgB, "", "pr-1", "u4", "u2", false)
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+			err = repo.PullRequestAddReviewer(orgB, "", "pr-1", "u1", false)
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+
+			pr, err := repo.GetPullRequest(orgB, "", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, []string{"u4"}, pr.AssignedReviewers)
+
+			reviews, err := repo.GetReview(orgA, "u2")
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+		},
+	},
+	{
+		name: "repositories, stats and export are scoped by organization",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			teamA := addOrgTeam(t, orgA, repo, "backend", "u1", "u2")
+			addOrgTeam(t, orgB, repo, "payments", "u3")
+
+			_, err := repo.RepositoryAdd(orgA, models.Repository{Name: "api", OwnerTeam: "backend"})
+			require.NoError(t, err)
+			_, err = repo.RepositoryAdd(orgB, models.Repository{Name: "api"})
+			require.NoError(t, err)
+
+			repositories, err := repo.RepositoryList(orgB)
+			require.NoError(t, err)
+			require.Equal(t, []models.Repository{{Name: "api"}}, repositories)
+
+			_, err = repo.PullRequestCreate(orgA, models.PR{
+				ID: "pr-1", Name: "name-pr-1", AuthorID: "u1", TeamID: teamA, Repository: "api", AssignedReviewers: []string{"u2"},
+			})
+			require.NoError(t, err)
+			_, err = repo.GetPullRequest(orgB, "api", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+			require.NoError(t, repo.RepositoryDelete(orgB, "api"))
+			_, err = repo.RepositoryGet(orgA, "api")
+			require.NoError(t, err)
+
+			stats, total, err := repo.TeamStats(orgB, models.TeamStatsFilter{
+				Since:       now().Add(-time.Hour),
+				StaleBefore: now().Add(time.Hour),
+				Limit:       10,
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, 1, total)
+			require.Len(t, stats, 1)
+			require.Equal(t, "payments", stats[0].TeamName)
+			require.Empty(t, stats[0].Weekly)
+
+			var teams []string
+			require.NoError(t, repo.ExportTeams(orgB, func(team models.Team) error {
+				teams = append(teams, team.Name)
+				return nil
+			}))
+			require.Equal(t, []string{"payments"}, teams)
+
+			var users []string
+			require.NoError(t, repo.ExportUsers(orgB, func(user models.User) error {
+				users = append(users, user.ID)
+				return nil
+			}))
+			require.Equal(t, []string{"u3"}, users)
+
+			var prs []string
+			require.NoError(t, repo.ExportPullRequests(orgB, func(pr models.PR) error {
+				prs = append(prs, pr.ID)
+				return nil
+			}))
+			require.Empty(t, prs)
+
+			createdAt := now().Add(-time.Hour)
+			err = repo.ImportPullRequest(orgB, models.PR{
+				ID: "pr-2", Name: "name-pr-2", AuthorID: "u1", Status: models.PRStatusOPEN, CreatedAt: &createdAt,
+			})
+			require.Error(t, err)
+		},
+	},
+	{
+		name: "idempotency keys are scoped by organization",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			now := now()
+			request := models.IdempotentRequest{
+				Key:         "key-1",
+				Path:        "/pullRequest/create",
+				Fingerprint: "fingerprint-1",
+				ExpiresAt:   now.Add(time.Hour),
+				Lease:       time.Minute,
+			}
+
+			stored, err := repo.ReserveIdempotencyKey(orgA, request, now)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			completed := request
+			completed.StatusCode = 201
+			completed.Response = []byte(`{"pr":{}}`)
+			require.NoError(t, repo.SaveIdempotentResponse(orgA, completed))
+
+			// тот же ключ другой организации не получает чужой ответ
+			other := request
+			other.Fingerprint = "fingerprint-2"
+			stored, err = repo.ReserveIdempotencyKey(orgB, other, now)
+			require.NoError(t, err)
+			require.Nil(t, stored)
+
+			stored, err = repo.ReserveIdempotencyKey(orgA, request, now)
+			require.NoError(t, err)
+			require.NotNil(t, stored)
+			require.Equal(t, 201, stored.StatusCode)
+		},
+	},
+	{
+		name: "background scans report the organization",
+		run: func(t *testing.T, backend Backend) {
+			orgA, orgB := organizations()
+			repo := backend.Repository
+
+			addOrgTeam(t, orgA, repo, "backend", "u1", "u2")
+			teamB := addOrgTeam(t, orgB, repo, "backend", "u3", "u4")
+			_, err := repo.SetTeamPolicy(orgB, models.TeamPolicy{
+				TeamName:         "backend",
+				ReviewSLA:        ptr(time.Hour),
+				EscalationPolicy: models.EscalationPolicyReassign,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+				RotationWindow:   time.Hour,
+			})
+			require.NoError(t, err)
+			createOrgPR(t, orgB, repo, teamB, "pr-1", "u3", "u4")
+
+			reviews, err := repo.GetStaleReviews(context.Background(), now().Add(2*time.Hour), 10)
+			require.NoError(t, err)
+			require.Len(t, reviews, 1)
+			require.Equal(t, "org-b", reviews[0].Organization)
+			require.Equal(t, teamB, reviews[0].TeamID)
+
+			now := now()
+			_, err = repo.CreateOutOfOffice(orgA, models.OutOfOffice{
+				UserID:          "u2",
+				From:            now.Add(-time.Hour),
+				To:              now.Add(time.Hour),
+				ReassignReviews: true,
+			})
+			require.NoError(t, err)
+
+			started, err := repo.GetStartedOutOfOffice(context.Background(), now, 10)
+			require.NoError(t, err)
+			require.Len(t, started, 1)
+			require.Equal(t, "org-a", started[0].Organization)
+		},
+	},
+}
@@ -0,0 +1,291 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var pullRequestCases = []testCase{
+	{
+		name: "create and get pull request",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			before := now()
+
+			created, err := repo.PullRequestCreate(ctx, models.PR{
+				ID:                "pr-1",
+				Name:              "Add search",
+				AuthorID:          "u1",
+				TeamID:            teamID,
+				AssignedReviewers: []string{"u2", "u3"},
+				FallbackReviewers: []string{"u3"},
+			})
+			require.NoError(t, err)
+			require.Equal(t, models.PRStatusOPEN, created.Status)
+			require.EqualValues(t, 1, created.Version)
+			require.NotNil(t, created.CreatedAt)
+			require.WithinDuration(t, before, *created.CreatedAt, time.Minute)
+
+			pr, err := repo.GetPullRequest(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, "pr-1", pr.ID)
+			require.Equal(t, "Add search", pr.Name)
+			require.Equal(t, "u1", pr.AuthorID)
+			require.Equal(t, teamID, pr.TeamID)
+			require.Equal(t, models.PRStatusOPEN, pr.Status)
+			require.Nil(t, pr.MergedAt)
+			require.True(t, created.CreatedAt.Equal(*pr.CreatedAt))
+			require.ElementsMatch(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+			require.Equal(t, []string{"u3"}, pr.FallbackReviewers)
+			require.EqualValues(t, 1, pr.Version)
+
+			version, err := repo.GetPullRequestVersion(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.EqualValues(t, 1, version)
+
+			reviews, err := repo.GetReview(ctx, "u2")
+			require.NoError(t, err)
+			require.Equal(t, []models.PRShort{
+				{ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: models.PRStatusOPEN},
+			}, reviews)
+
+			reviews, err = repo.GetReview(ctx, "u1")
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+		},
+	},
+	{
+		name: "create pull request without reviewers",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1")
+			createPR(t, repo, teamID, "pr-1", "u1")
+
+			pr, err := repo.GetPullRequest(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.Empty(t, pr.AssignedReviewers)
+			require.Empty(t, pr.FallbackReviewers)
+		},
+	},
+	{
+		name: "duplicate pull request",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2")
+			createPR(t, repo, teamID, "pr-1", "u1", "u2")
+
+			_, err := repo.PullRequestCreate(ctx, models.PR{
+				ID:                "pr-1",
+				Name:              "Other",
+				AuthorID:          "u2",
+				TeamID:            teamID,
+				AssignedReviewers: []string{"u1"},
+			})
+			require.ErrorIs(t, err, modelsErr.ErrPullRequestExist)
+
+			reviews, err := repo.GetReview(ctx, "u1")
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+
+			pairings, err := repo.GetRecentPairings(ctx, "u2", []string{"u1"}, now().Add(-time.Hour))
+			require.NoError(t, err)
+			require.Empty(t, pairings)
+		},
+	},
+	{
+		name: "missing pull request",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2")
+
+			_, err := repo.GetPullRequest(ctx, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			_, err = repo.PullRequestGet(ctx, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			_, err = repo.PullRequestMerge(ctx, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			_, err = repo.GetPullRequestVersion(ctx, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			err = repo.PullRequestReassign(ctx, "", "pr-1", "u1", "u2", false)
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			err = repo.PullRequestAddReviewer(ctx, "", "pr-1", "u2", false)
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			err = repo.PullRequestRemoveReviewer(ctx, "", "pr-1", "u2")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+		},
+	},
+	{
+		name: "merge pull request",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2")
+			created := createPR(t, repo, teamID, "pr-1", "u1", "u2")
+
+			merged, err := repo.PullRequestMerge(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, "pr-1", merged.ID)
+			require.Equal(t, "name-pr-1", merged.Name)
+			require.Equal(t, "u1", merged.AuthorID)
+			require.Equal(t, models.PRStatusMERGED, merged.Status)
+			require.EqualValues(t, 2, merged.Version)
+			require.True(t, created.CreatedAt.Equal(*merged.CreatedAt))
+			require.NotNil(t, merged.MergedAt)
+
+			again, err := repo.PullRequestMerge(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, models.PRStatusMERGED, again.Status)
+			require.EqualValues(t, 2, again.Version)
+			require.True(t, merged.MergedAt.Equal(*again.MergedAt))
+
+			version, err := repo.GetPullRequestVersion(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.EqualValues(t, 2, version)
+
+			_, err = repo.GetPullRequest(ctx, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRMerged)
+
+			got, err := repo.PullRequestGet(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, models.PRStatusMERGED, got.Status)
+			require.Equal(t, []string{"u2"}, got.AssignedReviewers)
+			require.EqualValues(t, 2, got.Version)
+			require.True(t, merged.MergedAt.Equal(*got.MergedAt))
+
+			reviews, err := repo.GetReview(ctx, "u2")
+			require.NoError(t, err)
+			require.Equal(t, []models.PRShort{
+				{ID: "pr-1", Name: "name-pr-1", AuthorID: "u1", Status: models.PRStatusMERGED},
+			}, reviews)
+		},
+	},
+	{
+		name: "merged pull request cannot be reassigned",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			createPR(t, repo, teamID, "pr-1", "u1", "u2")
+
+			_, err := repo.PullRequestMerge(ctx, "", "pr-1")
+			require.NoError(t, err)
+
+			// переназначение проверяет статус через GetPullRequest
+			_, err = repo.GetPullRequest(ctx, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRMerged)
+		},
+	},
+	{
+		name: "reassign reviewer",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3", "u4")
+			createPR(t, repo, teamID, "pr-1", "u1", "u2", "u3")
+
+			require.NoError(t, repo.PullRequestReassign(ctx, "", "pr-1", "u2", "u4", true))
+
+			pr, err := repo.GetPullRequest(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"u3", "u4"}, pr.AssignedReviewers)
+			require.Equal(t, []string{"u4"}, pr.FallbackReviewers)
+			require.EqualValues(t, 2, pr.Version)
+
+			reviews, err := repo.GetReview(ctx, "u2")
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+
+			reviews, err = repo.GetReview(ctx, "u4")
+			require.NoError(t, err)
+			require.Len(t, reviews, 1)
+		},
+	},
+	{
+		name: "add and remove reviewers",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			createPR(t, repo, teamID, "pr-1", "u1", "u2")
+
+			require.NoError(t, repo.PullRequestAddReviewer(ctx, "", "pr-1", "u3", true))
+
+			pr, err := repo.GetPullRequest(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+			require.Equal(t, []string{"u3"}, pr.FallbackReviewers)
+			require.EqualValues(t, 2, pr.Version)
+
+			require.NoError(t, repo.PullRequestRemoveReviewer(ctx, "", "pr-1", "u2"))
+
+			err = repo.PullRequestRemoveReviewer(ctx, "", "pr-1", "u2")
+			require.ErrorIs(t, err, modelsErr.ErrNotAssigned)
+
+			pr, err = repo.GetPullRequest(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, []string{"u3"}, pr.AssignedReviewers)
+			require.EqualValues(t, 3, pr.Version)
+		},
+	},
+	{
+		name: "recent pairings",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2", "u3", "u4")
+			since := now().Add(-time.Hour)
+
+			createPR(t, repo, teamID, "pr-1", "u1", "u2", "u3")
+			createPR(t, repo, teamID, "pr-2", "u1", "u2")
+			createPR(t, repo, teamID, "pr-3", "u4", "u2")
+			require.NoError(t, repo.PullRequestReassign(ctx, "", "pr-1", "u3", "u4", false))
+			require.NoError(t, repo.PullRequestAddReviewer(ctx, "", "pr-2", "u3", false))
+
+			pairings, err := repo.GetRecentPairings(ctx, "u1", []string{"u2", "u3", "u4"}, since)
+			require.NoError(t, err)
+			require.Equal(t, map[string]int{"u2": 2, "u3": 2, "u4": 1}, pairings)
+
+			pairings, err = repo.GetRecentPairings(ctx, "u1", []string{"u2"}, now().Add(time.Hour))
+			require.NoError(t, err)
+			require.Empty(t, pairings)
+
+			teamPairings, err := repo.TeamPairings(ctx, "backend", since)
+			require.NoError(t, err)
+			require.Equal(t, []models.ReviewPairing{
+				{AuthorID: "u1", ReviewerID: "u2", Reviews: 2},
+				{AuthorID: "u1", ReviewerID: "u3", Reviews: 2},
+				{AuthorID: "u1", ReviewerID: "u4", Reviews: 1},
+				{AuthorID: "u4", ReviewerID: "u2", Reviews: 1},
+			}, teamPairings)
+
+			_, err = repo.TeamPairings(ctx, "payments", since)
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+		},
+	},
+}
@@ -0,0 +1,183 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var repositoryCases = []testCase{
+	{
+		name: "add, update and list repositories",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			backendID := addTeam(t, repo, "backend", "u1")
+			paymentsID := addTeam(t, repo, "payments", "u2")
+
+			web, err := repo.RepositoryAdd(ctx, models.Repository{Name: "web"})
+			require.NoError(t, err)
+			require.Equal(t, &models.Repository{Name: "web"}, web)
+
+			api, err := repo.RepositoryAdd(ctx, models.Repository{
+				Name:            "api",
+				OwnerTeam:       "backend",
+				ReviewerCount:   ptr(1),
+				ShortfallPolicy: ptr(models.ShortfallPolicyReject),
+				SelectionMode:   ptr(models.SelectionModeRotation),
+			})
+			require.NoError(t, err)
+			require.Equal(t, &models.Repository{
+				Name:            "api",
+				OwnerTeam:       "backend",
+				OwnerTeamID:     backendID,
+				ReviewerCount:   ptr(1),
+				ShortfallPolicy: ptr(models.ShortfallPolicyReject),
+				SelectionMode:   ptr(models.SelectionModeRotation),
+			}, api)
+
+			_, err = repo.RepositoryAdd(ctx, models.Repository{Name: "api"})
+			require.ErrorIs(t, err, modelsErr.ErrRepositoryExist)
+
+			_, err = repo.RepositoryAdd(ctx, models.Repository{Name: "mobile", OwnerTeam: "frontend"})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			got, err := repo.RepositoryGet(ctx, "api")
+			require.NoError(t, err)
+			require.Equal(t, api, got)
+
+			repositories, err := repo.RepositoryList(ctx)
+			require.NoError(t, err)
+			require.Equal(t, []models.Repository{*api, *web}, repositories)
+
+			updated, err := repo.RepositoryUpdate(ctx, models.Repository{Name: "api", OwnerTeam: "payments"})
+			require.NoError(t, err)
+			require.Equal(t, &models.Repository{
+				Name:        "api",
+				OwnerTeam:   "payments",
+				OwnerTeamID: paymentsID,
+			}, updated)
+
+			_, err = repo.RepositoryUpdate(ctx, models.Repository{Name: "mobile"})
+			require.ErrorIs(t, err, modelsErr.ErrRepositoryNotFound)
+
+			_, err = repo.RepositoryUpdate(ctx, models.Repository{Name: "api", OwnerTeam: "frontend"})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			_, err = repo.RepositoryGet(ctx, "mobile")
+			require.ErrorIs(t, err, modelsErr.ErrRepositoryNotFound)
+		},
+	},
+	{
+		name: "empty repository list",
+		run: func(t *testing.T, backend Backend) {
+			repositories, err := backend.Repository.RepositoryList(context.Background())
+			require.NoError(t, err)
+			require.NotNil(t, repositories)
+			require.Empty(t, repositories)
+		},
+	},
+	{
+		name: "pull requests in repositories",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2")
+			for _, name := range []string{"api", "web"} {
+				_, err := repo.RepositoryAdd(ctx, models.Repository{Name: name, OwnerTeam: "backend"})
+				require.NoError(t, err)
+			}
+
+			for _, repository := range []string{"", "api", "web"} {
+				created, err := repo.PullRequestCreate(ctx, models.PR{
+					ID:                "pr-1",
+					Name:              "name-" + repository,
+					AuthorID:          "u1",
+					TeamID:            teamID,
+					Repository:        repository,
+					AssignedReviewers: []string{"u2"},
+				})
+				require.NoError(t, err, repository)
+				require.Equal(t, repository, created.Repository)
+			}
+
+			_, err := repo.PullRequestCreate(ctx, models.PR{
+				ID:         "pr-1",
+				Name:       "duplicate",
+				AuthorID:   "u1",
+				TeamID:     teamID,
+				Repository: "api",
+			})
+			require.ErrorIs(t, err, modelsErr.ErrPullRequestExist)
+
+			_, err = repo.PullRequestCreate(ctx, models.PR{
+				ID:         "pr-2",
+				Name:       "missing repository",
+				AuthorID:   "u1",
+				TeamID:     teamID,
+				Repository: "mobile",
+			})
+			require.ErrorIs(t, err, modelsErr.ErrRepositoryNotFound)
+
+			merged, err := repo.PullRequestMerge(ctx, "api", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, "api", merged.Repository)
+			require.Equal(t, "name-api", merged.Name)
+
+			pr, err := repo.GetPullRequest(ctx, "web", "pr-1")
+			require.NoError(t, err)
+			require.Equal(t, "web", pr.Repository)
+			require.Equal(t, "name-web", pr.Name)
+
+			_, err = repo.GetPullRequest(ctx, "mobile", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			require.NoError(t, repo.PullRequestRemoveReviewer(ctx, "", "pr-1", "u2"))
+
+			reviews, err := repo.GetReview(ctx, "u2")
+			require.NoError(t, err)
+			require.ElementsMatch(t, []models.PRShort{
+				{ID: "pr-1", Name: "name-api", AuthorID: "u1", Status: models.PRStatusMERGED, Repository: "api"},
+				{ID: "pr-1", Name: "name-web", AuthorID: "u1", Status: models.PRStatusOPEN, Repository: "web"},
+			}, reviews)
+		},
+	},
+	{
+		name: "delete repository",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1")
+			for _, name := range []string{"api", "web"} {
+				_, err := repo.RepositoryAdd(ctx, models.Repository{Name: name})
+				require.NoError(t, err)
+			}
+
+			_, err := repo.PullRequestCreate(ctx, models.PR{
+				ID:         "pr-1",
+				Name:       "name-pr-1",
+				AuthorID:   "u1",
+				TeamID:     teamID,
+				Repository: "api",
+			})
+			require.NoError(t, err)
+
+			require.ErrorIs(t, repo.RepositoryDelete(ctx, "api"), modelsErr.ErrRepositoryHasPRs)
+			require.ErrorIs(t, repo.RepositoryDelete(ctx, "mobile"), modelsErr.ErrRepositoryNotFound)
+			require.NoError(t, repo.RepositoryDelete(ctx, "web"))
+
+			_, err = repo.RepositoryGet(ctx, "web")
+			require.ErrorIs(t, err, modelsErr.ErrRepositoryNotFound)
+
+			_, err = repo.RepositoryGet(ctx, "api")
+			require.NoError(t, err)
+		},
+	},
+}
@@ -0,0 +1,154 @@
+package repositorytest
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/middleware/repo_middleware"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+type Transactor interface {
+	WithTx(ctx context.Context, function func(ctx context.Context) error, opts ...models.TxOption) error
+}
+
+type Backend struct {
+	Repository repo_middleware.Repository
+	Transactor Transactor
+}
+
+// Factory возвращает пустое хранилище; вызывается заново для каждого подтеста
+type Factory func(t *testing.T) Backend
+
+type testCase struct {
+	name string
+	run  func(t *testing.T, backend Backend)
+}
+
+// Run проверяет, что хранилище ведёт себя так же, как postgres.
+// Подтесты идут последовательно: реализации может достаться одна общая база.
+func Run(t *testing.T, factory Factory) {
+	t.Helper()
+
+	suites := []struct {
+		name  string
+		cases []testCase
+	}{
+		{name: "Team", cases: teamCases},
+		{name: "TeamMembership", cases: teamMembershipCases},
+		{name: "TeamSettings", cases: teamSettingsCases},
+		{name: "User", cases: userCases},
+		{name: "Candidates", cases: candidateCases},
+		{name: "PullRequest", cases: pullRequestCases},
+		{name: "Escalation", cases: escalationCases},
+		{name: "Stats", cases: statsCases},
+		{name: "Repository", cases: repositoryCases},
+		{name: "Idempotency", cases: idempotencyCases},
+		{name: "Transactor", cases: transactorCases},
+		{name: "Transfer", cases: transferCases},
+		{name: "Organization", cases: organizationCases},
+	}
+
+	for _, suite := range suites {
+		t.Run(suite.name, func(t *testing.T) {
+			for _, tc := range suite.cases {
+				t.Run(tc.name, func(t *testing.T) {
+					tc.run(t, factory(t))
+				})
+			}
+		})
+	}
+}
+
+func addTeam(t *testing.T, repo repo_middleware.Repository, teamName string, userIDs ...string) string {
+	t.Helper()
+	return addOrgTeam(t, context.Background(), repo, teamName, userIDs...)
+}
+
+// addOrgTeam создаёт команду в организации из ctx
+func addOrgTeam(t *testing.T, ctx context.Context, repo repo_middleware.Repository, teamName string, userIDs ...string) string {
+	t.Helper()
+
+	members := make([]models.Member, len(userIDs))
+	for i, userID := range userIDs {
+		members[i] = models.Member{UserID: userID, Username: "name-" + userID, IsActive: true}
+	}
+	require.NoError(t, repo.TeamAdd(ctx, models.Team{Name: teamName, Members: members}))
+
+	return orgTeamID(t, ctx, repo, userIDs[0], teamName)
+}
+
+func teamID(t *testing.T, repo repo_middleware.Repository, userID, teamName string) string {
+	t.Helper()
+	return orgTeamID(t, context.Background(), repo, userID, teamName)
+}
+
+func orgTeamID(t *testing.T, ctx context.Context, repo repo_middleware.Repository, userID, teamName string) string {
+	t.Helper()
+
+	teams, err := repo.GetUserTeams(ctx, userID)
+	require.NoError(t, err)
+
+	i := slices.IndexFunc(teams, func(team models.UserTeam) bool { return team.Name == teamName })
+	require.GreaterOrEqual(t, i, 0, "user %s is not a member of %s", userID, teamName)
+	return teams[i].ID
+}
+
+func createPR(
+	t *testing.T,
+	repo repo_middleware.Repository,
+	teamID, prID, authorID string,
+	reviewers ...string,
+) *models.PR {
+	t.Helper()
+	return createOrgPR(t, context.Background(), repo, teamID, prID, authorID, reviewers...)
+}
+
+func createOrgPR(
+	t *testing.T,
+	ctx context.Context,
+	repo repo_middleware.Repository,
+	teamID, prID, authorID string,
+	reviewers ...string,
+) *models.PR {
+	t.Helper()
+
+	pr, err := repo.PullRequestCreate(ctx, models.PR{
+		ID:                prID,
+		Name:              "name-" + prID,
+		AuthorID:          authorID,
+		TeamID:            teamID,
+		AssignedReviewers: reviewers,
+	})
+	require.NoError(t, err)
+	return pr
+}
+
+func candidateIDs(candidates []models.Candidate) []string {
+	userIDs := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		userIDs[i] = candidate.UserID
+	}
+	return userIDs
+}
+
+func auditActions(entries []models.TeamAuditEntry) []models.TeamAuditAction {
+	actions := make([]models.TeamAuditAction, len(entries))
+	for i, entry := range entries {
+		actions[i] = entry.Action
+	}
+	return actions
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// время с точностью postgres, в UTC
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
@@ -0,0 +1,96 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+var statsCases = []testCase{
+	{
+		name: "team stats",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			backendID := addTeam(t, repo, "backend", "u1", "u2", "u3")
+			addTeam(t, repo, "payments", "u4")
+			_, err := repo.SetIsActive(ctx, "u3", false)
+			require.NoError(t, err)
+
+			createPR(t, repo, backendID, "pr-1", "u1", "u2")
+			createPR(t, repo, backendID, "pr-2", "u2", "u1")
+			_, err = repo.PullRequestMerge(ctx, "", "pr-2")
+			require.NoError(t, err)
+
+			filter := models.TeamStatsFilter{
+				Since:       now().Add(-time.Hour),
+				StaleBefore: now().Add(time.Hour),
+				Limit:       10,
+			}
+
+			stats, total, err := repo.TeamStats(ctx, filter)
+			require.NoError(t, err)
+			require.EqualValues(t, 2, total)
+			require.Len(t, stats, 2)
+
+			backendStats := stats[0]
+			require.Equal(t, "backend", backendStats.TeamName)
+			require.Equal(t, 2, backendStats.ActiveMembers)
+			require.Equal(t, 1, backendStats.StaleOpenPRs)
+			require.NotNil(t, backendStats.TimeToMergeP50)
+			require.NotNil(t, backendStats.TimeToMergeP90)
+			require.GreaterOrEqual(t, *backendStats.TimeToMergeP50, time.Duration(0))
+
+			require.Len(t, backendStats.Weekly, 1)
+			require.True(t, weekStart(now()).Equal(backendStats.Weekly[0].WeekStart), backendStats.Weekly[0].WeekStart)
+			require.Equal(t, 2, backendStats.Weekly[0].Opened)
+			require.Equal(t, 1, backendStats.Weekly[0].Merged)
+
+			paymentsStats := stats[1]
+			require.Equal(t, "payments", paymentsStats.TeamName)
+			require.Equal(t, 1, paymentsStats.ActiveMembers)
+			require.Zero(t, paymentsStats.StaleOpenPRs)
+			require.Empty(t, paymentsStats.Weekly)
+			require.Nil(t, paymentsStats.TimeToMergeP50)
+
+			filter.StaleBefore = now().Add(-time.Hour)
+			filter.Limit = 1
+			stats, total, err = repo.TeamStats(ctx, filter)
+			require.NoError(t, err)
+			require.EqualValues(t, 2, total)
+			require.Len(t, stats, 1)
+			require.Equal(t, "backend", stats[0].TeamName)
+			require.Zero(t, stats[0].StaleOpenPRs)
+
+			filter.Offset = 1
+			stats, _, err = repo.TeamStats(ctx, filter)
+			require.NoError(t, err)
+			require.Len(t, stats, 1)
+			require.Equal(t, "payments", stats[0].TeamName)
+
+			filter.Offset = 2
+			stats, total, err = repo.TeamStats(ctx, filter)
+			require.NoError(t, err)
+			require.EqualValues(t, 2, total)
+			require.Empty(t, stats)
+
+			filter.Offset = 0
+			filter.Since = now().Add(time.Hour)
+			stats, _, err = repo.TeamStats(ctx, filter)
+			require.NoError(t, err)
+			require.Empty(t, stats[0].Weekly)
+			require.Nil(t, stats[0].TimeToMergeP50)
+		},
+	},
+}
+
+// понедельник недели t в UTC
+func weekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
@@ -0,0 +1,151 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var teamCases = []testCase{
+	{
+		name: "add and get team",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			require.NoError(t, repo.TeamAdd(ctx, models.Team{
+				Name: "backend",
+				Members: []models.Member{
+					{UserID: "u2", Username: "Bob", IsActive: false},
+					{UserID: "u1", Username: "Alice", IsActive: true},
+				},
+			}))
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.Equal(t, "backend", team.Name)
+			require.EqualValues(t, 1, team.Version)
+			require.Equal(t, []models.Member{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: false},
+			}, team.Members)
+
+			version, err := repo.GetTeamVersion(ctx, "backend")
+			require.NoError(t, err)
+			require.EqualValues(t, 1, version)
+		},
+	},
+	{
+		name: "duplicate team",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+
+			err := repo.TeamAdd(ctx, models.Team{
+				Name:    "backend",
+				Members: []models.Member{{UserID: "u2", Username: "Bob", IsActive: true}},
+			})
+			require.ErrorIs(t, err, modelsErr.ErrTeamExist)
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.Equal(t, []models.Member{{UserID: "u1", Username: "name-u1", IsActive: true}}, team.Members)
+
+			_, err = repo.GetUser(ctx, "u2")
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+		},
+	},
+	{
+		name: "team without members",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			for _, members := range [][]models.Member{nil, {}} {
+				err := repo.TeamAdd(ctx, models.Team{Name: "backend", Members: members})
+				require.ErrorIs(t, err, modelsErr.ErrEmptyTeam)
+			}
+
+			_, err := repo.GetTeamVersion(ctx, "backend")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+		},
+	},
+	{
+		name: "missing team",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.NotNil(t, team.Members)
+			require.Empty(t, team.Members)
+
+			_, err = repo.GetTeamVersion(ctx, "backend")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			_, err = repo.TeamAudit(ctx, "backend")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+		},
+	},
+	{
+		name: "user in several teams",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			backendID := addTeam(t, repo, "backend", "u1")
+			require.NoError(t, repo.TeamAdd(ctx, models.Team{
+				Name:    "payments",
+				Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: false}},
+			}))
+
+			teams, err := repo.GetUserTeams(ctx, "u1")
+			require.NoError(t, err)
+			require.Len(t, teams, 2)
+			require.Equal(t, models.UserTeam{ID: backendID, Name: "backend"}, teams[0])
+			require.Equal(t, "payments", teams[1].Name)
+			require.NotEqual(t, backendID, teams[1].ID)
+
+			user, err := repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.Equal(t, "Alice", user.Name)
+			require.False(t, user.IsActive)
+			require.Equal(t, "backend", user.TeamName)
+			require.Equal(t, []string{"backend", "payments"}, user.TeamNames)
+
+			_, err = repo.GetUserTeams(ctx, "u2")
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+		},
+	},
+	{
+		name: "team audit",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2")
+
+			entries, err := repo.TeamAudit(ctx, "backend")
+			require.NoError(t, err)
+			require.Equal(t, []models.TeamAuditAction{
+				models.TeamAuditTeamCreated,
+				models.TeamAuditMemberAdded,
+				models.TeamAuditMemberAdded,
+			}, auditActions(entries))
+			require.Nil(t, entries[0].UserID)
+			require.ElementsMatch(t, []string{"u1", "u2"}, []string{*entries[1].UserID, *entries[2].UserID})
+			for _, entry := range entries {
+				require.Equal(t, "backend", entry.TeamName)
+				require.Nil(t, entry.PreviousTeamName)
+				require.False(t, entry.CreatedAt.IsZero())
+			}
+		},
+	},
+}
@@ -0,0 +1,303 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var teamMembershipCases = []testCase{
+	{
+		name: "add and remove members",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2")
+
+			require.NoError(t, repo.TeamUpdateMembers(ctx, models.TeamMembersChange{
+				TeamName: "backend",
+				Add:      []models.Member{{UserID: "u3", Username: "Carol", IsActive: true}},
+				Remove:   []string{"u1"},
+			}))
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.EqualValues(t, 2, team.Version)
+			require.Equal(t, []models.Member{
+				{UserID: "u2", Username: "name-u2", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			}, team.Members)
+
+			teams, err := repo.GetUserTeams(ctx, "u1")
+			require.NoError(t, err)
+			require.NotNil(t, teams)
+			require.Empty(t, teams)
+
+			entries, err := repo.TeamAudit(ctx, "backend")
+			require.NoError(t, err)
+			require.Equal(t, []models.TeamAuditAction{
+				models.TeamAuditTeamCreated,
+				models.TeamAuditMemberAdded,
+				models.TeamAuditMemberAdded,
+				models.TeamAuditMemberAdded,
+				models.TeamAuditMemberRemoved,
+			}, auditActions(entries))
+			require.Equal(t, "u3", *entries[3].UserID)
+			require.Equal(t, "u1", *entries[4].UserID)
+		},
+	},
+	{
+		name: "re-adding a member updates the user",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2")
+
+			require.NoError(t, repo.TeamUpdateMembers(ctx, models.TeamMembersChange{
+				TeamName: "backend",
+				Add:      []models.Member{{UserID: "u1", Username: "Alice", IsActive: false}},
+			}))
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.EqualValues(t, 2, team.Version)
+			require.Equal(t, []models.Member{
+				{UserID: "u1", Username: "Alice", IsActive: false},
+				{UserID: "u2", Username: "name-u2", IsActive: true},
+			}, team.Members)
+
+			entries, err := repo.TeamAudit(ctx, "backend")
+			require.NoError(t, err)
+			require.Len(t, entries, 3)
+		},
+	},
+	{
+		name: "move members",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2")
+			addTeam(t, repo, "payments", "u3")
+
+			require.NoError(t, repo.TeamUpdateMembers(ctx, models.TeamMembersChange{
+				TeamName: "payments",
+				Add:      []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+				Move:     true,
+			}))
+
+			teams, err := repo.GetUserTeams(ctx, "u1")
+			require.NoError(t, err)
+			require.Len(t, teams, 1)
+			require.Equal(t, "payments", teams[0].Name)
+
+			for teamName, wantMembers := range map[string][]string{
+				"backend":  {"u2"},
+				"payments": {"u3", "u1"},
+			} {
+				team, err := repo.TeamGet(ctx, teamName)
+				require.NoError(t, err)
+				require.EqualValues(t, 2, team.Version, teamName)
+
+				var members []string
+				for _, member := range team.Members {
+					members = append(members, member.UserID)
+				}
+				require.Equal(t, wantMembers, members, teamName)
+
+				entries, err := repo.TeamAudit(ctx, teamName)
+				require.NoError(t, err)
+
+				moved := entries[len(entries)-1]
+				require.Equal(t, models.TeamAuditMemberMoved, moved.Action, teamName)
+				require.Equal(t, "payments", moved.TeamName)
+				require.Equal(t, "u1", *moved.UserID)
+				require.Equal(t, "backend", *moved.PreviousTeamName)
+			}
+		},
+	},
+	{
+		name: "invalid membership changes",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2")
+
+			tests := []struct {
+				name    string
+				change  models.TeamMembersChange
+				wantErr error
+			}{
+				{
+					name: "missing team",
+					change: models.TeamMembersChange{
+						TeamName: "payments",
+						Add:      []models.Member{{UserID: "u3", Username: "Carol", IsActive: true}},
+					},
+					wantErr: modelsErr.ErrTeamNotFound,
+				},
+				{
+					name: "remove user from another team",
+					change: models.TeamMembersChange{
+						TeamName: "backend",
+						Add:      []models.Member{{UserID: "u3", Username: "Carol", IsActive: true}},
+						Remove:   []string{"u4"},
+					},
+					wantErr: modelsErr.ErrUserNotInTeam,
+				},
+				{
+					name: "remove every member",
+					change: models.TeamMembersChange{
+						TeamName: "backend",
+						Remove:   []string{"u1", "u2"},
+					},
+					wantErr: modelsErr.ErrEmptyTeam,
+				},
+			}
+
+			for _, tt := range tests {
+				err := repo.TeamUpdateMembers(ctx, tt.change)
+				require.ErrorIs(t, err, tt.wantErr, tt.name)
+			}
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.EqualValues(t, 1, team.Version)
+			require.Len(t, team.Members, 2)
+
+			_, err = repo.GetUser(ctx, "u3")
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+		},
+	},
+	{
+		name: "rename team",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+			addTeam(t, repo, "payments", "u2")
+
+			require.ErrorIs(t, repo.TeamRename(ctx, "backend", "payments"), modelsErr.ErrTeamExist)
+			require.ErrorIs(t, repo.TeamRename(ctx, "frontend", "web"), modelsErr.ErrTeamNotFound)
+
+			require.NoError(t, repo.TeamRename(ctx, "backend", "platform"))
+
+			team, err := repo.TeamGet(ctx, "platform")
+			require.NoError(t, err)
+			require.EqualValues(t, 2, team.Version)
+			require.Len(t, team.Members, 1)
+
+			_, err = repo.GetTeamVersion(ctx, "backend")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			user, err := repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.Equal(t, "platform", user.TeamName)
+
+			entries, err := repo.TeamAudit(ctx, "platform")
+			require.NoError(t, err)
+
+			renamed := entries[len(entries)-1]
+			require.Equal(t, models.TeamAuditTeamRenamed, renamed.Action)
+			require.Equal(t, "platform", renamed.TeamName)
+			require.Equal(t, "backend", *renamed.PreviousTeamName)
+		},
+	},
+	{
+		name: "delete team with open pull requests",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2")
+			createPR(t, repo, teamID, "pr-1", "u1", "u2")
+
+			_, err := repo.TeamDelete(ctx, "backend", models.OpenPRPolicyReject)
+			require.ErrorIs(t, err, modelsErr.ErrTeamHasOpenPRs)
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.Len(t, team.Members, 2)
+
+			deletion, err := repo.TeamDelete(ctx, "backend", models.OpenPRPolicyUnassign)
+			require.NoError(t, err)
+			require.Equal(t, "backend", deletion.TeamName)
+			require.ElementsMatch(t, []string{"u1", "u2"}, deletion.ReleasedMembers)
+			require.Equal(t, 1, deletion.UnassignedReviews)
+
+			pr, err := repo.GetPullRequest(ctx, "", "pr-1")
+			require.NoError(t, err)
+			require.Empty(t, pr.TeamID)
+			require.Empty(t, pr.AssignedReviewers)
+			require.EqualValues(t, 2, pr.Version)
+
+			teams, err := repo.GetUserTeams(ctx, "u1")
+			require.NoError(t, err)
+			require.Empty(t, teams)
+
+			_, err = repo.TeamAudit(ctx, "backend")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			_, err = repo.TeamDelete(ctx, "backend", models.OpenPRPolicyReject)
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+		},
+	},
+	{
+		name: "delete team cascades",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			backendID := addTeam(t, repo, "backend", "u1")
+			paymentsID := addTeam(t, repo, "payments", "u2")
+
+			require.NoError(t, repo.SetTeamFallbacks(ctx, "backend", []string{"payments"}))
+			require.NoError(t, repo.SetTeamFallbacks(ctx, "payments", []string{"backend"}))
+			require.NoError(t, repo.SetCodeOwners(ctx, "backend", []models.CodeOwnersRule{
+				{Pattern: "*", Owners: []models.CodeOwner{{Kind: models.CodeOwnerUser, Name: "u1"}}},
+			}))
+			_, err := repo.RepositoryAdd(ctx, models.Repository{Name: "api", OwnerTeam: "backend"})
+			require.NoError(t, err)
+
+			merged := createPR(t, repo, backendID, "pr-1", "u1")
+			_, err = repo.PullRequestMerge(ctx, "", merged.ID)
+			require.NoError(t, err)
+
+			deletion, err := repo.TeamDelete(ctx, "backend", models.OpenPRPolicyReject)
+			require.NoError(t, err)
+			require.Equal(t, []string{"u1"}, deletion.ReleasedMembers)
+			require.Zero(t, deletion.UnassignedReviews)
+
+			fallbacks, err := repo.GetFallbackTeamIDs(ctx, paymentsID)
+			require.NoError(t, err)
+			require.Empty(t, fallbacks)
+
+			fallbacks, err = repo.GetFallbackTeamIDs(ctx, backendID)
+			require.NoError(t, err)
+			require.Empty(t, fallbacks)
+
+			rules, err := repo.GetCodeOwners(ctx, backendID)
+			require.NoError(t, err)
+			require.Empty(t, rules)
+
+			repository, err := repo.RepositoryGet(ctx, "api")
+			require.NoError(t, err)
+			require.Empty(t, repository.OwnerTeam)
+			require.Empty(t, repository.OwnerTeamID)
+
+			_, err = repo.GetTeamPolicy(ctx, backendID)
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			_, err = repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+		},
+	},
+}
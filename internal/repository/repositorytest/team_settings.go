@@ -0,0 +1,161 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var teamSettingsCases = []testCase{
+	{
+		name: "default team policy",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1")
+
+			policy, err := repo.GetTeamPolicy(ctx, teamID)
+			require.NoError(t, err)
+			require.Equal(t, &models.TeamPolicy{
+				TeamName:         "backend",
+				EscalationPolicy: models.EscalationPolicyReassign,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+				RotationWindow:   30 * 24 * time.Hour,
+			}, policy)
+		},
+	},
+	{
+		name: "set team policy",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1")
+
+			want := models.TeamPolicy{
+				TeamName:              "backend",
+				ReviewSLA:             ptr(90 * time.Minute),
+				EscalationPolicy:      models.EscalationPolicyAddReviewer,
+				DefaultMaxOpenReviews: ptr(3),
+				ShortfallPolicy:       models.ShortfallPolicyReject,
+				SelectionMode:         models.SelectionModeRotation,
+				RotationWindow:        7 * 24 * time.Hour,
+			}
+
+			policy, err := repo.SetTeamPolicy(ctx, want)
+			require.NoError(t, err)
+			require.Equal(t, &want, policy)
+
+			policy, err = repo.GetTeamPolicy(ctx, teamID)
+			require.NoError(t, err)
+			require.Equal(t, &want, policy)
+
+			want.ReviewSLA = nil
+			want.DefaultMaxOpenReviews = nil
+			policy, err = repo.SetTeamPolicy(ctx, want)
+			require.NoError(t, err)
+			require.Equal(t, &want, policy)
+
+			_, err = repo.SetTeamPolicy(ctx, models.TeamPolicy{
+				TeamName:         "payments",
+				EscalationPolicy: models.EscalationPolicyReassign,
+				ShortfallPolicy:  models.ShortfallPolicyAllow,
+				SelectionMode:    models.SelectionModeDefault,
+			})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			_, err = repo.GetTeamPolicy(ctx, "999999")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+		},
+	},
+	{
+		name: "fallback teams",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			backendID := addTeam(t, repo, "backend", "u1")
+			paymentsID := addTeam(t, repo, "payments", "u2")
+			platformID := addTeam(t, repo, "platform", "u3")
+
+			fallbacks, err := repo.GetFallbackTeamIDs(ctx, backendID)
+			require.NoError(t, err)
+			require.Empty(t, fallbacks)
+
+			require.NoError(t, repo.SetTeamFallbacks(ctx, "backend", []string{"platform", "payments"}))
+
+			fallbacks, err = repo.GetFallbackTeamIDs(ctx, backendID)
+			require.NoError(t, err)
+			require.Equal(t, []string{platformID, paymentsID}, fallbacks)
+
+			err = repo.SetTeamFallbacks(ctx, "backend", []string{"payments", "frontend"})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			err = repo.SetTeamFallbacks(ctx, "frontend", []string{"payments"})
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+
+			fallbacks, err = repo.GetFallbackTeamIDs(ctx, backendID)
+			require.NoError(t, err)
+			require.Equal(t, []string{platformID, paymentsID}, fallbacks)
+
+			require.NoError(t, repo.SetTeamFallbacks(ctx, "backend", nil))
+
+			fallbacks, err = repo.GetFallbackTeamIDs(ctx, backendID)
+			require.NoError(t, err)
+			require.Empty(t, fallbacks)
+		},
+	},
+	{
+		name: "codeowners",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1")
+
+			rules, err := repo.GetTeamCodeOwners(ctx, "backend")
+			require.NoError(t, err)
+			require.NotNil(t, rules)
+			require.Empty(t, rules)
+
+			want := []models.CodeOwnersRule{
+				{Pattern: "*", Owners: []models.CodeOwner{{Kind: models.CodeOwnerTeam, Name: "backend"}}},
+				{Pattern: "/db/", Owners: []models.CodeOwner{
+					{Kind: models.CodeOwnerUser, Name: "u1"},
+					{Kind: models.CodeOwnerTeam, Name: "platform"},
+				}},
+			}
+			require.NoError(t, repo.SetCodeOwners(ctx, "backend", want))
+
+			rules, err = repo.GetTeamCodeOwners(ctx, "backend")
+			require.NoError(t, err)
+			require.Equal(t, want, rules)
+
+			rules, err = repo.GetCodeOwners(ctx, teamID)
+			require.NoError(t, err)
+			require.Equal(t, want, rules)
+
+			require.NoError(t, repo.SetCodeOwners(ctx, "backend", nil))
+
+			rules, err = repo.GetTeamCodeOwners(ctx, "backend")
+			require.NoError(t, err)
+			require.Empty(t, rules)
+
+			rules, err = repo.GetCodeOwners(ctx, teamID)
+			require.NoError(t, err)
+			require.Empty(t, rules)
+
+			require.ErrorIs(t, repo.SetCodeOwners(ctx, "payments", want), modelsErr.ErrTeamNotFound)
+
+			_, err = repo.GetTeamCodeOwners(ctx, "payments")
+			require.ErrorIs(t, err, modelsErr.ErrTeamNotFound)
+		},
+	},
+}
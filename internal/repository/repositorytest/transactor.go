@@ -0,0 +1,176 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var transactorCases = []testCase{
+	{
+		name: "commit",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			err := backend.Transactor.WithTx(ctx, func(ctx context.Context) error {
+				if err := repo.TeamAdd(ctx, models.Team{
+					Name:    "backend",
+					Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+				}); err != nil {
+					return err
+				}
+
+				// запись видна внутри своей транзакции
+				team, err := repo.TeamGet(ctx, "backend")
+				if err != nil {
+					return err
+				}
+				if len(team.Members) != 1 {
+					return fmt.Errorf("team has %d members", len(team.Members))
+				}
+				return nil
+			})
+			require.NoError(t, err)
+
+			team, err := repo.TeamGet(ctx, "backend")
+			require.NoError(t, err)
+			require.Len(t, team.Members, 1)
+		},
+	},
+	{
+		name: "rollback",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "u1", "u2")
+			errTest := errors.New("test error")
+
+			err := backend.Transactor.WithTx(ctx, func(ctx context.Context) error {
+				if _, err := repo.PullRequestCreate(ctx, models.PR{
+					ID:                "pr-1",
+					Name:              "name-pr-1",
+					AuthorID:          "u1",
+					TeamID:            teamID,
+					AssignedReviewers: []string{"u2"},
+				}); err != nil {
+					return err
+				}
+				if _, err := repo.SetIsActive(ctx, "u2", false); err != nil {
+					return err
+				}
+				return errTest
+			})
+			require.ErrorIs(t, err, errTest)
+
+			_, err = repo.GetPullRequest(ctx, "", "pr-1")
+			require.ErrorIs(t, err, modelsErr.ErrPRNotFound)
+
+			user, err := repo.GetUser(ctx, "u2")
+			require.NoError(t, err)
+			require.True(t, user.IsActive)
+		},
+	},
+	{
+		name: "nested transaction",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+			errTest := errors.New("test error")
+
+			err := backend.Transactor.WithTx(ctx, func(ctx context.Context) error {
+				err := backend.Transactor.WithTx(ctx, func(ctx context.Context) error {
+					return repo.TeamRename(ctx, "backend", "platform")
+				})
+				if err != nil {
+					return err
+				}
+				return errTest
+			})
+			require.ErrorIs(t, err, errTest)
+
+			version, err := repo.GetTeamVersion(ctx, "backend")
+			require.NoError(t, err)
+			require.EqualValues(t, 1, version)
+		},
+	},
+	{
+		name: "read-only transaction rejects writes",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+
+			err := backend.Transactor.WithTx(ctx, func(ctx context.Context) error {
+				_, err := repo.TeamGet(ctx, "backend")
+				require.NoError(t, err)
+
+				_, err = repo.SetIsActive(ctx, "u1", false)
+				return err
+			}, models.WithReadOnly())
+			require.Error(t, err)
+
+			user, err := repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.True(t, user.IsActive)
+		},
+	},
+	{
+		name: "concurrent assignments respect review cap",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			teamID := addTeam(t, repo, "backend", "author", "reviewer")
+			_, err := repo.SetMaxOpenReviews(ctx, "reviewer", ptr(1))
+			require.NoError(t, err)
+
+			// GetActiveTeammates блокирует кандидатов до конца транзакции,
+			// поэтому лимит не превышается при параллельном назначении
+			const workers = 8
+			var wg sync.WaitGroup
+			errs := make(chan error, workers)
+			for i := range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					errs <- backend.Transactor.WithTx(ctx, func(ctx context.Context) error {
+						candidates, err := repo.GetActiveTeammates(ctx, teamID, []string{"author"}, now())
+						if err != nil {
+							return err
+						}
+						_, err = repo.PullRequestCreate(ctx, models.PR{
+							ID:                fmt.Sprintf("pr-%d", i),
+							Name:              fmt.Sprintf("name-pr-%d", i),
+							AuthorID:          "author",
+							TeamID:            teamID,
+							AssignedReviewers: candidateIDs(candidates),
+						})
+						return err
+					})
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				require.NoError(t, err)
+			}
+
+			reviews, err := repo.GetReview(ctx, "reviewer")
+			require.NoError(t, err)
+			require.Len(t, reviews, 1)
+		},
+	},
+}
@@ -0,0 +1,172 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/middleware/repo_middleware"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var transferCases = []testCase{
+	{
+		name: "export teams, users and pull requests",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			backendID := addTeam(t, repo, "backend", "u1", "u2")
+			paymentsID := addTeam(t, repo, "payments", "u3")
+			_, err := repo.SetMaxOpenReviews(ctx, "u3", ptr(2))
+			require.NoError(t, err)
+			createPR(t, repo, backendID, "pr-1", "u1", "u2")
+			createPR(t, repo, paymentsID, "pr-2", "u3")
+
+			require.Equal(t, []models.Team{
+				{Name: "backend", Members: []models.Member{
+					{UserID: "u1", Username: "name-u1", IsActive: true},
+					{UserID: "u2", Username: "name-u2", IsActive: true},
+				}},
+				{Name: "payments", Members: []models.Member{
+					{UserID: "u3", Username: "name-u3", IsActive: true},
+				}},
+			}, exportTeams(t, repo))
+
+			require.Equal(t, []models.User{
+				{ID: "u1", Name: "name-u1", IsActive: true, TimeZone: "UTC"},
+				{ID: "u2", Name: "name-u2", IsActive: true, TimeZone: "UTC"},
+				{ID: "u3", Name: "name-u3", IsActive: true, TimeZone: "UTC", MaxOpenReviews: ptr(2)},
+			}, exportUsers(t, repo))
+
+			prs := exportPullRequests(t, repo)
+			require.Len(t, prs, 2)
+			require.Equal(t, "pr-1", prs[0].ID)
+			require.Equal(t, "backend", prs[0].TeamName)
+			require.Equal(t, []string{"u2"}, prs[0].AssignedReviewers)
+			require.NotNil(t, prs[0].CreatedAt)
+			require.Equal(t, "pr-2", prs[1].ID)
+			require.Equal(t, "payments", prs[1].TeamName)
+			require.Equal(t, []string{}, prs[1].AssignedReviewers)
+		},
+	},
+	{
+		name: "import user",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			workingHours := &models.WorkingHours{Start: 9 * time.Hour, End: 18 * time.Hour}
+			require.NoError(t, repo.ImportUser(ctx, models.User{
+				ID:             "u1",
+				Name:           "Alice",
+				IsActive:       true,
+				TimeZone:       "Europe/Moscow",
+				WorkingHours:   workingHours,
+				MaxOpenReviews: ptr(3),
+			}))
+
+			user, err := repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.Equal(t, "Alice", user.Name)
+			require.Equal(t, "Europe/Moscow", user.TimeZone)
+			require.Equal(t, workingHours, user.WorkingHours)
+			require.Equal(t, ptr(3), user.MaxOpenReviews)
+
+			require.NoError(t, repo.ImportUser(ctx, models.User{
+				ID:       "u1",
+				Name:     "Alicia",
+				TimeZone: "UTC",
+			}))
+
+			require.Equal(t, []models.User{
+				{ID: "u1", Name: "Alicia", TimeZone: "UTC"},
+			}, exportUsers(t, repo))
+		},
+	},
+	{
+		name: "import pull request",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2", "u3")
+			_, err := repo.RepositoryAdd(ctx, models.Repository{Name: "api"})
+			require.NoError(t, err)
+
+			createdAt := now().Add(-48 * time.Hour)
+			mergedAt := createdAt.Add(time.Hour)
+			merged := models.PR{
+				ID:                "pr-1",
+				Name:              "old feature",
+				AuthorID:          "u1",
+				Status:            models.PRStatusMERGED,
+				AssignedReviewers: []string{"u2", "u3"},
+				FallbackReviewers: []string{"u3"},
+				CreatedAt:         &createdAt,
+				MergedAt:          &mergedAt,
+				Repository:        "api",
+				TeamName:          "backend",
+			}
+			require.NoError(t, repo.ImportPullRequest(ctx, merged))
+
+			open := models.PR{
+				ID:                "pr-1",
+				Name:              "same id outside repository",
+				AuthorID:          "u2",
+				Status:            models.PRStatusOPEN,
+				AssignedReviewers: []string{},
+				CreatedAt:         &mergedAt,
+			}
+			require.NoError(t, repo.ImportPullRequest(ctx, open))
+
+			require.Equal(t, []models.PR{merged, open}, exportPullRequests(t, repo))
+
+			_, err = repo.GetPullRequestVersion(ctx, "api", "pr-1")
+			require.NoError(t, err)
+
+			err = repo.ImportPullRequest(ctx, merged)
+			require.ErrorIs(t, err, modelsErr.ErrPullRequestExist)
+
+			merged.Repository = "mobile"
+			err = repo.ImportPullRequest(ctx, merged)
+			require.ErrorIs(t, err, modelsErr.ErrRepositoryNotFound)
+		},
+	},
+}
+
+func exportTeams(t *testing.T, repo repo_middleware.Repository) []models.Team {
+	t.Helper()
+
+	var teams []models.Team
+	require.NoError(t, repo.ExportTeams(context.Background(), func(team models.Team) error {
+		teams = append(teams, team)
+		return nil
+	}))
+	return teams
+}
+
+func exportUsers(t *testing.T, repo repo_middleware.Repository) []models.User {
+	t.Helper()
+
+	var users []models.User
+	require.NoError(t, repo.ExportUsers(context.Background(), func(user models.User) error {
+		users = append(users, user)
+		return nil
+	}))
+	return users
+}
+
+func exportPullRequests(t *testing.T, repo repo_middleware.Repository) []models.PR {
+	t.Helper()
+
+	var prs []models.PR
+	require.NoError(t, repo.ExportPullRequests(context.Background(), func(pr models.PR) error {
+		prs = append(prs, pr)
+		return nil
+	}))
+	return prs
+}
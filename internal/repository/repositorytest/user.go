@@ -0,0 +1,205 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+var userCases = []testCase{
+	{
+		name: "get user",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+
+			user, err := repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.Equal(t, &models.User{
+				ID:        "u1",
+				Name:      "name-u1",
+				IsActive:  true,
+				TeamName:  "backend",
+				TeamNames: []string{"backend"},
+				TimeZone:  "UTC",
+			}, user)
+
+			_, err = repo.GetUser(ctx, "u2")
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+		},
+	},
+	{
+		name: "set is active",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+
+			user, err := repo.SetIsActive(ctx, "u1", false)
+			require.NoError(t, err)
+			require.Equal(t, &models.User{
+				ID:        "u1",
+				Name:      "name-u1",
+				IsActive:  false,
+				TeamName:  "backend",
+				TeamNames: []string{"backend"},
+			}, user)
+
+			user, err = repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.False(t, user.IsActive)
+
+			_, err = repo.SetIsActive(ctx, "u2", false)
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+		},
+	},
+	{
+		name: "set schedule",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+
+			workingHours := &models.WorkingHours{Start: 9 * time.Hour, End: 18*time.Hour + 30*time.Minute}
+			user, err := repo.SetSchedule(ctx, "u1", "Europe/Moscow", workingHours)
+			require.NoError(t, err)
+			require.Equal(t, "Europe/Moscow", user.TimeZone)
+			require.Equal(t, workingHours, user.WorkingHours)
+			require.Equal(t, "backend", user.TeamName)
+
+			user, err = repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.Equal(t, "Europe/Moscow", user.TimeZone)
+			require.Equal(t, workingHours, user.WorkingHours)
+
+			user, err = repo.SetSchedule(ctx, "u1", "UTC", nil)
+			require.NoError(t, err)
+			require.Equal(t, "UTC", user.TimeZone)
+			require.Nil(t, user.WorkingHours)
+
+			_, err = repo.SetSchedule(ctx, "u2", "UTC", nil)
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+		},
+	},
+	{
+		name: "set max open reviews",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+
+			user, err := repo.SetMaxOpenReviews(ctx, "u1", ptr(2))
+			require.NoError(t, err)
+			require.Equal(t, ptr(2), user.MaxOpenReviews)
+			require.Equal(t, "backend", user.TeamName)
+
+			user, err = repo.GetUser(ctx, "u1")
+			require.NoError(t, err)
+			require.Equal(t, ptr(2), user.MaxOpenReviews)
+
+			user, err = repo.SetMaxOpenReviews(ctx, "u1", nil)
+			require.NoError(t, err)
+			require.Nil(t, user.MaxOpenReviews)
+
+			_, err = repo.SetMaxOpenReviews(ctx, "u2", ptr(2))
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+		},
+	},
+	{
+		name: "out of office",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1", "u2", "u3")
+			now := now()
+
+			periods := []models.OutOfOffice{
+				{UserID: "u1", From: now.Add(-time.Hour), To: now.Add(time.Hour), ReassignReviews: true},
+				{UserID: "u2", From: now.Add(-2 * time.Hour), To: now.Add(time.Hour), ReassignReviews: true},
+				{UserID: "u3", From: now.Add(-time.Hour), To: now.Add(time.Hour)},
+				{UserID: "u3", From: now.Add(time.Hour), To: now.Add(2 * time.Hour), ReassignReviews: true},
+			}
+			for i, period := range periods {
+				created, err := repo.CreateOutOfOffice(ctx, period)
+				require.NoError(t, err)
+				require.NotZero(t, created.ID)
+
+				period.ID = created.ID
+				require.Equal(t, period, *created)
+				// выборка по всем организациям возвращает организацию периода
+				period.Organization = models.DefaultOrganization
+				periods[i] = period
+			}
+
+			_, err := repo.CreateOutOfOffice(ctx, models.OutOfOffice{
+				UserID: "u4",
+				From:   now,
+				To:     now.Add(time.Hour),
+			})
+			require.ErrorIs(t, err, modelsErr.ErrUserNotFound)
+
+			started, err := repo.GetStartedOutOfOffice(ctx, now, 10)
+			require.NoError(t, err)
+			require.Equal(t, []models.OutOfOffice{periods[1], periods[0]}, started)
+
+			started, err = repo.GetStartedOutOfOffice(ctx, now, 1)
+			require.NoError(t, err)
+			require.Equal(t, []models.OutOfOffice{periods[1]}, started)
+
+			require.NoError(t, repo.MarkOutOfOfficeReassigned(ctx, periods[1].ID, now))
+
+			started, err = repo.GetStartedOutOfOffice(ctx, now, 10)
+			require.NoError(t, err)
+			require.Equal(t, []models.OutOfOffice{periods[0]}, started)
+
+			started, err = repo.GetStartedOutOfOffice(ctx, now.Add(90*time.Minute), 10)
+			require.NoError(t, err)
+			require.Equal(t, []models.OutOfOffice{periods[3]}, started)
+		},
+	},
+	{
+		name: "out of office with non-UTC offset",
+		run: func(t *testing.T, backend Backend) {
+			ctx := context.Background()
+			repo := backend.Repository
+
+			addTeam(t, repo, "backend", "u1")
+			now := now()
+			moscow := time.FixedZone("UTC+3", 3*60*60)
+
+			created, err := repo.CreateOutOfOffice(ctx, models.OutOfOffice{
+				UserID:          "u1",
+				From:            now.Add(-30 * time.Minute).In(moscow),
+				To:              now.Add(30 * time.Minute).In(moscow),
+				ReassignReviews: true,
+			})
+			require.NoError(t, err)
+
+			started, err := repo.GetStartedOutOfOffice(ctx, now.Add(-time.Hour), 10)
+			require.NoError(t, err)
+			require.Empty(t, started)
+
+			started, err = repo.GetStartedOutOfOffice(ctx, now, 10)
+			require.NoError(t, err)
+			require.Len(t, started, 1)
+			require.Equal(t, created.ID, started[0].ID)
+			require.True(t, now.Add(-30*time.Minute).Equal(started[0].From))
+			require.True(t, now.Add(30*time.Minute).Equal(started[0].To))
+
+			started, err = repo.GetStartedOutOfOffice(ctx, now.Add(time.Hour), 10)
+			require.NoError(t, err)
+			require.Empty(t, started)
+		},
+	},
+}
@@ -0,0 +1,165 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) SetCodeOwners(
+	ctx context.Context,
+	teamName string,
+	rules []models.CodeOwnersRule,
+) error {
+	logger := s.logger.With(
+		zap.String("team_name", teamName),
+		zap.Int("rules", len(rules)),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		teamID, err := s.getTeamID(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				logger.Warn("team not found")
+				return modelsErr.ErrTeamNotFound
+			}
+			logger.Error("get team ID query", zap.Error(err))
+			return err
+		}
+
+		deleteRules := s.queryBuilder.Delete("codeowners_rule").
+			Where(sq.Eq{"team_id": teamID})
+
+		if _, err = s.exec(ctx, "delete codeowners rules", deleteRules); err != nil {
+			logger.Error("delete codeowners rules", zap.Error(err))
+			return err
+		}
+
+		if len(rules) == 0 {
+			return nil
+		}
+
+		insertRules := s.queryBuilder.Insert("codeowners_rule").
+			Columns("team_id", "position", "pattern", "owners")
+		for position, rule := range rules {
+			owners := rule.Owners
+			if owners == nil {
+				owners = []models.CodeOwner{}
+			}
+			ownersJSON, err := json.Marshal(owners)
+			if err != nil {
+				logger.Error("marshal codeowners", zap.Error(err))
+				return err
+			}
+			insertRules = insertRules.Values(teamID, position, rule.Pattern, string(ownersJSON))
+		}
+
+		if _, err = s.exec(ctx, "insert codeowners rules", insertRules); err != nil {
+			logger.Error("insert codeowners rules", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *sqliteRepo) GetTeamCodeOwners(
+	ctx context.Context,
+	teamName string,
+) ([]models.CodeOwnersRule, error) {
+	logger := s.logger.With(zap.String("team_name", teamName))
+
+	getRules := s.queryBuilder.Select("r.pattern", "r.owners").
+		From("team t").
+		LeftJoin("codeowners_rule r ON r.team_id = t.id").
+		Where(sq.And{
+			sq.Eq{"t.name": teamName},
+			orgEq(ctx, "t.organization"),
+		}).
+		OrderBy("r.position")
+
+	rows, err := s.query(ctx, "get team codeowners", getRules)
+	if err != nil {
+		logger.Error("get team codeowners query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	teamFound := false
+	rules := make([]models.CodeOwnersRule, 0)
+	for rows.Next() {
+		teamFound = true
+
+		var pattern, owners *string
+		if err = rows.Scan(&pattern, &owners); err != nil {
+			logger.Error("scan codeowners rule", zap.Error(err))
+			return nil, err
+		}
+		if pattern == nil {
+			continue
+		}
+		rule := models.CodeOwnersRule{Pattern: *pattern}
+		if err = json.Unmarshal([]byte(*owners), &rule.Owners); err != nil {
+			logger.Error("unmarshal codeowners", zap.Error(err))
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate codeowners rules", zap.Error(err))
+		return nil, err
+	}
+
+	if !teamFound {
+		logger.Warn("team not found")
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return rules, nil
+}
+
+func (s *sqliteRepo) GetCodeOwners(
+	ctx context.Context,
+	teamID string,
+) ([]models.CodeOwnersRule, error) {
+	logger := s.logger.With(zap.String("team_id", teamID))
+
+	getRules := s.queryBuilder.Select("pattern", "owners").
+		From("codeowners_rule").
+		Where(sq.And{
+			sq.Eq{"team_id": teamID},
+			teamInOrg(ctx, "team_id"),
+		}).
+		OrderBy("position")
+
+	rows, err := s.query(ctx, "get codeowners", getRules)
+	if err != nil {
+		logger.Error("get codeowners query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []models.CodeOwnersRule
+	for rows.Next() {
+		var rule models.CodeOwnersRule
+		var owners string
+		if err = rows.Scan(&rule.Pattern, &owners); err != nil {
+			logger.Error("scan codeowners rule", zap.Error(err))
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(owners), &rule.Owners); err != nil {
+			logger.Error("unmarshal codeowners", zap.Error(err))
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
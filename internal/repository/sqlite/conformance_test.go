@@ -0,0 +1,34 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/db"
+	"github.com/Tortik3000/PR-service/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Backend {
+		logger := zap.NewNop()
+
+		sqlDB, err := Open(filepath.Join(t.TempDir(), "pr-service.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = sqlDB.Close() })
+
+		migrator, err := db.NewSQLiteMigrator(sqlDB)
+		require.NoError(t, err)
+		_, err = migrator.Up(t.Context())
+		require.NoError(t, err)
+
+		return repositorytest.Backend{
+			Repository: NewRepo(logger, sqlDB),
+			Transactor: NewTransactor(sqlDB, logger),
+		}
+	})
+}
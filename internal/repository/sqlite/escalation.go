@@ -0,0 +1,157 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (s *sqliteRepo) GetStaleReviews(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) ([]models.StaleReview, error) {
+	logger := s.logger.With(
+		zap.Time("now", now),
+		zap.Uint64("limit", limit),
+	)
+
+	getStale := s.queryBuilder.Select(
+		"pr.external_id",
+		"COALESCE(r.name, '')",
+		"pr.organization",
+		"pr.author_id",
+		"ar.user_id",
+		"CAST(pr.team_id AS TEXT)",
+		"t.escalation_policy",
+		"ar.assigned_at",
+	).
+		From("assigned_reviewer ar").
+		Join("pull_request pr ON pr.id = ar.pr_id").
+		Join("team t ON t.id = pr.team_id").
+		LeftJoin("repository r ON r.id = pr.repository_id").
+		Where(sq.And{
+			sq.Eq{"pr.status": models.PRStatusOPEN},
+			sq.NotEq{"t.review_sla_seconds": nil},
+			sq.Expr("ar.assigned_at + t.review_sla_seconds * 1000000 < ?", toMicros(now)),
+			sq.Expr(`NOT EXISTS (
+				SELECT 1 FROM escalation e
+				WHERE e.pr_id = ar.pr_id
+					AND e.idle_reviewer_id = ar.user_id
+					AND e.created_at >= ar.assigned_at
+			)`),
+		}).
+		OrderBy("ar.assigned_at").
+		Limit(limit)
+
+	rows, err := s.query(ctx, "get stale reviews", getStale)
+	if err != nil {
+		logger.Error("get stale reviews query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []models.StaleReview
+	for rows.Next() {
+		var review models.StaleReview
+		if err = rows.Scan(
+			&review.PRID,
+			&review.Repository,
+			&review.Organization,
+			&review.AuthorID,
+			&review.ReviewerID,
+			&review.TeamID,
+			&review.Policy,
+			timestamp{dst: &review.AssignedAt},
+		); err != nil {
+			logger.Error("scan stale review row", zap.Error(err))
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, rows.Err()
+}
+
+func (s *sqliteRepo) IsReviewEscalated(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+) (bool, error) {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+		zap.String("reviewer_id", reviewerID),
+	)
+
+	isEscalated := s.queryBuilder.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("escalation e").
+		Join("pull_request pr ON pr.id = e.pr_id").
+		Join("assigned_reviewer ar ON ar.pr_id = e.pr_id AND ar.user_id = e.idle_reviewer_id").
+		Where(sq.And{
+			sq.Eq{
+				"pr.external_id":     prID,
+				"e.idle_reviewer_id": reviewerID,
+			},
+			prRepositoryEq(ctx, repository),
+			sq.Expr("e.created_at >= ar.assigned_at"),
+		}).
+		Suffix(")")
+
+	var escalated bool
+	if err := s.scanRow(ctx, "is review escalated", isEscalated, &escalated); err != nil {
+		logger.Error("is review escalated query", zap.Error(err))
+		return false, err
+	}
+
+	return escalated, nil
+}
+
+func (s *sqliteRepo) CreateEscalation(
+	ctx context.Context,
+	escalation models.Escalation,
+) error {
+	logger := s.logger.With(
+		zap.String("repository", escalation.Repository),
+		zap.String("pr_id", escalation.PRID),
+		zap.String("idle_reviewer_id", escalation.IdleReviewerID),
+		zap.String("new_reviewer_id", escalation.NewReviewerID),
+		zap.String("policy", string(escalation.Policy)),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		prKey, err := s.getPRKey(ctx, escalation.Repository, escalation.PRID)
+		if err != nil {
+			logger.Error("get PR key", zap.Error(err))
+			return err
+		}
+
+		var newReviewerID *string
+		if escalation.NewReviewerID != "" {
+			newReviewerID = &escalation.NewReviewerID
+		}
+
+		createEscalation := s.queryBuilder.Insert("escalation").
+			Columns("pr_id", "team_id", "team_name", "idle_reviewer_id", "new_reviewer_id", "policy", "created_at").
+			Values(
+				prKey,
+				escalation.TeamID,
+				sq.Expr("(SELECT name FROM team WHERE id = ?)", escalation.TeamID),
+				escalation.IdleReviewerID,
+				newReviewerID,
+				escalation.Policy,
+				toMicros(escalation.CreatedAt),
+			)
+
+		if _, err = s.exec(ctx, "create escalation", createEscalation); err != nil {
+			logger.Error("create escalation", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
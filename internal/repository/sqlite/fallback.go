@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) SetTeamFallbacks(
+	ctx context.Context,
+	teamName string,
+	fallbackTeams []string,
+) error {
+	logger := s.logger.With(
+		zap.String("team_name", teamName),
+		zap.Strings("fallback_teams", fallbackTeams),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		names := append([]string{teamName}, fallbackTeams...)
+		getTeamIDs := s.queryBuilder.Select("id", "name").
+			From("team").
+			Where(sq.And{
+				sq.Eq{"name": names},
+				orgEq(ctx, "organization"),
+			})
+
+		rows, err := s.query(ctx, "get fallback team IDs", getTeamIDs)
+		if err != nil {
+			logger.Error("get fallback team IDs query", zap.Error(err))
+			return err
+		}
+		defer rows.Close()
+
+		teamIDs := make(map[string]int64, len(names))
+		for rows.Next() {
+			var id int64
+			var name string
+			if err = rows.Scan(&id, &name); err != nil {
+				logger.Error("scan team ID", zap.Error(err))
+				return err
+			}
+			teamIDs[name] = id
+		}
+		if err = rows.Err(); err != nil {
+			logger.Error("iterate team IDs", zap.Error(err))
+			return err
+		}
+		rows.Close()
+
+		for _, name := range names {
+			if _, ok := teamIDs[name]; !ok {
+				logger.Warn("team not found", zap.String("missing_team", name))
+				return modelsErr.ErrTeamNotFound
+			}
+		}
+
+		deleteFallbacks := s.queryBuilder.Delete("team_fallback").
+			Where(sq.Eq{"team_id": teamIDs[teamName]})
+
+		if _, err = s.exec(ctx, "delete fallbacks", deleteFallbacks); err != nil {
+			logger.Error("delete fallbacks", zap.Error(err))
+			return err
+		}
+
+		if len(fallbackTeams) == 0 {
+			return nil
+		}
+
+		insertFallbacks := s.queryBuilder.Insert("team_fallback").
+			Columns("team_id", "fallback_team_id", "priority")
+		for priority, name := range fallbackTeams {
+			insertFallbacks = insertFallbacks.Values(teamIDs[teamName], teamIDs[name], priority)
+		}
+
+		if _, err = s.exec(ctx, "insert fallbacks", insertFallbacks); err != nil {
+			logger.Error("insert fallbacks", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *sqliteRepo) GetFallbackTeamIDs(
+	ctx context.Context,
+	teamID string,
+) ([]string, error) {
+	logger := s.logger.With(zap.String("team_id", teamID))
+
+	getFallbacks := s.queryBuilder.Select("fallback_team_id").
+		From("team_fallback").
+		Where(sq.And{
+			sq.Eq{"team_id": teamID},
+			teamInOrg(ctx, "team_id"),
+		}).
+		OrderBy("priority")
+
+	rows, err := s.query(ctx, "get fallback teams", getFallbacks)
+	if err != nil {
+		logger.Error("get fallback teams query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fallbackTeamIDs []string
+	for rows.Next() {
+		var fallbackTeamID string
+		if err = rows.Scan(&fallbackTeamID); err != nil {
+			logger.Error("scan fallback team", zap.Error(err))
+			return nil, err
+		}
+		fallbackTeamIDs = append(fallbackTeamIDs, fallbackTeamID)
+	}
+
+	return fallbackTeamIDs, rows.Err()
+}
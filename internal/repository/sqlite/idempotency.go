@@ -0,0 +1,169 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (s *sqliteRepo) ReserveIdempotencyKey(
+	ctx context.Context,
+	request models.IdempotentRequest,
+	now time.Time,
+) (*models.IdempotentRequest, error) {
+	logger := s.logger.With(
+		zap.String("idempotency_key", request.Key),
+		zap.String("path", request.Path),
+	)
+
+	// просроченный ключ и ключ, чей запрос не завершился за время аренды, перезанимаются,
+	// живой возвращается как есть
+	reserveKey := s.queryBuilder.Insert("idempotency_key").
+		Columns("organization", "key", "path", "fingerprint", "created_at", "expires_at").
+		Values(
+			models.OrganizationFromContext(ctx),
+			request.Key,
+			request.Path,
+			request.Fingerprint,
+			toMicros(now),
+			toMicros(request.ExpiresAt),
+		).
+		Suffix(`ON CONFLICT (organization, key, path) DO UPDATE
+			SET fingerprint = excluded.fingerprint,
+				status_code = NULL,
+				content_type = NULL,
+				etag = NULL,
+				response = NULL,
+				created_at = excluded.created_at,
+				expires_at = excluded.expires_at
+			WHERE idempotency_key.expires_at <= ?
+				OR (idempotency_key.status_code IS NULL AND idempotency_key.created_at <= ?)
+			RETURNING key`, toMicros(now), toMicros(now.Add(-request.Lease)))
+
+	var key string
+	err := s.scanRow(ctx, "reserve idempotency key", reserveKey, &key)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		logger.Error("reserve idempotency key query", zap.Error(err))
+		return nil, err
+	}
+
+	getStored := s.queryBuilder.Select(
+		"fingerprint",
+		"COALESCE(status_code, 0)",
+		"COALESCE(content_type, '')",
+		"COALESCE(etag, '')",
+		"response",
+		"expires_at",
+	).
+		From("idempotency_key").
+		Where(sq.And{
+			sq.Eq{"key": request.Key, "path": request.Path},
+			orgEq(ctx, "organization"),
+		})
+
+	stored := models.IdempotentRequest{
+		Key:  request.Key,
+		Path: request.Path,
+	}
+	err = s.scanRow(ctx, "get idempotent request", getStored,
+		&stored.Fingerprint,
+		&stored.StatusCode,
+		&stored.ContentType,
+		&stored.ETag,
+		&stored.Response,
+		timestamp{dst: &stored.ExpiresAt},
+	)
+	if err != nil {
+		// ключ освободили между запросами — считаем, что он ещё занят
+		if errors.Is(err, sql.ErrNoRows) {
+			stored.Fingerprint = request.Fingerprint
+			return &stored, nil
+		}
+		logger.Error("get idempotent request query", zap.Error(err))
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+func (s *sqliteRepo) SaveIdempotentResponse(
+	ctx context.Context,
+	request models.IdempotentRequest,
+) error {
+	logger := s.logger.With(
+		zap.String("idempotency_key", request.Key),
+		zap.String("path", request.Path),
+		zap.Int("status_code", request.StatusCode),
+	)
+
+	saveResponse := s.queryBuilder.Update("idempotency_key").
+		Set("status_code", request.StatusCode).
+		Set("content_type", request.ContentType).
+		Set("etag", request.ETag).
+		Set("response", request.Response).
+		Where(sq.Eq{
+			"organization": models.OrganizationFromContext(ctx),
+			"key":          request.Key,
+			"path":         request.Path,
+			"fingerprint":  request.Fingerprint,
+		})
+
+	if _, err := s.exec(ctx, "save idempotent response", saveResponse); err != nil {
+		logger.Error("save idempotent response query", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteRepo) ReleaseIdempotencyKey(
+	ctx context.Context,
+	key, path string,
+) error {
+	logger := s.logger.With(
+		zap.String("idempotency_key", key),
+		zap.String("path", path),
+	)
+
+	releaseKey := s.queryBuilder.Delete("idempotency_key").
+		Where(sq.Eq{
+			"organization": models.OrganizationFromContext(ctx),
+			"key":          key,
+			"path":         path,
+			"status_code":  nil,
+		})
+
+	if _, err := s.exec(ctx, "release idempotency key", releaseKey); err != nil {
+		logger.Error("release idempotency key query", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteRepo) DeleteExpiredIdempotencyKeys(
+	ctx context.Context,
+	now time.Time,
+) (int64, error) {
+	logger := s.logger.With(zap.Time("now", now))
+
+	deleteExpired := s.queryBuilder.Delete("idempotency_key").
+		Where(sq.LtOrEq{"expires_at": toMicros(now)})
+
+	result, err := s.exec(ctx, "delete expired idempotency keys", deleteExpired)
+	if err != nil {
+		logger.Error("delete expired idempotency keys query", zap.Error(err))
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,129 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) CreateOutOfOffice(
+	ctx context.Context,
+	outOfOffice models.OutOfOffice,
+) (*models.OutOfOffice, error) {
+	logger := s.logger.With(
+		zap.String("user_id", outOfOffice.UserID),
+		zap.Time("from", outOfOffice.From),
+		zap.Time("to", outOfOffice.To),
+	)
+
+	// пользователь другой организации не найдётся, и вставка не вернёт строк
+	createOutOfOffice := s.queryBuilder.Insert("out_of_office").
+		Columns("user_id", "starts_at", "ends_at", "reassign_reviews").
+		Select(s.queryBuilder.Select("id").
+			Column("?", toMicros(outOfOffice.From)).
+			Column("?", toMicros(outOfOffice.To)).
+			Column("?", outOfOffice.ReassignReviews).
+			From("users").
+			Where(sq.And{
+				sq.Eq{"id": outOfOffice.UserID},
+				orgEq(ctx, "organization"),
+			})).
+		Suffix("RETURNING id")
+
+	err := s.scanRow(ctx, "create out of office", createOutOfOffice, &outOfOffice.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("create out of office query", zap.Error(modelsErr.ErrUserNotFound))
+			return nil, modelsErr.ErrUserNotFound
+		}
+		logger.Error("create out of office query", zap.Error(err))
+		return nil, err
+	}
+
+	return &outOfOffice, nil
+}
+
+func (s *sqliteRepo) GetStartedOutOfOffice(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) ([]models.OutOfOffice, error) {
+	logger := s.logger.With(
+		zap.Time("now", now),
+		zap.Uint64("limit", limit),
+	)
+
+	getStarted := s.queryBuilder.Select(
+		"o.id",
+		"o.user_id",
+		"u.organization",
+		"o.starts_at",
+		"o.ends_at",
+		"o.reassign_reviews",
+	).
+		From("out_of_office o").
+		Join("users u ON u.id = o.user_id").
+		Where(sq.And{
+			sq.Eq{"o.reassign_reviews": true},
+			sq.Eq{"o.reviews_reassigned_at": nil},
+			sq.LtOrEq{"o.starts_at": toMicros(now)},
+			sq.Gt{"o.ends_at": toMicros(now)},
+		}).
+		OrderBy("o.starts_at").
+		Limit(limit)
+
+	rows, err := s.query(ctx, "get started out of office", getStarted)
+	if err != nil {
+		logger.Error("get started out of office query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []models.OutOfOffice
+	for rows.Next() {
+		var period models.OutOfOffice
+		if err = rows.Scan(
+			&period.ID,
+			&period.UserID,
+			&period.Organization,
+			timestamp{dst: &period.From},
+			timestamp{dst: &period.To},
+			&period.ReassignReviews,
+		); err != nil {
+			logger.Error("scan out of office row", zap.Error(err))
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+
+	return periods, rows.Err()
+}
+
+func (s *sqliteRepo) MarkOutOfOfficeReassigned(
+	ctx context.Context,
+	id int64,
+	reassignedAt time.Time,
+) error {
+	logger := s.logger.With(zap.Int64("out_of_office_id", id))
+
+	markReassigned := s.queryBuilder.Update("out_of_office").
+		Set("reviews_reassigned_at", toMicros(reassignedAt)).
+		Where(sq.And{
+			sq.Eq{"id": id},
+			userInOrg(ctx, "user_id"),
+		})
+
+	if _, err := s.exec(ctx, "mark out of office reassigned", markReassigned); err != nil {
+		logger.Error("mark out of office reassigned", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,612 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) PullRequestCreate(
+	ctx context.Context,
+	pr models.PR,
+) (_ *models.PR, err error) {
+	logger := s.logger.With(
+		zap.String("author_id", pr.AuthorID),
+		zap.String("repository", pr.Repository),
+		zap.String("pr_id", pr.ID),
+		zap.String("pr_name", pr.Name),
+		zap.String("team_id", pr.TeamID),
+		zap.Any("reviewers", pr.AssignedReviewers),
+		zap.Any("fallback_reviewers", pr.FallbackReviewers),
+	)
+
+	err = s.inTx(ctx, func(ctx context.Context) (err error) {
+		var repositoryID *int64
+		if pr.Repository != "" {
+			repositoryID, err = s.getRepositoryID(ctx, pr.Repository)
+			if err != nil {
+				logger.Error("get repository id", zap.Error(err))
+				return err
+			}
+		}
+
+		if err = s.checkTeam(ctx, pr.TeamID); err != nil {
+			logger.Warn("check team", zap.Error(err))
+			return err
+		}
+		if err = s.checkUsers(ctx, append([]string{pr.AuthorID}, pr.AssignedReviewers...)); err != nil {
+			logger.Warn("check users", zap.Error(err))
+			return err
+		}
+
+		// внешний ID уникален только в организации и репозитории, поэтому PR хранится под суррогатным ключом
+		createdAt := s.now(ctx)
+		createPR := s.queryBuilder.Insert("pull_request").
+			Columns("id", "organization", "external_id", "repository_id", "name", "author_id", "team_id", "created_at").
+			Values(
+				sq.Expr("lower(hex(randomblob(16)))"),
+				models.OrganizationFromContext(ctx),
+				pr.ID,
+				repositoryID,
+				pr.Name,
+				pr.AuthorID,
+				pr.TeamID,
+				toMicros(createdAt),
+			).
+			Suffix("RETURNING id, version")
+
+		var key string
+		if err = s.scanRow(ctx, "create PR", createPR, &key, &pr.Version); err != nil {
+			if isUniqueViolation(err) {
+				logger.Error("create PR query", zap.Error(modelsErr.ErrPullRequestExist))
+				return modelsErr.ErrPullRequestExist
+			}
+			logger.Error("create PR query", zap.Error(err))
+			return err
+		}
+		pr.CreatedAt = &createdAt
+
+		if len(pr.AssignedReviewers) == 0 {
+			return nil
+		}
+
+		updateAssignedReviewers := s.queryBuilder.Insert("assigned_reviewer").
+			Columns("user_id", "pr_id", "from_fallback", "assigned_at")
+
+		for _, reviewerID := range pr.AssignedReviewers {
+			updateAssignedReviewers = updateAssignedReviewers.
+				Values(reviewerID, key, slices.Contains(pr.FallbackReviewers, reviewerID), toMicros(createdAt))
+		}
+
+		if _, err = s.exec(ctx, "insert reviewers", updateAssignedReviewers); err != nil {
+			logger.Error("insert reviewers", zap.Error(err))
+			return err
+		}
+
+		if err = s.recordPairings(ctx, key, pr.AssignedReviewers); err != nil {
+			logger.Error("record pairings", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pr.Status = models.PRStatusOPEN
+
+	return &pr, nil
+}
+
+func prRepositoryEq(ctx context.Context, repository string) sq.Sqlizer {
+	if repository == "" {
+		return sq.And{orgEq(ctx, "pr.organization"), sq.Eq{"pr.repository_id": nil}}
+	}
+	return sq.And{
+		orgEq(ctx, "pr.organization"),
+		sq.Expr("pr.repository_id = (SELECT id FROM repository WHERE name = ? AND organization = ?)",
+			repository, models.OrganizationFromContext(ctx)),
+	}
+}
+
+// checkTeam проверяет, что команда принадлежит организации из ctx
+func (s *sqliteRepo) checkTeam(
+	ctx context.Context,
+	teamID string,
+) error {
+	getTeam := s.queryBuilder.Select("id").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"id": teamID},
+			orgEq(ctx, "organization"),
+		})
+
+	var id int64
+	if err := s.scanRow(ctx, "check team", getTeam, &id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return modelsErr.ErrTeamNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// checkUsers проверяет, что пользователи принадлежат организации из ctx:
+// внешний ключ на users этого не гарантирует, идентификаторы общие для всех организаций
+func (s *sqliteRepo) checkUsers(
+	ctx context.Context,
+	userIDs []string,
+) error {
+	getUsers := s.queryBuilder.Select("id").
+		From("users").
+		Where(sq.And{
+			sq.Eq{"id": userIDs},
+			orgEq(ctx, "organization"),
+		})
+
+	rows, err := s.query(ctx, "check users", getUsers)
+	if err != nil {
+		return err
+	}
+	found, err := scanStrings(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if !slices.Contains(found, userID) {
+			return fmt.Errorf("%w: %s", modelsErr.ErrUserNotFound, userID)
+		}
+	}
+	return nil
+}
+
+func (s *sqliteRepo) getPRKey(
+	ctx context.Context,
+	repository, prID string,
+) (string, error) {
+	getKey := s.queryBuilder.Select("pr.id").
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		})
+
+	var key string
+	if err := s.scanRow(ctx, "get PR key", getKey, &key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", modelsErr.ErrPRNotFound
+		}
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (s *sqliteRepo) PullRequestMerge(
+	ctx context.Context,
+	repository, prID string,
+) (*models.PR, error) {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
+
+	updateStatus := s.queryBuilder.Update("pull_request AS pr").
+		Set("status", models.PRStatusMERGED).
+		SetMap(map[string]interface{}{
+			"merged_at": sq.Expr("COALESCE(merged_at, ?)", toMicros(s.now(ctx))),
+			"version":   sq.Expr("version + CASE WHEN status = ? THEN 0 ELSE 1 END", models.PRStatusMERGED)}).
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		}).
+		Suffix("RETURNING external_id, name, author_id, created_at, merged_at, status, version")
+
+	var dbPr models.PR
+	err := s.scanRow(ctx, "merge", updateStatus,
+		&dbPr.ID,
+		&dbPr.Name,
+		&dbPr.AuthorID,
+		nullTimestamp{dst: &dbPr.CreatedAt},
+		nullTimestamp{dst: &dbPr.MergedAt},
+		&dbPr.Status,
+		&dbPr.Version,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("merge query", zap.Error(modelsErr.ErrPRNotFound))
+			return nil, modelsErr.ErrPRNotFound
+		}
+		logger.Error("merge query", zap.Error(err))
+		return nil, err
+	}
+	dbPr.Repository = repository
+
+	return &dbPr, nil
+}
+
+func (s *sqliteRepo) GetPullRequest(
+	ctx context.Context,
+	repository, prID string,
+) (pr *models.PR, err error) {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
+
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = s.selectPullRequest(ctx, logger, repository, prID)
+		if err != nil {
+			return err
+		}
+
+		if pr.Status == models.PRStatusMERGED {
+			logger.Error("PR is already merged", zap.Error(modelsErr.ErrPRMerged))
+			return modelsErr.ErrPRMerged
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// PullRequestGet читает PR в любом статусе
+func (s *sqliteRepo) PullRequestGet(
+	ctx context.Context,
+	repository, prID string,
+) (pr *models.PR, err error) {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
+
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = s.selectPullRequest(ctx, logger, repository, prID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (s *sqliteRepo) selectPullRequest(
+	ctx context.Context,
+	logger *zap.Logger,
+	repository, prID string,
+) (*models.PR, error) {
+	getPR := s.queryBuilder.Select(
+		"pr.id",
+		"pr.external_id",
+		"pr.name",
+		"pr.author_id",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.status",
+		"COALESCE(CAST(pr.team_id AS TEXT), '')",
+		"pr.version",
+	).
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		})
+
+	var key string
+	pr := &models.PR{Repository: repository}
+	err := s.scanRow(ctx, "get PR", getPR,
+		&key,
+		&pr.ID,
+		&pr.Name,
+		&pr.AuthorID,
+		nullTimestamp{dst: &pr.CreatedAt},
+		nullTimestamp{dst: &pr.MergedAt},
+		&pr.Status,
+		&pr.TeamID,
+		&pr.Version,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("get PR query", zap.Error(modelsErr.ErrPRNotFound))
+			return nil, modelsErr.ErrPRNotFound
+		}
+		logger.Error("get PR query", zap.Error(err))
+		return nil, err
+	}
+
+	getReviewers := s.queryBuilder.Select("user_id", "from_fallback").
+		From("assigned_reviewer").
+		Where(sq.Eq{"pr_id": key})
+
+	rows, err := s.query(ctx, "get reviewers", getReviewers)
+	if err != nil {
+		logger.Error("get reviewers", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewer string
+		var fromFallback bool
+		if err = rows.Scan(&reviewer, &fromFallback); err != nil {
+			logger.Error("scan reviewer", zap.Error(err))
+			return nil, err
+		}
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewer)
+		if fromFallback {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, reviewer)
+		}
+	}
+
+	return pr, rows.Err()
+}
+
+func (s *sqliteRepo) PullRequestReassign(
+	ctx context.Context,
+	repository, prID, oldReviewerID, newReviewerID string,
+	fromFallback bool,
+) error {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+		zap.String("old_reviewer_id", oldReviewerID),
+		zap.String("new_reviewer_id", newReviewerID),
+		zap.Bool("from_fallback", fromFallback),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		prKey, err := s.getPRKey(ctx, repository, prID)
+		if err != nil {
+			logger.Error("get PR key", zap.Error(err))
+			return err
+		}
+
+		if err = s.checkUsers(ctx, []string{newReviewerID}); err != nil {
+			logger.Warn("check users", zap.Error(err))
+			return err
+		}
+
+		updateReviewers := s.queryBuilder.Update("assigned_reviewer").
+			Set("user_id", newReviewerID).
+			Set("from_fallback", fromFallback).
+			Set("assigned_at", toMicros(s.now(ctx))).
+			Where(sq.And{
+				sq.Eq{"user_id": oldReviewerID},
+				sq.Eq{"pr_id": prKey},
+			})
+
+		if _, err = s.exec(ctx, "reassign", updateReviewers); err != nil {
+			logger.Error("reassign reviewer", zap.Error(err))
+			return err
+		}
+
+		if err = s.bumpPRVersion(ctx, prKey); err != nil {
+			logger.Error("bump PR version", zap.Error(err))
+			return err
+		}
+
+		if err = s.recordPairings(ctx, prKey, []string{newReviewerID}); err != nil {
+			logger.Error("record pairings", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *sqliteRepo) PullRequestAddReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+	fromFallback bool,
+) error {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+		zap.String("reviewer_id", reviewerID),
+		zap.Bool("from_fallback", fromFallback),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		prKey, err := s.getPRKey(ctx, repository, prID)
+		if err != nil {
+			logger.Error("get PR key", zap.Error(err))
+			return err
+		}
+
+		if err = s.checkUsers(ctx, []string{reviewerID}); err != nil {
+			logger.Warn("check users", zap.Error(err))
+			return err
+		}
+
+		addReviewer := s.queryBuilder.Insert("assigned_reviewer").
+			Columns("user_id", "pr_id", "from_fallback", "assigned_at").
+			Values(reviewerID, prKey, fromFallback, toMicros(s.now(ctx)))
+
+		if _, err = s.exec(ctx, "add reviewer", addReviewer); err != nil {
+			logger.Error("add reviewer", zap.Error(err))
+			return err
+		}
+
+		if err = s.bumpPRVersion(ctx, prKey); err != nil {
+			logger.Error("bump PR version", zap.Error(err))
+			return err
+		}
+
+		if err = s.recordPairings(ctx, prKey, []string{reviewerID}); err != nil {
+			logger.Error("record pairings", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *sqliteRepo) PullRequestRemoveReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+) error {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+		zap.String("reviewer_id", reviewerID),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		prKey, err := s.getPRKey(ctx, repository, prID)
+		if err != nil {
+			logger.Error("get PR key", zap.Error(err))
+			return err
+		}
+
+		removeReviewer := s.queryBuilder.Delete("assigned_reviewer").
+			Where(sq.And{
+				sq.Eq{"user_id": reviewerID},
+				sq.Eq{"pr_id": prKey},
+			})
+
+		result, err := s.exec(ctx, "remove reviewer", removeReviewer)
+		if err != nil {
+			logger.Error("remove reviewer", zap.Error(err))
+			return err
+		}
+		removed, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("remove reviewer", zap.Error(err))
+			return err
+		}
+		if removed == 0 {
+			logger.Warn("remove reviewer", zap.Error(modelsErr.ErrNotAssigned))
+			return modelsErr.ErrNotAssigned
+		}
+
+		if err = s.bumpPRVersion(ctx, prKey); err != nil {
+			logger.Error("bump PR version", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *sqliteRepo) GetPullRequestVersion(
+	ctx context.Context,
+	repository, prID string,
+) (int64, error) {
+	logger := s.logger.With(
+		zap.String("repository", repository),
+		zap.String("pr_id", prID),
+	)
+
+	getVersion := s.queryBuilder.Select("pr.version").
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.external_id": prID},
+			prRepositoryEq(ctx, repository),
+		})
+
+	var version int64
+	if err := s.scanRow(ctx, "get PR version", getVersion, &version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("get PR version query", zap.Error(modelsErr.ErrPRNotFound))
+			return 0, modelsErr.ErrPRNotFound
+		}
+		logger.Error("get PR version query", zap.Error(err))
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (s *sqliteRepo) bumpPRVersion(
+	ctx context.Context,
+	prKey string,
+) error {
+	bumpVersion := s.queryBuilder.Update("pull_request").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": prKey})
+
+	_, err := s.exec(ctx, "bump PR version", bumpVersion)
+	return err
+}
+
+func (s *sqliteRepo) recordPairings(
+	ctx context.Context,
+	prKey string,
+	reviewerIDs []string,
+) error {
+	assignedAt := toMicros(s.now(ctx))
+	insertPairings := s.queryBuilder.Insert("review_pairing").
+		Columns("pr_id", "author_id", "reviewer_id", "assigned_at")
+	for _, reviewerID := range reviewerIDs {
+		insertPairings = insertPairings.Values(
+			prKey,
+			sq.Expr("(SELECT author_id FROM pull_request WHERE id = ?)", prKey),
+			reviewerID,
+			assignedAt,
+		)
+	}
+
+	_, err := s.exec(ctx, "record pairings", insertPairings)
+	return err
+}
+
+func (s *sqliteRepo) GetRecentPairings(
+	ctx context.Context,
+	authorID string,
+	reviewerIDs []string,
+	since time.Time,
+) (map[string]int, error) {
+	logger := s.logger.With(
+		zap.String("author_id", authorID),
+		zap.Strings("reviewer_ids", reviewerIDs),
+		zap.Time("since", since),
+	)
+
+	getPairings := s.queryBuilder.Select("reviewer_id", "COUNT(*)").
+		From("review_pairing").
+		Where(sq.And{
+			sq.Eq{"author_id": authorID},
+			userInOrg(ctx, "author_id"),
+			sq.Eq{"reviewer_id": reviewerIDs},
+			sq.GtOrEq{"assigned_at": toMicros(since)},
+		}).
+		GroupBy("reviewer_id")
+
+	rows, err := s.query(ctx, "get recent pairings", getPairings)
+	if err != nil {
+		logger.Error("get recent pairings query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	pairings := make(map[string]int, len(reviewerIDs))
+	for rows.Next() {
+		var reviewerID string
+		var count int
+		if err = rows.Scan(&reviewerID, &count); err != nil {
+			logger.Error("scan recent pairing", zap.Error(err))
+			return nil, err
+		}
+		pairings[reviewerID] = count
+	}
+
+	return pairings, rows.Err()
+}
@@ -0,0 +1,270 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) RepositoryAdd(
+	ctx context.Context,
+	repository models.Repository,
+) (added *models.Repository, err error) {
+	logger := s.logger.With(
+		zap.String("repository", repository.Name),
+		zap.String("owner_team", repository.OwnerTeam),
+	)
+
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		ownerTeamID, err := s.getOwnerTeamID(ctx, repository.OwnerTeam)
+		if err != nil {
+			logger.Error("get owner team", zap.Error(err))
+			return err
+		}
+
+		addRepository := s.queryBuilder.Insert("repository").
+			Columns("organization", "name", "owner_team_id", "reviewer_count", "shortfall_policy", "selection_mode", "created_at").
+			Values(
+				models.OrganizationFromContext(ctx),
+				repository.Name,
+				ownerTeamID,
+				repository.ReviewerCount,
+				repository.ShortfallPolicy,
+				repository.SelectionMode,
+				toMicros(s.now(ctx)),
+			)
+
+		if _, err = s.exec(ctx, "add repository", addRepository); err != nil {
+			if isUniqueViolation(err) {
+				logger.Warn("repository already exists", zap.Error(err))
+				return modelsErr.ErrRepositoryExist
+			}
+			logger.Error("add repository query", zap.Error(err))
+			return err
+		}
+
+		added, err = s.getRepository(ctx, repository.Name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+func (s *sqliteRepo) RepositoryGet(
+	ctx context.Context,
+	name string,
+) (*models.Repository, error) {
+	logger := s.logger.With(zap.String("repository", name))
+
+	repository, err := s.getRepository(ctx, name)
+	if err != nil {
+		logger.Error("get repository", zap.Error(err))
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+func (s *sqliteRepo) RepositoryList(
+	ctx context.Context,
+) ([]models.Repository, error) {
+	listRepositories := s.selectRepositories(ctx).
+		OrderBy("r.name")
+
+	rows, err := s.query(ctx, "list repositories", listRepositories)
+	if err != nil {
+		s.logger.Error("list repositories query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	repositories := []models.Repository{}
+	for rows.Next() {
+		var repository models.Repository
+		if err = rows.Scan(repositoryFields(&repository)...); err != nil {
+			s.logger.Error("scan repository row", zap.Error(err))
+			return nil, err
+		}
+		repositories = append(repositories, repository)
+	}
+
+	return repositories, rows.Err()
+}
+
+func (s *sqliteRepo) RepositoryUpdate(
+	ctx context.Context,
+	repository models.Repository,
+) (updated *models.Repository, err error) {
+	logger := s.logger.With(
+		zap.String("repository", repository.Name),
+		zap.String("owner_team", repository.OwnerTeam),
+	)
+
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		ownerTeamID, err := s.getOwnerTeamID(ctx, repository.OwnerTeam)
+		if err != nil {
+			logger.Error("get owner team", zap.Error(err))
+			return err
+		}
+
+		updateRepository := s.queryBuilder.Update("repository").
+			Set("owner_team_id", ownerTeamID).
+			Set("reviewer_count", repository.ReviewerCount).
+			Set("shortfall_policy", repository.ShortfallPolicy).
+			Set("selection_mode", repository.SelectionMode).
+			Where(sq.And{
+				sq.Eq{"name": repository.Name},
+				orgEq(ctx, "organization"),
+			})
+
+		result, err := s.exec(ctx, "update repository", updateRepository)
+		if err != nil {
+			logger.Error("update repository query", zap.Error(err))
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("update repository query", zap.Error(err))
+			return err
+		}
+		if affected == 0 {
+			logger.Warn("repository not found")
+			return modelsErr.ErrRepositoryNotFound
+		}
+
+		updated, err = s.getRepository(ctx, repository.Name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *sqliteRepo) RepositoryDelete(
+	ctx context.Context,
+	name string,
+) error {
+	logger := s.logger.With(zap.String("repository", name))
+
+	deleteRepository := s.queryBuilder.Delete("repository").
+		Where(sq.And{
+			sq.Eq{"name": name},
+			orgEq(ctx, "organization"),
+		})
+
+	result, err := s.exec(ctx, "delete repository", deleteRepository)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			logger.Warn("repository has pull requests", zap.Error(err))
+			return modelsErr.ErrRepositoryHasPRs
+		}
+		logger.Error("delete repository query", zap.Error(err))
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("delete repository query", zap.Error(err))
+		return err
+	}
+	if affected == 0 {
+		logger.Warn("repository not found")
+		return modelsErr.ErrRepositoryNotFound
+	}
+
+	return nil
+}
+
+func (s *sqliteRepo) getOwnerTeamID(
+	ctx context.Context,
+	teamName string,
+) (*int64, error) {
+	if teamName == "" {
+		return nil, nil
+	}
+
+	teamID, err := s.getTeamID(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, modelsErr.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	return &teamID, nil
+}
+
+func (s *sqliteRepo) getRepositoryID(
+	ctx context.Context,
+	name string,
+) (*int64, error) {
+	getID := s.queryBuilder.Select("id").
+		From("repository").
+		Where(sq.And{
+			sq.Eq{"name": name},
+			orgEq(ctx, "organization"),
+		})
+
+	var id int64
+	if err := s.scanRow(ctx, "get repository id", getID, &id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, modelsErr.ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (s *sqliteRepo) getRepository(
+	ctx context.Context,
+	name string,
+) (*models.Repository, error) {
+	getRepository := s.selectRepositories(ctx).
+		Where(sq.Eq{"r.name": name})
+
+	var repository models.Repository
+	if err := s.scanRow(ctx, "get repository", getRepository, repositoryFields(&repository)...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, modelsErr.ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	return &repository, nil
+}
+
+func (s *sqliteRepo) selectRepositories(ctx context.Context) sq.SelectBuilder {
+	return s.queryBuilder.Select(
+		"r.name",
+		"COALESCE(t.name, '')",
+		"COALESCE(CAST(r.owner_team_id AS TEXT), '')",
+		"r.reviewer_count",
+		"r.shortfall_policy",
+		"r.selection_mode",
+	).
+		From("repository r").
+		LeftJoin("team t ON t.id = r.owner_team_id").
+		Where(orgEq(ctx, "r.organization"))
+}
+
+func repositoryFields(repository *models.Repository) []any {
+	return []any{
+		&repository.Name,
+		&repository.OwnerTeam,
+		&repository.OwnerTeamID,
+		&repository.ReviewerCount,
+		&repository.ShortfallPolicy,
+		&repository.SelectionMode,
+	}
+}
@@ -0,0 +1,257 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+const busyTimeout = 5 * time.Second
+
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type sqliteRepo struct {
+	db           *sql.DB
+	logger       *zap.Logger
+	queryBuilder sq.StatementBuilderType
+}
+
+func NewRepo(
+	logger *zap.Logger,
+	db *sql.DB,
+) *sqliteRepo {
+	return &sqliteRepo{
+		db:           db,
+		logger:       logger,
+		queryBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Question),
+	}
+}
+
+// Open открывает файл базы. Транзакции начинаются с BEGIN IMMEDIATE: SQLite не
+// поддерживает FOR UPDATE, поэтому пишущие транзакции сериализуются блокировкой
+// на запись, взятой при старте, а не блокировками строк.
+func Open(path string) (*sql.DB, error) {
+	params := url.Values{}
+	params.Set("_txlock", "immediate")
+	params.Set("_busy_timeout", fmt.Sprint(busyTimeout.Milliseconds()))
+	params.Set("_foreign_keys", "on")
+	params.Set("_journal_mode", "WAL")
+
+	db, err := sql.Open("sqlite3", "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func (s *sqliteRepo) conn(ctx context.Context) querier {
+	if tx, ok := extractTx(ctx); ok {
+		return tx.tx
+	}
+	return s.db
+}
+
+// inTx выполняет function в транзакции из ctx или в новой, если её нет
+func (s *sqliteRepo) inTx(
+	ctx context.Context,
+	function func(ctx context.Context) error,
+) error {
+	if _, ok := extractTx(ctx); ok {
+		return function(ctx)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		s.logger.Error("beginTx", zap.Error(err))
+		return err
+	}
+
+	if err = function(injectTx(ctx, &txState{tx: tx, now: now()})); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger.Debug("failed to rollback transaction", zap.Error(rollbackErr))
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("commit", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteRepo) exec(
+	ctx context.Context,
+	name string,
+	query sq.Sqlizer,
+) (sql.Result, error) {
+	queryStr, args, err := s.build(name, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.conn(ctx).ExecContext(ctx, queryStr, args...)
+}
+
+func (s *sqliteRepo) query(
+	ctx context.Context,
+	name string,
+	query sq.Sqlizer,
+) (*sql.Rows, error) {
+	queryStr, args, err := s.build(name, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.conn(ctx).QueryContext(ctx, queryStr, args...)
+}
+
+func (s *sqliteRepo) scanRow(
+	ctx context.Context,
+	name string,
+	query sq.Sqlizer,
+	dest ...any,
+) error {
+	queryStr, args, err := s.build(name, query)
+	if err != nil {
+		return err
+	}
+	return s.conn(ctx).QueryRowContext(ctx, queryStr, args...).Scan(dest...)
+}
+
+func (s *sqliteRepo) build(name string, query sq.Sqlizer) (string, []any, error) {
+	queryStr, args, err := query.ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("build SQL (%s): %w", name, err)
+	}
+
+	s.logger.Debug("Executing "+name+" SQL",
+		zap.String("query", queryStr),
+		zap.Any("args", args),
+	)
+
+	return queryStr, args, nil
+}
+
+// orgEq ограничивает запрос организацией вызывающего из ctx
+func orgEq(ctx context.Context, column string) sq.Eq {
+	return sq.Eq{column: models.OrganizationFromContext(ctx)}
+}
+
+// teamInOrg ограничивает строки дочерних таблиц командами организации из ctx
+func teamInOrg(ctx context.Context, column string) sq.Sqlizer {
+	return sq.Expr(column+" IN (SELECT id FROM team WHERE organization = ?)", models.OrganizationFromContext(ctx))
+}
+
+// userInOrg ограничивает строки дочерних таблиц пользователями организации из ctx
+func userInOrg(ctx context.Context, column string) sq.Sqlizer {
+	return sq.Expr(column+" IN (SELECT id FROM users WHERE organization = ?)", models.OrganizationFromContext(ctx))
+}
+
+func scanStrings(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, rows.Err()
+}
+
+func isConstraintError(err error, codes ...sqlite3.ErrNoExtended) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	for _, code := range codes {
+		if sqliteErr.ExtendedCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+func isUniqueViolation(err error) bool {
+	return isConstraintError(err, sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey)
+}
+
+func isForeignKeyViolation(err error) bool {
+	return isConstraintError(err, sqlite3.ErrConstraintForeignKey)
+}
+
+// время хранится в микросекундах Unix-времени UTC, как timestamp в postgres
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// now возвращает время начала транзакции, как now() в postgres
+func (s *sqliteRepo) now(ctx context.Context) time.Time {
+	if tx, ok := extractTx(ctx); ok {
+		return tx.now
+	}
+	return now()
+}
+
+func toMicros(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
+func toNullMicros(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	micros := toMicros(*t)
+	return &micros
+}
+
+type timestamp struct {
+	dst *time.Time
+}
+
+func (t timestamp) Scan(src any) error {
+	micros, ok := src.(int64)
+	if !ok {
+		return fmt.Errorf("unsupported timestamp value %T", src)
+	}
+	*t.dst = time.UnixMicro(micros).UTC()
+	return nil
+}
+
+type nullTimestamp struct {
+	dst **time.Time
+}
+
+func (t nullTimestamp) Scan(src any) error {
+	if src == nil {
+		*t.dst = nil
+		return nil
+	}
+	var value time.Time
+	if err := (timestamp{dst: &value}).Scan(src); err != nil {
+		return err
+	}
+	*t.dst = &value
+	return nil
+}
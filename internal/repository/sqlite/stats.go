@@ -0,0 +1,347 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) TeamStats(
+	ctx context.Context,
+	filter models.TeamStatsFilter,
+) ([]models.TeamStats, uint64, error) {
+	logger := s.logger.With(
+		zap.Time("since", filter.Since),
+		zap.Time("stale_before", filter.StaleBefore),
+		zap.Uint64("limit", filter.Limit),
+		zap.Uint64("offset", filter.Offset),
+	)
+
+	countTeams := s.queryBuilder.Select("COUNT(*)").From("team").
+		Where(orgEq(ctx, "organization"))
+
+	var total uint64
+	if err := s.scanRow(ctx, "count teams", countTeams, &total); err != nil {
+		logger.Error("count teams query", zap.Error(err))
+		return nil, 0, err
+	}
+
+	getTeams := s.queryBuilder.Select(
+		"t.id",
+		"t.name",
+		"COUNT(u.id) FILTER (WHERE u.is_active)",
+	).
+		From("team t").
+		LeftJoin("team_membership m ON m.team_id = t.id").
+		LeftJoin("users u ON u.id = m.user_id").
+		Where(orgEq(ctx, "t.organization")).
+		GroupBy("t.id", "t.name").
+		OrderBy("t.name").
+		Limit(filter.Limit).
+		Offset(filter.Offset)
+
+	rows, err := s.query(ctx, "get teams page", getTeams)
+	if err != nil {
+		logger.Error("get teams page query", zap.Error(err))
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var teamIDs []int64
+	statsByTeam := make(map[int64]*models.TeamStats)
+	for rows.Next() {
+		var teamID int64
+		var stats models.TeamStats
+		if err = rows.Scan(&teamID, &stats.TeamName, &stats.ActiveMembers); err != nil {
+			logger.Error("scan team row", zap.Error(err))
+			return nil, 0, err
+		}
+		teamIDs = append(teamIDs, teamID)
+		statsByTeam[teamID] = &stats
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team rows", zap.Error(err))
+		return nil, 0, err
+	}
+	rows.Close()
+
+	if len(teamIDs) == 0 {
+		return []models.TeamStats{}, total, nil
+	}
+
+	if err = s.fillWeeklyThroughput(ctx, logger, teamIDs, filter.Since, statsByTeam); err != nil {
+		return nil, 0, err
+	}
+	if err = s.fillTimeToMerge(ctx, logger, teamIDs, filter.Since, statsByTeam); err != nil {
+		return nil, 0, err
+	}
+	if err = s.fillStaleOpenPRs(ctx, logger, teamIDs, filter.StaleBefore, statsByTeam); err != nil {
+		return nil, 0, err
+	}
+
+	stats := make([]models.TeamStats, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		stats = append(stats, *statsByTeam[teamID])
+	}
+
+	return stats, total, nil
+}
+
+func (s *sqliteRepo) fillWeeklyThroughput(
+	ctx context.Context,
+	logger *zap.Logger,
+	teamIDs []int64,
+	since time.Time,
+	statsByTeam map[int64]*models.TeamStats,
+) error {
+	opened, err := s.countPRsPerWeek(ctx, logger, "pr.created_at", sq.And{
+		sq.Eq{"pr.team_id": teamIDs},
+		sq.GtOrEq{"pr.created_at": toMicros(since)},
+	})
+	if err != nil {
+		return err
+	}
+
+	merged, err := s.countPRsPerWeek(ctx, logger, "pr.merged_at", sq.And{
+		sq.Eq{"pr.team_id": teamIDs},
+		sq.Eq{"pr.status": models.PRStatusMERGED},
+		sq.GtOrEq{"pr.merged_at": toMicros(since)},
+	})
+	if err != nil {
+		return err
+	}
+
+	for teamID, stats := range statsByTeam {
+		weeks := make(map[time.Time]*models.WeeklyThroughput)
+		for week, count := range opened[teamID] {
+			weeks[week] = &models.WeeklyThroughput{WeekStart: week, Opened: count}
+		}
+		for week, count := range merged[teamID] {
+			if _, ok := weeks[week]; !ok {
+				weeks[week] = &models.WeeklyThroughput{WeekStart: week}
+			}
+			weeks[week].Merged = count
+		}
+
+		for _, week := range weeks {
+			stats.Weekly = append(stats.Weekly, *week)
+		}
+		slices.SortFunc(stats.Weekly, func(a, b models.WeeklyThroughput) int {
+			return a.WeekStart.Compare(b.WeekStart)
+		})
+	}
+
+	return nil
+}
+
+// Неделя начинается в понедельник, как date_trunc('week', ...) в postgres:
+// 1970-01-01 был четвергом, поэтому номер дня сдвигается на 3.
+func weekStartExpr(column string) string {
+	day := fmt.Sprintf("(%s / %d)", column, time.Hour.Microseconds()*24)
+	return fmt.Sprintf("(%s - (%s + 3) %% 7) * %d", day, day, time.Hour.Microseconds()*24)
+}
+
+func (s *sqliteRepo) countPRsPerWeek(
+	ctx context.Context,
+	logger *zap.Logger,
+	column string,
+	where sq.Sqlizer,
+) (map[int64]map[time.Time]int, error) {
+	countPerWeek := s.queryBuilder.Select(
+		"pr.team_id",
+		weekStartExpr(column)+" AS week",
+		"COUNT(*)",
+	).
+		From("pull_request pr").
+		Where(where).
+		GroupBy("pr.team_id", "week")
+
+	rows, err := s.query(ctx, "count PRs per week", countPerWeek)
+	if err != nil {
+		logger.Error("count PRs per week query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int64]map[time.Time]int)
+	for rows.Next() {
+		var teamID int64
+		var week time.Time
+		var count int
+		if err = rows.Scan(&teamID, timestamp{dst: &week}, &count); err != nil {
+			logger.Error("scan PRs per week row", zap.Error(err))
+			return nil, err
+		}
+		if counts[teamID] == nil {
+			counts[teamID] = make(map[time.Time]int)
+		}
+		counts[teamID][week] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// в SQLite нет percentile_cont, процентили считаются по отсортированным длительностям
+func (s *sqliteRepo) fillTimeToMerge(
+	ctx context.Context,
+	logger *zap.Logger,
+	teamIDs []int64,
+	since time.Time,
+	statsByTeam map[int64]*models.TeamStats,
+) error {
+	getTimeToMerge := s.queryBuilder.Select(
+		"pr.team_id",
+		"pr.merged_at - pr.created_at AS time_to_merge",
+	).
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.team_id": teamIDs},
+			sq.Eq{"pr.status": models.PRStatusMERGED},
+			sq.GtOrEq{"pr.merged_at": toMicros(since)},
+		}).
+		OrderBy("pr.team_id", "time_to_merge")
+
+	rows, err := s.query(ctx, "time to merge", getTimeToMerge)
+	if err != nil {
+		logger.Error("time to merge query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	timeToMerge := make(map[int64][]float64)
+	for rows.Next() {
+		var teamID, micros int64
+		if err = rows.Scan(&teamID, &micros); err != nil {
+			logger.Error("scan time to merge row", zap.Error(err))
+			return err
+		}
+		timeToMerge[teamID] = append(timeToMerge[teamID], float64(micros)/1e6)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate time to merge rows", zap.Error(err))
+		return err
+	}
+
+	for teamID, seconds := range timeToMerge {
+		stats := statsByTeam[teamID]
+		stats.TimeToMergeP50 = secondsToDuration(percentile(seconds, 0.5))
+		stats.TimeToMergeP90 = secondsToDuration(percentile(seconds, 0.9))
+	}
+
+	return nil
+}
+
+// линейная интерполяция, как percentile_cont в postgres; values отсортированы
+func percentile(values []float64, p float64) float64 {
+	position := p * float64(len(values)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	return values[lower] + (values[upper]-values[lower])*(position-float64(lower))
+}
+
+func (s *sqliteRepo) fillStaleOpenPRs(
+	ctx context.Context,
+	logger *zap.Logger,
+	teamIDs []int64,
+	staleBefore time.Time,
+	statsByTeam map[int64]*models.TeamStats,
+) error {
+	getStale := s.queryBuilder.Select("pr.team_id", "COUNT(*)").
+		From("pull_request pr").
+		Where(sq.And{
+			sq.Eq{"pr.team_id": teamIDs},
+			sq.Eq{"pr.status": models.PRStatusOPEN},
+			sq.Lt{"pr.created_at": toMicros(staleBefore)},
+		}).
+		GroupBy("pr.team_id")
+
+	rows, err := s.query(ctx, "stale PRs", getStale)
+	if err != nil {
+		logger.Error("stale PRs query", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var teamID int64
+		var count int
+		if err = rows.Scan(&teamID, &count); err != nil {
+			logger.Error("scan stale PRs row", zap.Error(err))
+			return err
+		}
+		statsByTeam[teamID].StaleOpenPRs = count
+	}
+
+	return rows.Err()
+}
+
+func secondsToDuration(seconds float64) *time.Duration {
+	d := time.Duration(seconds * float64(time.Second))
+	return &d
+}
+
+func (s *sqliteRepo) TeamPairings(
+	ctx context.Context,
+	teamName string,
+	since time.Time,
+) ([]models.ReviewPairing, error) {
+	logger := s.logger.With(
+		zap.String("team_name", teamName),
+		zap.Time("since", since),
+	)
+
+	getPairings := s.queryBuilder.Select("rp.author_id", "rp.reviewer_id", "COUNT(rp.id)").
+		From("team t").
+		LeftJoin("team_membership m ON m.team_id = t.id").
+		LeftJoin("review_pairing rp ON rp.author_id = m.user_id AND rp.assigned_at >= ?", toMicros(since)).
+		Where(sq.Eq{"t.name": teamName}).
+		Where(orgEq(ctx, "t.organization")).
+		GroupBy("rp.author_id", "rp.reviewer_id").
+		OrderBy("rp.author_id", "rp.reviewer_id")
+
+	rows, err := s.query(ctx, "team pairings", getPairings)
+	if err != nil {
+		logger.Error("team pairings query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	teamFound := false
+	pairings := make([]models.ReviewPairing, 0)
+	for rows.Next() {
+		teamFound = true
+
+		var authorID, reviewerID *string
+		var reviews int
+		if err = rows.Scan(&authorID, &reviewerID, &reviews); err != nil {
+			logger.Error("scan team pairing", zap.Error(err))
+			return nil, err
+		}
+		if authorID != nil {
+			pairings = append(pairings, models.ReviewPairing{
+				AuthorID:   *authorID,
+				ReviewerID: *reviewerID,
+				Reviews:    reviews,
+			})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team pairings", zap.Error(err))
+		return nil, err
+	}
+
+	if !teamFound {
+		logger.Warn("team not found")
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return pairings, nil
+}
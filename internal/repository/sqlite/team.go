@@ -0,0 +1,459 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) TeamAdd(
+	ctx context.Context,
+	team models.Team,
+) error {
+	logger := s.logger.With(
+		zap.String("team_name", team.Name),
+		zap.Any("members", team.Members),
+	)
+
+	if len(team.Members) == 0 {
+		logger.Warn("team has no members")
+		return modelsErr.ErrEmptyTeam
+	}
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		createTeam := s.queryBuilder.Insert("team").
+			Columns("organization", "name").
+			Values(models.OrganizationFromContext(ctx), team.Name).
+			Suffix("RETURNING id")
+
+		var teamID int64
+		if err := s.scanRow(ctx, "create team", createTeam, &teamID); err != nil {
+			if isUniqueViolation(err) {
+				logger.Warn("team already exists", zap.Error(err))
+				return modelsErr.ErrTeamExist
+			}
+			logger.Error("create team query", zap.Error(err))
+			return err
+		}
+
+		userIDs := make([]string, len(team.Members))
+		for i, m := range team.Members {
+			userIDs[i] = m.UserID
+		}
+
+		if err := s.upsertUsers(ctx, team.Members); err != nil {
+			logger.Error("insert users", zap.Error(err))
+			return err
+		}
+
+		if err := s.addMemberships(ctx, teamID, userIDs); err != nil {
+			logger.Error("insert memberships", zap.Error(err))
+			return err
+		}
+
+		audit := []teamAuditRecord{{action: models.TeamAuditTeamCreated, teamID: teamID, teamName: team.Name}}
+		for _, userID := range userIDs {
+			audit = append(audit, teamAuditRecord{
+				action:   models.TeamAuditMemberAdded,
+				teamID:   teamID,
+				teamName: team.Name,
+				userID:   &userID,
+			})
+		}
+		if err := s.recordTeamAudit(ctx, audit); err != nil {
+			logger.Error("record team audit", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *sqliteRepo) TeamGet(
+	ctx context.Context,
+	teamName string,
+) (*models.Team, error) {
+	logger := s.logger.With(zap.String("team_name", teamName))
+
+	query := s.queryBuilder.Select(
+		"t.name",
+		"t.version",
+		"u.id",
+		"u.name",
+		"u.is_active",
+	).
+		From("team t").
+		Join("team_membership m ON m.team_id = t.id").
+		Join("users u ON u.id = m.user_id").
+		Where(sq.And{
+			sq.Eq{"t.name": teamName},
+			orgEq(ctx, "t.organization"),
+		}).
+		OrderBy("m.joined_at", "u.id")
+
+	rows, err := s.query(ctx, "get team", query)
+	if err != nil {
+		logger.Error("get team query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var team models.Team
+	team.Members = make([]models.Member, 0)
+
+	for rows.Next() {
+		var member models.Member
+		err = rows.Scan(
+			&team.Name,
+			&team.Version,
+			&member.UserID,
+			&member.Username,
+			&member.IsActive,
+		)
+		if err != nil {
+			logger.Error("scan team row", zap.Error(err))
+			return nil, err
+		}
+
+		team.Members = append(team.Members, member)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team rows", zap.Error(err))
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+func (s *sqliteRepo) GetActiveTeammates(
+	ctx context.Context,
+	teamID string,
+	excludedUsers []string,
+	now time.Time,
+) ([]models.Candidate, error) {
+	logger := s.logger.With(
+		zap.String("team_id", teamID),
+		zap.Any("excluded_users", excludedUsers),
+		zap.Time("now", now),
+	)
+
+	return s.getActiveCandidates(ctx, logger, sq.Expr(
+		"EXISTS (SELECT 1 FROM team_membership m WHERE m.user_id = u.id AND m.team_id = ?)",
+		teamID,
+	), excludedUsers, now)
+}
+
+func (s *sqliteRepo) GetActiveCodeOwners(
+	ctx context.Context,
+	owners []models.CodeOwner,
+	excludedUsers []string,
+	now time.Time,
+) ([]models.Candidate, error) {
+	logger := s.logger.With(
+		zap.Any("owners", owners),
+		zap.Any("excluded_users", excludedUsers),
+		zap.Time("now", now),
+	)
+
+	var userIDs, teamNames []string
+	for _, owner := range owners {
+		switch owner.Kind {
+		case models.CodeOwnerUser:
+			userIDs = append(userIDs, owner.Name)
+		case models.CodeOwnerTeam:
+			teamNames = append(teamNames, owner.Name)
+		}
+	}
+
+	return s.getActiveCandidates(ctx, logger, sq.Or{
+		sq.Eq{"u.id": userIDs},
+		sq.Expr("EXISTS (SELECT 1 FROM team_membership m JOIN team t ON t.id = m.team_id WHERE m.user_id = u.id AND ?)",
+			sq.Eq{"t.name": teamNames}),
+	}, excludedUsers, now)
+}
+
+// Кандидаты и их открытые ревью читаются в одной транзакции: она держит блокировку
+// на запись, поэтому параллельное назначение не превысит лимит, как FOR UPDATE в postgres.
+func (s *sqliteRepo) getActiveCandidates(
+	ctx context.Context,
+	logger *zap.Logger,
+	condition sq.Sqlizer,
+	excludedUsers []string,
+	now time.Time,
+) (candidates []models.Candidate, err error) {
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		// Участнику нескольких команд без личного лимита достаётся самый строгий из лимитов его команд.
+		getCandidates := s.queryBuilder.Select(
+			"u.id",
+			"u.time_zone",
+			"u.work_start_minute",
+			"u.work_end_minute",
+			`COALESCE(u.max_open_reviews, (
+				SELECT MIN(t.default_max_open_reviews)
+				FROM team_membership m
+				JOIN team t ON t.id = m.team_id
+				WHERE m.user_id = u.id
+			))`,
+		).
+			From("users u").
+			Where(
+				sq.And{
+					condition,
+					orgEq(ctx, "u.organization"),
+					sq.Eq{"u.is_active": true},
+					sq.NotEq{"u.id": excludedUsers},
+					sq.Expr(`NOT EXISTS (
+						SELECT 1 FROM out_of_office o
+						WHERE o.user_id = u.id
+							AND o.starts_at <= ?
+							AND o.ends_at > ?
+					)`, toMicros(now), toMicros(now)),
+				},
+			).
+			OrderBy("u.id")
+
+		rows, err := s.query(ctx, "get active candidates", getCandidates)
+		if err != nil {
+			logger.Error("get active candidates query", zap.Error(err))
+			return err
+		}
+		defer rows.Close()
+
+		maxOpenReviews := make(map[string]int)
+		for rows.Next() {
+			var candidate models.Candidate
+			var workStart, workEnd, maxOpen *int
+			if err = rows.Scan(&candidate.UserID, &candidate.TimeZone, &workStart, &workEnd, &maxOpen); err != nil {
+				logger.Error("scan candidate", zap.Error(err))
+				return err
+			}
+			candidate.WorkingHours = toWorkingHours(workStart, workEnd)
+			if maxOpen != nil {
+				maxOpenReviews[candidate.UserID] = *maxOpen
+			}
+			candidates = append(candidates, candidate)
+		}
+		if err = rows.Err(); err != nil {
+			logger.Error("iterate candidates", zap.Error(err))
+			return err
+		}
+		rows.Close()
+
+		if len(maxOpenReviews) == 0 {
+			return nil
+		}
+
+		openReviews, err := s.countOpenReviews(ctx, slices.Collect(maps.Keys(maxOpenReviews)))
+		if err != nil {
+			logger.Error("count open reviews", zap.Error(err))
+			return err
+		}
+
+		candidates = slices.DeleteFunc(candidates, func(candidate models.Candidate) bool {
+			maxOpen, capped := maxOpenReviews[candidate.UserID]
+			return capped && openReviews[candidate.UserID] >= maxOpen
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+func (s *sqliteRepo) countOpenReviews(
+	ctx context.Context,
+	userIDs []string,
+) (map[string]int, error) {
+	countOpen := s.queryBuilder.Select("ar.user_id", "COUNT(*)").
+		From("assigned_reviewer ar").
+		Join("pull_request pr ON pr.id = ar.pr_id").
+		Where(sq.And{
+			sq.Eq{"ar.user_id": userIDs},
+			sq.Eq{"pr.status": models.PRStatusOPEN},
+		}).
+		GroupBy("ar.user_id")
+
+	rows, err := s.query(ctx, "count open reviews", countOpen)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	openReviews := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var count int
+		if err = rows.Scan(&userID, &count); err != nil {
+			return nil, err
+		}
+		openReviews[userID] = count
+	}
+
+	return openReviews, rows.Err()
+}
+
+func (s *sqliteRepo) GetUserTeams(
+	ctx context.Context,
+	userID string,
+) ([]models.UserTeam, error) {
+	logger := s.logger.With(zap.String("user_id", userID))
+
+	getTeams := s.queryBuilder.Select("CAST(t.id AS TEXT)", "t.name").
+		From("users u").
+		LeftJoin("team_membership m ON m.user_id = u.id").
+		LeftJoin("team t ON t.id = m.team_id").
+		Where(sq.And{
+			sq.Eq{"u.id": userID},
+			orgEq(ctx, "u.organization"),
+		}).
+		OrderBy("m.joined_at", "t.id")
+
+	rows, err := s.query(ctx, "get user teams", getTeams)
+	if err != nil {
+		logger.Error("get user teams query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	userFound := false
+	teams := make([]models.UserTeam, 0)
+	for rows.Next() {
+		userFound = true
+
+		var teamID, teamName *string
+		if err = rows.Scan(&teamID, &teamName); err != nil {
+			logger.Error("scan user team", zap.Error(err))
+			return nil, err
+		}
+		if teamID != nil {
+			teams = append(teams, models.UserTeam{ID: *teamID, Name: *teamName})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate user teams", zap.Error(err))
+		return nil, err
+	}
+
+	if !userFound {
+		logger.Warn("user not found")
+		return nil, modelsErr.ErrUserNotFound
+	}
+
+	return teams, nil
+}
+
+func (s *sqliteRepo) SetTeamPolicy(
+	ctx context.Context,
+	policy models.TeamPolicy,
+) (*models.TeamPolicy, error) {
+	logger := s.logger.With(
+		zap.String("team_name", policy.TeamName),
+		zap.Any("review_sla", policy.ReviewSLA),
+		zap.String("escalation_policy", string(policy.EscalationPolicy)),
+		zap.Any("default_max_open_reviews", policy.DefaultMaxOpenReviews),
+		zap.String("shortfall_policy", string(policy.ShortfallPolicy)),
+		zap.String("selection_mode", string(policy.SelectionMode)),
+		zap.Duration("rotation_window", policy.RotationWindow),
+	)
+
+	var reviewSLASeconds *int64
+	if policy.ReviewSLA != nil {
+		seconds := int64(policy.ReviewSLA.Seconds())
+		reviewSLASeconds = &seconds
+	}
+
+	setPolicy := s.queryBuilder.Update("team").
+		Set("review_sla_seconds", reviewSLASeconds).
+		Set("escalation_policy", policy.EscalationPolicy).
+		Set("default_max_open_reviews", policy.DefaultMaxOpenReviews).
+		Set("shortfall_policy", policy.ShortfallPolicy).
+		Set("selection_mode", policy.SelectionMode).
+		Set("rotation_window_seconds", int64(policy.RotationWindow.Seconds())).
+		Where(sq.And{
+			sq.Eq{"name": policy.TeamName},
+			orgEq(ctx, "organization"),
+		}).
+		Suffix("RETURNING " + teamPolicyColumns)
+
+	dbPolicy, err := s.scanTeamPolicy(ctx, "set team policy", setPolicy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return nil, modelsErr.ErrTeamNotFound
+		}
+		logger.Error("set team policy query", zap.Error(err))
+		return nil, err
+	}
+
+	return dbPolicy, nil
+}
+
+func (s *sqliteRepo) GetTeamPolicy(
+	ctx context.Context,
+	teamID string,
+) (*models.TeamPolicy, error) {
+	logger := s.logger.With(zap.String("team_id", teamID))
+
+	getPolicy := s.queryBuilder.Select(teamPolicyColumns).
+		From("team").
+		Where(sq.And{
+			sq.Eq{"id": teamID},
+			orgEq(ctx, "organization"),
+		})
+
+	policy, err := s.scanTeamPolicy(ctx, "get team policy", getPolicy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return nil, modelsErr.ErrTeamNotFound
+		}
+		logger.Error("get team policy query", zap.Error(err))
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+const teamPolicyColumns = "name, review_sla_seconds, escalation_policy, default_max_open_reviews, shortfall_policy, " +
+	"selection_mode, rotation_window_seconds"
+
+func (s *sqliteRepo) scanTeamPolicy(
+	ctx context.Context,
+	name string,
+	query sq.Sqlizer,
+) (*models.TeamPolicy, error) {
+	var policy models.TeamPolicy
+	var reviewSLASeconds *int64
+	var rotationWindowSeconds int64
+	err := s.scanRow(ctx, name, query,
+		&policy.TeamName,
+		&reviewSLASeconds,
+		&policy.EscalationPolicy,
+		&policy.DefaultMaxOpenReviews,
+		&policy.ShortfallPolicy,
+		&policy.SelectionMode,
+		&rotationWindowSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	policy.RotationWindow = time.Duration(rotationWindowSeconds) * time.Second
+
+	if reviewSLASeconds != nil {
+		reviewSLA := time.Duration(*reviewSLASeconds) * time.Second
+		policy.ReviewSLA = &reviewSLA
+	}
+
+	return &policy, nil
+}
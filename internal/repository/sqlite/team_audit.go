@@ -0,0 +1,115 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+type teamAuditRecord struct {
+	userID       *string
+	fromTeamID   *int64
+	fromTeamName *string
+	action       models.TeamAuditAction
+	teamName     string
+	teamID       int64
+}
+
+func (s *sqliteRepo) recordTeamAudit(
+	ctx context.Context,
+	records []teamAuditRecord,
+) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	createdAt := toMicros(s.now(ctx))
+	insertAudit := s.queryBuilder.Insert("team_audit").
+		Columns("action", "team_id", "team_name", "user_id", "from_team_id", "from_team_name", "created_at")
+	for _, record := range records {
+		insertAudit = insertAudit.Values(
+			record.action,
+			record.teamID,
+			record.teamName,
+			record.userID,
+			record.fromTeamID,
+			record.fromTeamName,
+			createdAt,
+		)
+	}
+
+	_, err := s.exec(ctx, "record team audit", insertAudit)
+	return err
+}
+
+func (s *sqliteRepo) TeamAudit(
+	ctx context.Context,
+	teamName string,
+) ([]models.TeamAuditEntry, error) {
+	logger := s.logger.With(zap.String("team_name", teamName))
+
+	getAudit := s.queryBuilder.Select(
+		"a.action",
+		"a.team_name",
+		"a.user_id",
+		"a.from_team_name",
+		"a.created_at",
+	).
+		From("team t").
+		LeftJoin("team_audit a ON a.team_id = t.id OR a.from_team_id = t.id").
+		Where(sq.And{
+			sq.Eq{"t.name": teamName},
+			orgEq(ctx, "t.organization"),
+		}).
+		OrderBy("a.id")
+
+	rows, err := s.query(ctx, "team audit", getAudit)
+	if err != nil {
+		logger.Error("team audit query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	teamFound := false
+	entries := make([]models.TeamAuditEntry, 0)
+	for rows.Next() {
+		teamFound = true
+
+		var action, entryTeamName *string
+		var createdAt *time.Time
+		var entry models.TeamAuditEntry
+		if err = rows.Scan(
+			&action,
+			&entryTeamName,
+			&entry.UserID,
+			&entry.PreviousTeamName,
+			nullTimestamp{dst: &createdAt},
+		); err != nil {
+			logger.Error("scan team audit entry", zap.Error(err))
+			return nil, err
+		}
+		if action == nil {
+			continue
+		}
+		entry.Action = models.TeamAuditAction(*action)
+		entry.TeamName = *entryTeamName
+		entry.CreatedAt = *createdAt
+		entries = append(entries, entry)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("iterate team audit", zap.Error(err))
+		return nil, err
+	}
+
+	if !teamFound {
+		logger.Warn("team not found")
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return entries, nil
+}
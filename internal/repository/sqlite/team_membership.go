@@ -0,0 +1,497 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+type memberTeam struct {
+	teamName string
+	teamID   int64
+}
+
+func (s *sqliteRepo) TeamUpdateMembers(
+	ctx context.Context,
+	change models.TeamMembersChange,
+) error {
+	logger := s.logger.With(
+		zap.String("team_name", change.TeamName),
+		zap.Any("add", change.Add),
+		zap.Strings("remove", change.Remove),
+		zap.Bool("move", change.Move),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		teamID, err := s.getTeamID(ctx, change.TeamName)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				logger.Warn("team not found")
+				return modelsErr.ErrTeamNotFound
+			}
+			logger.Error("get team ID", zap.Error(err))
+			return err
+		}
+
+		var audit []teamAuditRecord
+		if len(change.Add) > 0 {
+			audit, err = s.addMembers(ctx, teamID, change)
+			if err != nil {
+				logger.Error("add members", zap.Error(err))
+				return err
+			}
+		}
+
+		if len(change.Remove) > 0 {
+			removeMembers := s.queryBuilder.Delete("team_membership").
+				Where(sq.Eq{"team_id": teamID, "user_id": change.Remove}).
+				Suffix("RETURNING user_id")
+
+			rows, err := s.query(ctx, "remove members", removeMembers)
+			if err != nil {
+				logger.Error("remove members query", zap.Error(err))
+				return err
+			}
+			removed, err := scanStrings(rows)
+			if err != nil {
+				logger.Error("scan removed members", zap.Error(err))
+				return err
+			}
+
+			for _, userID := range change.Remove {
+				if !slices.Contains(removed, userID) {
+					logger.Warn("user is not a team member", zap.String("user_id", userID))
+					return fmt.Errorf("%w: %s", modelsErr.ErrUserNotInTeam, userID)
+				}
+				audit = append(audit, teamAuditRecord{
+					action:   models.TeamAuditMemberRemoved,
+					teamID:   teamID,
+					teamName: change.TeamName,
+					userID:   &userID,
+				})
+			}
+		}
+
+		countMembers := s.queryBuilder.Select("COUNT(*)").
+			From("team_membership").
+			Where(sq.Eq{"team_id": teamID})
+
+		var members int
+		if err = s.scanRow(ctx, "count members", countMembers, &members); err != nil {
+			logger.Error("count members query", zap.Error(err))
+			return err
+		}
+		if members == 0 {
+			logger.Warn("team would be left without members")
+			return modelsErr.ErrEmptyTeam
+		}
+
+		if err = s.bumpTeamVersions(ctx, changedTeamIDs(teamID, audit)); err != nil {
+			logger.Error("bump team versions", zap.Error(err))
+			return err
+		}
+
+		if err = s.recordTeamAudit(ctx, audit); err != nil {
+			logger.Error("record team audit", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+// версия меняется и у команд, из которых участники ушли при переносе
+func changedTeamIDs(teamID int64, audit []teamAuditRecord) []int64 {
+	teamIDs := []int64{teamID}
+	for _, record := range audit {
+		if record.fromTeamID != nil && !slices.Contains(teamIDs, *record.fromTeamID) {
+			teamIDs = append(teamIDs, *record.fromTeamID)
+		}
+	}
+	return teamIDs
+}
+
+func (s *sqliteRepo) addMembers(
+	ctx context.Context,
+	teamID int64,
+	change models.TeamMembersChange,
+) ([]teamAuditRecord, error) {
+	userIDs := make([]string, len(change.Add))
+	for i, m := range change.Add {
+		userIDs[i] = m.UserID
+	}
+
+	currentTeams, err := s.getMemberTeams(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var audit []teamAuditRecord
+	var movedUsers []string
+	for _, userID := range userIDs {
+		var member bool
+		var otherTeams []memberTeam
+		for _, current := range currentTeams[userID] {
+			if current.teamID == teamID {
+				member = true
+			} else {
+				otherTeams = append(otherTeams, current)
+			}
+		}
+
+		if change.Move && len(otherTeams) > 0 {
+			movedUsers = append(movedUsers, userID)
+			for _, from := range otherTeams {
+				audit = append(audit, teamAuditRecord{
+					action:       models.TeamAuditMemberMoved,
+					teamID:       teamID,
+					teamName:     change.TeamName,
+					userID:       &userID,
+					fromTeamID:   &from.teamID,
+					fromTeamName: &from.teamName,
+				})
+			}
+			continue
+		}
+
+		if !member {
+			audit = append(audit, teamAuditRecord{
+				action:   models.TeamAuditMemberAdded,
+				teamID:   teamID,
+				teamName: change.TeamName,
+				userID:   &userID,
+			})
+		}
+	}
+
+	if err = s.upsertUsers(ctx, change.Add); err != nil {
+		return nil, err
+	}
+
+	if err = s.addMemberships(ctx, teamID, userIDs); err != nil {
+		return nil, err
+	}
+
+	if len(movedUsers) > 0 {
+		leaveTeams := s.queryBuilder.Delete("team_membership").
+			Where(sq.And{
+				sq.Eq{"user_id": movedUsers},
+				sq.NotEq{"team_id": teamID},
+			})
+
+		if _, err = s.exec(ctx, "leave other teams", leaveTeams); err != nil {
+			return nil, err
+		}
+	}
+
+	return audit, nil
+}
+
+func (s *sqliteRepo) upsertUsers(
+	ctx context.Context,
+	members []models.Member,
+) error {
+	organization := models.OrganizationFromContext(ctx)
+	upsertUsers := s.queryBuilder.Insert("users").
+		Columns("organization", "id", "name", "is_active")
+	for _, m := range members {
+		upsertUsers = upsertUsers.Values(organization, m.UserID, m.Username, m.IsActive)
+	}
+	// пользователь другой организации не обновляется и не попадает в RETURNING
+	upsertUsers = upsertUsers.Suffix(`
+		ON CONFLICT (id) DO UPDATE
+		SET name = excluded.name,
+			is_active = excluded.is_active
+		WHERE users.organization = excluded.organization
+		RETURNING id
+	`)
+
+	rows, err := s.query(ctx, "upsert users", upsertUsers)
+	if err != nil {
+		return err
+	}
+	upserted, err := scanStrings(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		if !slices.Contains(upserted, m.UserID) {
+			return fmt.Errorf("%w: %s", modelsErr.ErrUserIDTaken, m.UserID)
+		}
+	}
+	return nil
+}
+
+func (s *sqliteRepo) addMemberships(
+	ctx context.Context,
+	teamID int64,
+	userIDs []string,
+) error {
+	joinedAt := toMicros(s.now(ctx))
+	insertMemberships := s.queryBuilder.Insert("team_membership").
+		Columns("team_id", "user_id", "joined_at")
+	for _, userID := range userIDs {
+		insertMemberships = insertMemberships.Values(teamID, userID, joinedAt)
+	}
+	insertMemberships = insertMemberships.Suffix("ON CONFLICT DO NOTHING")
+
+	_, err := s.exec(ctx, "insert memberships", insertMemberships)
+	return err
+}
+
+func (s *sqliteRepo) TeamRename(
+	ctx context.Context,
+	teamName, newTeamName string,
+) error {
+	logger := s.logger.With(
+		zap.String("team_name", teamName),
+		zap.String("new_team_name", newTeamName),
+	)
+
+	return s.inTx(ctx, func(ctx context.Context) error {
+		renameTeam := s.queryBuilder.Update("team").
+			Set("name", newTeamName).
+			Set("version", sq.Expr("version + 1")).
+			Where(sq.And{
+				sq.Eq{"name": teamName},
+				orgEq(ctx, "organization"),
+			}).
+			Suffix("RETURNING id")
+
+		var teamID int64
+		if err := s.scanRow(ctx, "rename team", renameTeam, &teamID); err != nil {
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				logger.Warn("team not found")
+				return modelsErr.ErrTeamNotFound
+			case isUniqueViolation(err):
+				logger.Warn("team already exists", zap.Error(err))
+				return modelsErr.ErrTeamExist
+			}
+			logger.Error("rename team query", zap.Error(err))
+			return err
+		}
+
+		err := s.recordTeamAudit(ctx, []teamAuditRecord{{
+			action:       models.TeamAuditTeamRenamed,
+			teamID:       teamID,
+			teamName:     newTeamName,
+			fromTeamName: &teamName,
+		}})
+		if err != nil {
+			logger.Error("record team audit", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *sqliteRepo) TeamDelete(
+	ctx context.Context,
+	teamName string,
+	openPRPolicy models.OpenPRPolicy,
+) (deletion *models.TeamDeletion, err error) {
+	logger := s.logger.With(
+		zap.String("team_name", teamName),
+		zap.String("open_pr_policy", string(openPRPolicy)),
+	)
+
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		teamID, err := s.getTeamID(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				logger.Warn("team not found")
+				return modelsErr.ErrTeamNotFound
+			}
+			logger.Error("get team ID", zap.Error(err))
+			return err
+		}
+
+		releaseMembers := s.queryBuilder.Delete("team_membership").
+			Where(sq.Eq{"team_id": teamID}).
+			Suffix("RETURNING user_id")
+
+		rows, err := s.query(ctx, "release members", releaseMembers)
+		if err != nil {
+			logger.Error("release members query", zap.Error(err))
+			return err
+		}
+		members, err := scanStrings(rows)
+		if err != nil {
+			logger.Error("scan released members", zap.Error(err))
+			return err
+		}
+
+		deletion = &models.TeamDeletion{
+			TeamName:        teamName,
+			ReleasedMembers: members,
+		}
+
+		switch openPRPolicy {
+		case models.OpenPRPolicyUnassign:
+			unassign := s.queryBuilder.Delete("assigned_reviewer").
+				Where(sq.Expr(
+					"pr_id IN (SELECT id FROM pull_request WHERE team_id = ? AND status = ?)",
+					teamID, models.PRStatusOPEN,
+				))
+
+			result, err := s.exec(ctx, "unassign reviews", unassign)
+			if err != nil {
+				logger.Error("unassign reviews", zap.Error(err))
+				return err
+			}
+			unassigned, err := result.RowsAffected()
+			if err != nil {
+				logger.Error("unassign reviews", zap.Error(err))
+				return err
+			}
+			deletion.UnassignedReviews = int(unassigned)
+
+			bumpVersions := s.queryBuilder.Update("pull_request").
+				Set("version", sq.Expr("version + 1")).
+				Where(sq.Eq{"team_id": teamID, "status": models.PRStatusOPEN})
+
+			if _, err = s.exec(ctx, "bump PR versions", bumpVersions); err != nil {
+				logger.Error("bump PR versions", zap.Error(err))
+				return err
+			}
+
+		default:
+			countOpen := s.queryBuilder.Select("COUNT(*)").
+				From("pull_request").
+				Where(sq.Eq{"team_id": teamID, "status": models.PRStatusOPEN})
+
+			var openPRs int
+			if err = s.scanRow(ctx, "count open PRs", countOpen, &openPRs); err != nil {
+				logger.Error("count open PRs query", zap.Error(err))
+				return err
+			}
+			if openPRs > 0 {
+				logger.Warn("team has open PRs", zap.Int("open_prs", openPRs))
+				return modelsErr.ErrTeamHasOpenPRs
+			}
+		}
+
+		deleteTeam := s.queryBuilder.Delete("team").
+			Where(sq.Eq{"id": teamID})
+
+		if _, err = s.exec(ctx, "delete team", deleteTeam); err != nil {
+			logger.Error("delete team", zap.Error(err))
+			return err
+		}
+
+		audit := []teamAuditRecord{{action: models.TeamAuditTeamDeleted, teamID: teamID, teamName: teamName}}
+		for _, userID := range members {
+			audit = append(audit, teamAuditRecord{
+				action:   models.TeamAuditMemberRemoved,
+				teamID:   teamID,
+				teamName: teamName,
+				userID:   &userID,
+			})
+		}
+		if err = s.recordTeamAudit(ctx, audit); err != nil {
+			logger.Error("record team audit", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+func (s *sqliteRepo) GetTeamVersion(
+	ctx context.Context,
+	teamName string,
+) (int64, error) {
+	logger := s.logger.With(zap.String("team_name", teamName))
+
+	getVersion := s.queryBuilder.Select("version").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"name": teamName},
+			orgEq(ctx, "organization"),
+		})
+
+	var version int64
+	if err := s.scanRow(ctx, "get team version", getVersion, &version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("team not found")
+			return 0, modelsErr.ErrTeamNotFound
+		}
+		logger.Error("get team version query", zap.Error(err))
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (s *sqliteRepo) bumpTeamVersions(
+	ctx context.Context,
+	teamIDs []int64,
+) error {
+	bumpVersions := s.queryBuilder.Update("team").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": teamIDs})
+
+	_, err := s.exec(ctx, "bump team versions", bumpVersions)
+	return err
+}
+
+func (s *sqliteRepo) getTeamID(
+	ctx context.Context,
+	teamName string,
+) (int64, error) {
+	getTeamID := s.queryBuilder.Select("id").
+		From("team").
+		Where(sq.And{
+			sq.Eq{"name": teamName},
+			orgEq(ctx, "organization"),
+		})
+
+	var teamID int64
+	err := s.scanRow(ctx, "get team ID", getTeamID, &teamID)
+	return teamID, err
+}
+
+func (s *sqliteRepo) getMemberTeams(
+	ctx context.Context,
+	userIDs []string,
+) (map[string][]memberTeam, error) {
+	getTeams := s.queryBuilder.Select("m.user_id", "m.team_id", "t.name").
+		From("team_membership m").
+		Join("team t ON t.id = m.team_id").
+		Where(sq.Eq{"m.user_id": userIDs}).
+		OrderBy("m.joined_at", "m.team_id")
+
+	rows, err := s.query(ctx, "get member teams", getTeams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make(map[string][]memberTeam, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var team memberTeam
+		if err = rows.Scan(&userID, &team.teamID, &team.teamName); err != nil {
+			return nil, err
+		}
+		teams[userID] = append(teams[userID], team)
+	}
+
+	return teams, rows.Err()
+}
@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+type transactor struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewTransactor(db *sql.DB, logger *zap.Logger) *transactor {
+	return &transactor{
+		db:     db,
+		logger: logger,
+	}
+}
+
+type txInjector struct{}
+
+type txState struct {
+	tx  *sql.Tx
+	now time.Time
+}
+
+// WithTx открывает транзакцию с блокировкой на запись, поэтому транзакции
+// выполняются последовательно: уровень изоляции всегда SERIALIZABLE, конфликтов
+// сериализации не бывает, и повторы не нужны. Ожидание блокировки ограничено busy_timeout.
+func (t transactor) WithTx(
+	ctx context.Context,
+	function func(ctx context.Context) error,
+	opts ...models.TxOption,
+) error {
+	// вложенный вызов работает в уже открытой транзакции, повторяет её внешний WithTx
+	if _, ok := extractTx(ctx); ok {
+		if err := function(ctx); err != nil {
+			return fmt.Errorf("function execution error: %w", err)
+		}
+		return nil
+	}
+
+	conn, err := t.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("can not get connection, error: %w", err)
+	}
+	defer conn.Close()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("can not begin transaction, error: %w", err)
+	}
+
+	options := models.NewTxOptions(opts...)
+	if options.ReadOnly {
+		// драйвер игнорирует TxOptions.ReadOnly, запись запрещается на уровне соединения.
+		// Режим включается после BEGIN: BEGIN IMMEDIATE в нём считается записью.
+		if _, err = tx.ExecContext(ctx, "PRAGMA query_only = ON"); err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				t.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+			return fmt.Errorf("can not set read-only mode, error: %w", err)
+		}
+		defer t.resetQueryOnly(ctx, conn)
+	}
+
+	err = function(injectTx(ctx, &txState{tx: tx, now: now()}))
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			t.logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+		}
+		return fmt.Errorf("function execution error: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("can not commit transaction, error: %w", err)
+	}
+
+	return nil
+}
+
+// соединение, оставшееся в режиме только чтения, выбрасывается из пула
+func (t transactor) resetQueryOnly(ctx context.Context, conn *sql.Conn) {
+	_, err := conn.ExecContext(context.WithoutCancel(ctx), "PRAGMA query_only = OFF")
+	if err == nil {
+		return
+	}
+
+	t.logger.Error("failed to reset read-only mode", zap.Error(err))
+	_ = conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+}
+
+func injectTx(ctx context.Context, tx *txState) context.Context {
+	return context.WithValue(ctx, txInjector{}, tx)
+}
+
+func extractTx(ctx context.Context) (*txState, bool) {
+	tx, ok := ctx.Value(txInjector{}).(*txState)
+	return tx, ok
+}
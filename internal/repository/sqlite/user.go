@@ -0,0 +1,269 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (s *sqliteRepo) GetReview(
+	ctx context.Context,
+	userID string,
+) ([]models.PRShort, error) {
+	logger := s.logger.With(zap.String("user_id", userID))
+
+	getPRs := s.queryBuilder.Select(
+		"pr.external_id",
+		"pr.name",
+		"pr.author_id",
+		"pr.status",
+		"COALESCE(r.name, '')",
+	).
+		From("assigned_reviewer ar").
+		Join("pull_request pr ON ar.pr_id = pr.id").
+		LeftJoin("repository r ON r.id = pr.repository_id").
+		Where(sq.Eq{"ar.user_id": userID}).
+		Where(orgEq(ctx, "pr.organization"))
+
+	rows, err := s.query(ctx, "GetReview", getPRs)
+	if err != nil {
+		logger.Error("GetReview query", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []models.PRShort
+	for rows.Next() {
+		var dbPR models.PRShort
+		if err = rows.Scan(
+			&dbPR.ID,
+			&dbPR.Name,
+			&dbPR.AuthorID,
+			&dbPR.Status,
+			&dbPR.Repository,
+		); err != nil {
+			logger.Error("scan pr row", zap.Error(err))
+			return nil, err
+		}
+		prs = append(prs, dbPR)
+	}
+
+	return prs, rows.Err()
+}
+
+// В SQLite нет массивов, команды пользователя собираются в JSON-массив.
+const userTeamNamesColumn = `(
+	SELECT json_group_array(t.name ORDER BY m.joined_at, t.id)
+	FROM team_membership m
+	JOIN team t ON t.id = m.team_id
+	WHERE m.user_id = u.id
+)`
+
+func primaryTeamName(teamNames []string) string {
+	if len(teamNames) == 0 {
+		return ""
+	}
+	return teamNames[0]
+}
+
+func (s *sqliteRepo) SetIsActive(
+	ctx context.Context,
+	userID string,
+	isActive bool,
+) (*models.User, error) {
+	logger := s.logger.With(
+		zap.String("user_id", userID),
+		zap.Bool("is_active", isActive),
+	)
+
+	setIsActive := s.queryBuilder.Update("users").
+		Set("is_active", isActive).
+		Where(sq.Eq{"id": userID}).
+		Where(orgEq(ctx, "organization"))
+
+	user, err := s.updateUser(ctx, logger, "SetIsActive", setIsActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.User{
+		ID:        user.ID,
+		Name:      user.Name,
+		TeamName:  user.TeamName,
+		TeamNames: user.TeamNames,
+		IsActive:  user.IsActive,
+	}, nil
+}
+
+func (s *sqliteRepo) SetSchedule(
+	ctx context.Context,
+	userID, timeZone string,
+	workingHours *models.WorkingHours,
+) (*models.User, error) {
+	logger := s.logger.With(
+		zap.String("user_id", userID),
+		zap.String("time_zone", timeZone),
+		zap.Any("working_hours", workingHours),
+	)
+
+	var workStart, workEnd *int
+	if workingHours != nil {
+		start := int(workingHours.Start.Minutes())
+		end := int(workingHours.End.Minutes())
+		workStart, workEnd = &start, &end
+	}
+
+	setSchedule := s.queryBuilder.Update("users").
+		Set("time_zone", timeZone).
+		Set("work_start_minute", workStart).
+		Set("work_end_minute", workEnd).
+		Where(sq.Eq{"id": userID}).
+		Where(orgEq(ctx, "organization"))
+
+	user, err := s.updateUser(ctx, logger, "SetSchedule", setSchedule)
+	if err != nil {
+		return nil, err
+	}
+	user.MaxOpenReviews = nil
+
+	return user, nil
+}
+
+func toWorkingHours(workStart, workEnd *int) *models.WorkingHours {
+	if workStart == nil || workEnd == nil {
+		return nil
+	}
+	return &models.WorkingHours{
+		Start: time.Duration(*workStart) * time.Minute,
+		End:   time.Duration(*workEnd) * time.Minute,
+	}
+}
+
+func (s *sqliteRepo) SetMaxOpenReviews(
+	ctx context.Context,
+	userID string,
+	maxOpenReviews *int,
+) (*models.User, error) {
+	logger := s.logger.With(
+		zap.String("user_id", userID),
+		zap.Any("max_open_reviews", maxOpenReviews),
+	)
+
+	setMaxOpenReviews := s.queryBuilder.Update("users").
+		Set("max_open_reviews", maxOpenReviews).
+		Where(sq.Eq{"id": userID}).
+		Where(orgEq(ctx, "organization"))
+
+	user, err := s.updateUser(ctx, logger, "SetMaxOpenReviews", setMaxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+	user.TimeZone = ""
+	user.WorkingHours = nil
+
+	return user, nil
+}
+
+// RETURNING в SQLite не видит другие таблицы, поэтому пользователь
+// перечитывается после обновления в той же транзакции. Вызывающий оставляет
+// только поля, которые возвращает тот же метод в postgres.
+func (s *sqliteRepo) updateUser(
+	ctx context.Context,
+	logger *zap.Logger,
+	name string,
+	update sq.UpdateBuilder,
+) (user *models.User, err error) {
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		updateUser := update.Suffix("RETURNING id")
+
+		var userID string
+		if err := s.scanRow(ctx, name, updateUser, &userID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				logger.Error(name+" query", zap.Error(modelsErr.ErrUserNotFound))
+				return modelsErr.ErrUserNotFound
+			}
+			logger.Error(name+" query", zap.Error(err))
+			return err
+		}
+
+		user, err = s.getUser(ctx, userID)
+		if err != nil {
+			logger.Error("GetUser query", zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *sqliteRepo) GetUser(
+	ctx context.Context,
+	userID string,
+) (*models.User, error) {
+	logger := s.logger.With(zap.String("user_id", userID))
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("GetUser query", zap.Error(modelsErr.ErrUserNotFound))
+			return nil, modelsErr.ErrUserNotFound
+		}
+		logger.Error("GetUser query", zap.Error(err))
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *sqliteRepo) getUser(
+	ctx context.Context,
+	userID string,
+) (*models.User, error) {
+	getUser := s.queryBuilder.Select(
+		"u.name",
+		userTeamNamesColumn,
+		"u.is_active",
+		"u.time_zone",
+		"u.work_start_minute",
+		"u.work_end_minute",
+		"u.max_open_reviews",
+	).
+		From("users u").
+		Where(sq.Eq{"u.id": userID}).
+		Where(orgEq(ctx, "u.organization"))
+
+	user := models.User{ID: userID}
+	var teamNames string
+	var workStart, workEnd *int
+	err := s.scanRow(ctx, "GetUser", getUser,
+		&user.Name,
+		&teamNames,
+		&user.IsActive,
+		&user.TimeZone,
+		&workStart,
+		&workEnd,
+		&user.MaxOpenReviews,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal([]byte(teamNames), &user.TeamNames); err != nil {
+		return nil, err
+	}
+	user.WorkingHours = toWorkingHours(workStart, workEnd)
+	user.TeamName = primaryTeamName(user.TeamNames)
+
+	return &user, nil
+}
@@ -0,0 +1,64 @@
+package pr_service
+
+import (
+	"context"
+
+	"github.com/Tortik3000/PR-service/internal/codeowners"
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (u *useCase) SetCodeOwners(
+	ctx context.Context,
+	teamName, content string,
+) ([]models.CodeOwnersRule, error) {
+	rules, err := codeowners.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.teamRepository.SetCodeOwners(ctx, teamName, rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (u *useCase) GetCodeOwners(
+	ctx context.Context,
+	teamName string,
+) ([]models.CodeOwnersRule, error) {
+	rules, err := u.teamRepository.GetTeamCodeOwners(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (u *useCase) pickCodeOwners(
+	ctx context.Context,
+	teamID string,
+	changedFiles, excludedUsers []string,
+	count int,
+) ([]string, error) {
+	if len(changedFiles) == 0 {
+		return nil, nil
+	}
+
+	rules, err := u.teamRepository.GetCodeOwners(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := codeowners.Match(rules, changedFiles)
+	if len(owners) == 0 {
+		return nil, nil
+	}
+
+	candidates, err := u.teamRepository.GetActiveCodeOwners(ctx, owners, excludedUsers, u.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return u.selectReviewers(candidates, count), nil
+}
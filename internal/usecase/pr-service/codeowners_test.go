@@ -0,0 +1,176 @@
+package pr_service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
+)
+
+func TestUseCase_SetCodeOwners(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		content   string
+		wantRules []models.CodeOwnersRule
+		repoErr   error
+		wantErr   error
+	}{
+		{
+			name:    "success",
+			content: "*.sql @u1 @acme/dba\n",
+			wantRules: []models.CodeOwnersRule{
+				{Pattern: "*.sql", Owners: []models.CodeOwner{
+					{Kind: models.CodeOwnerUser, Name: "u1"},
+					{Kind: models.CodeOwnerTeam, Name: "dba"},
+				}},
+			},
+		},
+		{
+			name:    "invalid file is not stored",
+			content: "*.sql dba@example.com\n",
+			wantErr: modelsErr.ErrInvalidCodeOwners,
+		},
+		{
+			name:    "team not found",
+			content: "* @u1\n",
+			repoErr: modelsErr.ErrTeamNotFound,
+			wantErr: modelsErr.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			u := &useCase{
+				teamRepository: mockTeamRepo,
+			}
+
+			if tt.repoErr != nil || tt.wantErr == nil {
+				mockTeamRepo.EXPECT().SetCodeOwners(gomock.Any(), "backend", gomock.Any()).Return(tt.repoErr)
+			}
+
+			rules, err := u.SetCodeOwners(t.Context(), "backend", tt.content)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, rules)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRules, rules)
+		})
+	}
+}
+
+func TestUseCase_PullRequestCreate_CodeOwners(t *testing.T) {
+	t.Parallel()
+
+	rules := []models.CodeOwnersRule{
+		{Pattern: "*", Owners: []models.CodeOwner{{Kind: models.CodeOwnerUser, Name: "lead"}}},
+		{Pattern: "/db/", Owners: []models.CodeOwner{{Kind: models.CodeOwnerTeam, Name: "dba"}}},
+	}
+	dba := []models.CodeOwner{{Kind: models.CodeOwnerTeam, Name: "dba"}}
+
+	tests := []struct {
+		name          string
+		changedFiles  []string
+		owners        []models.CodeOwner
+		ownerCands    []string
+		teammates     []string
+		wantReviewers []string
+	}{
+		{
+			name:          "owner fills first slot",
+			changedFiles:  []string{"db/migrations/012.sql"},
+			owners:        dba,
+			ownerCands:    []string{"dba1"},
+			teammates:     []string{"u1"},
+			wantReviewers: []string{"dba1", "u1"},
+		},
+		{
+			name:          "owners fill all slots",
+			changedFiles:  []string{"db/migrations/012.sql"},
+			owners:        dba,
+			ownerCands:    []string{"dba1", "dba2", "dba3"},
+			wantReviewers: []string{"dba1", "dba2"},
+		},
+		{
+			name:          "no active owners",
+			changedFiles:  []string{"db/migrations/012.sql"},
+			owners:        dba,
+			teammates:     []string{"u1", "u2"},
+			wantReviewers: []string{"u1", "u2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+
+			ctx := t.Context()
+			u := &useCase{
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				pullRequestsRepository: mockPRRepo,
+				logger:                 zap.NewNop(),
+				clock:                  fakeclock.NewFake(time.Now()),
+			}
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockTeamRepo.EXPECT().GetUserTeams(ctx, "author").Return([]models.UserTeam{{ID: "team", Name: "backend"}}, nil)
+			mockTeamRepo.EXPECT().GetTeamPolicy(ctx, "team").Return(&models.TeamPolicy{}, nil)
+			mockTeamRepo.EXPECT().GetCodeOwners(ctx, "team").Return(rules, nil)
+			mockTeamRepo.EXPECT().GetActiveCodeOwners(ctx, tt.owners, []string{"author"}, gomock.Any()).
+				Return(toCandidates(tt.ownerCands), nil)
+
+			owners := min(len(tt.ownerCands), countMaxReviewers)
+			if owners < countMaxReviewers {
+				excluded := append([]string{"author"}, tt.ownerCands...)
+				mockTeamRepo.EXPECT().GetActiveTeammates(ctx, "team", excluded, gomock.Any()).
+					Return(toCandidates(tt.teammates), nil)
+			}
+
+			pr := &models.PR{ID: "pr1", AssignedReviewers: tt.wantReviewers}
+			mockPRRepo.EXPECT().PullRequestCreate(ctx, models.PR{
+				AssignedReviewers: tt.wantReviewers,
+				AuthorID:          "author",
+				ID:                "pr1",
+				Name:              "name",
+				TeamID:            "team",
+			}).
+				Return(pr, nil)
+
+			result, shortfall, err := u.PullRequestCreate(ctx, "", "author", "pr1", "name", "", tt.changedFiles)
+			require.NoError(t, err)
+			assert.Zero(t, shortfall)
+			assert.Equal(t, pr, result)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package pr_service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+const staleReviewsBatchSize = uint64(100)
+
+func (u *useCase) EscalateStaleReviews(
+	ctx context.Context,
+) ([]models.Escalation, error) {
+	now := u.clock.Now()
+
+	reviews, err := u.escalationRepository.GetStaleReviews(ctx, now, staleReviewsBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	escalations := make([]models.Escalation, 0, len(reviews))
+	for _, review := range reviews {
+		// фоновый обход идёт по всем организациям, дальше каждый PR обрабатывается в своей
+		ctx := models.WithOrganization(ctx, review.Organization)
+		logger := u.logger.With(
+			zap.String("organization", review.Organization),
+			zap.String("repository", review.Repository),
+			zap.String("pr_id", review.PRID),
+			zap.String("reviewer_id", review.ReviewerID),
+			zap.String("policy", string(review.Policy)),
+		)
+
+		escalation, err := u.escalateReview(ctx, review, now)
+		if err != nil {
+			logger.Error("escalate stale review", zap.Error(err))
+			continue
+		}
+		if escalation == nil {
+			continue
+		}
+
+		if err = u.escalationPublisher.PublishEscalation(ctx, *escalation); err != nil {
+			logger.Error("publish escalation", zap.Error(err))
+		}
+		escalations = append(escalations, *escalation)
+	}
+
+	return escalations, nil
+}
+
+func (u *useCase) escalateReview(
+	ctx context.Context,
+	review models.StaleReview,
+	now time.Time,
+) (*models.Escalation, error) {
+	var escalation *models.Escalation
+
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		// транзакция может повториться, результат прошлой попытки не должен утечь
+		escalation = nil
+
+		pr, err := u.pullRequestsRepository.GetPullRequest(ctx, review.Repository, review.PRID)
+		if err != nil {
+			if errors.Is(err, modelsErr.ErrPRMerged) {
+				return nil
+			}
+			return err
+		}
+
+		if !slices.Contains(pr.AssignedReviewers, review.ReviewerID) {
+			return nil
+		}
+
+		// PR уже заблокирован транзакцией: параллельный обход, успевший эскалировать
+		// это ревью, закоммитил запись, и при ADD_REVIEWER ревьювер остаётся назначенным
+		escalated, err := u.escalationRepository.IsReviewEscalated(ctx, review.Repository, review.PRID, review.ReviewerID)
+		if err != nil {
+			return err
+		}
+		if escalated {
+			return nil
+		}
+
+		policy, err := u.getReviewPolicy(ctx, review.TeamID, review.Repository)
+		if err != nil {
+			return err
+		}
+
+		excludedUsers := []string{pr.AuthorID}
+		excludedUsers = append(excludedUsers, pr.AssignedReviewers...)
+
+		teammates, fallbackReviewers, err := u.pickReviewers(ctx, review.TeamID, policy, pr.AuthorID, excludedUsers, 1)
+		if err != nil {
+			return err
+		}
+
+		escalation = &models.Escalation{
+			CreatedAt:      now,
+			PRID:           review.PRID,
+			TeamID:         review.TeamID,
+			Repository:     review.Repository,
+			IdleReviewerID: review.ReviewerID,
+			Policy:         review.Policy,
+		}
+
+		if len(teammates) > 0 {
+			escalation.NewReviewerID = teammates[0]
+			fromFallback := len(fallbackReviewers) > 0
+
+			switch review.Policy {
+			case models.EscalationPolicyAddReviewer:
+				err = u.pullRequestsRepository.PullRequestAddReviewer(ctx, review.Repository, review.PRID, escalation.NewReviewerID, fromFallback)
+			default:
+				err = u.pullRequestsRepository.PullRequestReassign(
+					ctx, review.Repository, review.PRID, review.ReviewerID, escalation.NewReviewerID, fromFallback)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return u.escalationRepository.CreateEscalation(ctx, *escalation)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return escalation, nil
+}
@@ -0,0 +1,219 @@
+package pr_service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
+)
+
+func TestUseCase_EscalateStaleReviews(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 10, 22, 12, 0, 0, 0, time.UTC)
+	openPR := &models.PR{
+		ID:                "pr1",
+		AuthorID:          "author",
+		AssignedReviewers: []string{"idle", "u2"},
+	}
+	review := func(policy models.EscalationPolicy) models.StaleReview {
+		return models.StaleReview{
+			PRID:         "pr1",
+			Organization: "org-a",
+			AuthorID:     "author",
+			ReviewerID:   "idle",
+			TeamID:       "team",
+			Policy:       policy,
+			AssignedAt:   now.Add(-48 * time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		review models.StaleReview
+
+		pr         *models.PR
+		getPRErr   error
+		escalated  bool
+		candidates []string
+
+		expectReassign    bool
+		expectAddReviewer bool
+		expectRecord      bool
+		want              []models.Escalation
+	}{
+		{
+			name:           "reassign policy replaces idle reviewer",
+			review:         review(models.EscalationPolicyReassign),
+			pr:             openPR,
+			candidates:     []string{"u3"},
+			expectReassign: true,
+			expectRecord:   true,
+			want: []models.Escalation{{
+				CreatedAt:      now,
+				PRID:           "pr1",
+				TeamID:         "team",
+				IdleReviewerID: "idle",
+				NewReviewerID:  "u3",
+				Policy:         models.EscalationPolicyReassign,
+			}},
+		},
+		{
+			name:              "add reviewer policy keeps idle reviewer",
+			review:            review(models.EscalationPolicyAddReviewer),
+			pr:                openPR,
+			candidates:        []string{"u3"},
+			expectAddReviewer: true,
+			expectRecord:      true,
+			want: []models.Escalation{{
+				CreatedAt:      now,
+				PRID:           "pr1",
+				TeamID:         "team",
+				IdleReviewerID: "idle",
+				NewReviewerID:  "u3",
+				Policy:         models.EscalationPolicyAddReviewer,
+			}},
+		},
+		{
+			name:         "no candidate is still recorded",
+			review:       review(models.EscalationPolicyReassign),
+			pr:           openPR,
+			candidates:   nil,
+			expectRecord: true,
+			want: []models.Escalation{{
+				CreatedAt:      now,
+				PRID:           "pr1",
+				TeamID:         "team",
+				IdleReviewerID: "idle",
+				Policy:         models.EscalationPolicyReassign,
+			}},
+		},
+		{
+			name:     "merged meanwhile is skipped",
+			review:   review(models.EscalationPolicyReassign),
+			getPRErr: modelsErr.ErrPRMerged,
+			want:     []models.Escalation{},
+		},
+		{
+			name:   "reviewer already replaced is skipped",
+			review: review(models.EscalationPolicyReassign),
+			pr: &models.PR{
+				ID:                "pr1",
+				AuthorID:          "author",
+				AssignedReviewers: []string{"u2", "u3"},
+			},
+			want: []models.Escalation{},
+		},
+		{
+			name:      "escalated by concurrent run is skipped",
+			review:    review(models.EscalationPolicyAddReviewer),
+			pr:        openPR,
+			escalated: true,
+			want:      []models.Escalation{},
+		},
+		{
+			name:     "failed escalation is skipped",
+			review:   review(models.EscalationPolicyReassign),
+			getPRErr: modelsErr.ErrInternal,
+			want:     []models.Escalation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+			mockEscalationRepo := mocks.NewMockescalationRepository(ctrl)
+			mockPublisher := mocks.NewMockescalationPublisher(ctrl)
+
+			u := &useCase{
+				logger:                 zap.NewNop(),
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				pullRequestsRepository: mockPRRepo,
+				escalationRepository:   mockEscalationRepo,
+				escalationPublisher:    mockPublisher,
+				clock:                  fakeclock.NewFake(now),
+			}
+			ctx := t.Context()
+
+			mockEscalationRepo.EXPECT().
+				GetStaleReviews(ctx, now, staleReviewsBatchSize).
+				Return([]models.StaleReview{tt.review}, nil)
+			// ревью обрабатывается в организации, которую вернул обход
+			ctx = models.WithOrganization(ctx, tt.review.Organization)
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockPRRepo.EXPECT().GetPullRequest(ctx, "", tt.review.PRID).Return(tt.pr, tt.getPRErr)
+
+			if tt.pr != nil && tt.getPRErr == nil && slices.Contains(tt.pr.AssignedReviewers, tt.review.ReviewerID) {
+				mockEscalationRepo.EXPECT().
+					IsReviewEscalated(ctx, "", tt.review.PRID, tt.review.ReviewerID).
+					Return(tt.escalated, nil)
+			}
+
+			if tt.pr != nil && tt.getPRErr == nil && len(tt.want) > 0 {
+				mockTeamRepo.EXPECT().GetTeamPolicy(ctx, tt.review.TeamID).Return(&models.TeamPolicy{}, nil)
+				mockTeamRepo.EXPECT().
+					GetActiveTeammates(ctx, tt.review.TeamID, []string{"author", "idle", "u2"}, now).
+					Return(toCandidates(tt.candidates), nil)
+				if len(tt.candidates) == 0 {
+					mockTeamRepo.EXPECT().GetFallbackTeamIDs(ctx, tt.review.TeamID).Return(nil, nil)
+				}
+			}
+			if tt.expectReassign {
+				mockPRRepo.EXPECT().PullRequestReassign(ctx, "", "pr1", "idle", tt.candidates[0], false).Return(nil)
+			}
+			if tt.expectAddReviewer {
+				mockPRRepo.EXPECT().PullRequestAddReviewer(ctx, "", "pr1", tt.candidates[0], false).Return(nil)
+			}
+			if tt.expectRecord {
+				mockEscalationRepo.EXPECT().CreateEscalation(ctx, tt.want[0]).Return(nil)
+				mockPublisher.EXPECT().PublishEscalation(ctx, tt.want[0]).Return(nil)
+			}
+
+			escalations, err := u.EscalateStaleReviews(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, escalations)
+		})
+	}
+}
+
+func TestUseCase_EscalateStaleReviews_RepoError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEscalationRepo := mocks.NewMockescalationRepository(ctrl)
+	u := &useCase{
+		escalationRepository: mockEscalationRepo,
+		clock:                fakeclock.NewFake(time.Now()),
+	}
+
+	mockEscalationRepo.EXPECT().
+		GetStaleReviews(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, modelsErr.ErrInternal)
+
+	_, err := u.EscalateStaleReviews(t.Context())
+	require.ErrorIs(t, err, modelsErr.ErrInternal)
+}
@@ -0,0 +1,53 @@
+package pr_service
+
+import (
+	"context"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+func (u *useCase) BeginIdempotentRequest(
+	ctx context.Context,
+	request models.IdempotentRequest,
+) (*models.IdempotentRequest, error) {
+	now := u.clock.Now()
+	request.ExpiresAt = now.Add(request.TTL)
+
+	stored, err := u.idempotencyRepository.ReserveIdempotencyKey(ctx, request, now)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, nil
+	}
+
+	switch {
+	case stored.Fingerprint != request.Fingerprint:
+		return nil, modelsErr.ErrIdempotencyKeyReused
+	case !stored.Completed():
+		return nil, modelsErr.ErrIdempotencyKeyInProgress
+	}
+
+	return stored, nil
+}
+
+func (u *useCase) CompleteIdempotentRequest(
+	ctx context.Context,
+	request models.IdempotentRequest,
+) error {
+	return u.idempotencyRepository.SaveIdempotentResponse(ctx, request)
+}
+
+func (u *useCase) AbortIdempotentRequest(
+	ctx context.Context,
+	key, path string,
+) error {
+	return u.idempotencyRepository.ReleaseIdempotencyKey(ctx, key, path)
+}
+
+func (u *useCase) PurgeExpiredIdempotencyKeys(
+	ctx context.Context,
+) (int64, error) {
+	return u.idempotencyRepository.DeleteExpiredIdempotencyKeys(ctx, u.clock.Now())
+}
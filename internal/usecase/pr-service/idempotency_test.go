@@ -0,0 +1,96 @@
+package pr_service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
+)
+
+func TestUseCase_BeginIdempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 12, 22, 10, 0, 0, 0, time.UTC)
+	request := models.IdempotentRequest{
+		Key:         "key-1",
+		Path:        "/pullRequest/create",
+		Fingerprint: "fp-1",
+		TTL:         time.Hour,
+	}
+	completed := models.IdempotentRequest{
+		Key:         "key-1",
+		Path:        "/pullRequest/create",
+		Fingerprint: "fp-1",
+		StatusCode:  201,
+		ContentType: "application/json",
+		Response:    []byte(`{}`),
+	}
+
+	tests := []struct {
+		name    string
+		stored  *models.IdempotentRequest
+		repoErr error
+		want    *models.IdempotentRequest
+		wantErr error
+	}{
+		{
+			name: "key reserved",
+		},
+		{
+			name:   "replay completed request",
+			stored: &completed,
+			want:   &completed,
+		},
+		{
+			name:    "different fingerprint",
+			stored:  &models.IdempotentRequest{Fingerprint: "fp-2", StatusCode: 201},
+			wantErr: modelsErr.ErrIdempotencyKeyReused,
+		},
+		{
+			name:    "request in progress",
+			stored:  &models.IdempotentRequest{Fingerprint: "fp-1"},
+			wantErr: modelsErr.ErrIdempotencyKeyInProgress,
+		},
+		{
+			name:    "repository error",
+			repoErr: modelsErr.ErrInternal,
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockIdempotencyRepo := mocks.NewMockidempotencyRepository(ctrl)
+			u := &useCase{
+				idempotencyRepository: mockIdempotencyRepo,
+				clock:                 fakeclock.NewFake(now),
+			}
+
+			reserved := request
+			reserved.ExpiresAt = now.Add(time.Hour)
+			mockIdempotencyRepo.EXPECT().
+				ReserveIdempotencyKey(gomock.Any(), reserved, now).
+				Return(tt.stored, tt.repoErr)
+
+			result, err := u.BeginIdempotentRequest(t.Context(), request)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package pr_service
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -14,24 +15,93 @@ type (
 	userRepository interface {
 		GetReview(ctx context.Context, userID string) ([]models.PRShort, error)
 		SetIsActive(ctx context.Context, userID string, isActive bool) (*models.User, error)
+		SetSchedule(ctx context.Context, userID, timeZone string, workingHours *models.WorkingHours) (*models.User, error)
+		SetMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*models.User, error)
+		GetUser(ctx context.Context, userID string) (*models.User, error)
 	}
 
 	teamRepository interface {
 		TeamAdd(ctx context.Context, team models.Team) error
 		TeamGet(ctx context.Context, teamName string) (*models.Team, error)
-		GetActiveTeammates(ctx context.Context, teamID string, excludedUsers []string, count uint64) ([]string, error)
-		GetTeamIDByUserID(ctx context.Context, userID string) (teamID string, err error)
+		GetTeamVersion(ctx context.Context, teamName string) (int64, error)
+		GetActiveTeammates(ctx context.Context, teamID string, excludedUsers []string, now time.Time) ([]models.Candidate, error)
+		GetUserTeams(ctx context.Context, userID string) ([]models.UserTeam, error)
+		SetTeamPolicy(ctx context.Context, policy models.TeamPolicy) (*models.TeamPolicy, error)
+		GetTeamPolicy(ctx context.Context, teamID string) (*models.TeamPolicy, error)
+		SetTeamFallbacks(ctx context.Context, teamName string, fallbackTeams []string) error
+		GetFallbackTeamIDs(ctx context.Context, teamID string) ([]string, error)
+		SetCodeOwners(ctx context.Context, teamName string, rules []models.CodeOwnersRule) error
+		GetTeamCodeOwners(ctx context.Context, teamName string) ([]models.CodeOwnersRule, error)
+		GetCodeOwners(ctx context.Context, teamID string) ([]models.CodeOwnersRule, error)
+		GetActiveCodeOwners(ctx context.Context, owners []models.CodeOwner, excludedUsers []string, now time.Time) ([]models.Candidate, error)
+		TeamUpdateMembers(ctx context.Context, change models.TeamMembersChange) error
+		TeamRename(ctx context.Context, teamName, newTeamName string) error
+		TeamDelete(ctx context.Context, teamName string, openPRPolicy models.OpenPRPolicy) (*models.TeamDeletion, error)
+		TeamAudit(ctx context.Context, teamName string) ([]models.TeamAuditEntry, error)
 	}
 
 	pullRequestsRepository interface {
-		PullRequestCreate(ctx context.Context, authorID, prID, prName string, teammates []string) (*models.PR, error)
-		PullRequestMerge(ctx context.Context, prID string) (*models.PR, error)
-		PullRequestReassign(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
-		GetPullRequest(ctx context.Context, prID string) (*models.PR, error)
+		PullRequestCreate(ctx context.Context, pr models.PR) (*models.PR, error)
+		PullRequestMerge(ctx context.Context, repository, prID string) (*models.PR, error)
+		PullRequestReassign(ctx context.Context, repository, prID, oldReviewerID, newReviewerID string, fromFallback bool) error
+		GetPullRequest(ctx context.Context, repository, prID string) (*models.PR, error)
+		PullRequestGet(ctx context.Context, repository, prID string) (*models.PR, error)
+		GetPullRequestVersion(ctx context.Context, repository, prID string) (int64, error)
+		PullRequestAddReviewer(ctx context.Context, repository, prID, reviewerID string, fromFallback bool) error
+		PullRequestRemoveReviewer(ctx context.Context, repository, prID, reviewerID string) error
+		GetRecentPairings(ctx context.Context, authorID string, reviewerIDs []string, since time.Time) (map[string]int, error)
+	}
+
+	repositoriesRepository interface {
+		RepositoryAdd(ctx context.Context, repository models.Repository) (*models.Repository, error)
+		RepositoryGet(ctx context.Context, name string) (*models.Repository, error)
+		RepositoryList(ctx context.Context) ([]models.Repository, error)
+		RepositoryUpdate(ctx context.Context, repository models.Repository) (*models.Repository, error)
+		RepositoryDelete(ctx context.Context, name string) error
+	}
+
+	statsRepository interface {
+		TeamStats(ctx context.Context, filter models.TeamStatsFilter) ([]models.TeamStats, uint64, error)
+		TeamPairings(ctx context.Context, teamName string, since time.Time) ([]models.ReviewPairing, error)
+	}
+
+	escalationRepository interface {
+		GetStaleReviews(ctx context.Context, now time.Time, limit uint64) ([]models.StaleReview, error)
+		IsReviewEscalated(ctx context.Context, repository, prID, reviewerID string) (bool, error)
+		CreateEscalation(ctx context.Context, escalation models.Escalation) error
+	}
+
+	outOfOfficeRepository interface {
+		CreateOutOfOffice(ctx context.Context, outOfOffice models.OutOfOffice) (*models.OutOfOffice, error)
+		GetStartedOutOfOffice(ctx context.Context, now time.Time, limit uint64) ([]models.OutOfOffice, error)
+		MarkOutOfOfficeReassigned(ctx context.Context, id int64, reassignedAt time.Time) error
+	}
+
+	idempotencyRepository interface {
+		ReserveIdempotencyKey(ctx context.Context, request models.IdempotentRequest, now time.Time) (*models.IdempotentRequest, error)
+		SaveIdempotentResponse(ctx context.Context, request models.IdempotentRequest) error
+		ReleaseIdempotencyKey(ctx context.Context, key, path string) error
+		DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+	}
+
+	transferRepository interface {
+		ExportTeams(ctx context.Context, fn func(team models.Team) error) error
+		ExportUsers(ctx context.Context, fn func(user models.User) error) error
+		ExportPullRequests(ctx context.Context, fn func(pr models.PR) error) error
+		ImportUser(ctx context.Context, user models.User) error
+		ImportPullRequest(ctx context.Context, pr models.PR) error
 	}
 
 	transactor interface {
-		WithTx(ctx context.Context, function func(ctx context.Context) error) error
+		WithTx(ctx context.Context, function func(ctx context.Context) error, opts ...models.TxOption) error
+	}
+
+	clock interface {
+		Now() time.Time
+	}
+
+	escalationPublisher interface {
+		PublishEscalation(ctx context.Context, escalation models.Escalation) error
 	}
 )
 
@@ -40,7 +110,15 @@ type useCase struct {
 	pullRequestsRepository pullRequestsRepository
 	teamRepository         teamRepository
 	userRepository         userRepository
+	statsRepository        statsRepository
+	escalationRepository   escalationRepository
+	outOfOfficeRepository  outOfOfficeRepository
+	repositoriesRepository repositoriesRepository
+	idempotencyRepository  idempotencyRepository
+	transferRepository     transferRepository
 	transactor             transactor
+	clock                  clock
+	escalationPublisher    escalationPublisher
 }
 
 func NewUseCase(
@@ -48,13 +126,29 @@ func NewUseCase(
 	pullRequestsRepository pullRequestsRepository,
 	teamRepository teamRepository,
 	userRepository userRepository,
+	statsRepository statsRepository,
+	escalationRepository escalationRepository,
+	outOfOfficeRepository outOfOfficeRepository,
+	repositoriesRepository repositoriesRepository,
+	idempotencyRepository idempotencyRepository,
+	transferRepository transferRepository,
 	transactor transactor,
+	clock clock,
+	escalationPublisher escalationPublisher,
 ) *useCase {
 	return &useCase{
 		logger:                 logger,
 		pullRequestsRepository: pullRequestsRepository,
 		teamRepository:         teamRepository,
 		userRepository:         userRepository,
+		statsRepository:        statsRepository,
+		escalationRepository:   escalationRepository,
+		outOfOfficeRepository:  outOfOfficeRepository,
+		repositoriesRepository: repositoriesRepository,
+		idempotencyRepository:  idempotencyRepository,
+		transferRepository:     transferRepository,
 		transactor:             transactor,
+		clock:                  clock,
+		escalationPublisher:    escalationPublisher,
 	}
 }
@@ -0,0 +1,85 @@
+package pr_service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+const startedOutOfOfficeBatchSize = uint64(100)
+
+func (u *useCase) SetOutOfOffice(
+	ctx context.Context,
+	outOfOffice models.OutOfOffice,
+) (*models.OutOfOffice, error) {
+	if !outOfOffice.To.After(outOfOffice.From) {
+		return nil, modelsErr.ErrInvalidPeriod
+	}
+
+	created, err := u.outOfOfficeRepository.CreateOutOfOffice(ctx, outOfOffice)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (u *useCase) ReassignOutOfOfficeReviews(
+	ctx context.Context,
+) (int, error) {
+	now := u.clock.Now()
+
+	periods, err := u.outOfOfficeRepository.GetStartedOutOfOffice(ctx, now, startedOutOfOfficeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	reassigned := 0
+	for _, period := range periods {
+		// фоновый обход идёт по всем организациям, дальше отпуск обрабатывается в своей
+		ctx := models.WithOrganization(ctx, period.Organization)
+		logger := u.logger.With(
+			zap.String("organization", period.Organization),
+			zap.String("user_id", period.UserID),
+			zap.Int64("out_of_office_id", period.ID),
+		)
+
+		prs, err := u.userRepository.GetReview(ctx, period.UserID)
+		if err != nil {
+			logger.Error("get reviews of absent user", zap.Error(err))
+			continue
+		}
+
+		failed := false
+		for _, pr := range prs {
+			if pr.Status != models.PRStatusOPEN {
+				continue
+			}
+
+			if _, _, err = u.PullRequestReassign(ctx, pr.Repository, pr.ID, period.UserID, "", nil, nil); err != nil {
+				logger.Error("reassign review of absent user",
+					zap.String("repository", pr.Repository),
+					zap.String("pr_id", pr.ID),
+					zap.Error(err),
+				)
+				failed = true
+				continue
+			}
+			reassigned++
+		}
+
+		// отпуск с непереназначенными ревью не отмечаем, чтобы следующий обход повторил попытку
+		if failed {
+			continue
+		}
+
+		if err = u.outOfOfficeRepository.MarkOutOfOfficeReassigned(ctx, period.ID, now); err != nil {
+			logger.Error("mark out of office reassigned", zap.Error(err))
+		}
+	}
+
+	return reassigned, nil
+}
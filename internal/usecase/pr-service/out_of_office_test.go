@@ -0,0 +1,195 @@
+package pr_service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
+)
+
+func TestUseCase_SetOutOfOffice(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      models.OutOfOffice
+		expectRepo bool
+		repoErr    error
+		wantErr    error
+	}{
+		{
+			name:       "success",
+			input:      models.OutOfOffice{UserID: "u1", From: from, To: from.Add(14 * 24 * time.Hour)},
+			expectRepo: true,
+		},
+		{
+			name:    "empty period",
+			input:   models.OutOfOffice{UserID: "u1", From: from, To: from},
+			wantErr: modelsErr.ErrInvalidPeriod,
+		},
+		{
+			name:       "user not found",
+			input:      models.OutOfOffice{UserID: "missing", From: from, To: from.Add(time.Hour)},
+			expectRepo: true,
+			repoErr:    modelsErr.ErrUserNotFound,
+			wantErr:    modelsErr.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOutOfOfficeRepo := mocks.NewMockoutOfOfficeRepository(ctrl)
+			u := &useCase{outOfOfficeRepository: mockOutOfOfficeRepo}
+
+			created := tt.input
+			created.ID = 1
+			if tt.expectRepo {
+				mockOutOfOfficeRepo.EXPECT().
+					CreateOutOfOffice(gomock.Any(), tt.input).
+					DoAndReturn(func(context.Context, models.OutOfOffice) (*models.OutOfOffice, error) {
+						if tt.repoErr != nil {
+							return nil, tt.repoErr
+						}
+						return &created, nil
+					})
+			}
+
+			result, err := u.SetOutOfOffice(t.Context(), tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &created, result)
+		})
+	}
+}
+
+func TestUseCase_ReassignOutOfOfficeReviews(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 12, 22, 9, 0, 0, 0, time.UTC)
+	period := models.OutOfOffice{
+		ID:              7,
+		UserID:          "absent",
+		Organization:    "org-a",
+		From:            now.Add(-time.Hour),
+		To:              now.Add(14 * 24 * time.Hour),
+		ReassignReviews: true,
+	}
+
+	tests := []struct {
+		name           string
+		withStuck      bool
+		wantMarked     bool
+		wantReassigned int
+	}{
+		{
+			name:           "all reviews reassigned",
+			wantMarked:     true,
+			wantReassigned: 1,
+		},
+		{
+			name:           "failed reassign leaves period for retry",
+			withStuck:      true,
+			wantReassigned: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockUserRepo := mocks.NewMockuserRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+			mockOutOfOfficeRepo := mocks.NewMockoutOfOfficeRepository(ctrl)
+
+			u := &useCase{
+				logger:                 zap.NewNop(),
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				userRepository:         mockUserRepo,
+				pullRequestsRepository: mockPRRepo,
+				outOfOfficeRepository:  mockOutOfOfficeRepo,
+				clock:                  fakeclock.NewFake(now),
+			}
+			ctx := t.Context()
+
+			mockOutOfOfficeRepo.EXPECT().
+				GetStartedOutOfOffice(ctx, now, startedOutOfOfficeBatchSize).
+				Return([]models.OutOfOffice{period}, nil)
+			// отпуск обрабатывается в организации, которую вернул обход
+			ctx = models.WithOrganization(ctx, period.Organization)
+
+			reviews := []models.PRShort{
+				{ID: "open", Status: models.PRStatusOPEN},
+				{ID: "merged", Status: models.PRStatusMERGED},
+			}
+			if tt.withStuck {
+				reviews = append(reviews, models.PRShort{ID: "stuck", Status: models.PRStatusOPEN})
+			}
+			openReviews := len(reviews) - 1
+			mockUserRepo.EXPECT().GetReview(ctx, "absent").Return(reviews, nil)
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			).Times(openReviews)
+			mockTeamRepo.EXPECT().GetTeamPolicy(ctx, "team").Return(&models.TeamPolicy{}, nil).Times(openReviews)
+			mockPRRepo.EXPECT().GetPullRequest(ctx, "", "open").Return(&models.PR{
+				ID:                "open",
+				AuthorID:          "author",
+				AssignedReviewers: []string{"absent"},
+				TeamID:            "team",
+			}, nil)
+			mockTeamRepo.EXPECT().
+				GetActiveTeammates(ctx, "team", []string{"author", "absent"}, now).
+				Return(toCandidates([]string{"u2"}), nil)
+			mockPRRepo.EXPECT().PullRequestReassign(ctx, "", "open", "absent", "u2", false).Return(nil)
+
+			if tt.withStuck {
+				// кандидатов на замену нет ни в команде, ни в резервных командах
+				mockPRRepo.EXPECT().GetPullRequest(ctx, "", "stuck").Return(&models.PR{
+					ID:                "stuck",
+					AuthorID:          "author",
+					AssignedReviewers: []string{"absent", "u2"},
+					TeamID:            "team",
+				}, nil)
+				mockTeamRepo.EXPECT().
+					GetActiveTeammates(ctx, "team", []string{"author", "absent", "u2"}, now).
+					Return(nil, nil)
+				mockTeamRepo.EXPECT().GetFallbackTeamIDs(ctx, "team").Return(nil, nil)
+			}
+
+			if tt.wantMarked {
+				mockOutOfOfficeRepo.EXPECT().MarkOutOfOfficeReassigned(ctx, period.ID, now).Return(nil)
+			}
+
+			reassigned, err := u.ReassignOutOfOfficeReviews(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReassigned, reassigned)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package pr_service
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
 
@@ -9,27 +11,67 @@ import (
 	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
 )
 
-const countMaxReviewers = uint64(2)
+const countMaxReviewers = 2
 
 func (u *useCase) PullRequestCreate(
 	ctx context.Context,
-	authorID, prID, prName string,
-) (*models.PR, error) {
+	repositoryName, authorID, prID, prName, teamName string,
+	changedFiles []string,
+) (*models.PR, int, error) {
 	var pr *models.PR
+	var shortfall int
 
 	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
-		teamID, err := u.teamRepository.GetTeamIDByUserID(ctx, authorID)
+		repository, err := u.getPRRepository(ctx, repositoryName)
+		if err != nil {
+			return err
+		}
+
+		var teamID string
+		if repository != nil && repository.OwnerTeamID != "" {
+			teamID = repository.OwnerTeamID
+		} else {
+			teamID, err = u.resolveAuthorTeam(ctx, authorID, teamName)
+			if err != nil {
+				return err
+			}
+		}
+
+		policy, err := u.teamRepository.GetTeamPolicy(ctx, teamID)
 		if err != nil {
 			return err
 		}
+		applyRepositoryOverrides(policy, repository)
+		count := reviewerCount(repository)
 
 		excludedUsers := []string{authorID}
-		teammates, err := u.teamRepository.GetActiveTeammates(ctx, teamID, excludedUsers, countMaxReviewers)
+		owners, err := u.pickCodeOwners(ctx, teamID, changedFiles, excludedUsers, count)
 		if err != nil {
 			return err
 		}
 
-		pr, err = u.pullRequestsRepository.PullRequestCreate(ctx, authorID, prID, prName, teammates)
+		excludedUsers = append(excludedUsers, owners...)
+		reviewers, fallbackReviewers, err := u.pickReviewers(
+			ctx, teamID, policy, authorID, excludedUsers, count-len(owners))
+		if err != nil {
+			return err
+		}
+		reviewers = slices.Concat(owners, reviewers)
+
+		shortfall = count - len(reviewers)
+		if shortfall > 0 && policy.ShortfallPolicy == models.ShortfallPolicyReject {
+			return modelsErr.ErrNotEnoughReviewers
+		}
+
+		pr, err = u.pullRequestsRepository.PullRequestCreate(ctx, models.PR{
+			AssignedReviewers: reviewers,
+			FallbackReviewers: fallbackReviewers,
+			AuthorID:          authorID,
+			ID:                prID,
+			Name:              prName,
+			TeamID:            teamID,
+			Repository:        repositoryName,
+		})
 		if err != nil {
 			return err
 		}
@@ -38,17 +80,69 @@ func (u *useCase) PullRequestCreate(
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return pr, nil
+	return pr, shortfall, nil
+}
+
+func (u *useCase) resolveAuthorTeam(
+	ctx context.Context,
+	authorID, teamName string,
+) (string, error) {
+	teams, err := u.teamRepository.GetUserTeams(ctx, authorID)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case teamName != "":
+		i := slices.IndexFunc(teams, func(team models.UserTeam) bool {
+			return team.Name == teamName
+		})
+		if i < 0 {
+			return "", fmt.Errorf("%w: %s", modelsErr.ErrUserNotInTeam, teamName)
+		}
+		return teams[i].ID, nil
+	case len(teams) == 0:
+		return "", modelsErr.ErrTeamNotFound
+	case len(teams) > 1:
+		return "", modelsErr.ErrTeamRequired
+	default:
+		return teams[0].ID, nil
+	}
+}
+
+func (u *useCase) PullRequestGet(
+	ctx context.Context,
+	repository, prID string,
+) (*models.PR, error) {
+	return u.pullRequestsRepository.PullRequestGet(ctx, repository, prID)
 }
 
 func (u *useCase) PullRequestMerge(
 	ctx context.Context,
-	prID string,
+	repository, prID string,
+	expectedVersion *int64,
 ) (*models.PR, error) {
-	pr, err := u.pullRequestsRepository.PullRequestMerge(ctx, prID)
+	var pr *models.PR
+
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if expectedVersion != nil {
+			version, err := u.pullRequestsRepository.GetPullRequestVersion(ctx, repository, prID)
+			if err != nil {
+				return err
+			}
+			if err = checkVersion(version, expectedVersion); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		pr, err = u.pullRequestsRepository.PullRequestMerge(ctx, repository, prID)
+		return err
+	})
+
 	if err != nil {
 		return nil, err
 	}
@@ -58,63 +152,88 @@ func (u *useCase) PullRequestMerge(
 
 func (u *useCase) PullRequestReassign(
 	ctx context.Context,
-	prID, oldReviewerID string,
+	repository, prID, oldReviewerID, newReviewerID string,
+	excludedUserIDs []string,
+	expectedVersion *int64,
 ) (*models.PR, string, error) {
 	var pr *models.PR
-	var newReviewerID string
+	var replacedBy string
 	logger := u.logger.With(
+		zap.String("repository", repository),
 		zap.String("pr_id", prID),
 		zap.String("old_reviewer_id", oldReviewerID),
 		zap.String("new_reviewer_id", newReviewerID),
+		zap.Strings("excluded_user_ids", excludedUserIDs),
 	)
 
 	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
-		teamID, err := u.teamRepository.GetTeamIDByUserID(ctx, oldReviewerID)
+		var err error
+		pr, err = u.pullRequestsRepository.GetPullRequest(ctx, repository, prID)
 		if err != nil {
 			return err
 		}
-
-		pr, err = u.pullRequestsRepository.GetPullRequest(ctx, prID)
-		if err != nil {
+		if err = checkVersion(pr.Version, expectedVersion); err != nil {
 			return err
 		}
-		wasReviewer := false
-		for _, reviewerID := range pr.AssignedReviewers {
-			if reviewerID == oldReviewerID {
-				wasReviewer = true
-			}
-		}
-		if !wasReviewer {
+		if !slices.Contains(pr.AssignedReviewers, oldReviewerID) {
 			logger.Error("pr reassign", zap.Error(modelsErr.ErrNotAssigned))
 			return modelsErr.ErrNotAssigned
 		}
-		excludedUsers := []string{pr.AuthorID}
-		excludedUsers = append(excludedUsers, pr.AssignedReviewers...)
-
-		teammates, err := u.teamRepository.GetActiveTeammates(ctx, teamID, excludedUsers, 1)
-		if err != nil {
-			return err
+		if pr.TeamID == "" {
+			logger.Error("pr reassign", zap.Error(modelsErr.ErrTeamNotFound))
+			return modelsErr.ErrTeamNotFound
 		}
 
-		if len(teammates) == 0 {
-			logger.Error("pr reassign", zap.Error(modelsErr.ErrNotActiveCandidate))
-			return modelsErr.ErrNotActiveCandidate
+		var fromFallback bool
+		if newReviewerID != "" {
+			fromFallback, err = u.validateReviewer(ctx, pr, newReviewerID)
+			if err != nil {
+				return err
+			}
+			replacedBy = newReviewerID
+		} else {
+			policy, err := u.getReviewPolicy(ctx, pr.TeamID, pr.Repository)
+			if err != nil {
+				return err
+			}
+
+			excludedUsers := slices.Concat([]string{pr.AuthorID}, pr.AssignedReviewers, excludedUserIDs)
+
+			teammates, fallbackReviewers, err := u.pickReviewers(ctx, pr.TeamID, policy, pr.AuthorID, excludedUsers, 1)
+			if err != nil {
+				return err
+			}
+
+			if len(teammates) == 0 {
+				logger.Error("pr reassign", zap.Error(modelsErr.ErrNotActiveCandidate))
+				return modelsErr.ErrNotActiveCandidate
+			}
+
+			replacedBy = teammates[0]
+			fromFallback = len(fallbackReviewers) > 0
 		}
 
-		newReviewerID = teammates[0]
-		err = u.pullRequestsRepository.PullRequestReassign(ctx, prID, oldReviewerID, newReviewerID)
+		err = u.pullRequestsRepository.PullRequestReassign(ctx, repository, prID, oldReviewerID, replacedBy, fromFallback)
 		if err != nil {
 			return err
 		}
 
-		newReviewers := []string{newReviewerID}
+		newReviewers := []string{replacedBy}
 		for _, reviewer := range pr.AssignedReviewers {
 			if reviewer != oldReviewerID {
 				newReviewers = append(newReviewers, reviewer)
 			}
 		}
+		newFallbackReviewers := slices.DeleteFunc(pr.FallbackReviewers, func(reviewer string) bool {
+			return reviewer == oldReviewerID
+		})
+		if fromFallback {
+			newFallbackReviewers = append(newFallbackReviewers, replacedBy)
+		}
 
 		pr.AssignedReviewers = newReviewers
+		pr.FallbackReviewers = newFallbackReviewers
+		pr.Version++
 
 		return nil
 	})
@@ -123,5 +242,168 @@ func (u *useCase) PullRequestReassign(
 		return nil, "", err
 	}
 
-	return pr, newReviewerID, nil
+	return pr, replacedBy, nil
+}
+
+func (u *useCase) PullRequestAddReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+	expectedVersion *int64,
+) (*models.PR, error) {
+	var pr *models.PR
+
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = u.pullRequestsRepository.GetPullRequest(ctx, repository, prID)
+		if err != nil {
+			return err
+		}
+		if err = checkVersion(pr.Version, expectedVersion); err != nil {
+			return err
+		}
+
+		fromFallback, err := u.validateReviewer(ctx, pr, reviewerID)
+		if err != nil {
+			return err
+		}
+
+		err = u.pullRequestsRepository.PullRequestAddReviewer(ctx, repository, prID, reviewerID, fromFallback)
+		if err != nil {
+			return err
+		}
+
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+		if fromFallback {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, reviewerID)
+		}
+		pr.Version++
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func (u *useCase) getReviewPolicy(
+	ctx context.Context,
+	teamID, repositoryName string,
+) (*models.TeamPolicy, error) {
+	policy, err := u.teamRepository.GetTeamPolicy(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := u.getPRRepository(ctx, repositoryName)
+	if err != nil {
+		return nil, err
+	}
+	applyRepositoryOverrides(policy, repository)
+
+	return policy, nil
+}
+
+func (u *useCase) validateReviewer(
+	ctx context.Context,
+	pr *models.PR,
+	reviewerID string,
+) (bool, error) {
+	if reviewerID == pr.AuthorID {
+		return false, modelsErr.ErrAuthorReviewer
+	}
+	if slices.Contains(pr.AssignedReviewers, reviewerID) {
+		return false, modelsErr.ErrAlreadyAssigned
+	}
+
+	reviewer, err := u.userRepository.GetUser(ctx, reviewerID)
+	if err != nil {
+		return false, err
+	}
+	if !reviewer.IsActive {
+		return false, modelsErr.ErrUserInactive
+	}
+
+	if pr.TeamID == "" {
+		return false, modelsErr.ErrTeamNotFound
+	}
+
+	return u.isFallbackReviewer(ctx, pr.TeamID, reviewerID)
+}
+
+func (u *useCase) isFallbackReviewer(
+	ctx context.Context,
+	teamID, reviewerID string,
+) (bool, error) {
+	reviewerTeams, err := u.teamRepository.GetUserTeams(ctx, reviewerID)
+	if err != nil {
+		return false, err
+	}
+
+	reviewerTeamIDs := make([]string, len(reviewerTeams))
+	for i, team := range reviewerTeams {
+		reviewerTeamIDs[i] = team.ID
+	}
+
+	if slices.Contains(reviewerTeamIDs, teamID) {
+		return false, nil
+	}
+
+	fallbackTeamIDs, err := u.teamRepository.GetFallbackTeamIDs(ctx, teamID)
+	if err != nil {
+		return false, err
+	}
+
+	if !slices.ContainsFunc(fallbackTeamIDs, func(fallbackTeamID string) bool {
+		return slices.Contains(reviewerTeamIDs, fallbackTeamID)
+	}) {
+		return false, modelsErr.ErrNotTeamMember
+	}
+
+	return true, nil
+}
+
+func (u *useCase) PullRequestRemoveReviewer(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+	expectedVersion *int64,
+) (*models.PR, error) {
+	var pr *models.PR
+
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = u.pullRequestsRepository.GetPullRequest(ctx, repository, prID)
+		if err != nil {
+			return err
+		}
+		if err = checkVersion(pr.Version, expectedVersion); err != nil {
+			return err
+		}
+
+		if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+			return modelsErr.ErrNotAssigned
+		}
+
+		err = u.pullRequestsRepository.PullRequestRemoveReviewer(ctx, repository, prID, reviewerID)
+		if err != nil {
+			return err
+		}
+
+		isRemoved := func(reviewer string) bool {
+			return reviewer == reviewerID
+		}
+		pr.AssignedReviewers = slices.DeleteFunc(pr.AssignedReviewers, isRemoved)
+		pr.FallbackReviewers = slices.DeleteFunc(pr.FallbackReviewers, isRemoved)
+		pr.Version++
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
 }
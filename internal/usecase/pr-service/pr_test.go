@@ -3,12 +3,14 @@ package pr_service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
 	"github.com/Tortik3000/PR-service/internal/models"
 	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
 	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
@@ -47,7 +49,7 @@ func TestUseCase_PullRequestCreate(t *testing.T) {
 			wantErr:         nil,
 		},
 		{
-			name:            "error in GetTeamIDByUserID",
+			name:            "error in GetUserTeams",
 			pr:              inputPR,
 			expectPR:        nil,
 			teamID:          "team",
@@ -96,35 +98,350 @@ func TestUseCase_PullRequestCreate(t *testing.T) {
 				teamRepository:         mockTeamRepo,
 				pullRequestsRepository: mockPRRepo,
 				logger:                 zap.NewNop(),
+				clock:                  fakeclock.NewFake(time.Now()),
 			}
 
 			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
-				func(_ context.Context, fn func(ctx context.Context) error) error {
+				func(_ context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
 					return fn(ctx)
 				},
 			)
 
-			mockTeamRepo.EXPECT().GetTeamIDByUserID(ctx, tt.pr.AuthorID).Return(tt.teamID, tt.getTeamErr)
+			mockTeamRepo.EXPECT().GetUserTeams(ctx, tt.pr.AuthorID).
+				Return([]models.UserTeam{{ID: tt.teamID, Name: "backend"}}, tt.getTeamErr)
 			if tt.getTeamErr == nil {
-				mockTeamRepo.EXPECT().GetActiveTeammates(ctx, tt.teamID, []string{tt.pr.AuthorID}, uint64(2)).
-					Return(tt.pr.AssignedReviewers, tt.getTeammatesErr)
+				mockTeamRepo.EXPECT().GetTeamPolicy(ctx, tt.teamID).Return(&models.TeamPolicy{}, nil)
+				mockTeamRepo.EXPECT().GetActiveTeammates(ctx, tt.teamID, []string{tt.pr.AuthorID}, gomock.Any()).
+					Return(toCandidates(tt.pr.AssignedReviewers), tt.getTeammatesErr)
 			}
 			if tt.getTeammatesErr == nil && tt.getTeamErr == nil {
-				mockPRRepo.EXPECT().PullRequestCreate(ctx, tt.pr.AuthorID, tt.pr.ID, tt.pr.Name, tt.pr.AssignedReviewers).
+				mockPRRepo.EXPECT().
+					PullRequestCreate(ctx, models.PR{
+						AssignedReviewers: tt.pr.AssignedReviewers,
+						AuthorID:          tt.pr.AuthorID,
+						ID:                tt.pr.ID,
+						Name:              tt.pr.Name,
+						TeamID:            tt.teamID,
+					}).
 					Return(tt.expectPR, tt.createPrErr)
 			}
 
-			pr, err := u.PullRequestCreate(ctx, tt.pr.AuthorID, tt.pr.ID, tt.pr.Name)
+			pr, shortfall, err := u.PullRequestCreate(ctx, "", tt.pr.AuthorID, tt.pr.ID, tt.pr.Name, "", nil)
 			if tt.wantErr != nil {
 				require.ErrorIs(t, err, tt.wantErr)
 			} else {
 				require.NoError(t, err)
+				assert.Zero(t, shortfall)
 			}
 			assert.Equal(t, pr, tt.expectPR)
 		})
 	}
 }
 
+func TestUseCase_PullRequestCreate_Shortfall(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		policy        models.ShortfallPolicy
+		wantShortfall int
+		wantErr       error
+	}{
+		{
+			name:          "allow",
+			policy:        models.ShortfallPolicyAllow,
+			wantShortfall: 1,
+		},
+		{
+			name:    "reject",
+			policy:  models.ShortfallPolicyReject,
+			wantErr: modelsErr.ErrNotEnoughReviewers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+
+			ctx := t.Context()
+
+			u := &useCase{
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				pullRequestsRepository: mockPRRepo,
+				logger:                 zap.NewNop(),
+				clock:                  fakeclock.NewFake(time.Now()),
+			}
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockTeamRepo.EXPECT().GetUserTeams(ctx, "author").Return([]models.UserTeam{{ID: "team", Name: "backend"}}, nil)
+			mockTeamRepo.EXPECT().GetTeamPolicy(ctx, "team").
+				Return(&models.TeamPolicy{ShortfallPolicy: tt.policy}, nil)
+			mockTeamRepo.EXPECT().GetActiveTeammates(ctx, "team", []string{"author"}, gomock.Any()).
+				Return(toCandidates([]string{"u1"}), nil)
+			mockTeamRepo.EXPECT().GetFallbackTeamIDs(ctx, "team").Return(nil, nil)
+
+			pr := &models.PR{ID: "pr1", AuthorID: "author", AssignedReviewers: []string{"u1"}}
+			if tt.wantErr == nil {
+				mockPRRepo.EXPECT().PullRequestCreate(ctx, models.PR{
+					AssignedReviewers: []string{"u1"},
+					AuthorID:          "author",
+					ID:                "pr1",
+					Name:              "name",
+					TeamID:            "team",
+				}).
+					Return(pr, nil)
+			}
+
+			result, shortfall, err := u.PullRequestCreate(ctx, "", "author", "pr1", "name", "", nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, pr, result)
+			assert.Equal(t, tt.wantShortfall, shortfall)
+		})
+	}
+}
+
+func TestUseCase_PullRequestCreate_TeamSelection(t *testing.T) {
+	t.Parallel()
+
+	twoTeams := []models.UserTeam{{ID: "1", Name: "backend"}, {ID: "2", Name: "platform"}}
+	tests := []struct {
+		name       string
+		teamName   string
+		userTeams  []models.UserTeam
+		wantTeamID string
+		wantErr    error
+	}{
+		{
+			name:       "single team by default",
+			userTeams:  twoTeams[:1],
+			wantTeamID: "1",
+		},
+		{
+			name:       "explicit team",
+			teamName:   "platform",
+			userTeams:  twoTeams,
+			wantTeamID: "2",
+		},
+		{
+			name:      "several teams without team name",
+			userTeams: twoTeams,
+			wantErr:   modelsErr.ErrTeamRequired,
+		},
+		{
+			name:      "author not in team",
+			teamName:  "frontend",
+			userTeams: twoTeams,
+			wantErr:   modelsErr.ErrUserNotInTeam,
+		},
+		{
+			name:      "author without team",
+			userTeams: []models.UserTeam{},
+			wantErr:   modelsErr.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+
+			ctx := t.Context()
+
+			u := &useCase{
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				pullRequestsRepository: mockPRRepo,
+				logger:                 zap.NewNop(),
+				clock:                  fakeclock.NewFake(time.Now()),
+			}
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockTeamRepo.EXPECT().GetUserTeams(ctx, "author").Return(tt.userTeams, nil)
+
+			pr := &models.PR{ID: "pr1", AuthorID: "author", AssignedReviewers: []string{"u1", "u2"}, TeamID: tt.wantTeamID}
+			if tt.wantErr == nil {
+				mockTeamRepo.EXPECT().GetTeamPolicy(ctx, tt.wantTeamID).Return(&models.TeamPolicy{}, nil)
+				mockTeamRepo.EXPECT().GetActiveTeammates(ctx, tt.wantTeamID, []string{"author"}, gomock.Any()).
+					Return(toCandidates([]string{"u1", "u2"}), nil)
+				mockPRRepo.EXPECT().
+					PullRequestCreate(ctx, models.PR{
+						AssignedReviewers: []string{"u1", "u2"},
+						AuthorID:          "author",
+						ID:                "pr1",
+						Name:              "name",
+						TeamID:            tt.wantTeamID,
+					}).
+					Return(pr, nil)
+			}
+
+			result, _, err := u.PullRequestCreate(ctx, "", "author", "pr1", "name", tt.teamName, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, pr, result)
+		})
+	}
+}
+
+func TestUseCase_PullRequestCreate_Repository(t *testing.T) {
+	t.Parallel()
+
+	three := 3
+	reject := models.ShortfallPolicyReject
+	rotation := models.SelectionModeRotation
+
+	tests := []struct {
+		name          string
+		repository    *models.Repository
+		getRepoErr    error
+		candidates    []string
+		wantTeamID    string
+		wantReviewers []string
+		wantShortfall int
+		wantErr       error
+	}{
+		{
+			name:          "owner team instead of author team",
+			repository:    &models.Repository{Name: "api", OwnerTeamID: "owner"},
+			candidates:    []string{"o1", "o2"},
+			wantTeamID:    "owner",
+			wantReviewers: []string{"o1", "o2"},
+		},
+		{
+			name:          "repository without owner uses author team",
+			repository:    &models.Repository{Name: "api"},
+			candidates:    []string{"u1", "u2"},
+			wantTeamID:    "team",
+			wantReviewers: []string{"u1", "u2"},
+		},
+		{
+			name:          "reviewer count override",
+			repository:    &models.Repository{Name: "api", OwnerTeamID: "owner", ReviewerCount: &three},
+			candidates:    []string{"o1", "o2"},
+			wantTeamID:    "owner",
+			wantReviewers: []string{"o1", "o2"},
+			wantShortfall: 1,
+		},
+		{
+			name: "shortfall policy override",
+			repository: &models.Repository{
+				Name:            "api",
+				OwnerTeamID:     "owner",
+				ReviewerCount:   &three,
+				ShortfallPolicy: &reject,
+				SelectionMode:   &rotation,
+			},
+			candidates: []string{"o1", "o2"},
+			wantTeamID: "owner",
+			wantErr:    modelsErr.ErrNotEnoughReviewers,
+		},
+		{
+			name:       "repository not found",
+			getRepoErr: modelsErr.ErrRepositoryNotFound,
+			wantErr:    modelsErr.ErrRepositoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+			mockRepositories := mocks.NewMockrepositoriesRepository(ctrl)
+
+			ctx := t.Context()
+
+			u := &useCase{
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				pullRequestsRepository: mockPRRepo,
+				repositoriesRepository: mockRepositories,
+				logger:                 zap.NewNop(),
+				clock:                  fakeclock.NewFake(time.Now()),
+			}
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockRepositories.EXPECT().RepositoryGet(ctx, "api").Return(tt.repository, tt.getRepoErr)
+
+			if tt.getRepoErr == nil {
+				if tt.repository.OwnerTeamID == "" {
+					mockTeamRepo.EXPECT().GetUserTeams(ctx, "author").
+						Return([]models.UserTeam{{ID: "team", Name: "backend"}}, nil)
+				}
+				mockTeamRepo.EXPECT().GetTeamPolicy(ctx, tt.wantTeamID).
+					Return(&models.TeamPolicy{ShortfallPolicy: models.ShortfallPolicyAllow}, nil)
+				mockTeamRepo.EXPECT().GetActiveTeammates(ctx, tt.wantTeamID, []string{"author"}, gomock.Any()).
+					Return(toCandidates(tt.candidates), nil)
+				if tt.repository.SelectionMode != nil {
+					mockPRRepo.EXPECT().GetRecentPairings(ctx, "author", tt.candidates, gomock.Any()).
+						Return(map[string]int{}, nil)
+				}
+				if len(tt.candidates) < reviewerCount(tt.repository) {
+					mockTeamRepo.EXPECT().GetFallbackTeamIDs(ctx, tt.wantTeamID).Return(nil, nil)
+				}
+			}
+
+			pr := &models.PR{ID: "pr1", Repository: "api", AssignedReviewers: tt.wantReviewers}
+			if tt.wantErr == nil {
+				mockPRRepo.EXPECT().PullRequestCreate(ctx, models.PR{
+					AssignedReviewers: tt.wantReviewers,
+					AuthorID:          "author",
+					ID:                "pr1",
+					Name:              "name",
+					TeamID:            tt.wantTeamID,
+					Repository:        "api",
+				}).Return(pr, nil)
+			}
+
+			result, shortfall, err := u.PullRequestCreate(ctx, "api", "author", "pr1", "name", "", nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantShortfall, shortfall)
+			assert.Equal(t, pr, result)
+		})
+	}
+}
+
 func TestUseCase_PullRequestReassign(t *testing.T) {
 	t.Parallel()
 
@@ -133,7 +450,10 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 		Name:              "name1",
 		AuthorID:          "author1",
 		AssignedReviewers: []string{"u1", "u2"},
+		TeamID:            "team",
 	}
+	teamlessPR := inputPR
+	teamlessPR.TeamID = ""
 
 	tests := []struct {
 		name string
@@ -144,7 +464,6 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 		expectPR      *models.PR
 		expectNewID   []string
 
-		getTeamErr      error
 		getPRerr        error
 		getTeammatesErr error
 		reassignPRErr   error
@@ -163,25 +482,23 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 			},
 			expectNewID: []string{"u3"},
 
-			getTeamErr:      nil,
 			getPRerr:        nil,
 			getTeammatesErr: nil,
 			reassignPRErr:   nil,
 			wantErr:         nil,
 		},
 		{
-			name:          "error in GetTeamIDByUserID",
+			name:          "PR without team",
 			teamID:        "team",
-			pr:            inputPR,
+			pr:            teamlessPR,
 			oldReviewerID: "u1",
 			expectPR:      nil,
 			expectNewID:   []string{""},
 
-			getTeamErr:      modelsErr.ErrInternal,
 			getPRerr:        nil,
 			getTeammatesErr: nil,
 			reassignPRErr:   nil,
-			wantErr:         modelsErr.ErrInternal,
+			wantErr:         modelsErr.ErrTeamNotFound,
 		},
 		{
 			name:          "error in GetPullRequest",
@@ -191,7 +508,6 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 			expectPR:      nil,
 			expectNewID:   []string{""},
 
-			getTeamErr:      nil,
 			getPRerr:        modelsErr.ErrInternal,
 			getTeammatesErr: nil,
 			reassignPRErr:   nil,
@@ -205,7 +521,6 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 			expectPR:      nil,
 			expectNewID:   []string{""},
 
-			getTeamErr:      nil,
 			getPRerr:        nil,
 			getTeammatesErr: nil,
 			reassignPRErr:   nil,
@@ -219,7 +534,6 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 			expectPR:      nil,
 			expectNewID:   []string{""},
 
-			getTeamErr:      nil,
 			getPRerr:        nil,
 			getTeammatesErr: modelsErr.ErrInternal,
 			reassignPRErr:   nil,
@@ -233,7 +547,6 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 			expectPR:      nil,
 			expectNewID:   []string{},
 
-			getTeamErr:      nil,
 			getPRerr:        nil,
 			getTeammatesErr: nil,
 			reassignPRErr:   nil,
@@ -247,7 +560,6 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 			expectPR:      nil,
 			expectNewID:   []string{""},
 
-			getTeamErr:      nil,
 			getPRerr:        nil,
 			getTeammatesErr: nil,
 			reassignPRErr:   modelsErr.ErrInternal,
@@ -273,20 +585,18 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 				teamRepository:         mockTeamRepo,
 				pullRequestsRepository: mockPRRepo,
 				logger:                 zap.NewNop(),
+				clock:                  fakeclock.NewFake(time.Now()),
 			}
 
 			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
-				func(_ context.Context, fn func(ctx context.Context) error) error {
+				func(_ context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
 					return fn(ctx)
 				},
 			)
 
-			mockTeamRepo.EXPECT().GetTeamIDByUserID(ctx, tt.oldReviewerID).Return(tt.teamID, tt.getTeamErr)
-			if tt.getTeamErr == nil {
-				mockPRRepo.EXPECT().GetPullRequest(ctx, tt.pr.ID).Return(&tt.pr, tt.getPRerr)
-			}
+			mockPRRepo.EXPECT().GetPullRequest(ctx, "", tt.pr.ID).Return(&tt.pr, tt.getPRerr)
 			wasReviewer := false
-			if tt.getTeamErr == nil && tt.getPRerr == nil {
+			if tt.getPRerr == nil && tt.pr.TeamID != "" {
 				for _, r := range inputPR.AssignedReviewers {
 					if r == tt.oldReviewerID {
 						wasReviewer = true
@@ -294,20 +604,24 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 					}
 				}
 				if wasReviewer {
-					mockTeamRepo.EXPECT().GetActiveTeammates(ctx, "team", gomock.Any(), uint64(1)).
-						Return(tt.expectNewID, tt.getTeammatesErr)
+					mockTeamRepo.EXPECT().GetTeamPolicy(ctx, "team").Return(&models.TeamPolicy{}, nil)
+					mockTeamRepo.EXPECT().GetActiveTeammates(ctx, "team", gomock.Any(), gomock.Any()).
+						Return(toCandidates(tt.expectNewID), tt.getTeammatesErr)
+					if tt.getTeammatesErr == nil && len(tt.expectNewID) == 0 {
+						mockTeamRepo.EXPECT().GetFallbackTeamIDs(ctx, "team").Return(nil, nil)
+					}
 				}
 			}
 
-			if tt.getTeamErr == nil &&
+			if tt.pr.TeamID != "" &&
 				tt.getPRerr == nil &&
 				tt.getTeammatesErr == nil &&
 				len(tt.expectNewID) != 0 &&
 				wasReviewer {
-				mockPRRepo.EXPECT().PullRequestReassign(ctx, tt.pr.ID, tt.oldReviewerID, tt.expectNewID[0]).Return(tt.reassignPRErr)
+				mockPRRepo.EXPECT().PullRequestReassign(ctx, "", tt.pr.ID, tt.oldReviewerID, tt.expectNewID[0], false).Return(tt.reassignPRErr)
 			}
 
-			pr, newReviewerID, err := u.PullRequestReassign(ctx, tt.pr.ID, tt.oldReviewerID)
+			pr, newReviewerID, err := u.PullRequestReassign(ctx, "", tt.pr.ID, tt.oldReviewerID, "", nil, nil)
 			if tt.wantErr != nil {
 				require.ErrorIs(t, err, tt.wantErr)
 			} else {
@@ -318,3 +632,363 @@ func TestUseCase_PullRequestReassign(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_PullRequestReassign_Options(t *testing.T) {
+	t.Parallel()
+
+	openPR := func() *models.PR {
+		return &models.PR{
+			ID:                "pr1",
+			AuthorID:          "author",
+			AssignedReviewers: []string{"u1", "u2"},
+			TeamID:            "team",
+		}
+	}
+
+	tests := []struct {
+		name           string
+		newReviewerID  string
+		excludedIDs    []string
+		setup          func(team *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository)
+		wantReplacedBy string
+		wantReviewers  []string
+		wantErr        error
+	}{
+		{
+			name:        "excluded users are skipped",
+			excludedIDs: []string{"u3"},
+			setup: func(team *mocks.MockteamRepository, _ *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				team.EXPECT().GetTeamPolicy(gomock.Any(), "team").Return(&models.TeamPolicy{}, nil)
+				team.EXPECT().GetActiveTeammates(gomock.Any(), "team", []string{"author", "u1", "u2", "u3"}, gomock.Any()).
+					Return(toCandidates([]string{"u4"}), nil)
+				pr.EXPECT().PullRequestReassign(gomock.Any(), "", "pr1", "u1", "u4", false).Return(nil)
+			},
+			wantReplacedBy: "u4",
+			wantReviewers:  []string{"u4", "u2"},
+		},
+		{
+			name:          "pinned replacement",
+			newReviewerID: "u5",
+			excludedIDs:   []string{"u5"},
+			setup: func(team *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				user.EXPECT().GetUser(gomock.Any(), "u5").Return(&models.User{ID: "u5", IsActive: true}, nil)
+				team.EXPECT().GetUserTeams(gomock.Any(), "u5").Return([]models.UserTeam{{ID: "team"}}, nil)
+				pr.EXPECT().PullRequestReassign(gomock.Any(), "", "pr1", "u1", "u5", false).Return(nil)
+			},
+			wantReplacedBy: "u5",
+			wantReviewers:  []string{"u5", "u2"},
+		},
+		{
+			name:          "pinned replacement already assigned",
+			newReviewerID: "u2",
+			setup:         func(*mocks.MockteamRepository, *mocks.MockuserRepository, *mocks.MockpullRequestsRepository) {},
+			wantErr:       modelsErr.ErrAlreadyAssigned,
+		},
+		{
+			name:          "pinned replacement inactive",
+			newReviewerID: "u5",
+			setup: func(_ *mocks.MockteamRepository, user *mocks.MockuserRepository, _ *mocks.MockpullRequestsRepository) {
+				user.EXPECT().GetUser(gomock.Any(), "u5").Return(&models.User{ID: "u5"}, nil)
+			},
+			wantErr: modelsErr.ErrUserInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockUserRepo := mocks.NewMockuserRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+
+			u := &useCase{
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				userRepository:         mockUserRepo,
+				pullRequestsRepository: mockPRRepo,
+				logger:                 zap.NewNop(),
+				clock:                  fakeclock.NewFake(time.Now()),
+			}
+
+			mockTransactor.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockPRRepo.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+			tt.setup(mockTeamRepo, mockUserRepo, mockPRRepo)
+
+			pr, replacedBy, err := u.PullRequestReassign(t.Context(), "", "pr1", "u1", tt.newReviewerID, tt.excludedIDs, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReplacedBy, replacedBy)
+			assert.Equal(t, tt.wantReviewers, pr.AssignedReviewers)
+		})
+	}
+}
+
+func TestUseCase_PullRequestAddReviewer(t *testing.T) {
+	t.Parallel()
+
+	openPR := func() *models.PR {
+		return &models.PR{
+			ID:                "pr1",
+			AuthorID:          "author",
+			AssignedReviewers: []string{"u1"},
+			TeamID:            "team",
+			Version:           1,
+		}
+	}
+
+	staleVersion := int64(0)
+
+	tests := []struct {
+		name            string
+		reviewer        string
+		expectedVersion *int64
+		setup           func(team *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository)
+		expectPR        *models.PR
+		wantErr         error
+	}{
+		{
+			name:     "teammate",
+			reviewer: "u2",
+			setup: func(team *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+				user.EXPECT().GetUser(gomock.Any(), "u2").Return(&models.User{ID: "u2", IsActive: true}, nil)
+				team.EXPECT().GetUserTeams(gomock.Any(), "u2").Return([]models.UserTeam{{ID: "other"}, {ID: "team"}}, nil)
+				pr.EXPECT().PullRequestAddReviewer(gomock.Any(), "", "pr1", "u2", false).Return(nil)
+			},
+			expectPR: &models.PR{
+				ID:                "pr1",
+				AuthorID:          "author",
+				AssignedReviewers: []string{"u1", "u2"},
+				TeamID:            "team",
+				Version:           2,
+			},
+		},
+		{
+			name:     "fallback team member",
+			reviewer: "helper",
+			setup: func(team *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+				user.EXPECT().GetUser(gomock.Any(), "helper").Return(&models.User{ID: "helper", IsActive: true}, nil)
+				team.EXPECT().GetUserTeams(gomock.Any(), "helper").Return([]models.UserTeam{{ID: "other"}}, nil)
+				team.EXPECT().GetFallbackTeamIDs(gomock.Any(), "team").Return([]string{"other"}, nil)
+				pr.EXPECT().PullRequestAddReviewer(gomock.Any(), "", "pr1", "helper", true).Return(nil)
+			},
+			expectPR: &models.PR{
+				ID:                "pr1",
+				AuthorID:          "author",
+				AssignedReviewers: []string{"u1", "helper"},
+				FallbackReviewers: []string{"helper"},
+				TeamID:            "team",
+				Version:           2,
+			},
+		},
+		{
+			name:            "stale version",
+			reviewer:        "u2",
+			expectedVersion: &staleVersion,
+			setup: func(_ *mocks.MockteamRepository, _ *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+			},
+			wantErr: modelsErr.ErrVersionMismatch,
+		},
+		{
+			name:     "merged PR",
+			reviewer: "u2",
+			setup: func(_ *mocks.MockteamRepository, _ *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(nil, modelsErr.ErrPRMerged)
+			},
+			wantErr: modelsErr.ErrPRMerged,
+		},
+		{
+			name:     "author",
+			reviewer: "author",
+			setup: func(_ *mocks.MockteamRepository, _ *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+			},
+			wantErr: modelsErr.ErrAuthorReviewer,
+		},
+		{
+			name:     "already assigned",
+			reviewer: "u1",
+			setup: func(_ *mocks.MockteamRepository, _ *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+			},
+			wantErr: modelsErr.ErrAlreadyAssigned,
+		},
+		{
+			name:     "inactive user",
+			reviewer: "u2",
+			setup: func(_ *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+				user.EXPECT().GetUser(gomock.Any(), "u2").Return(&models.User{ID: "u2"}, nil)
+			},
+			wantErr: modelsErr.ErrUserInactive,
+		},
+		{
+			name:     "user not found",
+			reviewer: "ghost",
+			setup: func(_ *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+				user.EXPECT().GetUser(gomock.Any(), "ghost").Return(nil, modelsErr.ErrUserNotFound)
+			},
+			wantErr: modelsErr.ErrUserNotFound,
+		},
+		{
+			name:     "other team",
+			reviewer: "stranger",
+			setup: func(team *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+				user.EXPECT().GetUser(gomock.Any(), "stranger").Return(&models.User{ID: "stranger", IsActive: true}, nil)
+				team.EXPECT().GetUserTeams(gomock.Any(), "stranger").Return([]models.UserTeam{{ID: "other"}}, nil)
+				team.EXPECT().GetFallbackTeamIDs(gomock.Any(), "team").Return(nil, nil)
+			},
+			wantErr: modelsErr.ErrNotTeamMember,
+		},
+		{
+			name:     "teamless user",
+			reviewer: "loner",
+			setup: func(team *mocks.MockteamRepository, user *mocks.MockuserRepository, pr *mocks.MockpullRequestsRepository) {
+				pr.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(openPR(), nil)
+				user.EXPECT().GetUser(gomock.Any(), "loner").Return(&models.User{ID: "loner", IsActive: true}, nil)
+				team.EXPECT().GetUserTeams(gomock.Any(), "loner").Return([]models.UserTeam{}, nil)
+				team.EXPECT().GetFallbackTeamIDs(gomock.Any(), "team").Return([]string{"other"}, nil)
+			},
+			wantErr: modelsErr.ErrNotTeamMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			mockUserRepo := mocks.NewMockuserRepository(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+
+			u := &useCase{
+				transactor:             mockTransactor,
+				teamRepository:         mockTeamRepo,
+				userRepository:         mockUserRepo,
+				pullRequestsRepository: mockPRRepo,
+				logger:                 zap.NewNop(),
+			}
+
+			mockTransactor.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			tt.setup(mockTeamRepo, mockUserRepo, mockPRRepo)
+
+			pr, err := u.PullRequestAddReviewer(t.Context(), "", "pr1", tt.reviewer, tt.expectedVersion)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectPR, pr)
+		})
+	}
+}
+
+func TestUseCase_PullRequestRemoveReviewer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		reviewer  string
+		getPR     *models.PR
+		getPRErr  error
+		removeErr error
+		expectPR  *models.PR
+		wantErr   error
+	}{
+		{
+			name:     "success",
+			reviewer: "helper",
+			getPR: &models.PR{
+				ID:                "pr1",
+				AssignedReviewers: []string{"u1", "helper"},
+				FallbackReviewers: []string{"helper"},
+			},
+			expectPR: &models.PR{
+				ID:                "pr1",
+				AssignedReviewers: []string{"u1"},
+				FallbackReviewers: []string{},
+				Version:           1,
+			},
+		},
+		{
+			name:     "not assigned",
+			reviewer: "u2",
+			getPR:    &models.PR{ID: "pr1", AssignedReviewers: []string{"u1"}},
+			wantErr:  modelsErr.ErrNotAssigned,
+		},
+		{
+			name:     "merged PR",
+			reviewer: "u1",
+			getPRErr: modelsErr.ErrPRMerged,
+			wantErr:  modelsErr.ErrPRMerged,
+		},
+		{
+			name:      "error in PullRequestRemoveReviewer",
+			reviewer:  "u1",
+			getPR:     &models.PR{ID: "pr1", AssignedReviewers: []string{"u1"}},
+			removeErr: modelsErr.ErrInternal,
+			wantErr:   modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+
+			u := &useCase{
+				transactor:             mockTransactor,
+				pullRequestsRepository: mockPRRepo,
+				logger:                 zap.NewNop(),
+			}
+
+			mockTransactor.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockPRRepo.EXPECT().GetPullRequest(gomock.Any(), "", "pr1").Return(tt.getPR, tt.getPRErr)
+			if tt.removeErr != nil || tt.wantErr == nil {
+				mockPRRepo.EXPECT().PullRequestRemoveReviewer(gomock.Any(), "", "pr1", tt.reviewer).Return(tt.removeErr)
+			}
+
+			pr, err := u.PullRequestRemoveReviewer(t.Context(), "", "pr1", tt.reviewer, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectPR, pr)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package pr_service
+
+import (
+	"context"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (u *useCase) RepositoryAdd(
+	ctx context.Context,
+	repository models.Repository,
+) (*models.Repository, error) {
+	created, err := u.repositoriesRepository.RepositoryAdd(ctx, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (u *useCase) RepositoryGet(
+	ctx context.Context,
+	name string,
+) (*models.Repository, error) {
+	repository, err := u.repositoriesRepository.RepositoryGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+func (u *useCase) RepositoryList(
+	ctx context.Context,
+) ([]models.Repository, error) {
+	repositories, err := u.repositoriesRepository.RepositoryList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return repositories, nil
+}
+
+func (u *useCase) RepositoryUpdate(
+	ctx context.Context,
+	repository models.Repository,
+) (*models.Repository, error) {
+	updated, err := u.repositoriesRepository.RepositoryUpdate(ctx, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (u *useCase) RepositoryDelete(
+	ctx context.Context,
+	name string,
+) error {
+	return u.repositoriesRepository.RepositoryDelete(ctx, name)
+}
+
+func (u *useCase) getPRRepository(
+	ctx context.Context,
+	name string,
+) (*models.Repository, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	return u.repositoriesRepository.RepositoryGet(ctx, name)
+}
+
+// настройки репозитория перекрывают политику команды только там, где они заданы
+func applyRepositoryOverrides(
+	policy *models.TeamPolicy,
+	repository *models.Repository,
+) {
+	if repository == nil {
+		return
+	}
+	if repository.ShortfallPolicy != nil {
+		policy.ShortfallPolicy = *repository.ShortfallPolicy
+	}
+	if repository.SelectionMode != nil {
+		policy.SelectionMode = *repository.SelectionMode
+	}
+}
+
+func reviewerCount(repository *models.Repository) int {
+	if repository == nil || repository.ReviewerCount == nil {
+		return countMaxReviewers
+	}
+
+	return *repository.ReviewerCount
+}
@@ -0,0 +1,145 @@
+package pr_service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+func (u *useCase) pickReviewers(
+	ctx context.Context,
+	teamID string,
+	policy *models.TeamPolicy,
+	authorID string,
+	excludedUsers []string,
+	count int,
+) (reviewers, fallbackReviewers []string, err error) {
+	if count == 0 {
+		return nil, nil, nil
+	}
+
+	now := u.clock.Now()
+
+	candidates, err := u.teamRepository.GetActiveTeammates(ctx, teamID, excludedUsers, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = u.countRecentPairings(ctx, policy, authorID, candidates); err != nil {
+		return nil, nil, err
+	}
+
+	reviewers = u.selectReviewers(candidates, count)
+	if len(reviewers) == count {
+		return reviewers, nil, nil
+	}
+
+	fallbackTeamIDs, err := u.teamRepository.GetFallbackTeamIDs(ctx, teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	excluded := slices.Concat(excludedUsers, reviewers)
+	for _, fallbackTeamID := range fallbackTeamIDs {
+		if len(reviewers) == count {
+			break
+		}
+
+		candidates, err = u.teamRepository.GetActiveTeammates(ctx, fallbackTeamID, excluded, now)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = u.countRecentPairings(ctx, policy, authorID, candidates); err != nil {
+			return nil, nil, err
+		}
+
+		selected := u.selectReviewers(candidates, count-len(reviewers))
+		reviewers = append(reviewers, selected...)
+		fallbackReviewers = append(fallbackReviewers, selected...)
+		excluded = append(excluded, selected...)
+	}
+
+	return reviewers, fallbackReviewers, nil
+}
+
+func (u *useCase) countRecentPairings(
+	ctx context.Context,
+	policy *models.TeamPolicy,
+	authorID string,
+	candidates []models.Candidate,
+) error {
+	if policy.SelectionMode != models.SelectionModeRotation || len(candidates) == 0 {
+		return nil
+	}
+
+	reviewerIDs := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		reviewerIDs[i] = candidate.UserID
+	}
+
+	since := u.clock.Now().Add(-policy.RotationWindow)
+	pairings, err := u.pullRequestsRepository.GetRecentPairings(ctx, authorID, reviewerIDs, since)
+	if err != nil {
+		return err
+	}
+
+	for i := range candidates {
+		candidates[i].RecentPairings = pairings[candidates[i].UserID]
+	}
+
+	return nil
+}
+
+func (u *useCase) selectReviewers(
+	candidates []models.Candidate,
+	count int,
+) []string {
+	now := u.clock.Now()
+
+	slices.SortStableFunc(candidates, func(a, b models.Candidate) int {
+		return cmp.Compare(a.RecentPairings, b.RecentPairings)
+	})
+
+	working := make([]string, 0, len(candidates))
+	var offHours []string
+	for _, candidate := range candidates {
+		if isWithinWorkingHours(candidate, now) {
+			working = append(working, candidate.UserID)
+		} else {
+			offHours = append(offHours, candidate.UserID)
+		}
+	}
+
+	selected := working
+	selected = append(selected, offHours...)
+	if len(selected) > count {
+		selected = selected[:count]
+	}
+
+	return selected
+}
+
+func isWithinWorkingHours(candidate models.Candidate, now time.Time) bool {
+	if candidate.WorkingHours == nil {
+		return true
+	}
+
+	loc, err := time.LoadLocation(candidate.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := now.In(loc)
+	sinceMidnight := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second
+
+	start, end := candidate.WorkingHours.Start, candidate.WorkingHours.End
+	if start <= end {
+		return sinceMidnight >= start && sinceMidnight < end
+	}
+
+	return sinceMidnight >= start || sinceMidnight < end
+}
@@ -0,0 +1,191 @@
+package pr_service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
+)
+
+func toCandidates(ids []string) []models.Candidate {
+	candidates := make([]models.Candidate, 0, len(ids))
+	for _, id := range ids {
+		candidates = append(candidates, models.Candidate{UserID: id})
+	}
+	return candidates
+}
+
+func TestUseCase_SelectReviewers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+	office := &models.WorkingHours{Start: 9 * time.Hour, End: 18 * time.Hour}
+
+	tests := []struct {
+		name       string
+		candidates []models.Candidate
+		count      int
+		want       []string
+	}{
+		{
+			name: "working hours first",
+			candidates: []models.Candidate{
+				{UserID: "tokyo", TimeZone: "Asia/Tokyo", WorkingHours: office},
+				{UserID: "berlin", TimeZone: "Europe/Berlin", WorkingHours: office},
+				{UserID: "always"},
+			},
+			count: 2,
+			want:  []string{"berlin", "always"},
+		},
+		{
+			name: "off-hours fallback",
+			candidates: []models.Candidate{
+				{UserID: "tokyo", TimeZone: "Asia/Tokyo", WorkingHours: office},
+				{UserID: "berlin", TimeZone: "Europe/Berlin", WorkingHours: office},
+			},
+			count: 2,
+			want:  []string{"berlin", "tokyo"},
+		},
+		{
+			name: "overnight shift",
+			candidates: []models.Candidate{
+				{UserID: "day", TimeZone: "UTC", WorkingHours: &models.WorkingHours{Start: 12 * time.Hour, End: 20 * time.Hour}},
+				{UserID: "night", TimeZone: "UTC", WorkingHours: &models.WorkingHours{Start: 22 * time.Hour, End: 11 * time.Hour}},
+			},
+			count: 1,
+			want:  []string{"night"},
+		},
+		{
+			name: "least recent pairings first",
+			candidates: []models.Candidate{
+				{UserID: "frequent", RecentPairings: 3},
+				{UserID: "berlin", TimeZone: "Europe/Berlin", WorkingHours: office, RecentPairings: 1},
+				{UserID: "never"},
+			},
+			count: 2,
+			want:  []string{"never", "berlin"},
+		},
+		{
+			name:       "no candidates",
+			candidates: nil,
+			count:      2,
+			want:       []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := &useCase{clock: fakeclock.NewFake(now)}
+			assert.Equal(t, tt.want, u.selectReviewers(tt.candidates, tt.count))
+		})
+	}
+}
+
+func TestUseCase_PickReviewers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		own           []string
+		fallbacks     map[string][]string
+		fallbackOrder []string
+		wantReviewers []string
+		wantFallback  []string
+	}{
+		{
+			name:          "own team is enough",
+			own:           []string{"u1", "u2"},
+			wantReviewers: []string{"u1", "u2"},
+		},
+		{
+			name:          "fills from fallbacks by priority",
+			own:           []string{"u1"},
+			fallbacks:     map[string][]string{"backend": {}, "platform": {"p1", "p2"}},
+			fallbackOrder: []string{"backend", "platform"},
+			wantReviewers: []string{"u1", "p1"},
+			wantFallback:  []string{"p1"},
+		},
+		{
+			name:          "fallbacks exhausted",
+			own:           nil,
+			fallbacks:     map[string][]string{"backend": {"b1"}},
+			fallbackOrder: []string{"backend"},
+			wantReviewers: []string{"b1"},
+			wantFallback:  []string{"b1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			u := &useCase{
+				teamRepository: mockTeamRepo,
+				clock:          fakeclock.NewFake(now),
+			}
+			ctx := t.Context()
+
+			mockTeamRepo.EXPECT().GetActiveTeammates(ctx, "team", []string{"author"}, now).
+				Return(toCandidates(tt.own), nil)
+			if len(tt.own) < countMaxReviewers {
+				mockTeamRepo.EXPECT().GetFallbackTeamIDs(ctx, "team").Return(tt.fallbackOrder, nil)
+				for _, teamID := range tt.fallbackOrder {
+					mockTeamRepo.EXPECT().GetActiveTeammates(ctx, teamID, gomock.Any(), now).
+						Return(toCandidates(tt.fallbacks[teamID]), nil)
+				}
+			}
+
+			reviewers, fallbackReviewers, err := u.pickReviewers(ctx, "team", &models.TeamPolicy{}, "author", []string{"author"}, countMaxReviewers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReviewers, reviewers)
+			assert.Equal(t, tt.wantFallback, fallbackReviewers)
+		})
+	}
+}
+
+func TestUseCase_PickReviewers_Rotation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+	policy := &models.TeamPolicy{
+		SelectionMode:  models.SelectionModeRotation,
+		RotationWindow: 7 * 24 * time.Hour,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+	mockPRRepo := mocks.NewMockpullRequestsRepository(ctrl)
+	u := &useCase{
+		teamRepository:         mockTeamRepo,
+		pullRequestsRepository: mockPRRepo,
+		clock:                  fakeclock.NewFake(now),
+	}
+	ctx := t.Context()
+
+	mockTeamRepo.EXPECT().GetActiveTeammates(ctx, "team", []string{"author"}, now).
+		Return(toCandidates([]string{"u1", "u2", "u3"}), nil)
+	mockPRRepo.EXPECT().
+		GetRecentPairings(ctx, "author", []string{"u1", "u2", "u3"}, now.Add(-policy.RotationWindow)).
+		Return(map[string]int{"u1": 4, "u2": 1}, nil)
+
+	reviewers, fallbackReviewers, err := u.pickReviewers(ctx, "team", policy, "author", []string{"author"}, countMaxReviewers)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u3", "u2"}, reviewers)
+	assert.Empty(t, fallbackReviewers)
+}
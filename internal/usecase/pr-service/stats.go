@@ -0,0 +1,102 @@
+package pr_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+const week = 7 * 24 * time.Hour
+
+func (u *useCase) TeamStats(
+	ctx context.Context,
+	query models.TeamStatsQuery,
+) ([]models.TeamStats, uint64, error) {
+	now := u.clock.Now().UTC()
+	firstWeek := startOfWeek(now).Add(-time.Duration(query.Weeks-1) * week)
+
+	filter := models.TeamStatsFilter{
+		Since:       firstWeek,
+		StaleBefore: now.Add(-time.Duration(query.StaleDays) * 24 * time.Hour),
+		Limit:       query.PageSize,
+		Offset:      (query.Page - 1) * query.PageSize,
+	}
+
+	stats, total, err := u.statsRepository.TeamStats(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	repositories, err := u.repositoriesRepository.RepositoryList(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range stats {
+		stats[i].Weekly = fillMissingWeeks(stats[i].Weekly, firstWeek, query.Weeks)
+		stats[i].ReviewerCount = teamReviewerCount(stats[i].TeamName, repositories)
+		stats[i].Understaffed = stats[i].ActiveMembers < stats[i].ReviewerCount
+	}
+
+	return stats, total, nil
+}
+
+func (u *useCase) TeamPairings(
+	ctx context.Context,
+	teamName string,
+	days int,
+) ([]models.ReviewPairing, time.Time, error) {
+	since := u.clock.Now().UTC().Add(-time.Duration(days) * 24 * time.Hour)
+
+	pairings, err := u.statsRepository.TeamPairings(ctx, teamName, since)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return pairings, since, nil
+}
+
+// teamReviewerCount — наибольшее число ревьюверов, которое могут запросить у команды:
+// PR вне репозиториев назначаются по умолчанию, а репозитории без владельца
+// берут ревьюверов из команды автора, поэтому их настройка относится к любой команде
+func teamReviewerCount(teamName string, repositories []models.Repository) int {
+	count := countMaxReviewers
+	for _, repository := range repositories {
+		if repository.OwnerTeam != "" && repository.OwnerTeam != teamName {
+			continue
+		}
+		count = max(count, reviewerCount(&repository))
+	}
+	return count
+}
+
+func startOfWeek(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func fillMissingWeeks(
+	weekly []models.WeeklyThroughput,
+	firstWeek time.Time,
+	weeks int,
+) []models.WeeklyThroughput {
+	byWeek := make(map[time.Time]models.WeeklyThroughput, len(weekly))
+	for _, w := range weekly {
+		byWeek[w.WeekStart.UTC()] = w
+	}
+
+	filled := make([]models.WeeklyThroughput, 0, weeks)
+	for i := range weeks {
+		weekStart := firstWeek.Add(time.Duration(i) * week)
+		w, ok := byWeek[weekStart]
+		if !ok {
+			w = models.WeeklyThroughput{WeekStart: weekStart}
+		}
+		w.WeekStart = weekStart
+		filled = append(filled, w)
+	}
+
+	return filled
+}
@@ -0,0 +1,227 @@
+package pr_service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
+)
+
+func TestUseCase_TeamStats(t *testing.T) {
+	t.Parallel()
+
+	one, three, four := 1, 3, 4
+
+	tests := []struct {
+		name  string
+		query models.TeamStatsQuery
+
+		repoStats    []models.TeamStats
+		repoTotal    uint64
+		repoErr      error
+		repositories []models.Repository
+
+		wantUnderstaffed   []bool
+		wantReviewerCounts []int
+		wantTotal          uint64
+		wantErr            error
+	}{
+		{
+			name:  "success",
+			query: models.TeamStatsQuery{Page: 2, PageSize: 10, Weeks: 3, StaleDays: 7},
+			repoStats: []models.TeamStats{
+				{TeamName: "small", ActiveMembers: 1},
+				{TeamName: "big", ActiveMembers: 5},
+			},
+			repoTotal:          12,
+			wantUnderstaffed:   []bool{true, false},
+			wantReviewerCounts: []int{countMaxReviewers, countMaxReviewers},
+			wantTotal:          12,
+		},
+		{
+			name:  "reviewer count from repositories",
+			query: models.TeamStatsQuery{Page: 1, PageSize: 10, Weeks: 1, StaleDays: 7},
+			repoStats: []models.TeamStats{
+				{TeamName: "platform", ActiveMembers: 3},
+				{TeamName: "mobile", ActiveMembers: 3},
+				{TeamName: "web", ActiveMembers: 3},
+			},
+			repoTotal: 3,
+			repositories: []models.Repository{
+				{Name: "infra", OwnerTeam: "platform", ReviewerCount: &four},
+				{Name: "app", OwnerTeam: "mobile", ReviewerCount: &one},
+				{Name: "docs", ReviewerCount: &three},
+			},
+			wantUnderstaffed:   []bool{true, false, false},
+			wantReviewerCounts: []int{4, 3, 3},
+			wantTotal:          3,
+		},
+		{
+			name:    "error from repository",
+			query:   models.TeamStatsQuery{Page: 1, PageSize: 10, Weeks: 1, StaleDays: 1},
+			repoErr: modelsErr.ErrInternal,
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStatsRepo := mocks.NewMockstatsRepository(ctrl)
+			mockRepositoriesRepo := mocks.NewMockrepositoriesRepository(ctrl)
+			now := time.Date(2025, 10, 22, 15, 0, 0, 0, time.UTC)
+			u := &useCase{
+				statsRepository:        mockStatsRepo,
+				repositoriesRepository: mockRepositoriesRepo,
+				clock:                  fakeclock.NewFake(now),
+			}
+
+			mockStatsRepo.EXPECT().
+				TeamStats(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, filter models.TeamStatsFilter) ([]models.TeamStats, uint64, error) {
+					assert.Equal(t, tt.query.PageSize, filter.Limit)
+					assert.Equal(t, (tt.query.Page-1)*tt.query.PageSize, filter.Offset)
+					firstWeek := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -7*(tt.query.Weeks-1))
+					assert.Equal(t, firstWeek, filter.Since)
+					assert.Equal(t, now.AddDate(0, 0, -tt.query.StaleDays), filter.StaleBefore)
+					return tt.repoStats, tt.repoTotal, tt.repoErr
+				})
+			if tt.repoErr == nil {
+				mockRepositoriesRepo.EXPECT().RepositoryList(gomock.Any()).Return(tt.repositories, nil)
+			}
+
+			stats, total, err := u.TeamStats(t.Context(), tt.query)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, total)
+			require.Len(t, stats, len(tt.wantUnderstaffed))
+			for i, s := range stats {
+				assert.Equal(t, tt.wantUnderstaffed[i], s.Understaffed)
+				assert.Equal(t, tt.wantReviewerCounts[i], s.ReviewerCount)
+				assert.Len(t, s.Weekly, tt.query.Weeks)
+			}
+		})
+	}
+}
+
+func TestUseCase_TeamPairings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		repoPairings []models.ReviewPairing
+		repoErr      error
+		wantErr      error
+	}{
+		{
+			name: "success",
+			repoPairings: []models.ReviewPairing{
+				{AuthorID: "u1", ReviewerID: "u2", Reviews: 3},
+			},
+		},
+		{
+			name:    "team not found",
+			repoErr: modelsErr.ErrTeamNotFound,
+			wantErr: modelsErr.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStatsRepo := mocks.NewMockstatsRepository(ctrl)
+			mockRepositoriesRepo := mocks.NewMockrepositoriesRepository(ctrl)
+			now := time.Date(2025, 10, 22, 15, 0, 0, 0, time.UTC)
+			u := &useCase{
+				statsRepository:        mockStatsRepo,
+				repositoriesRepository: mockRepositoriesRepo,
+				clock:                  fakeclock.NewFake(now),
+			}
+
+			wantSince := now.AddDate(0, 0, -14)
+			mockStatsRepo.EXPECT().
+				TeamPairings(gomock.Any(), "core", wantSince).
+				Return(tt.repoPairings, tt.repoErr)
+
+			pairings, since, err := u.TeamPairings(t.Context(), "core", 14)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, wantSince, since)
+			assert.Equal(t, tt.repoPairings, pairings)
+		})
+	}
+}
+
+func TestFillMissingWeeks(t *testing.T) {
+	t.Parallel()
+
+	firstWeek := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+	weekly := []models.WeeklyThroughput{
+		{WeekStart: firstWeek.AddDate(0, 0, 7), Opened: 3, Merged: 1},
+	}
+
+	filled := fillMissingWeeks(weekly, firstWeek, 3)
+
+	assert.Equal(t, []models.WeeklyThroughput{
+		{WeekStart: firstWeek},
+		{WeekStart: firstWeek.AddDate(0, 0, 7), Opened: 3, Merged: 1},
+		{WeekStart: firstWeek.AddDate(0, 0, 14)},
+	}, filled)
+}
+
+func TestStartOfWeek(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   time.Time
+		want time.Time
+	}{
+		{
+			name: "sunday",
+			in:   time.Date(2025, 10, 26, 23, 59, 0, 0, time.UTC),
+			want: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "monday",
+			in:   time.Date(2025, 10, 20, 8, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "wednesday",
+			in:   time.Date(2025, 10, 22, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, startOfWeek(tt.in))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package pr_service
 
 import (
 	"context"
+	"slices"
 
 	"github.com/Tortik3000/PR-service/internal/models"
 	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
@@ -34,3 +35,160 @@ func (u *useCase) TeamGet(
 
 	return team, nil
 }
+
+func (u *useCase) SetTeamPolicy(
+	ctx context.Context,
+	policy models.TeamPolicy,
+) (*models.TeamPolicy, error) {
+	updated, err := u.teamRepository.SetTeamPolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (u *useCase) SetTeamFallbacks(
+	ctx context.Context,
+	teamName string,
+	fallbackTeams []string,
+) ([]string, error) {
+	if slices.Contains(fallbackTeams, teamName) {
+		return nil, modelsErr.ErrInvalidFallback
+	}
+
+	if err := u.teamRepository.SetTeamFallbacks(ctx, teamName, fallbackTeams); err != nil {
+		return nil, err
+	}
+
+	return fallbackTeams, nil
+}
+
+func (u *useCase) TeamUpdateMembers(
+	ctx context.Context,
+	change models.TeamMembersChange,
+	expectedVersion *int64,
+) (*models.Team, error) {
+	for _, member := range change.Add {
+		if slices.Contains(change.Remove, member.UserID) {
+			return nil, modelsErr.ErrInvalidMembers
+		}
+	}
+
+	var team *models.Team
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkTeamVersion(ctx, change.TeamName, expectedVersion); err != nil {
+			return err
+		}
+
+		if err := u.teamRepository.TeamUpdateMembers(ctx, change); err != nil {
+			return err
+		}
+
+		// читаем в той же транзакции: вне её запрос может уйти на отстающую реплику
+		var err error
+		team, err = u.teamRepository.TeamGet(ctx, change.TeamName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(team.Members) == 0 {
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return team, nil
+}
+
+func (u *useCase) TeamRename(
+	ctx context.Context,
+	teamName, newTeamName string,
+	expectedVersion *int64,
+) (*models.Team, error) {
+	var team *models.Team
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkTeamVersion(ctx, teamName, expectedVersion); err != nil {
+			return err
+		}
+
+		if err := u.teamRepository.TeamRename(ctx, teamName, newTeamName); err != nil {
+			return err
+		}
+
+		var err error
+		team, err = u.teamRepository.TeamGet(ctx, newTeamName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(team.Members) == 0 {
+		return nil, modelsErr.ErrTeamNotFound
+	}
+
+	return team, nil
+}
+
+func (u *useCase) TeamDelete(
+	ctx context.Context,
+	teamName string,
+	openPRPolicy models.OpenPRPolicy,
+	expectedVersion *int64,
+) (*models.TeamDeletion, error) {
+	var deletion *models.TeamDeletion
+
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.checkTeamVersion(ctx, teamName, expectedVersion); err != nil {
+			return err
+		}
+
+		var err error
+		deletion, err = u.teamRepository.TeamDelete(ctx, teamName, openPRPolicy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+func (u *useCase) checkTeamVersion(
+	ctx context.Context,
+	teamName string,
+	expectedVersion *int64,
+) error {
+	if expectedVersion == nil {
+		return nil
+	}
+
+	version, err := u.teamRepository.GetTeamVersion(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	return checkVersion(version, expectedVersion)
+}
+
+// nil — клиент не передал If-Match, проверка не нужна
+func checkVersion(version int64, expectedVersion *int64) error {
+	if expectedVersion != nil && *expectedVersion != version {
+		return modelsErr.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+func (u *useCase) TeamAudit(
+	ctx context.Context,
+	teamName string,
+) ([]models.TeamAuditEntry, error) {
+	entries, err := u.teamRepository.TeamAudit(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
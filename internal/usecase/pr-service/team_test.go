@@ -1,6 +1,8 @@
 package pr_service
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -88,3 +90,171 @@ func TestUseCase_TeamGet(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_TeamUpdateMembers(t *testing.T) {
+	t.Parallel()
+
+	team := &models.Team{
+		Name:    "team",
+		Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+	}
+
+	version := int64(3)
+	staleVersion := int64(2)
+
+	tests := []struct {
+		name            string
+		change          models.TeamMembersChange
+		expectedVersion *int64
+		updateErr       error
+		wantTeam        *models.Team
+		wantErr         error
+	}{
+		{
+			name: "success",
+			change: models.TeamMembersChange{
+				TeamName: "team",
+				Add:      []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+				Remove:   []string{"u2"},
+			},
+			wantTeam: team,
+		},
+		{
+			name: "same user added and removed",
+			change: models.TeamMembersChange{
+				TeamName: "team",
+				Add:      []models.Member{{UserID: "u1"}},
+				Remove:   []string{"u1"},
+			},
+			wantErr: modelsErr.ErrInvalidMembers,
+		},
+		{
+			name: "removed user not in team",
+			change: models.TeamMembersChange{
+				TeamName: "team",
+				Remove:   []string{"u3"},
+			},
+			updateErr: modelsErr.ErrUserNotInTeam,
+			wantErr:   modelsErr.ErrUserNotInTeam,
+		},
+		{
+			name: "matching version",
+			change: models.TeamMembersChange{
+				TeamName: "team",
+				Remove:   []string{"u2"},
+			},
+			expectedVersion: &version,
+			wantTeam:        team,
+		},
+		{
+			name: "stale version",
+			change: models.TeamMembersChange{
+				TeamName: "team",
+				Remove:   []string{"u2"},
+			},
+			expectedVersion: &staleVersion,
+			wantErr:         modelsErr.ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			u := &useCase{
+				transactor:     mockTransactor,
+				teamRepository: mockTeamRepo,
+			}
+
+			if !errors.Is(tt.wantErr, modelsErr.ErrInvalidMembers) {
+				mockTransactor.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+						return fn(ctx)
+					},
+				)
+			}
+			if tt.expectedVersion != nil {
+				mockTeamRepo.EXPECT().GetTeamVersion(gomock.Any(), "team").Return(version, nil)
+			}
+			if !errors.Is(tt.wantErr, modelsErr.ErrInvalidMembers) && !errors.Is(tt.wantErr, modelsErr.ErrVersionMismatch) {
+				mockTeamRepo.EXPECT().TeamUpdateMembers(gomock.Any(), tt.change).Return(tt.updateErr)
+			}
+			if tt.updateErr == nil && tt.wantErr == nil {
+				mockTeamRepo.EXPECT().TeamGet(gomock.Any(), "team").Return(team, nil)
+			}
+
+			result, err := u.TeamUpdateMembers(t.Context(), tt.change, tt.expectedVersion)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTeam, result)
+		})
+	}
+}
+
+func TestUseCase_TeamRename(t *testing.T) {
+	t.Parallel()
+
+	team := &models.Team{
+		Name:    "platform",
+		Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+	}
+
+	tests := []struct {
+		name      string
+		renameErr error
+		wantTeam  *models.Team
+		wantErr   error
+	}{
+		{
+			name:     "success",
+			wantTeam: team,
+		},
+		{
+			name:      "name taken",
+			renameErr: modelsErr.ErrTeamExist,
+			wantErr:   modelsErr.ErrTeamExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			mockTeamRepo := mocks.NewMockteamRepository(ctrl)
+			u := &useCase{
+				transactor:     mockTransactor,
+				teamRepository: mockTeamRepo,
+			}
+
+			mockTransactor.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					return fn(ctx)
+				},
+			)
+			mockTeamRepo.EXPECT().TeamRename(gomock.Any(), "backend", "platform").Return(tt.renameErr)
+			if tt.renameErr == nil {
+				mockTeamRepo.EXPECT().TeamGet(gomock.Any(), "platform").Return(team, nil)
+			}
+
+			result, err := u.TeamRename(t.Context(), "backend", "platform", nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTeam, result)
+		})
+	}
+}
@@ -0,0 +1,427 @@
+package pr_service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+)
+
+// errImportRolledBack откатывает транзакцию импорта при dry-run или ошибках в строках
+var errImportRolledBack = errors.New("import rolled back")
+
+// importLineError — ошибка в данных строки: попадает в отчёт, а не прерывает импорт
+type importLineError struct {
+	err error
+}
+
+func (e importLineError) Error() string {
+	return e.err.Error()
+}
+
+func (e importLineError) Unwrap() error {
+	return e.err
+}
+
+func lineErrorf(format string, args ...any) error {
+	return importLineError{err: fmt.Errorf(format, args...)}
+}
+
+// Import применяет записи по порядку в одной транзакции. Сначала все записи
+// проверяются сами по себе, затем применяются: ссылки на команды, пользователей
+// и репозитории проверяются заранее, чтобы ошибка строки не обрывала транзакцию.
+// При dry-run или хотя бы одной ошибке транзакция откатывается.
+func (u *useCase) Import(
+	ctx context.Context,
+	batch models.ImportBatch,
+	dryRun bool,
+) (*models.ImportReport, error) {
+	validationErrors := slices.Clone(batch.Errors)
+
+	now := u.clock.Now()
+	defined := make(map[string]int, len(batch.Records))
+	records := make([]models.TransferRecord, 0, len(batch.Records))
+	for _, record := range batch.Records {
+		if err := validateTransferRecord(&record, defined, now); err != nil {
+			validationErrors = append(validationErrors, models.ImportError{Line: record.Line, Message: err.Error()})
+			continue
+		}
+		records = append(records, record)
+	}
+
+	var report *models.ImportReport
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		// транзакция может повториться, счётчики прошлой попытки не должны утечь
+		report = &models.ImportReport{
+			DryRun: dryRun,
+			Errors: slices.Clone(validationErrors),
+		}
+
+		for _, record := range records {
+			err := u.importRecord(ctx, report, record)
+			if lineErr := (importLineError{}); errors.As(err, &lineErr) {
+				report.Errors = append(report.Errors, models.ImportError{Line: record.Line, Message: lineErr.Error()})
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if dryRun || len(report.Errors) > 0 {
+			return errImportRolledBack
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportRolledBack) {
+		return nil, err
+	}
+
+	report.Applied = err == nil
+	slices.SortStableFunc(report.Errors, func(a, b models.ImportError) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+
+	return report, nil
+}
+
+func (u *useCase) importRecord(
+	ctx context.Context,
+	report *models.ImportReport,
+	record models.TransferRecord,
+) error {
+	switch record.Kind {
+	case models.TransferKindTeam:
+		return u.importTeam(ctx, &report.Teams, *record.Team)
+	case models.TransferKindUser:
+		return u.importUser(ctx, &report.Users, *record.User)
+	case models.TransferKindPullRequest:
+		return u.importPullRequest(ctx, &report.PullRequests, *record.PR)
+	default:
+		return lineErrorf("unknown kind %q", record.Kind)
+	}
+}
+
+// участники, которых нет в записи, из команды не исключаются
+func (u *useCase) importTeam(
+	ctx context.Context,
+	counts *models.ImportCounts,
+	team models.Team,
+) error {
+	_, err := u.teamRepository.GetTeamVersion(ctx, team.Name)
+	if errors.Is(err, modelsErr.ErrTeamNotFound) {
+		err = u.teamRepository.TeamAdd(ctx, team)
+		// идентификатор, занятый другой организацией, — ошибка строки, а не сбой импорта
+		if errors.Is(err, modelsErr.ErrUserIDTaken) {
+			return lineErrorf("team %s: %w", team.Name, err)
+		}
+		if err != nil {
+			return err
+		}
+		counts.Created++
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	current, err := u.teamRepository.TeamGet(ctx, team.Name)
+	if err != nil {
+		return err
+	}
+
+	var changed []models.Member
+	for _, member := range team.Members {
+		if !slices.Contains(current.Members, member) {
+			changed = append(changed, member)
+		}
+	}
+	if len(changed) == 0 {
+		counts.Unchanged++
+		return nil
+	}
+
+	err = u.teamRepository.TeamUpdateMembers(ctx, models.TeamMembersChange{
+		TeamName: team.Name,
+		Add:      changed,
+	})
+	if errors.Is(err, modelsErr.ErrUserIDTaken) {
+		return lineErrorf("team %s: %w", team.Name, err)
+	}
+	if err != nil {
+		return err
+	}
+	counts.Updated++
+
+	return nil
+}
+
+func (u *useCase) importUser(
+	ctx context.Context,
+	counts *models.ImportCounts,
+	user models.User,
+) error {
+	current, err := u.userRepository.GetUser(ctx, user.ID)
+	switch {
+	case errors.Is(err, modelsErr.ErrUserNotFound):
+		counts.Created++
+	case err != nil:
+		return err
+	case sameUser(current, &user):
+		counts.Unchanged++
+		return nil
+	default:
+		counts.Updated++
+	}
+
+	err = u.transferRepository.ImportUser(ctx, user)
+	if errors.Is(err, modelsErr.ErrUserIDTaken) {
+		return lineErrorf("user %s: %w", user.ID, err)
+	}
+	return err
+}
+
+// существующие PR не изменяются: импорт переносит историю, а не правит её
+func (u *useCase) importPullRequest(
+	ctx context.Context,
+	counts *models.ImportCounts,
+	pr models.PR,
+) error {
+	_, err := u.pullRequestsRepository.GetPullRequestVersion(ctx, pr.Repository, pr.ID)
+	if err == nil {
+		counts.Unchanged++
+		return nil
+	}
+	if !errors.Is(err, modelsErr.ErrPRNotFound) {
+		return err
+	}
+
+	if pr.Repository != "" {
+		_, err = u.repositoriesRepository.RepositoryGet(ctx, pr.Repository)
+		if errors.Is(err, modelsErr.ErrRepositoryNotFound) {
+			return lineErrorf("repository %s: %w", pr.Repository, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if pr.TeamName != "" {
+		_, err = u.teamRepository.GetTeamVersion(ctx, pr.TeamName)
+		if errors.Is(err, modelsErr.ErrTeamNotFound) {
+			return lineErrorf("team %s: %w", pr.TeamName, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = u.checkUserExists(ctx, "author", pr.AuthorID); err != nil {
+		return err
+	}
+	for _, reviewerID := range pr.AssignedReviewers {
+		if err = u.checkUserExists(ctx, "reviewer", reviewerID); err != nil {
+			return err
+		}
+	}
+
+	if err = u.transferRepository.ImportPullRequest(ctx, pr); err != nil {
+		return err
+	}
+	counts.Created++
+
+	return nil
+}
+
+func (u *useCase) checkUserExists(ctx context.Context, role, userID string) error {
+	_, err := u.userRepository.GetUser(ctx, userID)
+	if errors.Is(err, modelsErr.ErrUserNotFound) {
+		return lineErrorf("%s %s: %w", role, userID, err)
+	}
+	return err
+}
+
+// Export передаёт в fn команды, затем пользователей, затем PR. Всё читается в одной
+// транзакции repeatable read, поэтому выгрузка согласована, даже если данные меняются.
+func (u *useCase) Export(
+	ctx context.Context,
+	fn func(record models.TransferRecord) error,
+) error {
+	return u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		err := u.transferRepository.ExportTeams(ctx, func(team models.Team) error {
+			return fn(models.TransferRecord{Kind: models.TransferKindTeam, Team: &team})
+		})
+		if err != nil {
+			return err
+		}
+
+		err = u.transferRepository.ExportUsers(ctx, func(user models.User) error {
+			return fn(models.TransferRecord{Kind: models.TransferKindUser, User: &user})
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.transferRepository.ExportPullRequests(ctx, func(pr models.PR) error {
+			return fn(models.TransferRecord{Kind: models.TransferKindPullRequest, PR: &pr})
+		})
+	}, models.WithIsolationLevel(models.IsolationLevelRepeatableRead), models.WithReadOnly())
+}
+
+// validateTransferRecord проверяет запись без обращения к хранилищу и дополняет
+// необязательные поля значениями по умолчанию. defined — строки, где уже встречались
+// сущности из файла: повтор одной сущности почти наверняка ошибка в выгрузке.
+func validateTransferRecord(record *models.TransferRecord, defined map[string]int, now time.Time) error {
+	var key string
+	switch record.Kind {
+	case models.TransferKindTeam:
+		if record.Team == nil {
+			return errors.New("team is required for kind team")
+		}
+		if err := validateImportTeam(*record.Team); err != nil {
+			return err
+		}
+		key = "team " + record.Team.Name
+
+	case models.TransferKindUser:
+		if record.User == nil {
+			return errors.New("user is required for kind user")
+		}
+		if err := validateImportUser(record.User); err != nil {
+			return err
+		}
+		key = "user " + record.User.ID
+
+	case models.TransferKindPullRequest:
+		if record.PR == nil {
+			return errors.New("pull_request is required for kind pull_request")
+		}
+		if err := validateImportPullRequest(record.PR, now); err != nil {
+			return err
+		}
+		key = "pull request " + record.PR.ID
+		if record.PR.Repository != "" {
+			key += " in repository " + record.PR.Repository
+		}
+
+	default:
+		return fmt.Errorf("unknown kind %q", record.Kind)
+	}
+
+	if line, ok := defined[key]; ok {
+		return fmt.Errorf("%s is already defined on line %d", key, line)
+	}
+	defined[key] = record.Line
+
+	return nil
+}
+
+func validateImportTeam(team models.Team) error {
+	if team.Name == "" {
+		return errors.New("team_name is required")
+	}
+	if len(team.Members) == 0 {
+		return errors.New("team must have at least one member")
+	}
+
+	userIDs := make(map[string]struct{}, len(team.Members))
+	for _, member := range team.Members {
+		if member.UserID == "" || member.Username == "" {
+			return errors.New("member user_id and username are required")
+		}
+		if _, ok := userIDs[member.UserID]; ok {
+			return fmt.Errorf("member %s is listed twice", member.UserID)
+		}
+		userIDs[member.UserID] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateImportUser(user *models.User) error {
+	if user.ID == "" || user.Name == "" {
+		return errors.New("user_id and username are required")
+	}
+
+	if user.TimeZone == "" {
+		user.TimeZone = "UTC"
+	}
+	if _, err := time.LoadLocation(user.TimeZone); err != nil {
+		return modelsErr.ErrInvalidTimeZone
+	}
+
+	if user.MaxOpenReviews != nil && *user.MaxOpenReviews < 1 {
+		return errors.New("max_open_reviews must be positive")
+	}
+
+	return nil
+}
+
+func validateImportPullRequest(pr *models.PR, now time.Time) error {
+	if pr.ID == "" || pr.Name == "" || pr.AuthorID == "" {
+		return errors.New("pull_request_id, pull_request_name and author_id are required")
+	}
+
+	// метки времени хранятся в UTC: колонки без зоны сдвинули бы другие смещения
+	createdAt := now
+	if pr.CreatedAt != nil {
+		createdAt = pr.CreatedAt.UTC()
+	}
+	pr.CreatedAt = &createdAt
+	if pr.MergedAt != nil {
+		mergedAt := pr.MergedAt.UTC()
+		pr.MergedAt = &mergedAt
+	}
+
+	switch pr.Status {
+	case models.PRStatusOPEN:
+		if pr.MergedAt != nil {
+			return errors.New("mergedAt is set for an open pull request")
+		}
+	case models.PRStatusMERGED:
+		if pr.MergedAt == nil {
+			return errors.New("mergedAt is required for a merged pull request")
+		}
+		if pr.MergedAt.Before(*pr.CreatedAt) {
+			return modelsErr.ErrInvalidPeriod
+		}
+	}
+
+	for i, reviewerID := range pr.AssignedReviewers {
+		if reviewerID == "" {
+			return errors.New("assigned_reviewers must not contain empty IDs")
+		}
+		if reviewerID == pr.AuthorID {
+			return modelsErr.ErrAuthorReviewer
+		}
+		if slices.Contains(pr.AssignedReviewers[:i], reviewerID) {
+			return fmt.Errorf("reviewer %s is assigned twice", reviewerID)
+		}
+	}
+	for _, reviewerID := range pr.FallbackReviewers {
+		if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+			return fmt.Errorf("fallback reviewer %s is not in assigned_reviewers", reviewerID)
+		}
+	}
+
+	return nil
+}
+
+func sameUser(current, imported *models.User) bool {
+	sameHours := current.WorkingHours == nil && imported.WorkingHours == nil ||
+		current.WorkingHours != nil && imported.WorkingHours != nil &&
+			*current.WorkingHours == *imported.WorkingHours
+	sameLimit := current.MaxOpenReviews == nil && imported.MaxOpenReviews == nil ||
+		current.MaxOpenReviews != nil && imported.MaxOpenReviews != nil &&
+			*current.MaxOpenReviews == *imported.MaxOpenReviews
+
+	return current.Name == imported.Name &&
+		current.IsActive == imported.IsActive &&
+		current.TimeZone == imported.TimeZone &&
+		sameHours && sameLimit
+}
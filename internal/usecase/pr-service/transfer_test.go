@@ -0,0 +1,337 @@
+package pr_service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	fakeclock "github.com/Tortik3000/PR-service/internal/clock"
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/usecase/pr-service/mocks"
+)
+
+type transferMocks struct {
+	team     *mocks.MockteamRepository
+	user     *mocks.MockuserRepository
+	pr       *mocks.MockpullRequestsRepository
+	repos    *mocks.MockrepositoriesRepository
+	transfer *mocks.MocktransferRepository
+}
+
+func TestUseCase_Import(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 12, 22, 10, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-time.Hour)
+	createdAtMoscow := createdAt.In(time.FixedZone("UTC+3", 3*60*60))
+	mergedAtNewYork := now.In(time.FixedZone("UTC-5", -5*60*60))
+
+	team := models.Team{Name: "backend", Members: []models.Member{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob", IsActive: true},
+	}}
+	user := models.User{ID: "u1", Name: "Alice", IsActive: true, TimeZone: "UTC"}
+	pr := models.PR{
+		ID:                "pr-1",
+		Name:              "feature",
+		AuthorID:          "u1",
+		Status:            models.PRStatusOPEN,
+		AssignedReviewers: []string{"u2"},
+		CreatedAt:         &createdAt,
+		TeamName:          "backend",
+	}
+
+	tests := []struct {
+		name         string
+		batch        models.ImportBatch
+		dryRun       bool
+		attempts     int
+		mockBehavior func(m transferMocks)
+		want         *models.ImportReport
+		wantErr      error
+	}{
+		{
+			name: "new entities are created",
+			batch: models.ImportBatch{Records: []models.TransferRecord{
+				{Kind: models.TransferKindTeam, Team: &team, Line: 1},
+				{Kind: models.TransferKindUser, User: &user, Line: 2},
+				{Kind: models.TransferKindPullRequest, PR: &pr, Line: 3},
+			}},
+			mockBehavior: func(m transferMocks) {
+				m.team.EXPECT().GetTeamVersion(gomock.Any(), "backend").Return(int64(0), modelsErr.ErrTeamNotFound)
+				m.team.EXPECT().TeamAdd(gomock.Any(), team).Return(nil)
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(nil, modelsErr.ErrUserNotFound)
+				m.transfer.EXPECT().ImportUser(gomock.Any(), user).Return(nil)
+				m.pr.EXPECT().GetPullRequestVersion(gomock.Any(), "", "pr-1").Return(int64(0), modelsErr.ErrPRNotFound)
+				m.team.EXPECT().GetTeamVersion(gomock.Any(), "backend").Return(int64(1), nil)
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(&user, nil)
+				m.user.EXPECT().GetUser(gomock.Any(), "u2").Return(&models.User{ID: "u2"}, nil)
+				m.transfer.EXPECT().ImportPullRequest(gomock.Any(), pr).Return(nil)
+			},
+			want: &models.ImportReport{
+				Teams:        models.ImportCounts{Created: 1},
+				Users:        models.ImportCounts{Created: 1},
+				PullRequests: models.ImportCounts{Created: 1},
+				Applied:      true,
+			},
+		},
+		{
+			name: "existing entities are updated or left unchanged",
+			batch: models.ImportBatch{Records: []models.TransferRecord{
+				{Kind: models.TransferKindTeam, Team: &team, Line: 1},
+				{Kind: models.TransferKindUser, User: &user, Line: 2},
+				{Kind: models.TransferKindPullRequest, PR: &pr, Line: 3},
+			}},
+			mockBehavior: func(m transferMocks) {
+				m.team.EXPECT().GetTeamVersion(gomock.Any(), "backend").Return(int64(1), nil)
+				m.team.EXPECT().TeamGet(gomock.Any(), "backend").Return(&models.Team{
+					Name:    "backend",
+					Members: []models.Member{{UserID: "u1", Username: "Alice", IsActive: true}},
+				}, nil)
+				m.team.EXPECT().TeamUpdateMembers(gomock.Any(), models.TeamMembersChange{
+					TeamName: "backend",
+					Add:      []models.Member{{UserID: "u2", Username: "Bob", IsActive: true}},
+				}).Return(nil)
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(&models.User{
+					ID: "u1", Name: "Alice", IsActive: true, TimeZone: "UTC", TeamName: "backend",
+				}, nil)
+				m.pr.EXPECT().GetPullRequestVersion(gomock.Any(), "", "pr-1").Return(int64(3), nil)
+			},
+			want: &models.ImportReport{
+				Teams:        models.ImportCounts{Updated: 1},
+				Users:        models.ImportCounts{Unchanged: 1},
+				PullRequests: models.ImportCounts{Unchanged: 1},
+				Applied:      true,
+			},
+		},
+		{
+			name:   "dry run is not applied",
+			dryRun: true,
+			batch: models.ImportBatch{Records: []models.TransferRecord{
+				{Kind: models.TransferKindUser, User: &models.User{ID: "u1", Name: "Alice"}, Line: 1},
+			}},
+			mockBehavior: func(m transferMocks) {
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(nil, modelsErr.ErrUserNotFound)
+				m.transfer.EXPECT().ImportUser(gomock.Any(), models.User{ID: "u1", Name: "Alice", TimeZone: "UTC"}).Return(nil)
+			},
+			want: &models.ImportReport{
+				Users:  models.ImportCounts{Created: 1},
+				DryRun: true,
+			},
+		},
+		{
+			name: "invalid lines are reported",
+			batch: models.ImportBatch{
+				Records: []models.TransferRecord{
+					{Kind: models.TransferKindUser, User: &user, Line: 1},
+					{Kind: models.TransferKindUser, User: &user, Line: 3},
+					{Kind: models.TransferKindUser, User: &models.User{ID: "u3", Name: "Eve", TimeZone: "Mars/Base"}, Line: 4},
+					{Kind: models.TransferKindPullRequest, PR: &models.PR{
+						ID: "pr-2", Name: "fix", AuthorID: "u1", Status: models.PRStatusMERGED,
+					}, Line: 5},
+					{Kind: models.TransferKindTeam, Line: 6},
+					{Kind: "repository", Line: 7},
+					{Kind: models.TransferKindPullRequest, PR: &models.PR{
+						ID: "pr-3", Name: "docs", AuthorID: "u9", CreatedAt: &createdAt,
+					}, Line: 8},
+				},
+				Errors: []models.ImportError{{Line: 2, Message: "invalid JSON"}},
+			},
+			mockBehavior: func(m transferMocks) {
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(nil, modelsErr.ErrUserNotFound)
+				m.transfer.EXPECT().ImportUser(gomock.Any(), user).Return(nil)
+				m.pr.EXPECT().GetPullRequestVersion(gomock.Any(), "", "pr-3").Return(int64(0), modelsErr.ErrPRNotFound)
+				m.user.EXPECT().GetUser(gomock.Any(), "u9").Return(nil, modelsErr.ErrUserNotFound)
+			},
+			want: &models.ImportReport{
+				Users: models.ImportCounts{Created: 1},
+				Errors: []models.ImportError{
+					{Line: 2, Message: "invalid JSON"},
+					{Line: 3, Message: "user u1 is already defined on line 1"},
+					{Line: 4, Message: modelsErr.ErrInvalidTimeZone.Error()},
+					{Line: 5, Message: "mergedAt is required for a merged pull request"},
+					{Line: 6, Message: "team is required for kind team"},
+					{Line: 7, Message: `unknown kind "repository"`},
+					{Line: 8, Message: "author u9: " + modelsErr.ErrUserNotFound.Error()},
+				},
+			},
+		},
+		{
+			name: "user id of another organization is reported",
+			batch: models.ImportBatch{Records: []models.TransferRecord{
+				{Kind: models.TransferKindUser, User: &user, Line: 1},
+			}},
+			mockBehavior: func(m transferMocks) {
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(nil, modelsErr.ErrUserNotFound)
+				m.transfer.EXPECT().ImportUser(gomock.Any(), user).Return(modelsErr.ErrUserIDTaken)
+			},
+			want: &models.ImportReport{
+				Users:  models.ImportCounts{Created: 1},
+				Errors: []models.ImportError{{Line: 1, Message: "user u1: " + modelsErr.ErrUserIDTaken.Error()}},
+			},
+		},
+		{
+			name:     "retried transaction reports only the last attempt",
+			attempts: 2,
+			batch: models.ImportBatch{
+				Records: []models.TransferRecord{
+					{Kind: models.TransferKindUser, User: &user, Line: 1},
+					{Kind: models.TransferKindTeam, Line: 3},
+				},
+				Errors: []models.ImportError{{Line: 2, Message: "invalid JSON"}},
+			},
+			mockBehavior: func(m transferMocks) {
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(nil, modelsErr.ErrUserNotFound).Times(2)
+				m.transfer.EXPECT().ImportUser(gomock.Any(), user).Return(nil).Times(2)
+			},
+			want: &models.ImportReport{
+				Users: models.ImportCounts{Created: 1},
+				Errors: []models.ImportError{
+					{Line: 2, Message: "invalid JSON"},
+					{Line: 3, Message: "team is required for kind team"},
+				},
+			},
+		},
+		{
+			name: "pull request timestamps are converted to UTC",
+			batch: models.ImportBatch{Records: []models.TransferRecord{
+				{Kind: models.TransferKindPullRequest, PR: &models.PR{
+					ID:                "pr-1",
+					Name:              "feature",
+					AuthorID:          "u1",
+					Status:            models.PRStatusMERGED,
+					AssignedReviewers: []string{},
+					CreatedAt:         &createdAtMoscow,
+					MergedAt:          &mergedAtNewYork,
+				}, Line: 1},
+			}},
+			mockBehavior: func(m transferMocks) {
+				m.pr.EXPECT().GetPullRequestVersion(gomock.Any(), "", "pr-1").Return(int64(0), modelsErr.ErrPRNotFound)
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(&user, nil)
+				m.transfer.EXPECT().ImportPullRequest(gomock.Any(), models.PR{
+					ID:                "pr-1",
+					Name:              "feature",
+					AuthorID:          "u1",
+					Status:            models.PRStatusMERGED,
+					AssignedReviewers: []string{},
+					CreatedAt:         &createdAt,
+					MergedAt:          &now,
+				}).Return(nil)
+			},
+			want: &models.ImportReport{
+				PullRequests: models.ImportCounts{Created: 1},
+				Applied:      true,
+			},
+		},
+		{
+			name: "repository error aborts import",
+			batch: models.ImportBatch{Records: []models.TransferRecord{
+				{Kind: models.TransferKindUser, User: &user, Line: 1},
+			}},
+			mockBehavior: func(m transferMocks) {
+				m.user.EXPECT().GetUser(gomock.Any(), "u1").Return(nil, modelsErr.ErrInternal)
+			},
+			wantErr: modelsErr.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := transferMocks{
+				team:     mocks.NewMockteamRepository(ctrl),
+				user:     mocks.NewMockuserRepository(ctrl),
+				pr:       mocks.NewMockpullRequestsRepository(ctrl),
+				repos:    mocks.NewMockrepositoriesRepository(ctrl),
+				transfer: mocks.NewMocktransferRepository(ctrl),
+			}
+			mockTransactor := mocks.NewMocktransactor(ctrl)
+			u := &useCase{
+				teamRepository:         m.team,
+				userRepository:         m.user,
+				pullRequestsRepository: m.pr,
+				repositoriesRepository: m.repos,
+				transferRepository:     m.transfer,
+				transactor:             mockTransactor,
+				clock:                  fakeclock.NewFake(now),
+			}
+
+			mockTransactor.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error, _ ...models.TxOption) error {
+					// повтор после конфликта сериализации вызывает fn заново
+					for range tt.attempts - 1 {
+						_ = fn(ctx)
+					}
+					return fn(ctx)
+				},
+			)
+			tt.mockBehavior(m)
+
+			report, err := u.Import(t.Context(), tt.batch, tt.dryRun)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, report)
+		})
+	}
+}
+
+func TestUseCase_Export(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransferRepo := mocks.NewMocktransferRepository(ctrl)
+	mockTransactor := mocks.NewMocktransactor(ctrl)
+	u := &useCase{
+		transferRepository: mockTransferRepo,
+		transactor:         mockTransactor,
+	}
+
+	team := models.Team{Name: "backend"}
+	user := models.User{ID: "u1"}
+	pr := models.PR{ID: "pr-1"}
+
+	mockTransactor.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, opts ...models.TxOption) error {
+			assert.Equal(t, models.TxOptions{
+				IsolationLevel: models.IsolationLevelRepeatableRead,
+				ReadOnly:       true,
+			}, models.NewTxOptions(opts...))
+			return fn(ctx)
+		},
+	)
+	mockTransferRepo.EXPECT().ExportTeams(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(models.Team) error) error { return fn(team) },
+	)
+	mockTransferRepo.EXPECT().ExportUsers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(models.User) error) error { return fn(user) },
+	)
+	mockTransferRepo.EXPECT().ExportPullRequests(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(models.PR) error) error { return fn(pr) },
+	)
+
+	var records []models.TransferRecord
+	err := u.Export(t.Context(), func(record models.TransferRecord) error {
+		records = append(records, record)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []models.TransferRecord{
+		{Kind: models.TransferKindTeam, Team: &team},
+		{Kind: models.TransferKindUser, User: &user},
+		{Kind: models.TransferKindPullRequest, PR: &pr},
+	}, records)
+}
@@ -2,8 +2,10 @@ package pr_service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
 )
 
 func (u *useCase) GetReview(
@@ -30,3 +32,33 @@ func (u *useCase) SetIsActive(
 
 	return user, nil
 }
+
+func (u *useCase) SetSchedule(
+	ctx context.Context,
+	userID, timeZone string,
+	workingHours *models.WorkingHours,
+) (*models.User, error) {
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return nil, modelsErr.ErrInvalidTimeZone
+	}
+
+	user, err := u.userRepository.SetSchedule(ctx, userID, timeZone, workingHours)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (u *useCase) SetMaxOpenReviews(
+	ctx context.Context,
+	userID string,
+	maxOpenReviews *int,
+) (*models.User, error) {
+	user, err := u.userRepository.SetMaxOpenReviews(ctx, userID, maxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+)
+
+//go:generate mockgen_uber -source=escalation.go -destination=mocks/escalation_mock.go -package=mocks
+
+type escalationUseCase interface {
+	EscalateStaleReviews(ctx context.Context) ([]models.Escalation, error)
+}
+
+type escalationWorker struct {
+	logger   *zap.Logger
+	useCase  escalationUseCase
+	interval time.Duration
+}
+
+func NewEscalationWorker(
+	logger *zap.Logger,
+	useCase escalationUseCase,
+	interval time.Duration,
+) *escalationWorker {
+	return &escalationWorker{
+		logger:   logger,
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+func (w *escalationWorker) Run(ctx context.Context) {
+	runPeriodically(ctx, w.logger.With(zap.String("worker", "escalation")), w.interval, w.runOnce)
+}
+
+func (w *escalationWorker) runOnce(ctx context.Context) {
+	escalations, err := w.useCase.EscalateStaleReviews(ctx)
+	if err != nil {
+		w.logger.Error("escalate stale reviews", zap.Error(err))
+		return
+	}
+
+	if len(escalations) > 0 {
+		w.logger.Info("stale reviews escalated", zap.Int("count", len(escalations)))
+	}
+}
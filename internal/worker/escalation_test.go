@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/Tortik3000/PR-service/internal/models"
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/worker/mocks"
+)
+
+func TestEscalationWorker_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		results []error
+	}{
+		{
+			name:    "keeps running after errors",
+			results: []error{modelsErr.ErrInternal, nil},
+		},
+		{
+			name:    "success",
+			results: []error{nil, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+
+			var calls atomic.Int32
+			mockUseCase := mocks.NewMockescalationUseCase(ctrl)
+			mockUseCase.EXPECT().
+				EscalateStaleReviews(gomock.Any()).
+				DoAndReturn(func(context.Context) ([]models.Escalation, error) {
+					call := int(calls.Add(1))
+					if call >= len(tt.results) {
+						cancel()
+						return nil, nil
+					}
+					return []models.Escalation{{PRID: "pr1"}}, tt.results[call-1]
+				}).
+				MinTimes(len(tt.results))
+
+			w := NewEscalationWorker(zap.NewNop(), mockUseCase, time.Millisecond)
+
+			done := make(chan struct{})
+			go func() {
+				w.Run(ctx)
+				close(done)
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				require.FailNow(t, "worker did not stop after context cancellation")
+			}
+			assert.GreaterOrEqual(t, int(calls.Load()), len(tt.results))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+//go:generate mockgen_uber -source=idempotency.go -destination=mocks/idempotency_mock.go -package=mocks
+
+type idempotencyUseCase interface {
+	PurgeExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+type idempotencyWorker struct {
+	logger   *zap.Logger
+	useCase  idempotencyUseCase
+	interval time.Duration
+}
+
+func NewIdempotencyWorker(
+	logger *zap.Logger,
+	useCase idempotencyUseCase,
+	interval time.Duration,
+) *idempotencyWorker {
+	return &idempotencyWorker{
+		logger:   logger,
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+func (w *idempotencyWorker) Run(ctx context.Context) {
+	runPeriodically(ctx, w.logger.With(zap.String("worker", "idempotency")), w.interval, w.runOnce)
+}
+
+func (w *idempotencyWorker) runOnce(ctx context.Context) {
+	deleted, err := w.useCase.PurgeExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		w.logger.Error("purge expired idempotency keys", zap.Error(err))
+		return
+	}
+
+	if deleted > 0 {
+		w.logger.Info("expired idempotency keys purged", zap.Int64("count", deleted))
+	}
+}
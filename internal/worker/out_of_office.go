@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+//go:generate mockgen_uber -source=out_of_office.go -destination=mocks/out_of_office_mock.go -package=mocks
+
+type outOfOfficeUseCase interface {
+	ReassignOutOfOfficeReviews(ctx context.Context) (int, error)
+}
+
+type outOfOfficeWorker struct {
+	logger   *zap.Logger
+	useCase  outOfOfficeUseCase
+	interval time.Duration
+}
+
+func NewOutOfOfficeWorker(
+	logger *zap.Logger,
+	useCase outOfOfficeUseCase,
+	interval time.Duration,
+) *outOfOfficeWorker {
+	return &outOfOfficeWorker{
+		logger:   logger,
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+func (w *outOfOfficeWorker) Run(ctx context.Context) {
+	runPeriodically(ctx, w.logger.With(zap.String("worker", "out_of_office")), w.interval, w.runOnce)
+}
+
+func (w *outOfOfficeWorker) runOnce(ctx context.Context) {
+	reassigned, err := w.useCase.ReassignOutOfOfficeReviews(ctx)
+	if err != nil {
+		w.logger.Error("reassign out of office reviews", zap.Error(err))
+		return
+	}
+
+	if reassigned > 0 {
+		w.logger.Info("out of office reviews reassigned", zap.Int("count", reassigned))
+	}
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	modelsErr "github.com/Tortik3000/PR-service/internal/models/errors"
+	"github.com/Tortik3000/PR-service/internal/worker/mocks"
+)
+
+func TestOutOfOfficeWorker_Run(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	results := []error{modelsErr.ErrInternal, nil}
+
+	var calls atomic.Int32
+	mockUseCase := mocks.NewMockoutOfOfficeUseCase(ctrl)
+	mockUseCase.EXPECT().
+		ReassignOutOfOfficeReviews(gomock.Any()).
+		DoAndReturn(func(context.Context) (int, error) {
+			call := int(calls.Add(1))
+			if call >= len(results) {
+				cancel()
+				return 0, nil
+			}
+			return 1, results[call-1]
+		}).
+		MinTimes(len(results))
+
+	w := NewOutOfOfficeWorker(zap.NewNop(), mockUseCase, time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.FailNow(t, "worker did not stop after context cancellation")
+	}
+	assert.GreaterOrEqual(t, int(calls.Load()), len(results))
+}
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func runPeriodically(
+	ctx context.Context,
+	logger *zap.Logger,
+	interval time.Duration,
+	job func(ctx context.Context),
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logger.Info("worker started", zap.Duration("interval", interval))
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("worker stopped")
+			return
+		case <-ticker.C:
+			job(ctx)
+		}
+	}
+}